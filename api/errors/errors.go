@@ -19,6 +19,33 @@ var ErrGetBalance = errors.New("get balance error")
 // ErrGetUsername signals an error in getting the username for an account
 var ErrGetUsername = errors.New("get username error")
 
+// ErrGetTransactionsByAddress signals an error in getting the transactions history of an account
+var ErrGetTransactionsByAddress = errors.New("get transactions by address error")
+
+// ErrGetLogEvents signals an error in getting the log events emitted by an account
+var ErrGetLogEvents = errors.New("get log events error")
+
+// ErrGetTransactionsPool signals an error in getting a view over the transactions pool
+var ErrGetTransactionsPool = errors.New("get transactions pool error")
+
+// ErrGetTransactionProcessStatus signals an error in following the processing of a transaction
+var ErrGetTransactionProcessStatus = errors.New("get transaction process status error")
+
+// ErrGetGasPriceSuggestions signals an error in suggesting gas prices
+var ErrGetGasPriceSuggestions = errors.New("get gas price suggestions error")
+
+// ErrGetStateStatistics signals an error in getting the state size statistics
+var ErrGetStateStatistics = errors.New("get state statistics error")
+
+// ErrSendTransactionsWithReceipts signals an error in sending transactions with receipts
+var ErrSendTransactionsWithReceipts = errors.New("send transactions with receipts error")
+
+// ErrWaitForTransactionsInclusion signals an error in waiting for transactions to be included in blocks
+var ErrWaitForTransactionsInclusion = errors.New("wait for transactions inclusion error")
+
+// ErrSubscribeToTransactionsPool signals an error in subscribing to the changes of the transactions pool
+var ErrSubscribeToTransactionsPool = errors.New("subscribe to transactions pool error")
+
 // ErrGetValueForKey signals an error in getting the value of a key for an account
 var ErrGetValueForKey = errors.New("get value for key error")
 
@@ -46,9 +73,15 @@ var ErrEmptyKey = errors.New("key is empty")
 // ErrEmptyTokenIdentifier signals that an empty token identifier was provided
 var ErrEmptyTokenIdentifier = errors.New("token identifier is empty")
 
+// ErrEmptyEventIdentifier signals that an empty event identifier was provided
+var ErrEmptyEventIdentifier = errors.New("event identifier is empty")
+
 // ErrEmptyRole signals that an empty role was provided
 var ErrEmptyRole = errors.New("role is empty")
 
+// ErrEmptyRelayer signals that an empty relayer was provided
+var ErrEmptyRelayer = errors.New("relayer is empty")
+
 // ErrNonceInvalid signals that nonce is invalid
 var ErrNonceInvalid = errors.New("nonce is invalid")
 
@@ -58,6 +91,12 @@ var ErrValidation = errors.New("validation error")
 // ErrTxGenerationFailed signals an error generating a transaction
 var ErrTxGenerationFailed = errors.New("transaction generation failed")
 
+// ErrEmptyTransactionsBundle signals that a bundle without transactions was provided
+var ErrEmptyTransactionsBundle = errors.New("empty transactions bundle")
+
+// ErrTooManyTransactionsInBundle signals that a bundle holds too many transactions
+var ErrTooManyTransactionsInBundle = errors.New("too many transactions in bundle")
+
 // ErrValidationEmptyTxHash signals that an empty tx hash was provided
 var ErrValidationEmptyTxHash = errors.New("TxHash is empty")
 
@@ -115,6 +154,24 @@ var ErrNilHttpServer = errors.New("nil http server")
 // ErrCannotCreateGinWebServer signals that the gin web server cannot be created
 var ErrCannotCreateGinWebServer = errors.New("cannot create gin web server")
 
+// ErrInvalidBatchRequest signals that an invalid batch request was received
+var ErrInvalidBatchRequest = errors.New("invalid batch request")
+
+// ErrEmptyBatchRequest signals that a batch request without sub-requests was received
+var ErrEmptyBatchRequest = errors.New("empty batch request")
+
+// ErrTooManyBatchSubRequests signals that a batch request holds too many sub-requests
+var ErrTooManyBatchSubRequests = errors.New("too many batch sub-requests")
+
+// ErrInvalidBatchBlockPin signals that both a block nonce and a block hash were provided for pinning a batch request
+var ErrInvalidBatchBlockPin = errors.New("only one of the block nonce and the block hash can be provided")
+
+// ErrBatchBlockNonceNotAvailable signals that the current block nonce, used for pinning a batch request, is not available
+var ErrBatchBlockNonceNotAvailable = errors.New("current block nonce not available")
+
+// ErrInvalidBatchSubRequest signals that an invalid sub-request was provided in a batch request
+var ErrInvalidBatchSubRequest = errors.New("invalid batch sub-request")
+
 // ErrNilFacadeHandler signals that a nil facade handler has been provided
 var ErrNilFacadeHandler = errors.New("nil facade handler")
 
@@ -123,3 +180,6 @@ var ErrFacadeWrongTypeAssertion = errors.New("facade - wrong type assertion")
 
 // ErrGetGenesisNodes signals that an error happened when trying to feth genesis nodes config
 var ErrGetGenesisNodes = errors.New("getting genesis nodes failed")
+
+// ErrInvalidStateOverrides signals that invalid state overrides have been provided
+var ErrInvalidStateOverrides = errors.New("invalid state overrides")
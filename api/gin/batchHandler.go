@@ -0,0 +1,252 @@
+package gin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	batchPath = "/batch"
+
+	blockNonceParam    = "blockNonce"
+	blockHashParam     = "blockHash"
+	blockRootHashParam = "blockRootHash"
+)
+
+// BatchRequest holds the sub-requests of a /batch request. The block nonce or the block hash, if provided, or else the
+// nonce of the current block at the start of the batch, is passed to all the sub-requests which do not specify a block
+// on their own, so that all of them read the same state
+type BatchRequest struct {
+	BlockNonce *uint64            `json:"blockNonce,omitempty"`
+	BlockHash  string             `json:"blockHash,omitempty"`
+	Requests   []*BatchSubRequest `json:"requests"`
+}
+
+// BatchSubRequest holds an API call which is part of a batch request. The path includes the query string, if any
+type BatchSubRequest struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// BatchSubResponse holds the HTTP status and the response of a sub-request
+type BatchSubResponse struct {
+	Status   int             `json:"status"`
+	Response json.RawMessage `json:"response"`
+}
+
+// batchHandler dispatches the sub-requests of a batch request, one by one, through the same gin engine, so that each
+// sub-request passes through the middlewares and the throttlers of its own endpoint
+type batchHandler struct {
+	engine         http.Handler
+	statusMetrics  statusMetricsProvider
+	maxSubRequests uint32
+}
+
+func registerBatchRoute(ws *gin.Engine, statusMetrics statusMetricsProvider, maxSubRequests uint32) {
+	bh := &batchHandler{
+		engine:         ws,
+		statusMetrics:  statusMetrics,
+		maxSubRequests: maxSubRequests,
+	}
+
+	ws.POST(batchPath, bh.handle)
+}
+
+func (bh *batchHandler) handle(c *gin.Context) {
+	request := &BatchRequest{}
+	err := c.ShouldBindJSON(request)
+	if err == nil {
+		err = bh.checkRequest(request)
+	}
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", apiErrors.ErrInvalidBatchRequest.Error(), err.Error()),
+			shared.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	if request.BlockNonce == nil && len(request.BlockHash) == 0 {
+		currentNonce, errNonce := bh.getCurrentBlockNonce()
+		if errNonce != nil {
+			shared.RespondWith(c, http.StatusInternalServerError, nil, errNonce.Error(), shared.ReturnCodeInternalError)
+			return
+		}
+
+		request.BlockNonce = &currentNonce
+	}
+
+	responses := make([]*BatchSubResponse, 0, len(request.Requests))
+	for _, subRequest := range request.Requests {
+		responses = append(responses, bh.dispatch(c.Request, request, subRequest))
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"responses": responses}, "", shared.ReturnCodeSuccess)
+}
+
+func (bh *batchHandler) checkRequest(request *BatchRequest) error {
+	if len(request.Requests) == 0 {
+		return apiErrors.ErrEmptyBatchRequest
+	}
+	if len(request.Requests) > int(bh.maxSubRequests) {
+		return fmt.Errorf("%w: maximum %d sub-requests are allowed", apiErrors.ErrTooManyBatchSubRequests, bh.maxSubRequests)
+	}
+	if request.BlockNonce != nil && len(request.BlockHash) > 0 {
+		return apiErrors.ErrInvalidBatchBlockPin
+	}
+
+	for idx, subRequest := range request.Requests {
+		if subRequest == nil {
+			return fmt.Errorf("%w at index %d: nil sub-request", apiErrors.ErrInvalidBatchSubRequest, idx)
+		}
+		if subRequest.Method != http.MethodGet && subRequest.Method != http.MethodPost {
+			return fmt.Errorf("%w at index %d: unsupported method %s", apiErrors.ErrInvalidBatchSubRequest, idx, subRequest.Method)
+		}
+		if !strings.HasPrefix(subRequest.Path, "/") || strings.HasPrefix(subRequest.Path, batchPath) {
+			return fmt.Errorf("%w at index %d: invalid path %s", apiErrors.ErrInvalidBatchSubRequest, idx, subRequest.Path)
+		}
+	}
+
+	return nil
+}
+
+func (bh *batchHandler) getCurrentBlockNonce() (uint64, error) {
+	metrics, err := bh.statusMetrics.StatusMetrics().NetworkMetrics()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", apiErrors.ErrBatchBlockNonceNotAvailable, err.Error())
+	}
+
+	currentNonce, ok := metrics[common.MetricNonce].(uint64)
+	if !ok {
+		return 0, apiErrors.ErrBatchBlockNonceNotAvailable
+	}
+
+	return currentNonce, nil
+}
+
+func (bh *batchHandler) dispatch(parent *http.Request, request *BatchRequest, subRequest *BatchSubRequest) *BatchSubResponse {
+	httpRequest, err := createSubRequest(parent, request, subRequest)
+	if err != nil {
+		return newBatchErrorResponse(http.StatusBadRequest, err.Error(), shared.ReturnCodeRequestError)
+	}
+
+	recorder := httptest.NewRecorder()
+	bh.engine.ServeHTTP(recorder, httpRequest)
+
+	responseBytes := recorder.Body.Bytes()
+	if !json.Valid(responseBytes) {
+		return newBatchErrorResponse(recorder.Code, strings.TrimSpace(string(responseBytes)), shared.ReturnCodeRequestError)
+	}
+
+	return &BatchSubResponse{
+		Status:   recorder.Code,
+		Response: responseBytes,
+	}
+}
+
+func createSubRequest(parent *http.Request, request *BatchRequest, subRequest *BatchSubRequest) (*http.Request, error) {
+	subRequestURL, err := url.Parse(subRequest.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	pinQuery(subRequestURL, request)
+	body, err := pinBody(subRequest.Body, request)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := middleware.ContextWithSubRequestMarker(parent.Context())
+	httpRequest, err := http.NewRequestWithContext(ctx, subRequest.Method, subRequestURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	// the sub-requests are accounted by the source throttler as coming from the batch request originator
+	httpRequest.RemoteAddr = parent.RemoteAddr
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	return httpRequest, nil
+}
+
+func pinQuery(subRequestURL *url.URL, request *BatchRequest) {
+	query := subRequestURL.Query()
+	hasQueryParam := func(name string) bool {
+		return len(query.Get(name)) > 0
+	}
+	if hasBlockParam(hasQueryParam) {
+		return
+	}
+
+	if request.BlockNonce != nil {
+		query.Set(blockNonceParam, strconv.FormatUint(*request.BlockNonce, 10))
+	} else {
+		query.Set(blockHashParam, request.BlockHash)
+	}
+	subRequestURL.RawQuery = query.Encode()
+}
+
+func pinBody(body []byte, request *BatchRequest) ([]byte, error) {
+	if len(body) == 0 {
+		return body, nil
+	}
+
+	fields := make(map[string]json.RawMessage)
+	err := json.Unmarshal(body, &fields)
+	if err != nil {
+		// not a JSON object, so it can not be pinned
+		return body, nil
+	}
+
+	hasField := func(name string) bool {
+		_, found := fields[name]
+		return found
+	}
+	if hasBlockParam(hasField) {
+		return body, nil
+	}
+
+	if request.BlockNonce != nil {
+		fields[blockNonceParam], err = json.Marshal(*request.BlockNonce)
+	} else {
+		fields[blockHashParam], err = json.Marshal(request.BlockHash)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(fields)
+}
+
+func hasBlockParam(has func(name string) bool) bool {
+	return has(blockNonceParam) || has(blockHashParam) || has(blockRootHashParam)
+}
+
+func newBatchErrorResponse(status int, errMessage string, code shared.ReturnCode) *BatchSubResponse {
+	response, _ := json.Marshal(shared.GenericAPIResponse{
+		Data:  nil,
+		Error: errMessage,
+		Code:  code,
+	})
+
+	return &BatchSubResponse{
+		Status:   status,
+		Response: response,
+	}
+}
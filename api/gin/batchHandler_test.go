@@ -0,0 +1,277 @@
+package gin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type batchResponse struct {
+	Data struct {
+		Responses []*BatchSubResponse `json:"responses"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+const currentBlockNonce = uint64(42)
+
+func createStatusMetricsProvider(networkMetrics map[string]interface{}, err error) *mock.FacadeStub {
+	return &mock.FacadeStub{
+		StatusMetricsHandler: func() external.StatusMetricsHandler {
+			return &testscommon.StatusMetricsStub{
+				NetworkMetricsCalled: func() (map[string]interface{}, error) {
+					return networkMetrics, err
+				},
+			}
+		},
+	}
+}
+
+func createBatchTestEngine(maxSubRequests uint32, middlewares ...shared.MiddlewareProcessor) *gin.Engine {
+	statusMetrics := createStatusMetricsProvider(map[string]interface{}{common.MetricNonce: currentBlockNonce}, nil)
+
+	return createBatchTestEngineWithStatusMetrics(statusMetrics, maxSubRequests, middlewares...)
+}
+
+func createBatchTestEngineWithStatusMetrics(
+	statusMetrics statusMetricsProvider,
+	maxSubRequests uint32,
+	middlewares ...shared.MiddlewareProcessor,
+) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	ws := gin.New()
+	for _, mw := range middlewares {
+		ws.Use(mw.MiddlewareHandlerFunc())
+	}
+
+	ws.GET("/address/:address/balance", func(c *gin.Context) {
+		data := gin.H{"address": c.Param("address"), "blockNonce": c.Query("blockNonce"), "blockHash": c.Query("blockHash")}
+		shared.RespondWith(c, http.StatusOK, data, "", shared.ReturnCodeSuccess)
+	})
+	ws.POST("/vm-values/query", func(c *gin.Context) {
+		body, _ := ioutil.ReadAll(c.Request.Body)
+		shared.RespondWith(c, http.StatusOK, gin.H{"body": string(body)}, "", shared.ReturnCodeSuccess)
+	})
+	registerBatchRoute(ws, statusMetrics, maxSubRequests)
+
+	return ws
+}
+
+func sendBatchRequest(t *testing.T, ws *gin.Engine, request interface{}) (*batchResponse, int) {
+	buff, _ := json.Marshal(request)
+	req := httptest.NewRequest(http.MethodPost, batchPath, bytes.NewBuffer(buff))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &batchResponse{}
+	err := json.Unmarshal(resp.Body.Bytes(), response)
+	require.Nil(t, err)
+
+	return response, resp.Code
+}
+
+func decodeSubResponse(t *testing.T, subResponse *BatchSubResponse) *shared.GenericAPIResponse {
+	response := &shared.GenericAPIResponse{}
+	err := json.Unmarshal(subResponse.Response, response)
+	require.Nil(t, err)
+
+	return response
+}
+
+func TestBatchHandler_InvalidRequestsShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := createBatchTestEngine(2)
+	blockNonce := uint64(5)
+	getBalance := &BatchSubRequest{Method: http.MethodGet, Path: "/address/alice/balance"}
+
+	testCases := []struct {
+		name        string
+		request     interface{}
+		expectedErr error
+	}{
+		{name: "not a batch request", request: "not a batch request", expectedErr: apiErrors.ErrInvalidBatchRequest},
+		{name: "no sub-requests", request: &BatchRequest{}, expectedErr: apiErrors.ErrEmptyBatchRequest},
+		{
+			name:        "too many sub-requests",
+			request:     &BatchRequest{Requests: []*BatchSubRequest{getBalance, getBalance, getBalance}},
+			expectedErr: apiErrors.ErrTooManyBatchSubRequests,
+		},
+		{
+			name:        "both block nonce and hash",
+			request:     &BatchRequest{BlockNonce: &blockNonce, BlockHash: "aa", Requests: []*BatchSubRequest{getBalance}},
+			expectedErr: apiErrors.ErrInvalidBatchBlockPin,
+		},
+		{
+			name:        "unsupported method",
+			request:     &BatchRequest{Requests: []*BatchSubRequest{{Method: http.MethodDelete, Path: "/address/alice/balance"}}},
+			expectedErr: apiErrors.ErrInvalidBatchSubRequest,
+		},
+		{
+			name:        "nested batch",
+			request:     &BatchRequest{Requests: []*BatchSubRequest{{Method: http.MethodPost, Path: batchPath}}},
+			expectedErr: apiErrors.ErrInvalidBatchSubRequest,
+		},
+		{
+			name:        "relative path",
+			request:     &BatchRequest{Requests: []*BatchSubRequest{{Method: http.MethodGet, Path: "address/alice/balance"}}},
+			expectedErr: apiErrors.ErrInvalidBatchSubRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		response, code := sendBatchRequest(t, ws, tc.request)
+		assert.Equal(t, http.StatusBadRequest, code, tc.name)
+		assert.True(t, strings.Contains(response.Error, tc.expectedErr.Error()), tc.name)
+	}
+}
+
+func TestBatchHandler_ShouldDispatchTheSubRequests(t *testing.T) {
+	t.Parallel()
+
+	ws := createBatchTestEngine(10)
+	request := &BatchRequest{
+		Requests: []*BatchSubRequest{
+			{Method: http.MethodGet, Path: "/address/alice/balance"},
+			{Method: http.MethodPost, Path: "/vm-values/query", Body: json.RawMessage(`{"funcName":"get"}`)},
+			{Method: http.MethodGet, Path: "/missing"},
+		},
+	}
+
+	response, code := sendBatchRequest(t, ws, request)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, 3, len(response.Data.Responses))
+
+	assert.Equal(t, http.StatusOK, response.Data.Responses[0].Status)
+	balanceResponse := decodeSubResponse(t, response.Data.Responses[0])
+	assert.Equal(t, "alice", balanceResponse.Data.(map[string]interface{})["address"])
+	assert.Equal(t, "42", balanceResponse.Data.(map[string]interface{})["blockNonce"])
+
+	assert.Equal(t, http.StatusOK, response.Data.Responses[1].Status)
+	queryResponse := decodeSubResponse(t, response.Data.Responses[1])
+	assert.Equal(t, `{"blockNonce":42,"funcName":"get"}`, queryResponse.Data.(map[string]interface{})["body"])
+
+	assert.Equal(t, http.StatusNotFound, response.Data.Responses[2].Status)
+	notFoundResponse := decodeSubResponse(t, response.Data.Responses[2])
+	assert.Equal(t, shared.ReturnCodeRequestError, notFoundResponse.Code)
+}
+
+func TestBatchHandler_ShouldPinTheSubRequestsToTheSameBlock(t *testing.T) {
+	t.Parallel()
+
+	ws := createBatchTestEngine(10)
+	blockNonce := uint64(37)
+	request := &BatchRequest{
+		BlockNonce: &blockNonce,
+		Requests: []*BatchSubRequest{
+			{Method: http.MethodGet, Path: "/address/alice/balance"},
+			{Method: http.MethodGet, Path: "/address/bob/balance?blockHash=aabb"},
+			{Method: http.MethodPost, Path: "/vm-values/query", Body: json.RawMessage(`{"funcName":"get"}`)},
+			{Method: http.MethodPost, Path: "/vm-values/query", Body: json.RawMessage(`{"blockNonce":2}`)},
+		},
+	}
+
+	response, code := sendBatchRequest(t, ws, request)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, 4, len(response.Data.Responses))
+
+	data := decodeSubResponse(t, response.Data.Responses[0]).Data.(map[string]interface{})
+	assert.Equal(t, "37", data["blockNonce"])
+
+	data = decodeSubResponse(t, response.Data.Responses[1]).Data.(map[string]interface{})
+	assert.Equal(t, "", data["blockNonce"])
+	assert.Equal(t, "aabb", data["blockHash"])
+
+	data = decodeSubResponse(t, response.Data.Responses[2]).Data.(map[string]interface{})
+	assert.Equal(t, `{"blockNonce":37,"funcName":"get"}`, data["body"])
+
+	data = decodeSubResponse(t, response.Data.Responses[3]).Data.(map[string]interface{})
+	assert.Equal(t, `{"blockNonce":2}`, data["body"])
+}
+
+func TestBatchHandler_CurrentBlockNonceNotAvailableShouldError(t *testing.T) {
+	t.Parallel()
+
+	request := &BatchRequest{
+		Requests: []*BatchSubRequest{{Method: http.MethodGet, Path: "/address/alice/balance"}},
+	}
+
+	t.Run("metrics error", func(t *testing.T) {
+		t.Parallel()
+
+		statusMetrics := createStatusMetricsProvider(nil, errors.New("node is starting"))
+		ws := createBatchTestEngineWithStatusMetrics(statusMetrics, 10)
+
+		response, code := sendBatchRequest(t, ws, request)
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBatchBlockNonceNotAvailable.Error()))
+		assert.True(t, strings.Contains(response.Error, "node is starting"))
+	})
+	t.Run("missing nonce metric", func(t *testing.T) {
+		t.Parallel()
+
+		statusMetrics := createStatusMetricsProvider(map[string]interface{}{}, nil)
+		ws := createBatchTestEngineWithStatusMetrics(statusMetrics, 10)
+
+		response, code := sendBatchRequest(t, ws, request)
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Equal(t, apiErrors.ErrBatchBlockNonceNotAvailable.Error(), response.Error)
+	})
+	t.Run("pinned request should not read the current nonce", func(t *testing.T) {
+		t.Parallel()
+
+		statusMetrics := createStatusMetricsProvider(nil, errors.New("node is starting"))
+		ws := createBatchTestEngineWithStatusMetrics(statusMetrics, 10)
+
+		pinnedRequest := &BatchRequest{BlockHash: "aabb", Requests: request.Requests}
+		response, code := sendBatchRequest(t, ws, pinnedRequest)
+		require.Equal(t, http.StatusOK, code)
+
+		data := decodeSubResponse(t, response.Data.Responses[0]).Data.(map[string]interface{})
+		assert.Equal(t, "", data["blockNonce"])
+		assert.Equal(t, "aabb", data["blockHash"])
+	})
+}
+
+func TestBatchHandler_SubRequestsShouldBeThrottled(t *testing.T) {
+	t.Parallel()
+
+	sourceThrottler, _ := middleware.NewSourceThrottler(3)
+	globalThrottler, _ := middleware.NewGlobalThrottler(1)
+	ws := createBatchTestEngine(10, sourceThrottler, globalThrottler)
+
+	request := &BatchRequest{Requests: make([]*BatchSubRequest, 0)}
+	for i := 0; i < 3; i++ {
+		request.Requests = append(request.Requests, &BatchSubRequest{
+			Method: http.MethodGet,
+			Path:   fmt.Sprintf("/address/address%d/balance", i),
+		})
+	}
+
+	// the batch request and its first 2 sub-requests use the quota of the source
+	response, code := sendBatchRequest(t, ws, request)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, 3, len(response.Data.Responses))
+	assert.Equal(t, http.StatusOK, response.Data.Responses[0].Status)
+	assert.Equal(t, http.StatusOK, response.Data.Responses[1].Status)
+	assert.Equal(t, http.StatusTooManyRequests, response.Data.Responses[2].Status)
+	assert.Equal(t, shared.ReturnCodeSystemBusy, decodeSubResponse(t, response.Data.Responses[2]).Code)
+}
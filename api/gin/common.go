@@ -42,18 +42,29 @@ func checkArgs(args ArgsNewWebServer) error {
 	if check.IfNil(args.Facade) {
 		return errHandler("nil facade")
 	}
+	if isBatchRouteEnabled(args.ApiConfig) && args.AntiFloodConfig.MaxBatchSubRequests == 0 {
+		return errHandler("invalid max number of batch sub-requests")
+	}
 
 	return nil
 }
 
 func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
-	logConfig, ok := routesConfig.APIPackages["log"]
+	return isRouteEnabled(routesConfig, "log", "/log")
+}
+
+func isBatchRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
+	return isRouteEnabled(routesConfig, "batch", batchPath)
+}
+
+func isRouteEnabled(routesConfig config.ApiRoutesConfig, packageName string, route string) bool {
+	packageConfig, ok := routesConfig.APIPackages[packageName]
 	if !ok {
 		return false
 	}
 
-	for _, cfg := range logConfig.Routes {
-		if cfg.Name == "/log" && cfg.Open {
+	for _, cfg := range packageConfig.Routes {
+		if cfg.Name == route && cfg.Open {
 			return true
 		}
 	}
@@ -24,6 +24,22 @@ func TestCommon_checkArgs(t *testing.T) {
 	args.Facade = initial.NewInitialNodeFacade("api interface", false)
 	err = checkArgs(args)
 	require.NoError(t, err)
+
+	args.ApiConfig = config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"batch": {
+				Routes: []config.RouteConfig{
+					{Name: "/batch", Open: true},
+				},
+			},
+		},
+	}
+	err = checkArgs(args)
+	require.True(t, errors.Is(err, apiErrors.ErrCannotCreateGinWebServer))
+
+	args.AntiFloodConfig.MaxBatchSubRequests = 10
+	err = checkArgs(args)
+	require.NoError(t, err)
 }
 
 func TestCommon_isLogRouteEnabled(t *testing.T) {
@@ -1,6 +1,12 @@
 package gin
 
+import "github.com/ElrondNetwork/elrond-go/node/external"
+
 type resetHandler interface {
 	Reset()
 	IsInterfaceNil() bool
 }
+
+type statusMetricsProvider interface {
+	StatusMetrics() external.StatusMetricsHandler
+}
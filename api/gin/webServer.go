@@ -216,6 +216,10 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 		registerLoggerWsRoute(ginRouter, marshalizerForLogs)
 	}
 
+	if isBatchRouteEnabled(ws.apiConfig) {
+		registerBatchRoute(ginRouter, ws.facade, ws.antiFloodConfig.MaxBatchSubRequests)
+	}
+
 	if ws.facade.PprofEnabled() {
 		pprof.Register(ginRouter)
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -13,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/gin-gonic/gin"
 )
 
@@ -28,20 +30,37 @@ const (
 	getESDTsRolesPath         = "/:address/esdts/roles"
 	getRegisteredNFTsPath     = "/:address/registered-nfts"
 	getESDTNFTDataPath        = "/:address/nft/:tokenIdentifier/nonce/:nonce"
+	getTransactionsPath       = "/:address/transactions"
+	getLogEventsPath          = "/:address/events"
+
+	urlParamBlockNonce    = "blockNonce"
+	urlParamBlockHash     = "blockHash"
+	urlParamBlockRootHash = "blockRootHash"
+	urlParamFrom          = "from"
+	urlParamSize          = "size"
+	urlParamIdentifier    = "identifier"
+	urlParamTopic         = "topic"
+	urlParamFromBlock     = "fromBlock"
+	urlParamToBlock       = "toBlock"
+
+	defaultTransactionsPageSize = 20
+	maxTransactionsPageSize     = 100
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
 type addressFacadeHandler interface {
-	GetBalance(address string) (*big.Int, error)
-	GetUsername(address string) (string, error)
-	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (api.AccountResponse, error)
-	GetESDTData(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
+	GetBalance(address string, options common.BlockQueryOptions) (*big.Int, common.BlockInfo, error)
+	GetUsername(address string, options common.BlockQueryOptions) (string, common.BlockInfo, error)
+	GetValueForKey(address string, key string, options common.BlockQueryOptions) (string, common.BlockInfo, error)
+	GetAccount(address string, options common.BlockQueryOptions) (api.AccountResponse, common.BlockInfo, error)
+	GetESDTData(address string, key string, nonce uint64, options common.BlockQueryOptions) (*esdt.ESDigitalToken, common.BlockInfo, error)
 	GetESDTsRoles(address string) (map[string][]string, error)
 	GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error)
 	GetESDTsWithRole(address string, role string) ([]string, error)
-	GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error)
-	GetKeyValuePairs(address string) (map[string]string, error)
+	GetAllESDTTokens(address string, options common.BlockQueryOptions) (map[string]*esdt.ESDigitalToken, common.BlockInfo, error)
+	GetKeyValuePairs(address string, options common.BlockQueryOptions) (map[string]string, common.BlockInfo, error)
+	GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error)
+	GetLogEvents(address string, query common.LogEventsQuery) ([]*common.LogEventAPIResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -137,6 +156,16 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ag.getESDTsRoles,
 		},
+		{
+			Path:    getTransactionsPath,
+			Method:  http.MethodGet,
+			Handler: ag.getTransactions,
+		},
+		{
+			Path:    getLogEventsPath,
+			Method:  http.MethodGet,
+			Handler: ag.getLogEvents,
+		},
 	}
 	ag.endpoints = endpoints
 
@@ -146,7 +175,20 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 // addressGroup returns a response containing information about the account correlated with provided address
 func (ag *addressGroup) getAccount(c *gin.Context) {
 	addr := c.Param("address")
-	accountResponse, err := ag.getFacade().GetAccount(addr)
+	options, err := extractBlockQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	accountResponse, blockInfo, err := ag.getFacade().GetAccount(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -164,7 +206,7 @@ func (ag *addressGroup) getAccount(c *gin.Context) {
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"account": accountResponse},
+			Data:  gin.H{"account": accountResponse, "blockInfo": blockInfo},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
@@ -186,7 +228,20 @@ func (ag *addressGroup) getBalance(c *gin.Context) {
 		return
 	}
 
-	balance, err := ag.getFacade().GetBalance(addr)
+	options, err := extractBlockQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	balance, blockInfo, err := ag.getFacade().GetBalance(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -201,7 +256,7 @@ func (ag *addressGroup) getBalance(c *gin.Context) {
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"balance": balance.String()},
+			Data:  gin.H{"balance": balance.String(), "blockInfo": blockInfo},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
@@ -223,7 +278,20 @@ func (ag *addressGroup) getUsername(c *gin.Context) {
 		return
 	}
 
-	userName, err := ag.getFacade().GetUsername(addr)
+	options, err := extractBlockQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetUsername.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	userName, blockInfo, err := ag.getFacade().GetUsername(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -238,7 +306,7 @@ func (ag *addressGroup) getUsername(c *gin.Context) {
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"username": userName},
+			Data:  gin.H{"username": userName, "blockInfo": blockInfo},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
@@ -273,7 +341,20 @@ func (ag *addressGroup) getValueForKey(c *gin.Context) {
 		return
 	}
 
-	value, err := ag.getFacade().GetValueForKey(addr, key)
+	options, err := extractBlockQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetValueForKey.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	value, blockInfo, err := ag.getFacade().GetValueForKey(addr, key, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -289,7 +370,7 @@ func (ag *addressGroup) getValueForKey(c *gin.Context) {
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"value": value},
+			Data:  gin.H{"value": value, "blockInfo": blockInfo},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
@@ -311,7 +392,20 @@ func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
 		return
 	}
 
-	value, err := ag.getFacade().GetKeyValuePairs(addr)
+	options, err := extractBlockQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	value, blockInfo, err := ag.getFacade().GetKeyValuePairs(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -327,7 +421,7 @@ func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"pairs": value},
+			Data:  gin.H{"pairs": value, "blockInfo": blockInfo},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
@@ -362,7 +456,20 @@ func (ag *addressGroup) getESDTBalance(c *gin.Context) {
 		return
 	}
 
-	esdtData, err := ag.getFacade().GetESDTData(addr, tokenIdentifier, 0)
+	options, err := extractBlockQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTBalance.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	esdtData, blockInfo, err := ag.getFacade().GetESDTData(addr, tokenIdentifier, 0, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -384,7 +491,7 @@ func (ag *addressGroup) getESDTBalance(c *gin.Context) {
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"tokenData": tokenData},
+			Data:  gin.H{"tokenData": tokenData, "blockInfo": blockInfo},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
@@ -584,7 +691,20 @@ func (ag *addressGroup) getESDTNFTData(c *gin.Context) {
 		return
 	}
 
-	esdtData, err := ag.getFacade().GetESDTData(addr, tokenIdentifier, nonceAsBigInt.Uint64())
+	options, err := extractBlockQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTNFTData.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	esdtData, blockInfo, err := ag.getFacade().GetESDTData(addr, tokenIdentifier, nonceAsBigInt.Uint64(), options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -602,7 +722,7 @@ func (ag *addressGroup) getESDTNFTData(c *gin.Context) {
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"tokenData": tokenData},
+			Data:  gin.H{"tokenData": tokenData, "blockInfo": blockInfo},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
@@ -624,7 +744,20 @@ func (ag *addressGroup) getAllESDTData(c *gin.Context) {
 		return
 	}
 
-	tokens, err := ag.getFacade().GetAllESDTTokens(addr)
+	options, err := extractBlockQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTTokens.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tokens, blockInfo, err := ag.getFacade().GetAllESDTTokens(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -647,13 +780,238 @@ func (ag *addressGroup) getAllESDTData(c *gin.Context) {
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"esdts": formattedTokens},
+			Data:  gin.H{"esdts": formattedTokens, "blockInfo": blockInfo},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
 	)
 }
 
+// getTransactions returns a page of the transactions which touched the given address, the most recent first
+func (ag *addressGroup) getTransactions(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	from, size, err := extractPaginationParams(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txs, err := ag.getFacade().GetTransactionsByAddress(addr, from, size)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"transactions": txs.Transactions, "total": txs.Total},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getLogEvents returns a page of the events emitted by the given address with the provided identifier, the most recent first
+func (ag *addressGroup) getLogEvents(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetLogEvents.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	query, err := extractLogEventsQuery(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetLogEvents.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	events, err := ag.getFacade().GetLogEvents(addr, query)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetLogEvents.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"events": events},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// extractLogEventsQuery reads the mandatory event identifier, the optional hex encoded topic, the optional block nonces
+// range and the pagination parameters from the query string
+func extractLogEventsQuery(c *gin.Context) (common.LogEventsQuery, error) {
+	query := common.LogEventsQuery{
+		Identifier: c.Request.URL.Query().Get(urlParamIdentifier),
+	}
+	if query.Identifier == "" {
+		return common.LogEventsQuery{}, errors.ErrEmptyEventIdentifier
+	}
+
+	var err error
+	topicStr := c.Request.URL.Query().Get(urlParamTopic)
+	if topicStr != "" {
+		query.Topic, err = hex.DecodeString(topicStr)
+		if err != nil {
+			return common.LogEventsQuery{}, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, urlParamTopic, err.Error())
+		}
+	}
+
+	fromBlockStr := c.Request.URL.Query().Get(urlParamFromBlock)
+	if fromBlockStr != "" {
+		query.FromBlock, err = strconv.ParseUint(fromBlockStr, 10, 64)
+		if err != nil {
+			return common.LogEventsQuery{}, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, urlParamFromBlock, err.Error())
+		}
+	}
+
+	toBlockStr := c.Request.URL.Query().Get(urlParamToBlock)
+	if toBlockStr != "" {
+		toBlock, errParse := strconv.ParseUint(toBlockStr, 10, 64)
+		if errParse != nil {
+			return common.LogEventsQuery{}, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, urlParamToBlock, errParse.Error())
+		}
+		if toBlock < query.FromBlock {
+			return common.LogEventsQuery{}, fmt.Errorf("%w for %s: should not be lower than %s", errors.ErrInvalidQueryParameter, urlParamToBlock, urlParamFromBlock)
+		}
+
+		query.ToBlock = common.OptionalUint64{Value: toBlock, HasValue: true}
+	}
+
+	query.From, query.Size, err = extractPaginationParams(c)
+	if err != nil {
+		return common.LogEventsQuery{}, err
+	}
+
+	return query, nil
+}
+
+// extractPaginationParams reads the optional "from" and "size" query parameters
+func extractPaginationParams(c *gin.Context) (uint64, uint64, error) {
+	from := uint64(0)
+	fromStr := c.Request.URL.Query().Get(urlParamFrom)
+	if fromStr != "" {
+		var err error
+		from, err = strconv.ParseUint(fromStr, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, urlParamFrom, err.Error())
+		}
+	}
+
+	size := uint64(defaultTransactionsPageSize)
+	sizeStr := c.Request.URL.Query().Get(urlParamSize)
+	if sizeStr != "" {
+		var err error
+		size, err = strconv.ParseUint(sizeStr, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, urlParamSize, err.Error())
+		}
+	}
+	if size == 0 || size > maxTransactionsPageSize {
+		return 0, 0, fmt.Errorf("%w for %s: should be between 1 and %d", errors.ErrInvalidQueryParameter, urlParamSize, maxTransactionsPageSize)
+	}
+
+	return from, size, nil
+}
+
+// extractBlockQueryOptions reads the optional block identifiers from the query string. At most one of them can be provided
+// and, if none is, the current state will be used
+func extractBlockQueryOptions(c *gin.Context) (common.BlockQueryOptions, error) {
+	options := common.BlockQueryOptions{}
+	numProvidedParams := 0
+
+	blockNonceStr := c.Request.URL.Query().Get(urlParamBlockNonce)
+	if blockNonceStr != "" {
+		blockNonce, err := strconv.ParseUint(blockNonceStr, 10, 64)
+		if err != nil {
+			return common.BlockQueryOptions{}, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, urlParamBlockNonce, err.Error())
+		}
+
+		options.BlockNonce = common.OptionalUint64{Value: blockNonce, HasValue: true}
+		numProvidedParams++
+	}
+
+	blockHashStr := c.Request.URL.Query().Get(urlParamBlockHash)
+	if blockHashStr != "" {
+		blockHash, err := hex.DecodeString(blockHashStr)
+		if err != nil {
+			return common.BlockQueryOptions{}, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, urlParamBlockHash, err.Error())
+		}
+
+		options.BlockHash = blockHash
+		numProvidedParams++
+	}
+
+	blockRootHashStr := c.Request.URL.Query().Get(urlParamBlockRootHash)
+	if blockRootHashStr != "" {
+		blockRootHash, err := hex.DecodeString(blockRootHashStr)
+		if err != nil {
+			return common.BlockQueryOptions{}, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, urlParamBlockRootHash, err.Error())
+		}
+
+		options.BlockRootHash = blockRootHash
+		numProvidedParams++
+	}
+
+	if numProvidedParams > 1 {
+		return common.BlockQueryOptions{}, fmt.Errorf("%w: only one of %s, %s or %s can be provided",
+			errors.ErrInvalidQueryParameter, urlParamBlockNonce, urlParamBlockHash, urlParamBlockRootHash)
+	}
+
+	return options, nil
+}
+
 func buildTokenDataApiResponse(tokenIdentifier string, esdtData *esdt.ESDigitalToken) *esdtNFTTokenData {
 	tokenData := &esdtNFTTokenData{
 		TokenIdentifier: tokenIdentifier,
@@ -1,6 +1,7 @@
 package groups_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -16,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -102,6 +104,17 @@ type esdtTokensCompleteResponse struct {
 	Code  string
 }
 
+type balanceWithBlockInfoResponseData struct {
+	Balance   string           `json:"balance"`
+	BlockInfo common.BlockInfo `json:"blockInfo"`
+}
+
+type balanceWithBlockInfoResponse struct {
+	Data  balanceWithBlockInfoResponseData `json:"data"`
+	Error string                           `json:"error"`
+	Code  string
+}
+
 type keyValuePairsResponseData struct {
 	Pairs map[string]string `json:"pairs"`
 }
@@ -126,6 +139,27 @@ type usernameResponseData struct {
 	Username string `json:"username"`
 }
 
+type transactionsByAddressResponseData struct {
+	Transactions []*common.AddressTransactionAPIResponse `json:"transactions"`
+	Total        uint64                                  `json:"total"`
+}
+
+type transactionsByAddressResponse struct {
+	Data  transactionsByAddressResponseData `json:"data"`
+	Error string                            `json:"error"`
+	Code  string                            `json:"code"`
+}
+
+type logEventsResponseData struct {
+	Events []*common.LogEventAPIResponse `json:"events"`
+}
+
+type logEventsResponse struct {
+	Data  logEventsResponseData `json:"data"`
+	Error string                `json:"error"`
+	Code  string                `json:"code"`
+}
+
 type usernameResponse struct {
 	Data  usernameResponseData `json:"data"`
 	Error string               `json:"error"`
@@ -182,8 +216,8 @@ func TestGetBalance_WithCorrectAddressShouldNotReturnError(t *testing.T) {
 	amount := big.NewInt(10)
 	addr := "testAddress"
 	facade := mock.FacadeStub{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
-			return amount, nil
+		BalanceHandler: func(s string, _ common.BlockQueryOptions) (i *big.Int, info common.BlockInfo, e error) {
+			return amount, common.BlockInfo{}, nil
 		},
 	}
 
@@ -211,8 +245,8 @@ func TestGetBalance_WithWrongAddressShouldError(t *testing.T) {
 	t.Parallel()
 	otherAddress := "otherAddress"
 	facade := mock.FacadeStub{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
-			return big.NewInt(0), nil
+		BalanceHandler: func(s string, _ common.BlockQueryOptions) (i *big.Int, info common.BlockInfo, e error) {
+			return big.NewInt(0), common.BlockInfo{}, nil
 		},
 	}
 
@@ -236,8 +270,8 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 	addr := "addr"
 	balanceError := errors.New("error")
 	facade := mock.FacadeStub{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
-			return nil, balanceError
+		BalanceHandler: func(s string, _ common.BlockQueryOptions) (i *big.Int, info common.BlockInfo, e error) {
+			return nil, common.BlockInfo{}, balanceError
 		},
 	}
 
@@ -259,8 +293,8 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 func TestGetBalance_WithEmptyAddressShouldReturnError(t *testing.T) {
 	t.Parallel()
 	facade := mock.FacadeStub{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
-			return big.NewInt(0), errors.New("address was empty")
+		BalanceHandler: func(s string, _ common.BlockQueryOptions) (i *big.Int, info common.BlockInfo, e error) {
+			return big.NewInt(0), common.BlockInfo{}, errors.New("address was empty")
 		},
 	}
 
@@ -284,14 +318,117 @@ func TestGetBalance_WithEmptyAddressShouldReturnError(t *testing.T) {
 	))
 }
 
+func TestGetBalance_WithBlockQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid block nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			BalanceHandler: func(s string, _ common.BlockQueryOptions) (*big.Int, common.BlockInfo, error) {
+				require.Fail(t, "should have not been called")
+				return nil, common.BlockInfo{}, nil
+			},
+		}
+
+		response, code := getBalanceResponse(t, &facade, "/address/addr/balance?blockNonce=abc")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+	})
+	t.Run("invalid block hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			BalanceHandler: func(s string, _ common.BlockQueryOptions) (*big.Int, common.BlockInfo, error) {
+				require.Fail(t, "should have not been called")
+				return nil, common.BlockInfo{}, nil
+			},
+		}
+
+		response, code := getBalanceResponse(t, &facade, "/address/addr/balance?blockHash=not-hex")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+	})
+	t.Run("more than one block identifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			BalanceHandler: func(s string, _ common.BlockQueryOptions) (*big.Int, common.BlockInfo, error) {
+				require.Fail(t, "should have not been called")
+				return nil, common.BlockInfo{}, nil
+			},
+		}
+
+		response, code := getBalanceResponse(t, &facade, "/address/addr/balance?blockNonce=7&blockHash=abcd")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+	})
+	t.Run("block nonce should be passed to the facade", func(t *testing.T) {
+		t.Parallel()
+
+		expectedBlockInfo := common.BlockInfo{
+			Nonce:    7,
+			Hash:     "abcd",
+			RootHash: "0123",
+		}
+		facade := mock.FacadeStub{
+			BalanceHandler: func(s string, options common.BlockQueryOptions) (*big.Int, common.BlockInfo, error) {
+				assert.Equal(t, common.OptionalUint64{Value: 7, HasValue: true}, options.BlockNonce)
+				assert.Empty(t, options.BlockHash)
+				assert.Empty(t, options.BlockRootHash)
+
+				return big.NewInt(37), expectedBlockInfo, nil
+			},
+		}
+
+		response, code := getBalanceResponse(t, &facade, "/address/addr/balance?blockNonce=7")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, "37", response.Data.Balance)
+		assert.Equal(t, expectedBlockInfo, response.Data.BlockInfo)
+	})
+	t.Run("block hash should be passed to the facade", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			BalanceHandler: func(s string, options common.BlockQueryOptions) (*big.Int, common.BlockInfo, error) {
+				assert.False(t, options.BlockNonce.HasValue)
+				assert.Equal(t, []byte{0xab, 0xcd}, options.BlockHash)
+
+				return big.NewInt(37), common.BlockInfo{Hash: "abcd"}, nil
+			},
+		}
+
+		response, code := getBalanceResponse(t, &facade, "/address/addr/balance?blockHash=abcd")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "abcd", response.Data.BlockInfo.Hash)
+	})
+}
+
+func getBalanceResponse(t *testing.T, facade *mock.FacadeStub, url string) (*balanceWithBlockInfoResponse, int) {
+	addrGroup, err := groups.NewAddressGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", url, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &balanceWithBlockInfoResponse{}
+	loadResponse(resp.Body, response)
+
+	return response, resp.Code
+}
+
 func TestGetValueForKey_NodeFailsShouldError(t *testing.T) {
 	t.Parallel()
 
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
-			return "", expectedErr
+		GetValueForKeyCalled: func(_ string, _ string, _ common.BlockQueryOptions) (string, common.BlockInfo, error) {
+			return "", common.BlockInfo{}, expectedErr
 		},
 	}
 
@@ -316,8 +453,9 @@ func TestGetValueForKey_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	testValue := "value"
 	facade := mock.FacadeStub{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
-			return testValue, nil
+		GetValueForKeyCalled: func(_ string, _ string, options common.BlockQueryOptions) (string, common.BlockInfo, error) {
+			assert.Equal(t, common.OptionalUint64{Value: 7, HasValue: true}, options.BlockNonce)
+			return testValue, common.BlockInfo{Nonce: 7}, nil
 		},
 	}
 
@@ -326,7 +464,7 @@ func TestGetValueForKey_ShouldWork(t *testing.T) {
 
 	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
 
-	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/key/test", testAddress), nil)
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/key/test?blockNonce=7", testAddress), nil)
 	resp := httptest.NewRecorder()
 	ws.ServeHTTP(resp, req)
 
@@ -342,8 +480,8 @@ func TestGetUsername_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetUsernameCalled: func(_ string) (string, error) {
-			return "", expectedErr
+		GetUsernameCalled: func(_ string, _ common.BlockQueryOptions) (string, common.BlockInfo, error) {
+			return "", common.BlockInfo{}, expectedErr
 		},
 	}
 
@@ -368,8 +506,9 @@ func TestGetUsername_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	testUsername := "value"
 	facade := mock.FacadeStub{
-		GetUsernameCalled: func(_ string) (string, error) {
-			return testUsername, nil
+		GetUsernameCalled: func(_ string, options common.BlockQueryOptions) (string, common.BlockInfo, error) {
+			assert.Equal(t, common.OptionalUint64{Value: 7, HasValue: true}, options.BlockNonce)
+			return testUsername, common.BlockInfo{Nonce: 7}, nil
 		},
 	}
 
@@ -378,7 +517,7 @@ func TestGetUsername_ShouldWork(t *testing.T) {
 
 	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
 
-	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/username", testAddress), nil)
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/username?blockNonce=7", testAddress), nil)
 	resp := httptest.NewRecorder()
 	ws.ServeHTTP(resp, req)
 
@@ -388,13 +527,38 @@ func TestGetUsername_ShouldWork(t *testing.T) {
 	assert.Equal(t, testUsername, usernameResponseObj.Data.Username)
 }
 
+func TestGetUsername_InvalidBlockOptionsShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetUsernameCalled: func(_ string, _ common.BlockQueryOptions) (string, common.BlockInfo, error) {
+			require.Fail(t, "should have not been called")
+			return "", common.BlockInfo{}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/address/username?blockNonce=abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	usernameResponseObj := usernameResponse{}
+	loadResponse(resp.Body, &usernameResponseObj)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(usernameResponseObj.Error, apiErrors.ErrGetUsername.Error()))
+}
+
 func TestGetAccount_FailWhenFacadeStubGetAccountFails(t *testing.T) {
 	t.Parallel()
 
 	returnedError := "i am an error"
 	facade := mock.FacadeStub{
-		GetAccountHandler: func(address string) (api.AccountResponse, error) {
-			return api.AccountResponse{}, errors.New(returnedError)
+		GetAccountHandler: func(address string, _ common.BlockQueryOptions) (api.AccountResponse, common.BlockInfo, error) {
+			return api.AccountResponse{}, common.BlockInfo{}, errors.New(returnedError)
 		},
 	}
 
@@ -419,13 +583,13 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.FacadeStub{
-		GetAccountHandler: func(address string) (api.AccountResponse, error) {
+		GetAccountHandler: func(address string, _ common.BlockQueryOptions) (api.AccountResponse, common.BlockInfo, error) {
 			return api.AccountResponse{
 				Address:         "1234",
 				Balance:         big.NewInt(100).String(),
 				Nonce:           1,
 				DeveloperReward: big.NewInt(120).String(),
-			}, nil
+			}, common.BlockInfo{}, nil
 		},
 	}
 
@@ -461,8 +625,8 @@ func TestGetESDTBalance_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetESDTDataCalled: func(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
-			return nil, expectedErr
+		GetESDTDataCalled: func(_ string, _ string, _ uint64, _ common.BlockQueryOptions) (*esdt.ESDigitalToken, common.BlockInfo, error) {
+			return nil, common.BlockInfo{}, expectedErr
 		},
 	}
 
@@ -488,8 +652,8 @@ func TestGetESDTBalance_ShouldWork(t *testing.T) {
 	testValue := big.NewInt(100).String()
 	testProperties := []byte{byte(0), byte(1), byte(0)}
 	facade := mock.FacadeStub{
-		GetESDTDataCalled: func(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
-			return &esdt.ESDigitalToken{Value: big.NewInt(100), Properties: testProperties}, nil
+		GetESDTDataCalled: func(_ string, _ string, _ uint64, _ common.BlockQueryOptions) (*esdt.ESDigitalToken, common.BlockInfo, error) {
+			return &esdt.ESDigitalToken{Value: big.NewInt(100), Properties: testProperties}, common.BlockInfo{}, nil
 		},
 	}
 
@@ -515,8 +679,8 @@ func TestGetESDTNFTData_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetESDTDataCalled: func(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
-			return nil, expectedErr
+		GetESDTDataCalled: func(_ string, _ string, _ uint64, _ common.BlockQueryOptions) (*esdt.ESDigitalToken, common.BlockInfo, error) {
+			return nil, common.BlockInfo{}, expectedErr
 		},
 	}
 
@@ -543,11 +707,11 @@ func TestGetESDTNFTData_ShouldWork(t *testing.T) {
 	testNonce := uint64(37)
 	testProperties := []byte{byte(1), byte(0), byte(0)}
 	facade := mock.FacadeStub{
-		GetESDTDataCalled: func(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
+		GetESDTDataCalled: func(_ string, _ string, _ uint64, _ common.BlockQueryOptions) (*esdt.ESDigitalToken, common.BlockInfo, error) {
 			return &esdt.ESDigitalToken{
 				Value:         big.NewInt(100),
 				Properties:    []byte(testProperties),
-				TokenMetaData: &esdt.MetaData{Nonce: testNonce, Creator: []byte(testAddress)}}, nil
+				TokenMetaData: &esdt.MetaData{Nonce: testNonce, Creator: []byte(testAddress)}}, common.BlockInfo{}, nil
 		},
 	}
 
@@ -705,8 +869,8 @@ func TestGetFullESDTTokens_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetAllESDTTokensCalled: func(_ string) (map[string]*esdt.ESDigitalToken, error) {
-			return nil, expectedErr
+		GetAllESDTTokensCalled: func(_ string, _ common.BlockQueryOptions) (map[string]*esdt.ESDigitalToken, common.BlockInfo, error) {
+			return nil, common.BlockInfo{}, expectedErr
 		},
 	}
 
@@ -732,11 +896,11 @@ func TestGetFullESDTTokens_ShouldWork(t *testing.T) {
 	testValue1 := "token1"
 	testValue2 := "token2"
 	facade := mock.FacadeStub{
-		GetAllESDTTokensCalled: func(address string) (map[string]*esdt.ESDigitalToken, error) {
+		GetAllESDTTokensCalled: func(address string, _ common.BlockQueryOptions) (map[string]*esdt.ESDigitalToken, common.BlockInfo, error) {
 			tokens := make(map[string]*esdt.ESDigitalToken)
 			tokens[testValue1] = &esdt.ESDigitalToken{Value: big.NewInt(10)}
 			tokens[testValue2] = &esdt.ESDigitalToken{Value: big.NewInt(100)}
-			return tokens, nil
+			return tokens, common.BlockInfo{}, nil
 		},
 	}
 
@@ -785,8 +949,8 @@ func TestGetKeyValuePairs_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetKeyValuePairsCalled: func(_ string) (map[string]string, error) {
-			return nil, expectedErr
+		GetKeyValuePairsCalled: func(_ string, _ common.BlockQueryOptions) (map[string]string, common.BlockInfo, error) {
+			return nil, common.BlockInfo{}, expectedErr
 		},
 	}
 
@@ -814,8 +978,8 @@ func TestGetKeyValuePairs_ShouldWork(t *testing.T) {
 	}
 	testAddress := "address"
 	facade := mock.FacadeStub{
-		GetKeyValuePairsCalled: func(_ string) (map[string]string, error) {
-			return pairs, nil
+		GetKeyValuePairsCalled: func(_ string, _ common.BlockQueryOptions) (map[string]string, common.BlockInfo, error) {
+			return pairs, common.BlockInfo{}, nil
 		},
 	}
 
@@ -913,6 +1077,187 @@ func TestGetESDTsRoles_ShouldWork(t *testing.T) {
 	assert.Equal(t, roles, response.Data.Roles)
 }
 
+func TestGetTransactions(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	getTransactionsResponse := func(t *testing.T, facade *mock.FacadeStub, url string) (*transactionsByAddressResponse, int) {
+		addrGroup, err := groups.NewAddressGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &transactionsByAddressResponse{}
+		loadResponse(resp.Body, response)
+
+		return response, resp.Code
+	}
+
+	t.Run("invalid pagination params should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, query := range []string{"from=abc", "size=-1", "size=0", "size=101"} {
+			response, code := getTransactionsResponse(t, &mock.FacadeStub{}, fmt.Sprintf("/address/%s/transactions?%s", testAddress, query))
+			assert.Equal(t, http.StatusBadRequest, code)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetTransactionsByAddressCalled: func(_ string, _ uint64, _ uint64) (*common.AddressTransactionsAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response, code := getTransactionsResponse(t, facade, fmt.Sprintf("/address/%s/transactions", testAddress))
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsByAddress.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should use the default page", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsByAddressCalled: func(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+				assert.Equal(t, testAddress, address)
+				assert.Equal(t, uint64(0), from)
+				assert.Equal(t, uint64(20), size)
+
+				return &common.AddressTransactionsAPIResponse{
+					Transactions: make([]*common.AddressTransactionAPIResponse, 0),
+				}, nil
+			},
+		}
+
+		response, code := getTransactionsResponse(t, facade, fmt.Sprintf("/address/%s/transactions", testAddress))
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 0, len(response.Data.Transactions))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTx := &common.AddressTransactionAPIResponse{
+			Hash:       "aabb",
+			Type:       "normal",
+			Epoch:      1,
+			BlockNonce: 2,
+			BlockHash:  "ccdd",
+			Round:      3,
+			Timestamp:  4,
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsByAddressCalled: func(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+				assert.Equal(t, uint64(10), from)
+				assert.Equal(t, uint64(1), size)
+
+				return &common.AddressTransactionsAPIResponse{
+					Transactions: []*common.AddressTransactionAPIResponse{expectedTx},
+					Total:        11,
+				}, nil
+			},
+		}
+
+		response, code := getTransactionsResponse(t, facade, fmt.Sprintf("/address/%s/transactions?from=10&size=1", testAddress))
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, uint64(11), response.Data.Total)
+		assert.Equal(t, []*common.AddressTransactionAPIResponse{expectedTx}, response.Data.Transactions)
+	})
+}
+
+func TestGetLogEvents(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	getLogEventsResponse := func(t *testing.T, facade *mock.FacadeStub, url string) (*logEventsResponse, int) {
+		addrGroup, err := groups.NewAddressGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &logEventsResponse{}
+		loadResponse(resp.Body, response)
+
+		return response, resp.Code
+	}
+
+	t.Run("missing identifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, code := getLogEventsResponse(t, &mock.FacadeStub{}, fmt.Sprintf("/address/%s/events", testAddress))
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrEmptyEventIdentifier.Error()))
+	})
+	t.Run("invalid query params should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, query := range []string{"topic=xyz", "fromBlock=abc", "toBlock=-1", "fromBlock=5&toBlock=4", "size=0"} {
+			url := fmt.Sprintf("/address/%s/events?identifier=ESDTTransfer&%s", testAddress, query)
+			response, code := getLogEventsResponse(t, &mock.FacadeStub{}, url)
+			assert.Equal(t, http.StatusBadRequest, code)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetLogEventsCalled: func(_ string, _ common.LogEventsQuery) ([]*common.LogEventAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		response, code := getLogEventsResponse(t, facade, fmt.Sprintf("/address/%s/events?identifier=ESDTTransfer", testAddress))
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetLogEvents.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedEvent := &common.LogEventAPIResponse{
+			Address:    testAddress,
+			Identifier: "ESDTTransfer",
+			Topics:     [][]byte{[]byte("TKN-abcdef")},
+			TxHash:     "aabb",
+			BlockNonce: 7,
+			BlockHash:  "ccdd",
+		}
+		facade := &mock.FacadeStub{
+			GetLogEventsCalled: func(address string, query common.LogEventsQuery) ([]*common.LogEventAPIResponse, error) {
+				assert.Equal(t, testAddress, address)
+				assert.Equal(t, common.LogEventsQuery{
+					Identifier: "ESDTTransfer",
+					Topic:      []byte("TKN-abcdef"),
+					FromBlock:  5,
+					ToBlock:    common.OptionalUint64{Value: 10, HasValue: true},
+					From:       2,
+					Size:       20,
+				}, query)
+
+				return []*common.LogEventAPIResponse{expectedEvent}, nil
+			},
+		}
+
+		url := fmt.Sprintf("/address/%s/events?identifier=ESDTTransfer&topic=%s&fromBlock=5&toBlock=10&from=2",
+			testAddress, hex.EncodeToString([]byte("TKN-abcdef")))
+		response, code := getLogEventsResponse(t, facade, url)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []*common.LogEventAPIResponse{expectedEvent}, response.Data.Events)
+	})
+}
+
 func TestAddressGroup_UpdateFacadeStub(t *testing.T) {
 	t.Parallel()
 
@@ -976,6 +1321,8 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/nft/:tokenIdentifier/nonce/:nonce", Open: true},
 					{Name: "/:address/esdts-with-role/:role", Open: true},
 					{Name: "/:address/registered-nfts", Open: true},
+					{Name: "/:address/transactions", Open: true},
+					{Name: "/:address/events", Open: true},
 				},
 			},
 		},
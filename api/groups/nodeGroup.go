@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -23,6 +24,7 @@ const (
 	p2pStatusPath       = "/p2pstatus"
 	peerInfoPath        = "/peerinfo"
 	statusPath          = "/status"
+	stateStatisticsPath = "/state-statistics"
 )
 
 // nodeFacadeHandler defines the methods to be implemented by a facade for node requests
@@ -31,6 +33,7 @@ type nodeFacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetStateStatistics() (*common.StateStatisticsAPIResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -88,6 +91,11 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.peerInfo,
 		},
+		{
+			Path:    stateStatisticsPath,
+			Method:  http.MethodGet,
+			Handler: ng.stateStatistics,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -241,6 +249,32 @@ func (ng *nodeGroup) peerInfo(c *gin.Context) {
 	)
 }
 
+// stateStatistics returns the last computed state size statistics, with the largest data tries, and the state growth
+// over the analyzed epochs
+func (ng *nodeGroup) stateStatistics(c *gin.Context) {
+	statistics, err := ng.getFacade().GetStateStatistics()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetStateStatistics.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"statistics": statistics},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // prometheusMetrics is the endpoint which will return the data in the way that prometheus expects them
 func (ng *nodeGroup) prometheusMetrics(c *gin.Context) {
 	metrics, err := ng.getFacade().StatusMetrics().StatusMetricsWithoutP2PPrometheusString()
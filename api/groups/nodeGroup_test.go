@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
@@ -60,6 +61,13 @@ type queryResponse struct {
 	Result []string `json:"result"`
 }
 
+type stateStatisticsResponse struct {
+	Data struct {
+		Statistics *common.StateStatisticsAPIResponse `json:"statistics"`
+	} `json:"data"`
+	Error string `json:"error"`
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -387,6 +395,69 @@ func TestPeerInfo_PeerInfoShouldWork(t *testing.T) {
 	assert.NotNil(t, responseInfo["info"])
 }
 
+func TestStateStatistics_ErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetStateStatisticsCalled: func() (*common.StateStatisticsAPIResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/state-statistics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStateStatistics.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestStateStatistics_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetStateStatisticsCalled: func() (*common.StateStatisticsAPIResponse, error) {
+			return &common.StateStatisticsAPIResponse{
+				Last: &common.StateSizeAPIResponse{
+					Epoch:       3,
+					NumAccounts: 10,
+					LargestDataTries: []*common.DataTrieSizeAPIResponse{
+						{Address: "erd1contract"},
+					},
+				},
+			}, nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/state-statistics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &stateStatisticsResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	require.NotNil(t, response.Data.Statistics.Last)
+	assert.Equal(t, uint64(10), response.Data.Statistics.Last.NumAccounts)
+	assert.Equal(t, "erd1contract", response.Data.Statistics.Last.LargestDataTries[0].Address)
+}
+
 func TestPrometheusMetrics_ShouldReturnErrorIfFacadeReturnsError(t *testing.T) {
 	expectedErr := errors.New("i am an error")
 
@@ -466,6 +537,7 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/state-statistics", Open: true},
 				},
 			},
 		},
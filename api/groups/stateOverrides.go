@@ -0,0 +1,97 @@
+package groups
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// StateOverrideRequest holds the values which replace the state of an account for a single simulation or query. The
+// code and the storage keys and values are hex encoded. The fields which are not set leave the account values unchanged
+type StateOverrideRequest struct {
+	Balance string            `json:"balance,omitempty"`
+	Nonce   *uint64           `json:"nonce,omitempty"`
+	Code    string            `json:"code,omitempty"`
+	Storage map[string]string `json:"storage,omitempty"`
+}
+
+type addressDecoder interface {
+	DecodeAddressPubkey(pk string) ([]byte, error)
+}
+
+// createStateOverrides converts the state overrides requests, keyed by the bech32 address of the accounts, ordering
+// them by address
+func createStateOverrides(decoder addressDecoder, requests map[string]*StateOverrideRequest) ([]*common.AccountStateOverride, error) {
+	addresses := make([]string, 0, len(requests))
+	for address := range requests {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	overrides := make([]*common.AccountStateOverride, 0, len(requests))
+	for _, address := range addresses {
+		override, err := createStateOverride(decoder, address, requests[address])
+		if err != nil {
+			return nil, fmt.Errorf("%w for address %s: %s", errors.ErrInvalidStateOverrides, address, err.Error())
+		}
+
+		overrides = append(overrides, override)
+	}
+
+	return overrides, nil
+}
+
+func createStateOverride(decoder addressDecoder, address string, request *StateOverrideRequest) (*common.AccountStateOverride, error) {
+	decodedAddress, err := decoder.DecodeAddressPubkey(address)
+	if err != nil {
+		return nil, err
+	}
+
+	override := &common.AccountStateOverride{
+		Address: decodedAddress,
+	}
+	if request == nil {
+		return override, nil
+	}
+
+	if len(request.Balance) > 0 {
+		balance, ok := big.NewInt(0).SetString(request.Balance, 10)
+		if !ok || balance.Sign() < 0 {
+			return nil, fmt.Errorf("invalid balance %s", request.Balance)
+		}
+		override.Balance = balance
+	}
+	if request.Nonce != nil {
+		override.Nonce = common.OptionalUint64{
+			Value:    *request.Nonce,
+			HasValue: true,
+		}
+	}
+	if len(request.Code) > 0 {
+		override.Code, err = hex.DecodeString(request.Code)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid hex code: %s", request.Code, err.Error())
+		}
+	}
+	if len(request.Storage) > 0 {
+		override.Storage = make(map[string][]byte, len(request.Storage))
+	}
+	for key, value := range request.Storage {
+		decodedKey, errDecode := hex.DecodeString(key)
+		if errDecode != nil {
+			return nil, fmt.Errorf("'%s' is not a valid hex storage key: %s", key, errDecode.Error())
+		}
+		decodedValue, errDecode := hex.DecodeString(value)
+		if errDecode != nil {
+			return nil, fmt.Errorf("'%s' is not a valid hex storage value: %s", value, errDecode.Error())
+		}
+
+		override.Storage[string(decodedKey)] = decodedValue
+	}
+
+	return override, nil
+}
@@ -1,12 +1,15 @@
 package groups
 
 import (
+	"context"
 	"encoding/hex"
+	goErrors "errors"
 	"fmt"
 	"math/big"
 	"net/http"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -17,36 +20,74 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 )
 
 const (
 	sendTransactionEndpoint          = "/transaction/send"
 	simulateTransactionEndpoint      = "/transaction/simulate"
+	simulateBundleEndpoint           = "/transaction/simulate-bundle"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
+	sendWithReceiptsEndpoint         = "/transaction/send-with-receipts"
 	getTransactionEndpoint           = "/transaction/:hash"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
+	simulateBundlePath               = "/simulate-bundle"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
+	sendWithReceiptsPath             = "/send-with-receipts"
+	buildRelayedTransactionPath      = "/build-relayed"
 	getTransactionPath               = "/:txhash"
+	getTransactionProcessStatusPath  = "/:txhash/process-status"
 	getTransactionsPool              = "/pool"
+	getTransactionsPoolForSenderPath = "/pool/by-sender/:sender"
+	getTransactionsPoolByFilterPath  = "/pool/filter"
+	subscribeToTransactionsPoolPath  = "/pool/subscribe"
+	getGasPriceSuggestionsPath       = "/gas-price"
 
 	queryParamWithResults    = "withResults"
 	queryParamCheckSignature = "checkSignature"
+	queryParamWithTrace      = "withTrace"
+	queryParamSender         = "sender"
+	queryParamReceiver       = "receiver"
+	queryParamMinGasPrice    = "minGasPrice"
+	queryParamMaxGasPrice    = "maxGasPrice"
+	queryParamOffset         = "offset"
+	queryParamLimit          = "limit"
+
+	queryParamWaitForInclusion = "waitForInclusion"
+	queryParamTimeout          = "timeout"
+
+	poolEventsWriteTimeout  = time.Second * 10
+	defaultInclusionTimeout = time.Second * 30
+	maxInclusionTimeout     = time.Minute
 )
 
 // transactionFacadeHandler defines the methods to be implemented by a facade for transaction requests
 type transactionFacadeHandler interface {
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
+	CreateRelayedTransaction(innerTx *transaction.Transaction, relayer string, relayerNonce uint64, relayerSignatureHex string) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
+	ValidateTransactionForBundleSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SendTransactionsWithReceipts(txs []*transaction.Transaction) ([]*common.TransactionSendReceiptAPIResponse, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	GetMaxTransactionsInBundle() uint32
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionProcessStatus(txHash string) (*common.TransactionProcessStatusAPIResponse, error)
+	WaitForTransactionsInclusion(ctx context.Context, txsHashes []string, timeout time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error)
 	GetTransactionsPool() (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPoolForSender(sender string) (*common.TransactionsPoolSenderAPIResponse, error)
+	GetTransactionsPoolByFilter(filter common.TransactionsPoolFilter) ([]*common.TransactionsPoolEntryAPIResponse, error)
+	GetMaxTransactionsPoolFilterResults() uint32
+	SubscribeToTransactionsPoolEvents(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error)
+	GetGasPriceSuggestions() (*common.GasPriceSuggestionsAPIResponse, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
@@ -91,6 +132,22 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    simulateBundlePath,
+			Method:  http.MethodPost,
+			Handler: tg.simulateBundle,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(simulateBundleEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    buildRelayedTransactionPath,
+			Method:  http.MethodPost,
+			Handler: tg.buildRelayedTransaction,
+		},
 		{
 			Path:    costPath,
 			Method:  http.MethodPost,
@@ -101,6 +158,26 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Method:  http.MethodGet,
 			Handler: tg.getTransactionsPool,
 		},
+		{
+			Path:    getTransactionsPoolForSenderPath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionsPoolForSender,
+		},
+		{
+			Path:    getTransactionsPoolByFilterPath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionsPoolByFilter,
+		},
+		{
+			Path:    subscribeToTransactionsPoolPath,
+			Method:  http.MethodGet,
+			Handler: tg.subscribeToTransactionsPool,
+		},
+		{
+			Path:    getGasPriceSuggestionsPath,
+			Method:  http.MethodGet,
+			Handler: tg.getGasPriceSuggestions,
+		},
 		{
 			Path:    sendMultiplePath,
 			Method:  http.MethodPost,
@@ -112,6 +189,17 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    sendWithReceiptsPath,
+			Method:  http.MethodPost,
+			Handler: tg.sendTransactionsWithReceipts,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(sendWithReceiptsEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 		{
 			Path:    getTransactionPath,
 			Method:  http.MethodGet,
@@ -123,6 +211,17 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    getTransactionProcessStatusPath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionProcessStatus,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	tg.endpoints = endpoints
 
@@ -159,6 +258,16 @@ type SendTxRequest struct {
 	ChainID          string `form:"chainID" json:"chainID"`
 	Version          uint32 `form:"version" json:"version"`
 	Options          uint32 `json:"options,omitempty"`
+	Relayer          string `json:"relayer,omitempty"`
+	RelayerNonce     uint64 `json:"relayerNonce,omitempty"`
+	RelayerSignature string `json:"relayerSignature,omitempty"`
+}
+
+// SimulateTxRequest represents the structure of a transaction simulation request, which might override the state of
+// some accounts, keyed by their address
+type SimulateTxRequest struct {
+	SendTxRequest
+	StateOverrides map[string]*StateOverrideRequest `json:"stateOverrides,omitempty"`
 }
 
 // TxResponse represents the structure on which the response will be validated against
@@ -173,7 +282,7 @@ type TxResponse struct {
 
 // simulateTransaction will receive a transaction from the client and will simulate it's execution and return the results
 func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
-	var gtx = SendTxRequest{}
+	var gtx = SimulateTxRequest{}
 	err := c.ShouldBindJSON(&gtx)
 	if err != nil {
 		c.JSON(
@@ -200,21 +309,20 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
-	tx, txHash, err := tg.getFacade().CreateTransaction(
-		gtx.Nonce,
-		gtx.Value,
-		gtx.Receiver,
-		gtx.ReceiverUsername,
-		gtx.Sender,
-		gtx.SenderUsername,
-		gtx.GasPrice,
-		gtx.GasLimit,
-		gtx.Data,
-		gtx.Signature,
-		gtx.ChainID,
-		gtx.Version,
-		gtx.Options,
-	)
+	withTrace, err := getQueryParamWithTrace(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tx, txHash, err := tg.createTransaction(&gtx.SendTxRequest)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -240,7 +348,24 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
-	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx)
+	stateOverrides, err := createStateOverrides(tg.getFacade(), gtx.StateOverrides)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	options := txSimData.SimulationOptions{
+		StateOverrides: stateOverrides,
+		WithTrace:      withTrace,
+	}
+	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -264,6 +389,113 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	)
 }
 
+// simulateBundle will receive an ordered list of transactions from the client and will simulate their execution, one
+// after another, each transaction seeing the state changes of the previous ones
+func (tg *transactionGroup) simulateBundle(c *gin.Context) {
+	var gtxs []SendTxRequest
+	err := c.ShouldBindJSON(&gtxs)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+	if len(gtxs) == 0 {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrEmptyTransactionsBundle.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+	maxTransactionsInBundle := tg.getFacade().GetMaxTransactionsInBundle()
+	if uint32(len(gtxs)) > maxTransactionsInBundle {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s, maximum is %d", errors.ErrValidation.Error(), errors.ErrTooManyTransactionsInBundle.Error(), maxTransactionsInBundle),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	checkSignature, err := getQueryParameterCheckSignature(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txs := make([]*transaction.Transaction, 0, len(gtxs))
+	txsHashes := make([]string, 0, len(gtxs))
+	for idx := range gtxs {
+		tx, txHash, errCreate := tg.createTransaction(&gtxs[idx])
+		if errCreate == nil {
+			// the accounts related checks are done by the simulator, against the state changed by the previous
+			// transactions of the bundle
+			errCreate = tg.getFacade().ValidateTransactionForBundleSimulation(tx, checkSignature)
+		}
+		if errCreate != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s for transaction at index %d: %s", errors.ErrTxGenerationFailed.Error(), idx, errCreate.Error()),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		txs = append(txs, tx)
+		txsHashes = append(txsHashes, hex.EncodeToString(txHash))
+	}
+
+	bundleResults, err := tg.getFacade().SimulateTransactionsBundle(txs)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	for idx, result := range bundleResults.Results {
+		if idx < len(txsHashes) {
+			result.Hash = txsHashes[idx]
+		}
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"result": bundleResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // sendTransaction will receive a transaction from the client and propagate it for processing
 func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 	var gtx = SendTxRequest{}
@@ -280,21 +512,7 @@ func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 		return
 	}
 
-	tx, txHash, err := tg.getFacade().CreateTransaction(
-		gtx.Nonce,
-		gtx.Value,
-		gtx.Receiver,
-		gtx.ReceiverUsername,
-		gtx.Sender,
-		gtx.SenderUsername,
-		gtx.GasPrice,
-		gtx.GasLimit,
-		gtx.Data,
-		gtx.Signature,
-		gtx.ChainID,
-		gtx.Version,
-		gtx.Options,
-	)
+	tx, txHash, err := tg.createTransaction(&gtx)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -344,6 +562,69 @@ func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 	)
 }
 
+// buildRelayedTransaction will receive a transaction signed by its sender along with a relayer and will return the
+// relayed transaction, paying the gas on behalf of the sender, for the relayer to sign it
+func (tg *transactionGroup) buildRelayedTransaction(c *gin.Context) {
+	var gtx = SendTxRequest{}
+	err := c.ShouldBindJSON(&gtx)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+	if len(gtx.Relayer) == 0 {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrEmptyRelayer.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tx, _, err := tg.createTransaction(&gtx)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data: gin.H{"transaction": &transaction.FrontendTransaction{
+				Nonce:     tx.Nonce,
+				Value:     tx.Value.String(),
+				Receiver:  gtx.Sender,
+				Sender:    gtx.Relayer,
+				GasPrice:  tx.GasPrice,
+				GasLimit:  tx.GasLimit,
+				Data:      tx.Data,
+				Signature: hex.EncodeToString(tx.Signature),
+				ChainID:   string(tx.ChainID),
+				Version:   tx.Version,
+				Options:   tx.Options,
+			}},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // sendMultipleTransactions will receive a number of transactions and will propagate them for processing
 func (tg *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 	var gtx []SendTxRequest
@@ -367,22 +648,8 @@ func (tg *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 	)
 
 	txsHashes := make(map[int]string)
-	for idx, receivedTx := range gtx {
-		tx, txHash, err = tg.getFacade().CreateTransaction(
-			receivedTx.Nonce,
-			receivedTx.Value,
-			receivedTx.Receiver,
-			receivedTx.ReceiverUsername,
-			receivedTx.Sender,
-			receivedTx.SenderUsername,
-			receivedTx.GasPrice,
-			receivedTx.GasLimit,
-			receivedTx.Data,
-			receivedTx.Signature,
-			receivedTx.ChainID,
-			receivedTx.Version,
-			receivedTx.Options,
-		)
+	for idx := range gtx {
+		tx, txHash, err = tg.createTransaction(&gtx[idx])
 		if err != nil {
 			continue
 		}
@@ -422,88 +689,221 @@ func (tg *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 	)
 }
 
-// getTransaction returns transaction details for a given txhash
-func (tg *transactionGroup) getTransaction(c *gin.Context) {
-	txhash := c.Param("txhash")
-	if txhash == "" {
+// sendTransactionsWithReceipts will receive a number of transactions, will propagate the valid ones and will return a
+// receipt for each transaction, telling whether it was accepted or why it was rejected. With the waitForInclusion
+// query parameter, it responds only after the accepted transactions were included in blocks or the timeout elapsed
+func (tg *transactionGroup) sendTransactionsWithReceipts(c *gin.Context) {
+	waitForInclusion, inclusionTimeout, err := extractWaitForInclusionOptions(c)
+	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
 			shared.GenericAPIResponse{
 				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
 				Code:  shared.ReturnCodeRequestError,
 			},
 		)
 		return
 	}
 
-	withResults, err := getQueryParamWithResults(c)
+	var gtx []SendTxRequest
+	err = c.ShouldBindJSON(&gtx)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
 			shared.GenericAPIResponse{
 				Data:  nil,
-				Error: errors.ErrValidation.Error(),
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
 				Code:  shared.ReturnCodeRequestError,
 			},
 		)
 		return
 	}
 
-	tx, err := tg.getFacade().GetTransaction(txhash, withResults)
+	receipts := make([]*common.TransactionSendReceiptAPIResponse, len(gtx))
+	txs := make([]*transaction.Transaction, 0, len(gtx))
+	txsIndexes := make([]int, 0, len(gtx))
+	for idx := range gtx {
+		tx, _, errCreate := tg.createTransaction(&gtx[idx])
+		if errCreate != nil {
+			receipts[idx] = &common.TransactionSendReceiptAPIResponse{
+				Index:  idx,
+				Reason: common.TxRejectionInvalidTransaction,
+				Error:  errCreate.Error(),
+			}
+			continue
+		}
+
+		txs = append(txs, tx)
+		txsIndexes = append(txsIndexes, idx)
+	}
+
+	sentReceipts, err := tg.getFacade().SendTransactionsWithReceipts(txs)
+	if err == nil && len(sentReceipts) != len(txs) {
+		err = fmt.Errorf("expected %d receipts, got %d", len(txs), len(sentReceipts))
+	}
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
 			shared.GenericAPIResponse{
 				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransaction.Error(), err.Error()),
+				Error: fmt.Sprintf("%s: %s", errors.ErrSendTransactionsWithReceipts.Error(), err.Error()),
 				Code:  shared.ReturnCodeInternalError,
 			},
 		)
 		return
 	}
 
+	acceptedTxsHashes := make([]string, 0, len(sentReceipts))
+	for i, receipt := range sentReceipts {
+		receipt.Index = txsIndexes[i]
+		receipts[receipt.Index] = receipt
+		if receipt.Accepted {
+			acceptedTxsHashes = append(acceptedTxsHashes, receipt.Hash)
+		}
+	}
+
+	if waitForInclusion && len(acceptedTxsHashes) > 0 {
+		inclusions, errWait := tg.getFacade().WaitForTransactionsInclusion(c.Request.Context(), acceptedTxsHashes, inclusionTimeout)
+		if errWait != nil {
+			c.JSON(
+				http.StatusInternalServerError,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s: %s", errors.ErrWaitForTransactionsInclusion.Error(), errWait.Error()),
+					Code:  shared.ReturnCodeInternalError,
+				},
+			)
+			return
+		}
+
+		for _, receipt := range receipts {
+			if receipt.Accepted {
+				receipt.Inclusion = inclusions[receipt.Hash]
+			}
+		}
+	}
+
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"transaction": tx},
+			Data: gin.H{
+				"txsSent":  len(acceptedTxsHashes),
+				"receipts": receipts,
+			},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
 	)
 }
 
-// computeTransactionGasLimit returns how many gas units a transaction wil consume
-func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
-	var gtx SendTxRequest
-	err := c.ShouldBindJSON(&gtx)
+// getTransaction returns transaction details for a given txhash
+func (tg *transactionGroup) getTransaction(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	withResults, err := getQueryParamWithResults(c)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
 			shared.GenericAPIResponse{
 				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Error: errors.ErrValidation.Error(),
 				Code:  shared.ReturnCodeRequestError,
 			},
 		)
 		return
 	}
 
-	tx, _, err := tg.getFacade().CreateTransaction(
-		gtx.Nonce,
-		gtx.Value,
-		gtx.Receiver,
-		gtx.ReceiverUsername,
-		gtx.Sender,
-		gtx.SenderUsername,
-		gtx.GasPrice,
-		gtx.GasLimit,
-		gtx.Data,
-		gtx.Signature,
-		gtx.ChainID,
-		gtx.Version,
-		gtx.Options,
+	tx, err := tg.getFacade().GetTransaction(txhash, withResults)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"transaction": tx},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionProcessStatus returns the progress of a transaction through the steps of its processing, pointing
+// out the first step which is not done yet, together with the progress of the smart contract results it generated
+func (tg *transactionGroup) getTransactionProcessStatus(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	processStatus, err := tg.getFacade().GetTransactionProcessStatus(txhash)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionProcessStatus.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"processStatus": processStatus},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
 	)
+}
+
+// computeTransactionGasLimit returns how many gas units a transaction wil consume
+func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
+	var gtx SendTxRequest
+	err := c.ShouldBindJSON(&gtx)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tx, _, err := tg.createTransaction(&gtx)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -564,6 +964,323 @@ func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 	)
 }
 
+// getGasPriceSuggestions returns the slow, normal and fast gas prices suggested for the transactions of the shard, based
+// on the recent blocks and on the transactions waiting in the pool
+func (tg *transactionGroup) getGasPriceSuggestions(c *gin.Context) {
+	suggestions, err := tg.getFacade().GetGasPriceSuggestions()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetGasPriceSuggestions.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"gasPrice": suggestions},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionsPoolForSender returns the transactions of a sender found in the pool, together with the nonce gaps
+// blocking their selection and the risk of the transactions to be evicted
+func (tg *transactionGroup) getTransactionsPoolForSender(c *gin.Context) {
+	sender := c.Param("sender")
+	if sender == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	senderTxs, err := tg.getFacade().GetTransactionsPoolForSender(sender)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"txPool": senderTxs},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionsPoolByFilter returns the regular transactions in the pool matching the optional sender, receiver and
+// gas price range. The results are paginated by the optional offset and limit, the latter being capped by the
+// configured maximum number of results
+func (tg *transactionGroup) getTransactionsPoolByFilter(c *gin.Context) {
+	filter, err := extractTransactionsPoolFilter(c)
+	if err == nil {
+		filter.Offset, filter.Limit, err = extractTransactionsPoolPage(c, uint64(tg.getFacade().GetMaxTransactionsPoolFilterResults()))
+	}
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txs, err := tg.getFacade().GetTransactionsPoolByFilter(filter)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"transactions": txs},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// subscribeToTransactionsPool upgrades the connection to a web socket one, on which it streams the changes undergone by
+// the transactions of the pool matching the optional sender, receiver and gas price range
+func (tg *transactionGroup) subscribeToTransactionsPool(c *gin.Context) {
+	filter, err := extractTransactionsPoolFilter(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrSubscribeToTransactionsPool.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	subscription, err := tg.getFacade().SubscribeToTransactionsPoolEvents(filter)
+	if goErrors.Is(err, common.ErrTooManyPoolSubscriptions) {
+		c.JSON(
+			http.StatusTooManyRequests,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrSubscribeToTransactionsPool.Error(), err.Error()),
+				Code:  shared.ReturnCodeSystemBusy,
+			},
+		)
+		return
+	}
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrSubscribeToTransactionsPool.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	// the default origin check of the upgrader only accepts the same origin requests and the ones without an origin,
+	// such as the requests of the non-browser clients
+	upgrader := websocket.Upgrader{}
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// the upgrader already responded with an error
+		subscription.Unsubscribe()
+		log.Debug("transactionGroup.subscribeToTransactionsPool: cannot upgrade the connection", "error", err)
+		return
+	}
+
+	// the connection lasts as long as the subscription, thus it should not hold a simultaneous requests slot
+	middleware.ReleaseGlobalThrottlerSlot(c)
+	streamTransactionsPoolEvents(conn, subscription)
+}
+
+func streamTransactionsPoolEvents(conn *websocket.Conn, subscription *common.TransactionsPoolSubscription) {
+	defer func() {
+		subscription.Unsubscribe()
+		_ = conn.Close()
+	}()
+
+	// the client is not expected to send anything, the messages are only read in order to detect the disconnection
+	chClientGone := make(chan struct{})
+	go func() {
+		defer close(chClientGone)
+
+		for {
+			_, _, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case event, isOpen := <-subscription.Events:
+			if !isOpen {
+				closeMessage := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "the subscription has ended")
+				_ = conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(poolEventsWriteTimeout))
+				return
+			}
+
+			err := conn.SetWriteDeadline(time.Now().Add(poolEventsWriteTimeout))
+			if err != nil {
+				return
+			}
+			err = conn.WriteJSON(event)
+			if err != nil {
+				return
+			}
+		case <-chClientGone:
+			return
+		}
+	}
+}
+
+func extractTransactionsPoolFilter(c *gin.Context) (common.TransactionsPoolFilter, error) {
+	filter := common.TransactionsPoolFilter{
+		Sender:   c.Request.URL.Query().Get(queryParamSender),
+		Receiver: c.Request.URL.Query().Get(queryParamReceiver),
+	}
+
+	var err error
+	filter.MinGasPrice, err = getOptionalUint64QueryParam(c, queryParamMinGasPrice)
+	if err != nil {
+		return common.TransactionsPoolFilter{}, err
+	}
+	filter.MaxGasPrice, err = getOptionalUint64QueryParam(c, queryParamMaxGasPrice)
+	if err != nil {
+		return common.TransactionsPoolFilter{}, err
+	}
+
+	isRangeValid := !filter.MinGasPrice.HasValue || !filter.MaxGasPrice.HasValue || filter.MinGasPrice.Value <= filter.MaxGasPrice.Value
+	if !isRangeValid {
+		return common.TransactionsPoolFilter{}, fmt.Errorf("%w for %s: should not be lower than %s", errors.ErrInvalidQueryParameter, queryParamMaxGasPrice, queryParamMinGasPrice)
+	}
+
+	return filter, nil
+}
+
+// extractTransactionsPoolPage reads the optional offset and limit query parameters, the limit defaulting to the maximum
+// number of results
+func extractTransactionsPoolPage(c *gin.Context, maxResults uint64) (uint64, uint64, error) {
+	offset, err := getOptionalUint64QueryParam(c, queryParamOffset)
+	if err != nil {
+		return 0, 0, err
+	}
+	limit, err := getOptionalUint64QueryParam(c, queryParamLimit)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !limit.HasValue {
+		return offset.Value, maxResults, nil
+	}
+	if limit.Value == 0 || limit.Value > maxResults {
+		return 0, 0, fmt.Errorf("%w for %s: should be between 1 and %d", errors.ErrInvalidQueryParameter, queryParamLimit, maxResults)
+	}
+
+	return offset.Value, limit.Value, nil
+}
+
+func extractWaitForInclusionOptions(c *gin.Context) (bool, time.Duration, error) {
+	waitForInclusionStr := c.Request.URL.Query().Get(queryParamWaitForInclusion)
+	if waitForInclusionStr == "" {
+		return false, 0, nil
+	}
+
+	waitForInclusion, err := strconv.ParseBool(waitForInclusionStr)
+	if err != nil {
+		return false, 0, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, queryParamWaitForInclusion, err.Error())
+	}
+
+	timeoutInSeconds, err := getOptionalUint64QueryParam(c, queryParamTimeout)
+	if err != nil {
+		return false, 0, err
+	}
+	if !timeoutInSeconds.HasValue {
+		return waitForInclusion, defaultInclusionTimeout, nil
+	}
+
+	timeout := time.Duration(timeoutInSeconds.Value) * time.Second
+	if timeoutInSeconds.Value == 0 || timeout > maxInclusionTimeout {
+		return false, 0, fmt.Errorf("%w for %s: should be between 1 and %d seconds", errors.ErrInvalidQueryParameter, queryParamTimeout, int(maxInclusionTimeout.Seconds()))
+	}
+
+	return waitForInclusion, timeout, nil
+}
+
+func getOptionalUint64QueryParam(c *gin.Context, name string) (common.OptionalUint64, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return common.OptionalUint64{}, nil
+	}
+
+	value, err := strconv.ParseUint(valueStr, 10, 64)
+	if err != nil {
+		return common.OptionalUint64{}, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, name, err.Error())
+	}
+
+	return common.OptionalUint64{Value: value, HasValue: true}, nil
+}
+
+// createTransaction creates the transaction described by the request. When a relayer is provided, the described
+// transaction is the inner transaction, signed by its sender, which gets wrapped into a relayed transaction
+func (tg *transactionGroup) createTransaction(gtx *SendTxRequest) (*transaction.Transaction, []byte, error) {
+	tx, txHash, err := tg.getFacade().CreateTransaction(
+		gtx.Nonce,
+		gtx.Value,
+		gtx.Receiver,
+		gtx.ReceiverUsername,
+		gtx.Sender,
+		gtx.SenderUsername,
+		gtx.GasPrice,
+		gtx.GasLimit,
+		gtx.Data,
+		gtx.Signature,
+		gtx.ChainID,
+		gtx.Version,
+		gtx.Options,
+	)
+	if err != nil || len(gtx.Relayer) == 0 {
+		return tx, txHash, err
+	}
+
+	return tg.getFacade().CreateRelayedTransaction(tx, gtx.Relayer, gtx.RelayerNonce, gtx.RelayerSignature)
+}
+
 func getQueryParamWithResults(c *gin.Context) (bool, error) {
 	withResultsStr := c.Request.URL.Query().Get(queryParamWithResults)
 	if withResultsStr == "" {
@@ -573,6 +1290,15 @@ func getQueryParamWithResults(c *gin.Context) (bool, error) {
 	return strconv.ParseBool(withResultsStr)
 }
 
+func getQueryParamWithTrace(c *gin.Context) (bool, error) {
+	withTraceStr := c.Request.URL.Query().Get(queryParamWithTrace)
+	if withTraceStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(withTraceStr)
+}
+
 func getQueryParameterCheckSignature(c *gin.Context) (bool, error) {
 	bypassSignatureStr := c.Request.URL.Query().Get(queryParamCheckSignature)
 	if bypassSignatureStr == "" {
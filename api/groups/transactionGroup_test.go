@@ -2,6 +2,7 @@ package groups_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -11,6 +12,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	dataTx "github.com/ElrondNetwork/elrond-go-core/data/transaction"
@@ -21,6 +23,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -68,6 +71,22 @@ type simulateTxResponse struct {
 	Code  string      `json:"code"`
 }
 
+type simulateTxResultResponse struct {
+	Data struct {
+		Result *txSimData.SimulationResults `json:"result"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type simulateBundleResponse struct {
+	Data struct {
+		Result *txSimData.BundleSimulationResults `json:"result"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
 type sendSingleTxResponseData struct {
 	TxHash string `json:"txHash"`
 }
@@ -98,6 +117,55 @@ type txsPoolResponse struct {
 	Code  string              `json:"code"`
 }
 
+type txsPoolForSenderResponse struct {
+	Data struct {
+		TxPool common.TransactionsPoolSenderAPIResponse `json:"txPool"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type txsPoolByFilterResponse struct {
+	Data struct {
+		Transactions []*common.TransactionsPoolEntryAPIResponse `json:"transactions"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type transactionProcessStatusResponse struct {
+	Data struct {
+		ProcessStatus common.TransactionProcessStatusAPIResponse `json:"processStatus"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type gasPriceSuggestionsResponse struct {
+	Data struct {
+		GasPrice common.GasPriceSuggestionsAPIResponse `json:"gasPrice"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type sendWithReceiptsResponse struct {
+	Data struct {
+		TxsSent  int                                         `json:"txsSent"`
+		Receipts []*common.TransactionSendReceiptAPIResponse `json:"receipts"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type buildRelayedTxResponse struct {
+	Data struct {
+		Transaction *dataTx.FrontendTransaction `json:"transaction"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
 func TestGetTransaction_WithCorrectHashShouldReturnTransaction(t *testing.T) {
 	sender := "sender"
 	receiver := "receiver"
@@ -366,6 +434,127 @@ func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, hexTxHash, response.Data.TxHash)
 }
 
+func TestSendTransaction_WithRelayerShouldSendTheRelayedTransaction(t *testing.T) {
+	t.Parallel()
+
+	innerTx := &dataTx.Transaction{Nonce: 1, SndAddr: []byte("sender")}
+	relayedTx := &dataTx.Transaction{Nonce: 7, SndAddr: []byte("relayer")}
+	facade := mock.FacadeStub{
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return innerTx, []byte("inner hash"), nil
+		},
+		CreateRelayedTransactionCalled: func(tx *dataTx.Transaction, relayer string, relayerNonce uint64, relayerSignatureHex string) (*dataTx.Transaction, []byte, error) {
+			assert.Equal(t, innerTx, tx)
+			assert.Equal(t, "relayer", relayer)
+			assert.Equal(t, uint64(7), relayerNonce)
+			assert.Equal(t, "aabb", relayerSignatureHex)
+			return relayedTx, []byte("relayed hash"), nil
+		},
+		ValidateTransactionHandler: func(tx *dataTx.Transaction) error {
+			assert.Equal(t, relayedTx, tx)
+			return nil
+		},
+		SendBulkTransactionsHandler: func(txs []*dataTx.Transaction) (u uint64, err error) {
+			assert.Equal(t, []*dataTx.Transaction{relayedTx}, txs)
+			return 1, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonStr := `{"nonce": 1, "sender": "sender", "relayer": "relayer", "relayerNonce": 7, "relayerSignature": "aabb"}`
+	req, _ := http.NewRequest("POST", "/transaction/send", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := sendSingleTxResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, hex.EncodeToString([]byte("relayed hash")), response.Data.TxHash)
+}
+
+func TestBuildRelayedTransaction(t *testing.T) {
+	t.Parallel()
+
+	createFacade := func(createRelayedErr error) *mock.FacadeStub {
+		return &mock.FacadeStub{
+			CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{Nonce: nonce, GasPrice: gasPrice, GasLimit: gasLimit}, nil, nil
+			},
+			CreateRelayedTransactionCalled: func(innerTx *dataTx.Transaction, relayer string, relayerNonce uint64, relayerSignatureHex string) (*dataTx.Transaction, []byte, error) {
+				if createRelayedErr != nil {
+					return nil, nil, createRelayedErr
+				}
+				return &dataTx.Transaction{
+					Nonce:    relayerNonce,
+					Value:    big.NewInt(0),
+					GasPrice: innerTx.GasPrice,
+					GasLimit: innerTx.GasLimit + 50000,
+					Data:     []byte("relayedTx@aa"),
+					ChainID:  []byte("T"),
+					Version:  1,
+				}, []byte("hash"), nil
+			},
+		}
+	}
+	buildRelayedTx := func(facade *mock.FacadeStub, jsonStr string) (*httptest.ResponseRecorder, *buildRelayedTxResponse) {
+		transactionGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/transaction/build-relayed", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &buildRelayedTxResponse{}
+		loadResponse(resp.Body, response)
+
+		return resp, response
+	}
+
+	t.Run("empty relayer should error", func(t *testing.T) {
+		t.Parallel()
+
+		resp, response := buildRelayedTx(createFacade(nil), `{"nonce": 1, "sender": "sender"}`)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrEmptyRelayer.Error()))
+	})
+	t.Run("invalid inner transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("inner transaction: invalid signature")
+		resp, response := buildRelayedTx(createFacade(expectedErr), `{"nonce": 1, "sender": "sender", "relayer": "relayer"}`)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrTxGenerationFailed.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		jsonStr := `{"nonce": 1, "sender": "sender", "gasPrice": 10, "gasLimit": 60000, "relayer": "relayer", "relayerNonce": 7}`
+		resp, response := buildRelayedTx(createFacade(nil), jsonStr)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, &dataTx.FrontendTransaction{
+			Nonce:    7,
+			Value:    "0",
+			Receiver: "sender",
+			Sender:   "relayer",
+			GasPrice: 10,
+			GasLimit: 110000,
+			Data:     []byte("relayedTx@aa"),
+			ChainID:  "T",
+			Version:  1,
+		}, response.Data.Transaction)
+	})
+}
+
 func TestSendMultipleTransactions_ErrorWithExceededNumGoRoutines(t *testing.T) {
 	t.Parallel()
 
@@ -479,6 +668,205 @@ func TestSendMultipleTransactions_OkPayloadShouldWork(t *testing.T) {
 	assert.True(t, sendBulkTxsWasCalled)
 }
 
+func TestSendMultipleTransactions_WithRelayerShouldSendTheRelayedTransactions(t *testing.T) {
+	t.Parallel()
+
+	relayedTx := &dataTx.Transaction{Nonce: 7, SndAddr: []byte("relayer")}
+	var sentTxs []*dataTx.Transaction
+	facade := mock.FacadeStub{
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{Nonce: nonce, SndAddr: []byte(sender)}, []byte(sender), nil
+		},
+		CreateRelayedTransactionCalled: func(tx *dataTx.Transaction, relayer string, relayerNonce uint64, relayerSignatureHex string) (*dataTx.Transaction, []byte, error) {
+			assert.Equal(t, []byte("sender1"), tx.SndAddr)
+			assert.Equal(t, "relayer", relayer)
+			assert.Equal(t, uint64(7), relayerNonce)
+			assert.Equal(t, "aabb", relayerSignatureHex)
+			return relayedTx, []byte("relayed hash"), nil
+		},
+		ValidateTransactionHandler: func(tx *dataTx.Transaction) error {
+			return nil
+		},
+		SendBulkTransactionsHandler: func(txs []*dataTx.Transaction) (u uint64, err error) {
+			sentTxs = txs
+			return uint64(len(txs)), nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	txs := []*groups.SendTxRequest{
+		{Sender: "sender1", Nonce: 1, Relayer: "relayer", RelayerNonce: 7, RelayerSignature: "aabb"},
+		{Sender: "sender2", Nonce: 2},
+	}
+	jsonBytes, _ := json.Marshal(txs)
+	req, _ := http.NewRequest("POST", "/transaction/send-multiple", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 2, len(sentTxs))
+	assert.Equal(t, relayedTx, sentTxs[0])
+	assert.Equal(t, []byte("sender2"), sentTxs[1].SndAddr)
+}
+
+func TestSendTransactionsWithReceipts(t *testing.T) {
+	t.Parallel()
+
+	createFacade := func() *mock.FacadeStub {
+		return &mock.FacadeStub{
+			CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+				if sender == "bad sender" {
+					return nil, nil, errors.New("invalid sender")
+				}
+				return &dataTx.Transaction{Nonce: nonce, SndAddr: []byte(sender)}, nil, nil
+			},
+			SendTransactionsWithReceiptsCalled: func(txs []*dataTx.Transaction) ([]*common.TransactionSendReceiptAPIResponse, error) {
+				receipts := make([]*common.TransactionSendReceiptAPIResponse, 0, len(txs))
+				for idx, tx := range txs {
+					receipt := &common.TransactionSendReceiptAPIResponse{Index: idx, Hash: fmt.Sprintf("%02x", tx.Nonce), Accepted: true}
+					if tx.Nonce == 0 {
+						receipt.Accepted = false
+						receipt.Reason = common.TxRejectionNonceTooLow
+						receipt.Error = "lower nonce in transaction"
+					}
+					receipts = append(receipts, receipt)
+				}
+				return receipts, nil
+			},
+		}
+	}
+	sendTxs := func(facade *mock.FacadeStub, url string) (*httptest.ResponseRecorder, *sendWithReceiptsResponse) {
+		transactionGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		txs := []*groups.SendTxRequest{
+			{Sender: "sender", Nonce: 0},
+			{Sender: "bad sender", Nonce: 1},
+			{Sender: "sender", Nonce: 2},
+		}
+		jsonBytes, _ := json.Marshal(txs)
+		req, _ := http.NewRequest("POST", url, bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &sendWithReceiptsResponse{}
+		loadResponse(resp.Body, response)
+
+		return resp, response
+	}
+
+	t.Run("invalid timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		resp, response := sendTxs(createFacade(), "/transaction/send-with-receipts?waitForInclusion=true&timeout=3600")
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := createFacade()
+		facade.SendTransactionsWithReceiptsCalled = func(txs []*dataTx.Transaction) ([]*common.TransactionSendReceiptAPIResponse, error) {
+			return nil, expectedErr
+		}
+
+		resp, response := sendTxs(facade, "/transaction/send-with-receipts")
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrSendTransactionsWithReceipts.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should return the receipts in the order of the transactions", func(t *testing.T) {
+		t.Parallel()
+
+		facade := createFacade()
+		facade.WaitForTransactionsInclusionCalled = func(_ context.Context, txsHashes []string, timeout time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error) {
+			require.Fail(t, "should have not waited for the inclusion")
+			return nil, nil
+		}
+
+		resp, response := sendTxs(facade, "/transaction/send-with-receipts")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, 1, response.Data.TxsSent)
+		require.Equal(t, 3, len(response.Data.Receipts))
+
+		assert.Equal(t, 0, response.Data.Receipts[0].Index)
+		assert.False(t, response.Data.Receipts[0].Accepted)
+		assert.Equal(t, common.TxRejectionNonceTooLow, response.Data.Receipts[0].Reason)
+
+		assert.Equal(t, 1, response.Data.Receipts[1].Index)
+		assert.False(t, response.Data.Receipts[1].Accepted)
+		assert.Equal(t, common.TxRejectionInvalidTransaction, response.Data.Receipts[1].Reason)
+		assert.Equal(t, "invalid sender", response.Data.Receipts[1].Error)
+
+		assert.Equal(t, 2, response.Data.Receipts[2].Index)
+		assert.True(t, response.Data.Receipts[2].Accepted)
+		assert.Equal(t, "02", response.Data.Receipts[2].Hash)
+		assert.Nil(t, response.Data.Receipts[2].Inclusion)
+	})
+	t.Run("should wait for the inclusion of the accepted transactions", func(t *testing.T) {
+		t.Parallel()
+
+		expectedInclusion := &common.TransactionInclusionAPIResponse{Included: true, Status: "success", BlockNonce: 7, BlockHash: "aa"}
+		facade := createFacade()
+		facade.WaitForTransactionsInclusionCalled = func(ctx context.Context, txsHashes []string, timeout time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error) {
+			require.NotNil(t, ctx)
+			require.Equal(t, []string{"02"}, txsHashes)
+			require.Equal(t, time.Second*5, timeout)
+			return map[string]*common.TransactionInclusionAPIResponse{"02": expectedInclusion}, nil
+		}
+
+		resp, response := sendTxs(facade, "/transaction/send-with-receipts?waitForInclusion=true&timeout=5")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, 3, len(response.Data.Receipts))
+		assert.Nil(t, response.Data.Receipts[0].Inclusion)
+		assert.Nil(t, response.Data.Receipts[1].Inclusion)
+		assert.Equal(t, expectedInclusion, response.Data.Receipts[2].Inclusion)
+	})
+	t.Run("relayer should send the relayed transaction", func(t *testing.T) {
+		t.Parallel()
+
+		relayedTx := &dataTx.Transaction{Nonce: 7, SndAddr: []byte("relayer")}
+		facade := createFacade()
+		facade.CreateRelayedTransactionCalled = func(tx *dataTx.Transaction, relayer string, relayerNonce uint64, relayerSignatureHex string) (*dataTx.Transaction, []byte, error) {
+			assert.Equal(t, []byte("sender"), tx.SndAddr)
+			assert.Equal(t, "relayer", relayer)
+			assert.Equal(t, uint64(7), relayerNonce)
+			return relayedTx, []byte("relayed hash"), nil
+		}
+		sendTxsWithReceipts := facade.SendTransactionsWithReceiptsCalled
+		facade.SendTransactionsWithReceiptsCalled = func(txs []*dataTx.Transaction) ([]*common.TransactionSendReceiptAPIResponse, error) {
+			assert.Equal(t, []*dataTx.Transaction{relayedTx}, txs)
+			return sendTxsWithReceipts(txs)
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		txs := []*groups.SendTxRequest{{Sender: "sender", Nonce: 1, Relayer: "relayer", RelayerNonce: 7}}
+		jsonBytes, _ := json.Marshal(txs)
+		req, _ := http.NewRequest("POST", "/transaction/send-with-receipts", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &sendWithReceiptsResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, 1, len(response.Data.Receipts))
+		assert.True(t, response.Data.Receipts[0].Accepted)
+		assert.Equal(t, "07", response.Data.Receipts[0].Hash)
+	})
+}
+
 func TestComputeTransactionGasLimit(t *testing.T) {
 	t.Parallel()
 
@@ -554,7 +942,7 @@ func TestSimulateTransaction_CreateErrorsShouldErr(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
 			processTxWasCalled = true
 			return &txSimData.SimulationResults{
 				Status:     "ok",
@@ -609,7 +997,7 @@ func TestSimulateTransaction_ValidateErrorsShouldErr(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
 			processTxWasCalled = true
 			return &txSimData.SimulationResults{
 				Status:     "ok",
@@ -702,7 +1090,7 @@ func TestSimulateTransaction_UseQueryParameterShouldWork(t *testing.T) {
 		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
 			return &dataTx.Transaction{}, []byte("hash"), nil
 		},
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
 			return &txSimData.SimulationResults{}, nil
 		},
 	}
@@ -735,12 +1123,171 @@ func TestSimulateTransaction_UseQueryParameterShouldWork(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.Code)
 }
 
+func TestSimulateTransaction_WithTraceQueryParameter(t *testing.T) {
+	t.Parallel()
+
+	expectedTrace := &txSimData.ExecutionTrace{
+		ReturnCode: "ok",
+		CallTree:   &txSimData.CallFrame{Sender: "sender", Receiver: "receiver", CallType: "directCall"},
+	}
+	facade := mock.FacadeStub{
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{}, []byte("hash"), nil
+		},
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+			results := &txSimData.SimulationResults{}
+			if options.WithTrace {
+				results.Trace = expectedTrace
+			}
+
+			return results, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal(groups.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "100"})
+	testCases := []struct {
+		query         string
+		expectedCode  int
+		expectedTrace *txSimData.ExecutionTrace
+	}{
+		{query: "", expectedCode: http.StatusOK, expectedTrace: nil},
+		{query: "?withTrace=false", expectedCode: http.StatusOK, expectedTrace: nil},
+		{query: "?withTrace=true", expectedCode: http.StatusOK, expectedTrace: expectedTrace},
+		{query: "?withTrace=not-a-bool", expectedCode: http.StatusBadRequest, expectedTrace: nil},
+	}
+	for _, tc := range testCases {
+		req, _ := http.NewRequest("POST", "/transaction/simulate"+tc.query, bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		simulateResponse := simulateTxResultResponse{}
+		loadResponse(resp.Body, &simulateResponse)
+
+		assert.Equal(t, tc.expectedCode, resp.Code, tc.query)
+		if tc.expectedCode == http.StatusOK {
+			assert.Equal(t, tc.expectedTrace, simulateResponse.Data.Result.Trace, tc.query)
+		}
+	}
+}
+
+func TestSimulateTransaction_WithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(7)
+	createRequest := func(stateOverrides map[string]*groups.StateOverrideRequest) []byte {
+		request := groups.SimulateTxRequest{
+			SendTxRequest: groups.SendTxRequest{
+				Sender:   "sender1",
+				Receiver: "receiver1",
+				Value:    "100",
+			},
+			StateOverrides: stateOverrides,
+		}
+		jsonBytes, _ := json.Marshal(request)
+
+		return jsonBytes
+	}
+
+	t.Run("invalid state overrides should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, []byte("hash"), nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				return nil
+			},
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+				require.Fail(t, "should have not simulated the transaction")
+				return nil, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		invalidOverrides := []map[string]*groups.StateOverrideRequest{
+			{"not an address": {}},
+			{"aabb": {Balance: "-5"}},
+			{"aabb": {Code: "not hex"}},
+			{"aabb": {Storage: map[string]string{"aa": "not hex"}}},
+		}
+		for _, overrides := range invalidOverrides {
+			req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(createRequest(overrides)))
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			simulateResponse := simulateTxResponse{}
+			loadResponse(resp.Body, &simulateResponse)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.Contains(t, simulateResponse.Error, apiErrors.ErrInvalidStateOverrides.Error())
+		}
+	})
+	t.Run("should pass the state overrides", func(t *testing.T) {
+		t.Parallel()
+
+		var receivedOverrides []*common.AccountStateOverride
+		facade := mock.FacadeStub{
+			CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, []byte("hash"), nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				return nil
+			},
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+				receivedOverrides = options.StateOverrides
+				return &txSimData.SimulationResults{}, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		overrides := map[string]*groups.StateOverrideRequest{
+			"bbbb": {Code: "c0de", Storage: map[string]string{"0a": "0b"}},
+			"aaaa": {Balance: "1000", Nonce: &nonce},
+		}
+		req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(createRequest(overrides)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		expectedOverrides := []*common.AccountStateOverride{
+			{
+				Address: []byte{0xaa, 0xaa},
+				Balance: big.NewInt(1000),
+				Nonce:   common.OptionalUint64{Value: nonce, HasValue: true},
+			},
+			{
+				Address: []byte{0xbb, 0xbb},
+				Code:    []byte{0xc0, 0xde},
+				Storage: map[string][]byte{"\x0a": {0x0b}},
+			},
+		}
+		assert.Equal(t, expectedOverrides, receivedOverrides)
+	})
+}
+
 func TestSimulateTransaction_ProcessErrorsShouldErr(t *testing.T) {
 	t.Parallel()
 
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
 			return nil, expectedErr
 		},
 		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
@@ -786,7 +1333,7 @@ func TestSimulateTransaction(t *testing.T) {
 	processTxWasCalled := false
 
 	facade := mock.FacadeStub{
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
 			processTxWasCalled = true
 			return &txSimData.SimulationResults{
 				Status:     "ok",
@@ -834,6 +1381,139 @@ func TestSimulateTransaction(t *testing.T) {
 	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
 }
 
+func TestSimulateBundle_InvalidRequestsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{Nonce: nonce}, []byte("hash"), nil
+		},
+		ValidateTxForBundleSimulationCalled: func(tx *dataTx.Transaction, checkSignature bool) error {
+			if tx.Nonce == 1 {
+				return expectedErr
+			}
+			return nil
+		},
+		SimulateTransactionsBundleCalled: func(txs []*dataTx.Transaction) (*txSimData.BundleSimulationResults, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+		GetMaxTransactionsInBundleCalled: func() uint32 {
+			return 3
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	t.Run("invalid body", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer([]byte("invalid bytes")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("empty bundle", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer([]byte("[]")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := simulateBundleResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrEmptyTransactionsBundle.Error())
+	})
+	t.Run("too many transactions", func(t *testing.T) {
+		jsonBytes, _ := json.Marshal([]groups.SendTxRequest{{Nonce: 2}, {Nonce: 3}, {Nonce: 4}, {Nonce: 5}})
+		req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := simulateBundleResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrTooManyTransactionsInBundle.Error())
+	})
+	t.Run("invalid transaction", func(t *testing.T) {
+		jsonBytes, _ := json.Marshal([]groups.SendTxRequest{{Nonce: 0}, {Nonce: 1}})
+		req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := simulateBundleResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, "index 1")
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+}
+
+func TestSimulateBundle(t *testing.T) {
+	t.Parallel()
+
+	stateDiff := []*txSimData.AccountStateDiff{
+		{
+			Address:       "erd1alice",
+			BalanceBefore: "100",
+			BalanceAfter:  "40",
+			NonceBefore:   3,
+			NonceAfter:    5,
+		},
+	}
+	facade := mock.FacadeStub{
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{Nonce: nonce}, []byte{byte(nonce)}, nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+		ValidateTxForBundleSimulationCalled: func(tx *dataTx.Transaction, checkSignature bool) error {
+			return nil
+		},
+		GetMaxTransactionsInBundleCalled: func() uint32 {
+			return 2
+		},
+		SimulateTransactionsBundleCalled: func(txs []*dataTx.Transaction) (*txSimData.BundleSimulationResults, error) {
+			require.Equal(t, 2, len(txs))
+			require.Equal(t, uint64(3), txs[0].Nonce)
+			require.Equal(t, uint64(4), txs[1].Nonce)
+
+			return &txSimData.BundleSimulationResults{
+				Results: []*txSimData.SimulationResults{
+					{Status: dataTx.TxStatusSuccess},
+					{Status: dataTx.TxStatusFail, FailReason: "insufficient funds"},
+				},
+				StateDiff: stateDiff,
+			}, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal([]groups.SendTxRequest{{Nonce: 3}, {Nonce: 4}})
+	req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := simulateBundleResponse{}
+	loadResponse(resp.Body, &response)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 2, len(response.Data.Result.Results))
+	assert.Equal(t, "03", response.Data.Result.Results[0].Hash)
+	assert.Equal(t, dataTx.TxStatusSuccess, response.Data.Result.Results[0].Status)
+	assert.Equal(t, "04", response.Data.Result.Results[1].Hash)
+	assert.Equal(t, "insufficient funds", response.Data.Result.Results[1].FailReason)
+	assert.Equal(t, stateDiff, response.Data.Result.StateDiff)
+}
+
 func TestGetTransactionsPoolShouldError(t *testing.T) {
 	t.Parallel()
 
@@ -891,6 +1571,412 @@ func TestGetTransactionsPoolShouldWork(t *testing.T) {
 	assert.Equal(t, *expectedTxPool, txsPoolResp.Data.TxPool)
 }
 
+func TestGetTransactionsPoolForSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetTransactionsPoolForSenderCalled: func(_ string) (*common.TransactionsPoolSenderAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/by-sender/alice", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := generalResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsPool.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedView := &common.TransactionsPoolSenderAPIResponse{
+			Sender:       "alice",
+			AccountNonce: 11,
+			EvictionRisk: "medium",
+			NonceGaps:    []*common.NonceGapAPIResponse{{From: 11, To: 11}},
+			Transactions: []*common.TransactionsPoolEntryAPIResponse{{Hash: "aa", Nonce: 12, IsBlockedByNonceGap: true}},
+		}
+		facade := mock.FacadeStub{
+			GetTransactionsPoolForSenderCalled: func(sender string) (*common.TransactionsPoolSenderAPIResponse, error) {
+				require.Equal(t, "alice", sender)
+				return expectedView, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/by-sender/alice", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txsPoolForSenderResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *expectedView, response.Data.TxPool)
+	})
+}
+
+func TestGetTransactionsPoolByFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid query parameters should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetMaxPoolFilterResultsCalled: func() uint32 {
+				return 100
+			},
+		}
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		queries := []string{"minGasPrice=abc", "maxGasPrice=-1", "minGasPrice=10&maxGasPrice=9", "offset=-1", "limit=0", "limit=101"}
+		for _, query := range queries {
+			req, _ := http.NewRequest("GET", "/transaction/pool/filter?"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := generalResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()), query)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTxs := []*common.TransactionsPoolEntryAPIResponse{{Hash: "aa", Receiver: "bob", GasPrice: 15}}
+		facade := mock.FacadeStub{
+			GetTransactionsPoolByFilterCalled: func(filter common.TransactionsPoolFilter) ([]*common.TransactionsPoolEntryAPIResponse, error) {
+				require.Equal(t, common.TransactionsPoolFilter{
+					Receiver:    "bob",
+					MinGasPrice: common.OptionalUint64{Value: 10, HasValue: true},
+					Offset:      20,
+					Limit:       100,
+				}, filter)
+				return expectedTxs, nil
+			},
+			GetMaxPoolFilterResultsCalled: func() uint32 {
+				return 100
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/filter?receiver=bob&minGasPrice=10&offset=20", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txsPoolByFilterResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedTxs, response.Data.Transactions)
+	})
+}
+
+func TestGetTransactionProcessStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetTransactionProcessStatusCalled: func(txHash string) (*common.TransactionProcessStatusAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/aabb/process-status", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := generalResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionProcessStatus.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedStatus := common.TransactionProcessStatusAPIResponse{
+			Hash:             "aabb",
+			Type:             "normal",
+			Status:           "pending",
+			SourceShard:      0,
+			DestinationShard: 1,
+			Steps: []*common.TransactionProcessStepAPIResponse{
+				{Name: "executedAtSource", Done: true, Shard: 0, BlockNonce: 7, BlockHash: "cc"},
+				{Name: "notarizedAtSourceInMeta", Done: false, Shard: core.MetachainShardId},
+			},
+			PendingStep: "notarizedAtSourceInMeta",
+		}
+		facade := mock.FacadeStub{
+			GetTransactionProcessStatusCalled: func(txHash string) (*common.TransactionProcessStatusAPIResponse, error) {
+				require.Equal(t, "aabb", txHash)
+				return &expectedStatus, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/aabb/process-status", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionProcessStatusResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedStatus, response.Data.ProcessStatus)
+	})
+}
+
+func TestGetGasPriceSuggestions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetGasPriceSuggestionsCalled: func() (*common.GasPriceSuggestionsAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/gas-price", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := generalResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetGasPriceSuggestions.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSuggestions := common.GasPriceSuggestionsAPIResponse{
+			ShardID:              1,
+			MinGasPrice:          1000000000,
+			Slow:                 1000000000,
+			Normal:               1100000000,
+			Fast:                 1500000000,
+			NumRecentBlocks:      20,
+			AverageBlockFullness: 0.9,
+			PendingTransactions:  100,
+			PendingBlocks:        2.5,
+		}
+		facade := mock.FacadeStub{
+			GetGasPriceSuggestionsCalled: func() (*common.GasPriceSuggestionsAPIResponse, error) {
+				return &expectedSuggestions, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/gas-price", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := gasPriceSuggestionsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedSuggestions, response.Data.GasPrice)
+	})
+}
+
+func TestSubscribeToTransactionsPool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			SubscribeToTransactionsPoolEventsCalled: func(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error) {
+				return nil, expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/subscribe", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := generalResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrSubscribeToTransactionsPool.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("too many subscriptions should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			SubscribeToTransactionsPoolEventsCalled: func(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error) {
+				return nil, common.ErrTooManyPoolSubscriptions
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/subscribe", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := generalResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		assert.True(t, strings.Contains(response.Error, common.ErrTooManyPoolSubscriptions.Error()))
+	})
+	t.Run("cross origin request should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		chUnsubscribed := make(chan struct{})
+		facade := mock.FacadeStub{
+			SubscribeToTransactionsPoolEventsCalled: func(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error) {
+				return &common.TransactionsPoolSubscription{
+					Events: make(chan *common.TransactionsPoolEventAPIResponse),
+					Unsubscribe: func() {
+						close(chUnsubscribed)
+					},
+				}, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		server := httptest.NewServer(startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig()))
+		defer server.Close()
+
+		url := "ws" + strings.TrimPrefix(server.URL, "http") + "/transaction/pool/subscribe"
+		header := http.Header{}
+		header.Set("Origin", "http://another-origin.example")
+		_, resp, err := websocket.DefaultDialer.Dial(url, header)
+		require.Equal(t, websocket.ErrBadHandshake, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		select {
+		case <-chUnsubscribed:
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "the subscription should have been ended")
+		}
+	})
+	t.Run("should stream the events until the subscription ends", func(t *testing.T) {
+		t.Parallel()
+
+		chEvents := make(chan *common.TransactionsPoolEventAPIResponse, 1)
+		chUnsubscribed := make(chan struct{})
+		facade := mock.FacadeStub{
+			SubscribeToTransactionsPoolEventsCalled: func(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error) {
+				require.Equal(t, common.TransactionsPoolFilter{Sender: "alice"}, filter)
+				return &common.TransactionsPoolSubscription{
+					Events: chEvents,
+					Unsubscribe: func() {
+						close(chUnsubscribed)
+					},
+				}, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		server := httptest.NewServer(startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig()))
+		defer server.Close()
+
+		url := "ws" + strings.TrimPrefix(server.URL, "http") + "/transaction/pool/subscribe?sender=alice"
+		conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+		require.NoError(t, err)
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		expectedEvent := &common.TransactionsPoolEventAPIResponse{
+			Event:       "added",
+			Transaction: &common.TransactionsPoolEntryAPIResponse{Hash: "aa", Sender: "alice", Nonce: 7},
+		}
+		chEvents <- expectedEvent
+
+		receivedEvent := &common.TransactionsPoolEventAPIResponse{}
+		err = conn.ReadJSON(receivedEvent)
+		require.NoError(t, err)
+		assert.Equal(t, expectedEvent, receivedEvent)
+
+		close(chEvents)
+		_, _, err = conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseTryAgainLater))
+
+		select {
+		case <-chUnsubscribed:
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "the subscription should have been ended")
+		}
+	})
+}
+
 func getTransactionRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -898,11 +1984,19 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/send", Open: true},
 					{Name: "/send-multiple", Open: true},
+					{Name: "/send-with-receipts", Open: true},
+					{Name: "/build-relayed", Open: true},
 					{Name: "/cost", Open: true},
 					{Name: "/pool", Open: true},
+					{Name: "/pool/by-sender/:sender", Open: true},
+					{Name: "/pool/filter", Open: true},
+					{Name: "/pool/subscribe", Open: true},
+					{Name: "/gas-price", Open: true},
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
+					{Name: "/:txhash/process-status", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/simulate-bundle", Open: true},
 				},
 			},
 		},
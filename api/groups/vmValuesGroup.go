@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/gin-gonic/gin"
@@ -76,13 +77,17 @@ func NewVmValuesGroup(facade vmValuesFacadeHandler) (*vmValuesGroup, error) {
 
 // VMValueRequest represents the structure on which user input for generating a new transaction will validate against
 type VMValueRequest struct {
-	ScAddress      string   `json:"scAddress"`
-	FuncName       string   `json:"funcName"`
-	CallerAddr     string   `json:"caller"`
-	CallValue      string   `json:"value"`
-	Args           []string `json:"args"`
-	SameScState    bool     `json:"sameScState"`
-	ShouldBeSynced bool     `json:"shouldBeSynced"`
+	ScAddress      string                           `json:"scAddress"`
+	FuncName       string                           `json:"funcName"`
+	CallerAddr     string                           `json:"caller"`
+	CallValue      string                           `json:"value"`
+	Args           []string                         `json:"args"`
+	SameScState    bool                             `json:"sameScState"`
+	ShouldBeSynced bool                             `json:"shouldBeSynced"`
+	BlockNonce     *uint64                          `json:"blockNonce,omitempty"`
+	BlockHash      string                           `json:"blockHash,omitempty"`
+	BlockRootHash  string                           `json:"blockRootHash,omitempty"`
+	StateOverrides map[string]*StateOverrideRequest `json:"stateOverrides,omitempty"`
 }
 
 // getHex returns the data as bytes, hex-encoded
@@ -194,9 +199,45 @@ func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQue
 		scQuery.CallValue = callValue
 	}
 
+	scQuery.BlockOptions, err = createBlockQueryOptions(request)
+	if err != nil {
+		return nil, err
+	}
+
+	scQuery.StateOverrides, err = createStateOverrides(vvg.getFacade(), request.StateOverrides)
+	if err != nil {
+		return nil, err
+	}
+
 	return scQuery, nil
 }
 
+func createBlockQueryOptions(request *VMValueRequest) (common.BlockQueryOptions, error) {
+	options := common.BlockQueryOptions{}
+	if request.BlockNonce != nil {
+		options.BlockNonce = common.OptionalUint64{
+			Value:    *request.BlockNonce,
+			HasValue: true,
+		}
+	}
+
+	var err error
+	if len(request.BlockHash) > 0 {
+		options.BlockHash, err = hex.DecodeString(request.BlockHash)
+		if err != nil {
+			return common.BlockQueryOptions{}, fmt.Errorf("'%s' is not a valid block hash: %s", request.BlockHash, err.Error())
+		}
+	}
+	if len(request.BlockRootHash) > 0 {
+		options.BlockRootHash, err = hex.DecodeString(request.BlockRootHash)
+		if err != nil {
+			return common.BlockQueryOptions{}, fmt.Errorf("'%s' is not a valid block root hash: %s", request.BlockRootHash, err.Error())
+		}
+	}
+
+	return options, nil
+}
+
 func (vvg *vmValuesGroup) returnBadRequest(context *gin.Context, errScope string, err error) {
 	message := fmt.Sprintf("%s: %s", errScope, err)
 	context.JSON(
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -163,6 +164,108 @@ func TestQuery_ShouldWork(t *testing.T) {
 	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
 }
 
+func TestQuery_WithBlockOptionsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	blockNonce := uint64(37)
+	blockHash := []byte("block hash")
+	var receivedQuery *process.SCQuery
+	facade := mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+			receivedQuery = query
+			return &vm.VMOutputApi{}, nil
+		},
+	}
+
+	request := groups.VMValueRequest{
+		ScAddress:  dummyScAddress,
+		FuncName:   "function",
+		BlockNonce: &blockNonce,
+		BlockHash:  hex.EncodeToString(blockHash),
+	}
+
+	response := vmOutputResponse{}
+	statusCode := doPost(t, &facade, "/vm-values/query", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+	require.Equal(t, common.OptionalUint64{Value: blockNonce, HasValue: true}, receivedQuery.BlockOptions.BlockNonce)
+	require.Equal(t, blockHash, receivedQuery.BlockOptions.BlockHash)
+	require.Empty(t, receivedQuery.BlockOptions.BlockRootHash)
+}
+
+func TestQuery_WithStateOverridesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var receivedQuery *process.SCQuery
+	facade := mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+			receivedQuery = query
+			return &vm.VMOutputApi{}, nil
+		},
+	}
+
+	request := groups.VMValueRequest{
+		ScAddress: dummyScAddress,
+		FuncName:  "function",
+		StateOverrides: map[string]*groups.StateOverrideRequest{
+			dummyScAddress: {Storage: map[string]string{"6b6579": "76616c7565"}},
+		},
+	}
+
+	response := vmOutputResponse{}
+	statusCode := doPost(t, &facade, "/vm-values/query", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+	scAddress, _ := hex.DecodeString(dummyScAddress)
+	expectedOverrides := []*common.AccountStateOverride{
+		{
+			Address: scAddress,
+			Storage: map[string][]byte{"key": []byte("value")},
+		},
+	}
+	require.Equal(t, expectedOverrides, receivedQuery.StateOverrides)
+}
+
+func TestCreateSCQuery_InvalidStateOverridesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	group, _ := groups.NewVmValuesGroup(&mock.FacadeStub{})
+
+	_, err := group.CreateSCQuery(&groups.VMValueRequest{
+		ScAddress: dummyScAddress,
+		FuncName:  "function",
+		StateOverrides: map[string]*groups.StateOverrideRequest{
+			dummyScAddress: {Balance: "not a number"},
+		},
+	})
+	require.NotNil(t, err)
+	require.True(t, errors.Is(err, apiErrors.ErrInvalidStateOverrides))
+}
+
+func TestCreateSCQuery_InvalidBlockHashesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	group, _ := groups.NewVmValuesGroup(&mock.FacadeStub{})
+
+	_, err := group.CreateSCQuery(&groups.VMValueRequest{
+		ScAddress: dummyScAddress,
+		FuncName:  "function",
+		BlockHash: "not hex",
+	})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "'not hex' is not a valid block hash")
+
+	_, err = group.CreateSCQuery(&groups.VMValueRequest{
+		ScAddress:     dummyScAddress,
+		FuncName:      "function",
+		BlockRootHash: "not hex",
+	})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "'not hex' is not a valid block root hash")
+}
+
 func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	request := groups.VMValueRequest{
 		ScAddress: dummyScAddress,
@@ -13,6 +13,9 @@ import (
 
 var log = logger.GetOrCreate("api/middleware")
 
+// releaseGlobalSlotKey is the gin context key under which the function releasing the slot held by the request is kept
+const releaseGlobalSlotKey = "releaseGlobalThrottlerSlot"
+
 // globalThrottler is a middleware global limiter used to limit total number of simultaneous requests
 type globalThrottler struct {
 	queue            chan struct{}
@@ -35,6 +38,13 @@ func NewGlobalThrottler(maxConnections uint32) (*globalThrottler, error) {
 // MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
 func (gt *globalThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		// the sub-requests of a batch request are processed within the slot already taken by the batch request,
+		// otherwise a batch could wait for itself. They are still accounted by the source and endpoint throttlers
+		if IsSubRequest(c.Request) {
+			c.Next()
+			return
+		}
+
 		path := c.Request.URL.Path
 
 		select {
@@ -57,12 +67,33 @@ func (gt *globalThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 			return
 		}
 
-		defer gt.finish(path)
+		releaseOnce := sync.Once{}
+		release := func() {
+			releaseOnce.Do(func() {
+				gt.finish(path)
+			})
+		}
+		c.Set(releaseGlobalSlotKey, release)
+		defer release()
 
 		c.Next()
 	}
 }
 
+// ReleaseGlobalThrottlerSlot releases, ahead of the request's end, the slot held by a long-lived request, such as a
+// web socket connection, so that it does not hold back the other requests
+func ReleaseGlobalThrottlerSlot(c *gin.Context) {
+	releaseI, ok := c.Get(releaseGlobalSlotKey)
+	if !ok {
+		return
+	}
+
+	release, ok := releaseI.(func())
+	if ok {
+		release()
+	}
+}
+
 func (gt *globalThrottler) finish(path string) {
 	gt.mutDebugRequests.Lock()
 	gt.debugRequests[path]--
@@ -1,6 +1,7 @@
 package middleware_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -102,6 +103,80 @@ func TestGlobalThrottler_LimitOverShouldError(t *testing.T) {
 	mutResponses.Unlock()
 }
 
+func TestGlobalThrottler_SubRequestsShouldNotTakeASlot(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	handlerFunc := func(c *gin.Context) {
+		if !middleware.IsSubRequest(c.Request) {
+			<-release
+		}
+	}
+	ws := startNodeServerGlobalThrottler(handlerFunc, 1)
+
+	done := make(chan struct{})
+	go func() {
+		req, _ := http.NewRequest("GET", "/address/blocking/balance", nil)
+		ws.ServeHTTP(httptest.NewRecorder(), req)
+		close(done)
+	}()
+	time.Sleep(time.Millisecond * 100)
+
+	req, _ := http.NewRequest("GET", "/address/other/balance", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+
+	ctx := middleware.ContextWithSubRequestMarker(context.Background())
+	subRequest, _ := http.NewRequestWithContext(ctx, "GET", "/address/other/balance", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, subRequest)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	close(release)
+	<-done
+}
+
+func TestGlobalThrottler_ReleasedSlotShouldBeAvailable(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	handlerFunc := func(c *gin.Context) {
+		if c.Param("address") != "long-lived" {
+			return
+		}
+
+		middleware.ReleaseGlobalThrottlerSlot(c)
+		middleware.ReleaseGlobalThrottlerSlot(c)
+		<-release
+	}
+	ws := startNodeServerGlobalThrottler(handlerFunc, 1)
+
+	done := make(chan struct{})
+	go func() {
+		req, _ := http.NewRequest("GET", "/address/long-lived/balance", nil)
+		ws.ServeHTTP(httptest.NewRecorder(), req)
+		close(done)
+	}()
+	time.Sleep(time.Millisecond * 100)
+
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest("GET", "/address/other/balance", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	}
+
+	close(release)
+	<-done
+
+	// the slot released ahead is not released again when the request ends
+	req, _ := http.NewRequest("GET", "/address/other/balance", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
 func makeRequestGlobalThrottler(ws *gin.Engine, mutResponses *sync.Mutex, responses map[int]int) {
 	addr := "testAddress"
 	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/balance", addr), nil)
@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+)
+
+type subRequestKey struct{}
+
+// ContextWithSubRequestMarker returns a copy of the provided context which marks the requests built with it as being
+// sub-requests of an already admitted batch request
+func ContextWithSubRequestMarker(ctx context.Context) context.Context {
+	return context.WithValue(ctx, subRequestKey{}, true)
+}
+
+// IsSubRequest returns true if the request was dispatched internally, as part of a batch request. The marker lives in
+// the request context, so it can not be set by the API clients
+func IsSubRequest(request *http.Request) bool {
+	isSubRequest, _ := request.Context().Value(subRequestKey{}).(bool)
+	return isSubRequest
+}
@@ -1,8 +1,10 @@
 package mock
 
 import (
+	"context"
 	"encoding/hex"
 	"math/big"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
@@ -23,29 +25,34 @@ type FacadeStub struct {
 	ShouldErrorStart           bool
 	ShouldErrorStop            bool
 	GetHeartbeatsHandler       func() ([]data.PubKeyHeartbeat, error)
-	BalanceHandler             func(string) (*big.Int, error)
-	GetAccountHandler          func(address string) (api.AccountResponse, error)
+	BalanceHandler             func(string, common.BlockQueryOptions) (*big.Int, common.BlockInfo, error)
+	GetAccountHandler          func(address string, options common.BlockQueryOptions) (api.AccountResponse, common.BlockInfo, error)
 	GenerateTransactionHandler func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler      func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
+	CreateRelayedTransactionCalled          func(innerTx *transaction.Transaction, relayer string, relayerNonce uint64, relayerSignatureHex string) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler              func(tx *transaction.Transaction) error
 	ValidateTransactionForSimulationHandler func(tx *transaction.Transaction, bypassSignature bool) error
+	ValidateTxForBundleSimulationCalled     func(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactionsHandler             func(txs []*transaction.Transaction) (uint64, error)
+	SendTransactionsWithReceiptsCalled      func(txs []*transaction.Transaction) ([]*common.TransactionSendReceiptAPIResponse, error)
 	ExecuteSCQueryHandler                   func(query *process.SCQuery) (*vm.VMOutputApi, error)
 	StatusMetricsHandler                    func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler              func() (map[string]*state.ValidatorApiResponse, error)
 	ComputeTransactionGasLimitHandler       func(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	NodeConfigCalled                        func() map[string]interface{}
 	GetQueryHandlerCalled                   func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                    func(address string, key string) (string, error)
+	GetValueForKeyCalled                    func(address string, key string, options common.BlockQueryOptions) (string, common.BlockInfo, error)
 	GetPeerInfoCalled                       func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
-	GetUsernameCalled                       func(address string) (string, error)
-	GetKeyValuePairsCalled                  func(address string) (map[string]string, error)
-	SimulateTransactionExecutionHandler     func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
-	GetESDTDataCalled                       func(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
-	GetAllESDTTokensCalled                  func(address string) (map[string]*esdt.ESDigitalToken, error)
+	GetUsernameCalled                       func(address string, options common.BlockQueryOptions) (string, common.BlockInfo, error)
+	GetKeyValuePairsCalled                  func(address string, options common.BlockQueryOptions) (map[string]string, common.BlockInfo, error)
+	SimulateTransactionExecutionHandler     func(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	SimulateTransactionsBundleCalled        func(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	GetMaxTransactionsInBundleCalled        func() uint32
+	GetESDTDataCalled                       func(address string, key string, nonce uint64, options common.BlockQueryOptions) (*esdt.ESDigitalToken, common.BlockInfo, error)
+	GetAllESDTTokensCalled                  func(address string, options common.BlockQueryOptions) (map[string]*esdt.ESDigitalToken, common.BlockInfo, error)
 	GetESDTsWithRoleCalled                  func(address string, role string) ([]string, error)
 	GetESDTsRolesCalled                     func(address string) (map[string][]string, error)
 	GetNFTTokenIDsRegisteredByAddressCalled func(address string) ([]string, error)
@@ -71,6 +78,16 @@ type FacadeStub struct {
 	GetTokenSupplyCalled                    func(token string) (*api.ESDTSupply, error)
 	GetGenesisNodesPubKeysCalled            func() (map[uint32][]string, map[uint32][]string, error)
 	GetTransactionsPoolCalled               func() (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionProcessStatusCalled       func(txHash string) (*common.TransactionProcessStatusAPIResponse, error)
+	WaitForTransactionsInclusionCalled      func(ctx context.Context, txsHashes []string, timeout time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error)
+	GetTransactionsPoolForSenderCalled      func(sender string) (*common.TransactionsPoolSenderAPIResponse, error)
+	GetTransactionsPoolByFilterCalled       func(filter common.TransactionsPoolFilter) ([]*common.TransactionsPoolEntryAPIResponse, error)
+	GetMaxPoolFilterResultsCalled           func() uint32
+	SubscribeToTransactionsPoolEventsCalled func(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error)
+	GetGasPriceSuggestionsCalled            func() (*common.GasPriceSuggestionsAPIResponse, error)
+	GetStateStatisticsCalled                func() (*common.StateStatisticsAPIResponse, error)
+	GetTransactionsByAddressCalled          func(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error)
+	GetLogEventsCalled                      func(address string, query common.LogEventsQuery) ([]*common.LogEventAPIResponse, error)
 }
 
 // GetTokenSupply -
@@ -119,12 +136,12 @@ func (f *FacadeStub) VerifyProof(rootHash string, address string, proof [][]byte
 }
 
 // GetUsername -
-func (f *FacadeStub) GetUsername(address string) (string, error) {
+func (f *FacadeStub) GetUsername(address string, options common.BlockQueryOptions) (string, common.BlockInfo, error) {
 	if f.GetUsernameCalled != nil {
-		return f.GetUsernameCalled(address)
+		return f.GetUsernameCalled(address, options)
 	}
 
-	return "", nil
+	return "", common.BlockInfo{}, nil
 }
 
 // GetThrottlerForEndpoint -
@@ -157,35 +174,35 @@ func (f *FacadeStub) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 }
 
 // GetBalance is the mock implementation of a handler's GetBalance method
-func (f *FacadeStub) GetBalance(address string) (*big.Int, error) {
-	return f.BalanceHandler(address)
+func (f *FacadeStub) GetBalance(address string, options common.BlockQueryOptions) (*big.Int, common.BlockInfo, error) {
+	return f.BalanceHandler(address, options)
 }
 
 // GetValueForKey is the mock implementation of a handler's GetValueForKey method
-func (f *FacadeStub) GetValueForKey(address string, key string) (string, error) {
+func (f *FacadeStub) GetValueForKey(address string, key string, options common.BlockQueryOptions) (string, common.BlockInfo, error) {
 	if f.GetValueForKeyCalled != nil {
-		return f.GetValueForKeyCalled(address, key)
+		return f.GetValueForKeyCalled(address, key, options)
 	}
 
-	return "", nil
+	return "", common.BlockInfo{}, nil
 }
 
 // GetKeyValuePairs -
-func (f *FacadeStub) GetKeyValuePairs(address string) (map[string]string, error) {
+func (f *FacadeStub) GetKeyValuePairs(address string, options common.BlockQueryOptions) (map[string]string, common.BlockInfo, error) {
 	if f.GetKeyValuePairsCalled != nil {
-		return f.GetKeyValuePairsCalled(address)
+		return f.GetKeyValuePairsCalled(address, options)
 	}
 
-	return nil, nil
+	return nil, common.BlockInfo{}, nil
 }
 
 // GetESDTData -
-func (f *FacadeStub) GetESDTData(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error) {
+func (f *FacadeStub) GetESDTData(address string, key string, nonce uint64, options common.BlockQueryOptions) (*esdt.ESDigitalToken, common.BlockInfo, error) {
 	if f.GetESDTDataCalled != nil {
-		return f.GetESDTDataCalled(address, key, nonce)
+		return f.GetESDTDataCalled(address, key, nonce, options)
 	}
 
-	return &esdt.ESDigitalToken{Value: big.NewInt(0)}, nil
+	return &esdt.ESDigitalToken{Value: big.NewInt(0)}, common.BlockInfo{}, nil
 }
 
 // GetESDTsRoles -
@@ -198,12 +215,12 @@ func (f *FacadeStub) GetESDTsRoles(address string) (map[string][]string, error)
 }
 
 // GetAllESDTTokens -
-func (f *FacadeStub) GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error) {
+func (f *FacadeStub) GetAllESDTTokens(address string, options common.BlockQueryOptions) (map[string]*esdt.ESDigitalToken, common.BlockInfo, error) {
 	if f.GetAllESDTTokensCalled != nil {
-		return f.GetAllESDTTokensCalled(address)
+		return f.GetAllESDTTokensCalled(address, options)
 	}
 
-	return make(map[string]*esdt.ESDigitalToken), nil
+	return make(map[string]*esdt.ESDigitalToken), common.BlockInfo{}, nil
 }
 
 // GetNFTTokenIDsRegisteredByAddress -
@@ -234,8 +251,8 @@ func (f *FacadeStub) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 }
 
 // GetAccount -
-func (f *FacadeStub) GetAccount(address string) (api.AccountResponse, error) {
-	return f.GetAccountHandler(address)
+func (f *FacadeStub) GetAccount(address string, options common.BlockQueryOptions) (api.AccountResponse, common.BlockInfo, error) {
+	return f.GetAccountHandler(address, options)
 }
 
 // CreateTransaction is  mock implementation of a handler's CreateTransaction method
@@ -257,14 +274,41 @@ func (f *FacadeStub) CreateTransaction(
 	return f.CreateTransactionHandler(nonce, value, receiver, receiverUsername, sender, senderUsername, gasPrice, gasLimit, data, signatureHex, chainID, version, options)
 }
 
+// CreateRelayedTransaction -
+func (f *FacadeStub) CreateRelayedTransaction(innerTx *transaction.Transaction, relayer string, relayerNonce uint64, relayerSignatureHex string) (*transaction.Transaction, []byte, error) {
+	if f.CreateRelayedTransactionCalled != nil {
+		return f.CreateRelayedTransactionCalled(innerTx, relayer, relayerNonce, relayerSignatureHex)
+	}
+
+	return nil, nil, nil
+}
+
 // GetTransaction is the mock implementation of a handler's GetTransaction method
 func (f *FacadeStub) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return f.GetTransactionHandler(hash, withResults)
 }
 
 // SimulateTransactionExecution is the mock implementation of a handler's SimulateTransactionExecution method
-func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
-	return f.SimulateTransactionExecutionHandler(tx)
+func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	return f.SimulateTransactionExecutionHandler(tx, options)
+}
+
+// SimulateTransactionsBundle -
+func (f *FacadeStub) SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if f.SimulateTransactionsBundleCalled != nil {
+		return f.SimulateTransactionsBundleCalled(txs)
+	}
+
+	return nil, nil
+}
+
+// GetMaxTransactionsInBundle -
+func (f *FacadeStub) GetMaxTransactionsInBundle() uint32 {
+	if f.GetMaxTransactionsInBundleCalled != nil {
+		return f.GetMaxTransactionsInBundleCalled()
+	}
+
+	return 0
 }
 
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
@@ -272,6 +316,15 @@ func (f *FacadeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint6
 	return f.SendBulkTransactionsHandler(txs)
 }
 
+// SendTransactionsWithReceipts -
+func (f *FacadeStub) SendTransactionsWithReceipts(txs []*transaction.Transaction) ([]*common.TransactionSendReceiptAPIResponse, error) {
+	if f.SendTransactionsWithReceiptsCalled != nil {
+		return f.SendTransactionsWithReceiptsCalled(txs)
+	}
+
+	return nil, nil
+}
+
 // ValidateTransaction -
 func (f *FacadeStub) ValidateTransaction(tx *transaction.Transaction) error {
 	return f.ValidateTransactionHandler(tx)
@@ -282,6 +335,15 @@ func (f *FacadeStub) ValidateTransactionForSimulation(tx *transaction.Transactio
 	return f.ValidateTransactionForSimulationHandler(tx, bypassSignature)
 }
 
+// ValidateTransactionForBundleSimulation -
+func (f *FacadeStub) ValidateTransactionForBundleSimulation(tx *transaction.Transaction, checkSignature bool) error {
+	if f.ValidateTxForBundleSimulationCalled != nil {
+		return f.ValidateTxForBundleSimulationCalled(tx, checkSignature)
+	}
+
+	return nil
+}
+
 // ValidatorStatisticsApi is the mock implementation of a handler's ValidatorStatisticsApi method
 func (f *FacadeStub) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error) {
 	return f.ValidatorStatisticsHandler()
@@ -441,6 +503,96 @@ func (f *FacadeStub) GetTransactionsPool() (*common.TransactionsPoolAPIResponse,
 	return nil, nil
 }
 
+// GetTransactionProcessStatus -
+func (f *FacadeStub) GetTransactionProcessStatus(txHash string) (*common.TransactionProcessStatusAPIResponse, error) {
+	if f.GetTransactionProcessStatusCalled != nil {
+		return f.GetTransactionProcessStatusCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// WaitForTransactionsInclusion -
+func (f *FacadeStub) WaitForTransactionsInclusion(ctx context.Context, txsHashes []string, timeout time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error) {
+	if f.WaitForTransactionsInclusionCalled != nil {
+		return f.WaitForTransactionsInclusionCalled(ctx, txsHashes, timeout)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolForSender -
+func (f *FacadeStub) GetTransactionsPoolForSender(sender string) (*common.TransactionsPoolSenderAPIResponse, error) {
+	if f.GetTransactionsPoolForSenderCalled != nil {
+		return f.GetTransactionsPoolForSenderCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolByFilter -
+func (f *FacadeStub) GetTransactionsPoolByFilter(filter common.TransactionsPoolFilter) ([]*common.TransactionsPoolEntryAPIResponse, error) {
+	if f.GetTransactionsPoolByFilterCalled != nil {
+		return f.GetTransactionsPoolByFilterCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// GetMaxTransactionsPoolFilterResults -
+func (f *FacadeStub) GetMaxTransactionsPoolFilterResults() uint32 {
+	if f.GetMaxPoolFilterResultsCalled != nil {
+		return f.GetMaxPoolFilterResultsCalled()
+	}
+
+	return 0
+}
+
+// SubscribeToTransactionsPoolEvents -
+func (f *FacadeStub) SubscribeToTransactionsPoolEvents(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error) {
+	if f.SubscribeToTransactionsPoolEventsCalled != nil {
+		return f.SubscribeToTransactionsPoolEventsCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// GetGasPriceSuggestions -
+func (f *FacadeStub) GetGasPriceSuggestions() (*common.GasPriceSuggestionsAPIResponse, error) {
+	if f.GetGasPriceSuggestionsCalled != nil {
+		return f.GetGasPriceSuggestionsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetStateStatistics -
+func (f *FacadeStub) GetStateStatistics() (*common.StateStatisticsAPIResponse, error) {
+	if f.GetStateStatisticsCalled != nil {
+		return f.GetStateStatisticsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetLogEvents -
+func (f *FacadeStub) GetLogEvents(address string, query common.LogEventsQuery) ([]*common.LogEventAPIResponse, error) {
+	if f.GetLogEventsCalled != nil {
+		return f.GetLogEventsCalled(address, query)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsByAddress -
+func (f *FacadeStub) GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+	if f.GetTransactionsByAddressCalled != nil {
+		return f.GetTransactionsByAddressCalled(address, from, size)
+	}
+
+	return nil, nil
+}
+
 // Trigger -
 func (f *FacadeStub) Trigger(_ uint32, _ bool) error {
 	return nil
@@ -6,6 +6,8 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForDbMigrator
+    generateForTrieChecker
 }
 
 generateForNode() {
@@ -78,6 +80,34 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForDbMigrator() {
+    HELP="
+# DB migrator CLI
+
+The **DB migration Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbmigrator --help
+
+$(./dbmigrator/dbmigrator --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbmigrator/CLI.md
+}
+
+generateForTrieChecker() {
+    HELP="
+# Trie checker CLI
+
+The **Trie integrity checker Tool** exposes the following Command Line Interface:
+$(code)
+\$ triechecker --help
+
+$(./triechecker/triechecker --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./triechecker/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
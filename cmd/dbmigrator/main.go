@@ -0,0 +1,208 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	workingDirectory  string
+	chainID           string
+	sourceType        string
+	targetType        string
+	batchDelaySeconds int
+	maxBatchSize      int
+	maxOpenFiles      int
+	keepBackup        bool
+	logLevel          string
+}
+
+var (
+	dbMigratorHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// workingDirectory defines a flag for the node's working directory, the one holding the db directory
+	workingDirectory = cli.StringFlag{
+		Name:        "working-directory",
+		Usage:       "The node's working directory, the one containing the db directory",
+		Value:       ".",
+		Destination: &argsConfig.workingDirectory,
+	}
+	// chainID defines a flag for the chain ID directory to be migrated
+	chainID = cli.StringFlag{
+		Name:        "chain-id",
+		Usage:       "The chain ID, as found in the name of the directory under the db directory. Example: 1",
+		Value:       "1",
+		Destination: &argsConfig.chainID,
+	}
+	// sourceType defines a flag for the DB type the persisters currently use
+	sourceType = cli.StringFlag{
+		Name: "source-type",
+		Usage: fmt.Sprintf(
+			"The DB type the persisters currently use. Available options: %s, %s, %s",
+			storageUnit.LvlDB,
+			storageUnit.LvlDBSerial,
+			storageUnit.PebbleDB),
+		Value:       string(storageUnit.LvlDBSerial),
+		Destination: &argsConfig.sourceType,
+	}
+	// targetType defines a flag for the DB type the persisters will be converted to
+	targetType = cli.StringFlag{
+		Name: "target-type",
+		Usage: fmt.Sprintf(
+			"The DB type the persisters will be converted to. Available options: %s, %s, %s",
+			storageUnit.LvlDB,
+			storageUnit.LvlDBSerial,
+			storageUnit.PebbleDB),
+		Value:       string(storageUnit.PebbleDB),
+		Destination: &argsConfig.targetType,
+	}
+	// batchDelaySeconds defines a flag for the batch delay used when writing the target persisters
+	batchDelaySeconds = cli.IntFlag{
+		Name:        "batch-delay-seconds",
+		Usage:       "The number of seconds after which a pending batch is written in the target persister",
+		Value:       2,
+		Destination: &argsConfig.batchDelaySeconds,
+	}
+	// maxBatchSize defines a flag for the batch size used when writing the target persisters
+	maxBatchSize = cli.IntFlag{
+		Name:        "max-batch-size",
+		Usage:       "The number of key-value pairs after which a batch is written in the target persister",
+		Value:       30000,
+		Destination: &argsConfig.maxBatchSize,
+	}
+	// maxOpenFiles defines a flag for the number of files each opened persister can keep opened
+	maxOpenFiles = cli.IntFlag{
+		Name:        "max-open-files",
+		Usage:       "The maximum number of files each opened persister can keep opened",
+		Value:       10,
+		Destination: &argsConfig.maxOpenFiles,
+	}
+	// keepBackup is the flag that, if active, will keep the source persisters next to the migrated ones
+	keepBackup = cli.BoolFlag{
+		Name:        "keep-backup",
+		Usage:       "Boolean option that will keep each source persister in a .bak directory next to the migrated one",
+		Destination: &argsConfig.keepBackup,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("dbmigrator")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = dbMigratorHelpTemplate
+	app.Name = "DB migration Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary will convert the persisters of a stopped node's db directory from one DB type to another"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		workingDirectory,
+		chainID,
+		sourceType,
+		targetType,
+		batchDelaySeconds,
+		maxBatchSize,
+		maxOpenFiles,
+		keepBackup,
+		logLevel,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error migrating the db directory", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	err = checkDBType(argsConfig.sourceType)
+	if err != nil {
+		return err
+	}
+	err = checkDBType(argsConfig.targetType)
+	if err != nil {
+		return err
+	}
+
+	dbPathWithChainID := filepath.Join(argsConfig.workingDirectory, common.DefaultDBPath, argsConfig.chainID)
+	if !pathExists(dbPathWithChainID) {
+		return fmt.Errorf("db directory %s does not exist", dbPathWithChainID)
+	}
+
+	m, err := newMigrator(argsMigrator{
+		dbPathWithChainID: dbPathWithChainID,
+		sourceConfig:      createDBConfig(argsConfig.sourceType),
+		targetConfig:      createDBConfig(argsConfig.targetType),
+		keepBackup:        argsConfig.keepBackup,
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.migrate()
+}
+
+func createDBConfig(dbType string) config.DBConfig {
+	return config.DBConfig{
+		Type:              dbType,
+		BatchDelaySeconds: argsConfig.batchDelaySeconds,
+		MaxBatchSize:      argsConfig.maxBatchSize,
+		MaxOpenFiles:      argsConfig.maxOpenFiles,
+	}
+}
+
+// checkDBType only allows the on-disk DB types, a MemoryDB persister has nothing to be migrated
+func checkDBType(dbType string) error {
+	switch storageUnit.DBType(dbType) {
+	case storageUnit.LvlDB, storageUnit.LvlDBSerial, storageUnit.PebbleDB:
+		return nil
+	default:
+		return fmt.Errorf("unsupported DB type %s", dbType)
+	}
+}
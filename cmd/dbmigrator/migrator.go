@@ -0,0 +1,482 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/factory/directoryhandler"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+const (
+	migratingSuffix = ".migrating"
+	backupSuffix    = ".bak"
+	// persisterMarkerFile is written by both LevelDB and Pebble in the root of every database directory
+	persisterMarkerFile = "CURRENT"
+	// progressFile lists, one per line, the persisters already swapped, relative to the database path. It is removed
+	// once all the persisters were migrated
+	progressFile = "dbmigrator.progress"
+)
+
+var (
+	errVerificationFailed     = errors.New("verification failed")
+	errUnexpectedBackupFolder = errors.New("unexpected backup folder")
+	errSameOnDiskFormat       = errors.New("source and target DB types share the same on-disk format, there is nothing to migrate")
+)
+
+type argsMigrator struct {
+	dbPathWithChainID string
+	sourceConfig      config.DBConfig
+	targetConfig      config.DBConfig
+	keepBackup        bool
+}
+
+type persisterLocation struct {
+	shardID    string
+	epoch      uint32
+	isStatic   bool
+	identifier string
+}
+
+type persisterSummary struct {
+	numKeys uint64
+	digest  []byte
+}
+
+type migrator struct {
+	pathManager     *pathmanager.PathManager
+	directoryReader storage.DirectoryReaderHandler
+	sourceFactory   storage.PersisterFactory
+	targetFactory   storage.PersisterFactory
+	hasher          hashing.Hasher
+	targetType      string
+	keepBackup      bool
+	migrated        map[string]struct{}
+}
+
+func newMigrator(args argsMigrator) (*migrator, error) {
+	if args.sourceConfig.Type == args.targetConfig.Type {
+		return nil, fmt.Errorf("source and target DB types are the same: %s", args.sourceConfig.Type)
+	}
+	if isLevelDBType(args.sourceConfig.Type) && isLevelDBType(args.targetConfig.Type) {
+		return nil, fmt.Errorf("%w, source: %s, target: %s, only the DB.Type of the storers in config.toml should be changed",
+			errSameOnDiskFormat, args.sourceConfig.Type, args.targetConfig.Type)
+	}
+
+	pathManager, err := factory.CreatePathManagerFromSinglePathString(args.dbPathWithChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &migrator{
+		pathManager:     pathManager,
+		directoryReader: directoryhandler.NewDirectoryReader(),
+		sourceFactory:   factory.NewPersisterFactory(args.sourceConfig),
+		targetFactory:   factory.NewPersisterFactory(args.targetConfig),
+		hasher:          sha256.NewSha256(),
+		targetType:      args.targetConfig.Type,
+		keepBackup:      args.keepBackup,
+		migrated:        make(map[string]struct{}),
+	}, nil
+}
+
+// migrate will convert every persister found under the database path. It stops at the first error, leaving the
+// already migrated persisters in place, so that a new run will only handle the remaining ones
+func (m *migrator) migrate() error {
+	err := m.loadProgress()
+	if err != nil {
+		return err
+	}
+
+	locations, err := m.findPersisters()
+	if err != nil {
+		return err
+	}
+
+	log.Info("found persisters", "path", m.pathManager.DatabasePath(), "num persisters", len(locations))
+	for _, location := range locations {
+		err = m.migratePersister(m.pathFor(location))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = os.RemoveAll(filepath.Join(m.pathManager.DatabasePath(), progressFile))
+	if err != nil {
+		return err
+	}
+
+	log.Info("migration done", "path", m.pathManager.DatabasePath(), "num persisters", len(locations))
+	log.Info("the node will not be able to open the migrated persisters until the DB.Type of all its storers in "+
+		"config.toml is set to the target DB type", "target DB type", m.targetType)
+
+	return nil
+}
+
+// isLevelDBType returns true for both the serial and the non serial LevelDB types, which only differ in the way the
+// writes are handled, thus share the same on-disk format
+func isLevelDBType(dbType string) bool {
+	return dbType == string(storageUnit.LvlDB) || dbType == string(storageUnit.LvlDBSerial)
+}
+
+func (m *migrator) pathFor(location persisterLocation) string {
+	if location.isStatic {
+		return m.pathManager.PathForStatic(location.shardID, location.identifier)
+	}
+
+	return m.pathManager.PathForEpoch(location.shardID, location.epoch, location.identifier)
+}
+
+func (m *migrator) findPersisters() ([]persisterLocation, error) {
+	dbPath := m.pathManager.DatabasePath()
+	directories, err := m.directoryReader.ListDirectoriesAsString(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(directories)
+
+	locations := make([]persisterLocation, 0)
+	for _, directory := range directories {
+		isStatic := directory == common.DefaultStaticDbString
+		epoch, isEpoch := parseSuffix(directory, common.DefaultEpochString)
+		if !isStatic && !isEpoch {
+			log.Debug("skipping directory", "directory", directory)
+			continue
+		}
+
+		shardDirectories, errList := m.directoryReader.ListDirectoriesAsString(filepath.Join(dbPath, directory))
+		if errList != nil {
+			return nil, errList
+		}
+		sort.Strings(shardDirectories)
+
+		for _, shardDirectory := range shardDirectories {
+			if !strings.HasPrefix(shardDirectory, common.DefaultShardString+"_") {
+				continue
+			}
+
+			shardID := strings.TrimPrefix(shardDirectory, common.DefaultShardString+"_")
+			identifiers, errFind := m.findIdentifiers(filepath.Join(dbPath, directory, shardDirectory), "")
+			if errFind != nil {
+				return nil, errFind
+			}
+
+			for _, identifier := range identifiers {
+				locations = append(locations, persisterLocation{
+					shardID:    shardID,
+					epoch:      uint32(epoch),
+					isStatic:   isStatic,
+					identifier: identifier,
+				})
+			}
+		}
+	}
+
+	return locations, nil
+}
+
+// findIdentifiers returns the persister directories found under the provided shard directory, relative to it.
+// Nested identifiers such as DbLookupExtensions/MiniblocksMetadata are also returned
+func (m *migrator) findIdentifiers(shardPath string, relativePath string) ([]string, error) {
+	directories, err := m.directoryReader.ListDirectoriesAsString(filepath.Join(shardPath, relativePath))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(directories)
+
+	identifiers := make([]string, 0, len(directories))
+	for _, directory := range directories {
+		identifier := filepath.Join(relativePath, directory)
+		if strings.HasSuffix(directory, migratingSuffix) || strings.HasSuffix(directory, backupSuffix) {
+			// leftovers from previous runs are handled when migrating the persister they belong to
+			identifier = strings.TrimSuffix(strings.TrimSuffix(identifier, migratingSuffix), backupSuffix)
+			if !contains(identifiers, identifier) && !pathExists(filepath.Join(shardPath, identifier)) {
+				identifiers = append(identifiers, identifier)
+			}
+			continue
+		}
+
+		if pathExists(filepath.Join(shardPath, identifier, persisterMarkerFile)) {
+			if !contains(identifiers, identifier) {
+				identifiers = append(identifiers, identifier)
+			}
+			continue
+		}
+
+		nested, errFind := m.findIdentifiers(shardPath, identifier)
+		if errFind != nil {
+			return nil, errFind
+		}
+		identifiers = append(identifiers, nested...)
+	}
+
+	return identifiers, nil
+}
+
+// migratePersister copies the persister found at the provided path in a sibling directory, verifies the copy and
+// swaps the two directories. A swap interrupted between its two renames is completed on the next run
+func (m *migrator) migratePersister(path string) error {
+	migratingPath := path + migratingSuffix
+	backupPath := path + backupSuffix
+
+	_, isMigrated := m.migrated[m.relativePath(path)]
+	if pathExists(backupPath) {
+		if pathExists(path) {
+			if isMigrated {
+				log.Debug("persister already migrated, backup kept", "path", path)
+				return nil
+			}
+
+			return fmt.Errorf("%w %s: remove it or move it away before migrating", errUnexpectedBackupFolder, backupPath)
+		}
+		if !pathExists(migratingPath) {
+			return fmt.Errorf("%w %s: neither the persister nor its copy exist", errUnexpectedBackupFolder, backupPath)
+		}
+
+		// the copy was verified before the source was moved away, only the second rename is missing
+		log.Info("completing interrupted swap", "path", path)
+		return m.finishSwap(path, migratingPath, backupPath)
+	}
+	if isMigrated {
+		log.Debug("persister already migrated", "path", path)
+		return nil
+	}
+
+	err := os.RemoveAll(migratingPath)
+	if err != nil {
+		return err
+	}
+	if !pathExists(path) {
+		log.Debug("removed leftover copy without source", "path", migratingPath)
+		return nil
+	}
+
+	log.Info("migrating persister", "path", path)
+	sourceSummary, err := m.copyPersister(path, migratingPath)
+	if err != nil {
+		return fmt.Errorf("%w while copying %s", err, path)
+	}
+
+	targetSummary, err := m.summarize(m.targetFactory, migratingPath)
+	if err != nil {
+		return fmt.Errorf("%w while reading back %s", err, migratingPath)
+	}
+
+	if sourceSummary.numKeys != targetSummary.numKeys {
+		return fmt.Errorf("%w for %s: source has %d keys, target has %d keys",
+			errVerificationFailed, path, sourceSummary.numKeys, targetSummary.numKeys)
+	}
+	if !bytes.Equal(sourceSummary.digest, targetSummary.digest) {
+		return fmt.Errorf("%w for %s: key-value digests do not match", errVerificationFailed, path)
+	}
+
+	err = os.Rename(path, backupPath)
+	if err != nil {
+		return err
+	}
+
+	log.Info("persister migrated", "path", path, "num keys", sourceSummary.numKeys)
+
+	return m.finishSwap(path, migratingPath, backupPath)
+}
+
+func (m *migrator) finishSwap(path string, migratingPath string, backupPath string) error {
+	err := m.saveProgress(path)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(migratingPath, path)
+	if err != nil {
+		return err
+	}
+
+	if m.keepBackup {
+		return nil
+	}
+
+	return os.RemoveAll(backupPath)
+}
+
+func (m *migrator) relativePath(path string) string {
+	relativePath, err := filepath.Rel(m.pathManager.DatabasePath(), path)
+	if err != nil {
+		return path
+	}
+
+	return filepath.ToSlash(relativePath)
+}
+
+func (m *migrator) loadProgress() error {
+	content, err := ioutil.ReadFile(filepath.Join(m.pathManager.DatabasePath(), progressFile))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) > 0 {
+			m.migrated[line] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (m *migrator) saveProgress(path string) error {
+	relativePath := m.relativePath(path)
+	if _, ok := m.migrated[relativePath]; ok {
+		return nil
+	}
+
+	file, err := os.OpenFile(filepath.Join(m.pathManager.DatabasePath(), progressFile), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.WriteString(relativePath + "\n")
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = file.Sync()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	m.migrated[relativePath] = struct{}{}
+
+	return file.Close()
+}
+
+func (m *migrator) copyPersister(sourcePath string, targetPath string) (*persisterSummary, error) {
+	source, err := m.sourceFactory.Create(sourcePath)
+	if err != nil {
+		return nil, err
+	}
+	defer closePersister(source, sourcePath)
+
+	target, err := m.targetFactory.Create(targetPath)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := m.newSummary()
+	var errPut error
+	source.RangeKeys(func(key []byte, val []byte) bool {
+		errPut = target.Put(key, val)
+		if errPut != nil {
+			return false
+		}
+
+		m.addToSummary(summary, key, val)
+
+		return true
+	})
+
+	errClose := target.Close()
+	if errPut != nil {
+		return nil, errPut
+	}
+	if errClose != nil {
+		return nil, errClose
+	}
+
+	return summary, nil
+}
+
+func (m *migrator) summarize(persisterFactory storage.PersisterFactory, path string) (*persisterSummary, error) {
+	persister, err := persisterFactory.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	defer closePersister(persister, path)
+
+	summary := m.newSummary()
+	persister.RangeKeys(func(key []byte, val []byte) bool {
+		m.addToSummary(summary, key, val)
+
+		return true
+	})
+
+	return summary, nil
+}
+
+func (m *migrator) newSummary() *persisterSummary {
+	return &persisterSummary{
+		digest: make([]byte, m.hasher.Size()),
+	}
+}
+
+// addToSummary XORs the hash of the key-value pair into the digest, so the result does not depend on the
+// iteration order of the engines
+func (m *migrator) addToSummary(summary *persisterSummary, key []byte, val []byte) {
+	keyLength := make([]byte, 8)
+	binary.BigEndian.PutUint64(keyLength, uint64(len(key)))
+
+	buff := make([]byte, 0, len(keyLength)+len(key)+len(val))
+	buff = append(buff, keyLength...)
+	buff = append(buff, key...)
+	buff = append(buff, val...)
+
+	hash := m.hasher.Compute(string(buff))
+	for i := range summary.digest {
+		summary.digest[i] ^= hash[i]
+	}
+	summary.numKeys++
+}
+
+func closePersister(persister storage.Persister, path string) {
+	err := persister.Close()
+	if err != nil {
+		log.Warn("error closing persister", "path", path, "error", err)
+	}
+}
+
+func parseSuffix(directory string, prefix string) (uint64, bool) {
+	if !strings.HasPrefix(directory, prefix+"_") {
+		return 0, false
+	}
+
+	value, err := strconv.ParseUint(strings.TrimPrefix(directory, prefix+"_"), 10, 32)
+	if err != nil {
+		return 0, false
+	}
+
+	return value, true
+}
+
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func contains(list []string, element string) bool {
+	for _, item := range list {
+		if item == element {
+			return true
+		}
+	}
+
+	return false
+}
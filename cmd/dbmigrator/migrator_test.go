@@ -0,0 +1,203 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const numKeysForTest = 100
+
+func createDBConfigForTest(dbType storageUnit.DBType) config.DBConfig {
+	return config.DBConfig{
+		Type:              string(dbType),
+		BatchDelaySeconds: 2,
+		MaxBatchSize:      10,
+		MaxOpenFiles:      10,
+	}
+}
+
+func createPersisterForTest(t *testing.T, path string, dbType storageUnit.DBType, numKeys int) {
+	persister, err := factory.NewPersisterFactory(createDBConfigForTest(dbType)).Create(path)
+	require.Nil(t, err)
+
+	for i := 0; i < numKeys; i++ {
+		err = persister.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.Nil(t, err)
+	}
+
+	require.Nil(t, persister.Close())
+}
+
+func checkPersisterForTest(t *testing.T, path string, dbType storageUnit.DBType, numKeys int) {
+	persister, err := factory.NewPersisterFactory(createDBConfigForTest(dbType)).Create(path)
+	require.Nil(t, err)
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	counter := 0
+	persister.RangeKeys(func(_ []byte, _ []byte) bool {
+		counter++
+		return true
+	})
+	assert.Equal(t, numKeys, counter)
+
+	for i := 0; i < numKeys; i++ {
+		val, errGet := persister.Get([]byte(fmt.Sprintf("key%d", i)))
+		assert.Nil(t, errGet)
+		assert.Equal(t, []byte(fmt.Sprintf("value%d", i)), val)
+	}
+}
+
+func createMigratorForTest(t *testing.T, dbPath string, keepBackup bool) *migrator {
+	m, err := newMigrator(argsMigrator{
+		dbPathWithChainID: dbPath,
+		sourceConfig:      createDBConfigForTest(storageUnit.LvlDBSerial),
+		targetConfig:      createDBConfigForTest(storageUnit.PebbleDB),
+		keepBackup:        keepBackup,
+	})
+	require.Nil(t, err)
+
+	return m
+}
+
+func TestNewMigrator_SameTypesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	m, err := newMigrator(argsMigrator{
+		dbPathWithChainID: t.TempDir(),
+		sourceConfig:      createDBConfigForTest(storageUnit.PebbleDB),
+		targetConfig:      createDBConfigForTest(storageUnit.PebbleDB),
+	})
+	assert.Nil(t, m)
+	assert.NotNil(t, err)
+}
+
+func TestNewMigrator_LevelDBTypesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	m, err := newMigrator(argsMigrator{
+		dbPathWithChainID: t.TempDir(),
+		sourceConfig:      createDBConfigForTest(storageUnit.LvlDB),
+		targetConfig:      createDBConfigForTest(storageUnit.LvlDBSerial),
+	})
+	assert.Nil(t, m)
+	assert.True(t, errors.Is(err, errSameOnDiskFormat))
+
+	m, err = newMigrator(argsMigrator{
+		dbPathWithChainID: t.TempDir(),
+		sourceConfig:      createDBConfigForTest(storageUnit.LvlDBSerial),
+		targetConfig:      createDBConfigForTest(storageUnit.LvlDB),
+	})
+	assert.Nil(t, m)
+	assert.True(t, errors.Is(err, errSameOnDiskFormat))
+}
+
+func TestMigrator_MigrateShouldConvertAllPersisters(t *testing.T) {
+	t.Parallel()
+
+	dbPath := filepath.Join(t.TempDir(), "db", "1")
+	epochPath := filepath.Join(dbPath, "Epoch_0", "Shard_0", "MiniBlocks")
+	nestedEpochPath := filepath.Join(dbPath, "Epoch_1", "Shard_metachain", "ExportStateStorage", "MainDB")
+	staticPath := filepath.Join(dbPath, "Static", "Shard_0", "DbLookupExtensions_RoundHash")
+	createPersisterForTest(t, epochPath, storageUnit.LvlDBSerial, numKeysForTest)
+	createPersisterForTest(t, nestedEpochPath, storageUnit.LvlDBSerial, numKeysForTest)
+	createPersisterForTest(t, staticPath, storageUnit.LvlDBSerial, 0)
+
+	m := createMigratorForTest(t, dbPath, false)
+	locations, err := m.findPersisters()
+	require.Nil(t, err)
+	assert.Equal(t, 3, len(locations))
+
+	err = m.migrate()
+	require.Nil(t, err)
+
+	checkPersisterForTest(t, epochPath, storageUnit.PebbleDB, numKeysForTest)
+	checkPersisterForTest(t, nestedEpochPath, storageUnit.PebbleDB, numKeysForTest)
+	checkPersisterForTest(t, staticPath, storageUnit.PebbleDB, 0)
+	assert.False(t, pathExists(epochPath+backupSuffix))
+	assert.False(t, pathExists(epochPath+migratingSuffix))
+	assert.False(t, pathExists(filepath.Join(dbPath, progressFile)))
+}
+
+func TestMigrator_MigrateWithKeepBackupShouldKeepTheSource(t *testing.T) {
+	t.Parallel()
+
+	dbPath := filepath.Join(t.TempDir(), "db", "1")
+	path := filepath.Join(dbPath, "Epoch_0", "Shard_0", "MiniBlocks")
+	createPersisterForTest(t, path, storageUnit.LvlDBSerial, numKeysForTest)
+
+	err := createMigratorForTest(t, dbPath, true).migrate()
+	require.Nil(t, err)
+
+	checkPersisterForTest(t, path, storageUnit.PebbleDB, numKeysForTest)
+	checkPersisterForTest(t, path+backupSuffix, storageUnit.LvlDBSerial, numKeysForTest)
+
+	err = createMigratorForTest(t, dbPath, true).migrate()
+	assert.True(t, errors.Is(err, errUnexpectedBackupFolder))
+}
+
+func TestMigrator_MigrateShouldCompleteAnInterruptedSwap(t *testing.T) {
+	t.Parallel()
+
+	dbPath := filepath.Join(t.TempDir(), "db", "1")
+	path := filepath.Join(dbPath, "Epoch_0", "Shard_0", "MiniBlocks")
+	createPersisterForTest(t, path+migratingSuffix, storageUnit.PebbleDB, numKeysForTest)
+	createPersisterForTest(t, path+backupSuffix, storageUnit.LvlDBSerial, numKeysForTest)
+
+	err := createMigratorForTest(t, dbPath, false).migrate()
+	require.Nil(t, err)
+
+	checkPersisterForTest(t, path, storageUnit.PebbleDB, numKeysForTest)
+	assert.False(t, pathExists(path+backupSuffix))
+	assert.False(t, pathExists(path+migratingSuffix))
+}
+
+func TestMigrator_MigrateShouldSkipAlreadyMigratedPersisters(t *testing.T) {
+	t.Parallel()
+
+	dbPath := filepath.Join(t.TempDir(), "db", "1")
+	migratedPath := filepath.Join(dbPath, "Epoch_0", "Shard_0", "MiniBlocks")
+	path := filepath.Join(dbPath, "Epoch_0", "Shard_0", "Receipts")
+	createPersisterForTest(t, migratedPath, storageUnit.PebbleDB, numKeysForTest)
+	createPersisterForTest(t, path, storageUnit.LvlDBSerial, numKeysForTest)
+	createPersisterForTest(t, path+migratingSuffix, storageUnit.PebbleDB, 1)
+
+	err := ioutil.WriteFile(filepath.Join(dbPath, progressFile), []byte("Epoch_0/Shard_0/MiniBlocks\n"), 0644)
+	require.Nil(t, err)
+
+	err = createMigratorForTest(t, dbPath, false).migrate()
+	require.Nil(t, err)
+
+	checkPersisterForTest(t, migratedPath, storageUnit.PebbleDB, numKeysForTest)
+	checkPersisterForTest(t, path, storageUnit.PebbleDB, numKeysForTest)
+	assert.False(t, pathExists(filepath.Join(dbPath, progressFile)))
+}
+
+func TestMigrator_AddToSummaryShouldNotDependOnOrder(t *testing.T) {
+	t.Parallel()
+
+	m := createMigratorForTest(t, t.TempDir(), false)
+	first := m.newSummary()
+	m.addToSummary(first, []byte("a"), []byte("bc"))
+	m.addToSummary(first, []byte("d"), []byte("e"))
+
+	second := m.newSummary()
+	m.addToSummary(second, []byte("d"), []byte("e"))
+	m.addToSummary(second, []byte("a"), []byte("bc"))
+	assert.Equal(t, first, second)
+
+	third := m.newSummary()
+	m.addToSummary(third, []byte("ab"), []byte("c"))
+	m.addToSummary(third, []byte("d"), []byte("e"))
+	assert.NotEqual(t, first.digest, third.digest)
+}
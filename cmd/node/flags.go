@@ -301,6 +301,22 @@ var (
 		Value: 0,
 		Usage: "This flag will specify the start in epoch value in import-db process",
 	}
+	// outportReplayFromNonce defines a flag for the first nonce of the blocks re-pushed to the outport drivers
+	outportReplayFromNonce = cli.Uint64Flag{
+		Name: "outport-replay-from-nonce",
+		Usage: "This flag, if set, will make the node start in the outport replay mode: the blocks stored in the " +
+			"local databases, starting with the provided nonce, will be pushed again to the enabled outport drivers " +
+			"and the node will exit afterwards. Should be used together with the outport-replay-to-nonce flag. The " +
+			"replayed blocks do not hold the provided, refunded and penalized gas nor the altered accounts, as these " +
+			"are not persisted, and their logs are ordered as the transactions in the miniblocks",
+		Value: 0,
+	}
+	// outportReplayToNonce defines a flag for the last nonce of the blocks re-pushed to the outport drivers
+	outportReplayToNonce = cli.Uint64Flag{
+		Name:  "outport-replay-to-nonce",
+		Usage: "This flag will specify the last nonce (inclusive) of the blocks pushed in the outport replay mode",
+		Value: 0,
+	}
 	// redundancyLevel defines a flag that specifies the level of redundancy used by the current instance for the node (-1 = disabled, 0 = main instance (default), 1 = first backup, 2 = second backup, etc.)
 	redundancyLevel = cli.Int64Flag{
 		Name:  "redundancy-level",
@@ -372,6 +388,8 @@ func getFlags() []cli.Flag {
 		importDbNoSigCheck,
 		importDbSaveEpochRootHash,
 		importDbStartInEpoch,
+		outportReplayFromNonce,
+		outportReplayToNonce,
 		redundancyLevel,
 		fullArchive,
 		memBallast,
@@ -442,8 +460,14 @@ func applyFlags(ctx *cli.Context, cfgs *config.Configs, flagsConfig *config.Cont
 		ImportDbSaveTrieEpochRootHash: ctx.GlobalBool(importDbSaveEpochRootHash.Name),
 		ImportDBStartInEpoch:          uint32(ctx.GlobalUint64(importDbStartInEpoch.Name)),
 	}
+	outportReplayConfig := &config.OutportReplayConfig{
+		IsOutportReplayMode: ctx.IsSet(outportReplayFromNonce.Name) || ctx.IsSet(outportReplayToNonce.Name),
+		FromNonce:           ctx.GlobalUint64(outportReplayFromNonce.Name),
+		ToNonce:             ctx.GlobalUint64(outportReplayToNonce.Name),
+	}
 	cfgs.FlagsConfig = flagsConfig
 	cfgs.ImportDbConfig = importDBConfigs
+	cfgs.OutportReplayConfig = outportReplayConfig
 	err := applyCompatibleConfigs(log, cfgs)
 	if err != nil {
 		return err
@@ -482,6 +506,10 @@ func applyCompatibleConfigs(log logger.Logger, configs *config.Configs) error {
 		return processConfigImportDBMode(log, configs)
 	}
 
+	if configs.OutportReplayConfig.IsOutportReplayMode {
+		return processConfigOutportReplayMode(log, configs)
+	}
+
 	// if FullArchive is enabled, we override the conflicting StoragePruning settings and StartInEpoch as well
 	if configs.PreferencesConfig.Preferences.FullArchive {
 		return processConfigFullArchiveMode(log, configs)
@@ -534,6 +562,26 @@ func processConfigImportDBMode(log logger.Logger, configs *config.Configs) error
 	return nil
 }
 
+func processConfigOutportReplayMode(log logger.Logger, configs *config.Configs) error {
+	outportReplayConfig := configs.OutportReplayConfig
+	if outportReplayConfig.FromNonce > outportReplayConfig.ToNonce {
+		return fmt.Errorf("invalid outport replay nonces range, from nonce: %d, to nonce: %d",
+			outportReplayConfig.FromNonce, outportReplayConfig.ToNonce)
+	}
+
+	// the blocks are read from all the epochs found on disk, as a full archive node does
+	configs.PreferencesConfig.Preferences.FullArchive = true
+	configs.P2pConfig.KadDhtPeerDiscovery.Enabled = false
+
+	log.Warn("the node is in outport replay mode! Will auto-set some config values",
+		"from nonce", outportReplayConfig.FromNonce,
+		"to nonce", outportReplayConfig.ToNonce,
+		"kad dht discoverer", "off",
+	)
+
+	return processConfigFullArchiveMode(log, configs)
+}
+
 func processConfigFullArchiveMode(log logger.Logger, configs *config.Configs) error {
 	generalConfigs := configs.GeneralConfig
 
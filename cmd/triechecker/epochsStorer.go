@@ -0,0 +1,134 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/factory/directoryhandler"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+)
+
+var errReadOnlyStorer = errors.New("the storer is read only")
+
+// epochsStorer is a read-only storer looking for a key in the persisters of all the epochs, from the newest one.
+// It replaces the pruning storer, which needs the whole epoch start machinery of a running node
+type epochsStorer struct {
+	persisters []storage.Persister
+	paths      []string
+}
+
+func newEpochsStorer(
+	pathManager *pathmanager.PathManager,
+	persisterFactory storage.PersisterFactory,
+	shardID string,
+	identifier string,
+) (*epochsStorer, error) {
+	epochs, err := getEpochsFromDirectory(pathManager.DatabasePath())
+	if err != nil {
+		return nil, err
+	}
+
+	es := &epochsStorer{
+		persisters: make([]storage.Persister, 0, len(epochs)),
+		paths:      make([]string, 0, len(epochs)),
+	}
+	for _, epoch := range epochs {
+		path := pathManager.PathForEpoch(shardID, epoch, identifier)
+		_, err = os.Stat(path)
+		if err != nil {
+			continue
+		}
+
+		persister, errCreate := persisterFactory.Create(path)
+		if errCreate != nil {
+			_ = es.Close()
+			return nil, fmt.Errorf("%w while opening %s", errCreate, path)
+		}
+
+		es.persisters = append(es.persisters, persister)
+		es.paths = append(es.paths, path)
+	}
+
+	if len(es.persisters) == 0 {
+		return nil, fmt.Errorf("no %s persister found for shard %s in %s", identifier, shardID, pathManager.DatabasePath())
+	}
+
+	log.Debug("opened epochs storer", "identifier", identifier, "num persisters", len(es.persisters))
+
+	return es, nil
+}
+
+// getEpochsFromDirectory returns the epochs having a directory in the provided path, the newest first
+func getEpochsFromDirectory(path string) ([]uint32, error) {
+	directories, err := directoryhandler.NewDirectoryReader().ListDirectoriesAsString(path)
+	if err != nil {
+		return nil, err
+	}
+
+	epochs := make([]uint32, 0, len(directories))
+	for _, directory := range directories {
+		if !strings.HasPrefix(directory, common.DefaultEpochString+"_") {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(directory, common.DefaultEpochString+"_"), 10, 32)
+		if errParse != nil {
+			continue
+		}
+
+		epochs = append(epochs, uint32(epoch))
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] > epochs[j]
+	})
+
+	return epochs, nil
+}
+
+// Get returns the value from the newest persister holding the key
+func (es *epochsStorer) Get(key []byte) ([]byte, error) {
+	for _, persister := range es.persisters {
+		val, err := persister.Get(key)
+		if err == nil && len(val) != 0 {
+			return val, nil
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+// Put returns an error as the storer is read only
+func (es *epochsStorer) Put(_, _ []byte) error {
+	return errReadOnlyStorer
+}
+
+// Remove returns an error as the storer is read only
+func (es *epochsStorer) Remove(_ []byte) error {
+	return errReadOnlyStorer
+}
+
+// Close closes all the opened persisters
+func (es *epochsStorer) Close() error {
+	var lastErr error
+	for i, persister := range es.persisters {
+		err := persister.Close()
+		if err != nil {
+			log.Warn("error closing persister", "path", es.paths[i], "error", err)
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (es *epochsStorer) IsInterfaceNil() bool {
+	return es == nil
+}
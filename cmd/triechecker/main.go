@@ -0,0 +1,440 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	hasherFactory "github.com/ElrondNetwork/elrond-go-core/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	marshalizerFactory "github.com/ElrondNetwork/elrond-go-core/marshal/factory"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/disabled"
+	commonFactory "github.com/ElrondNetwork/elrond-go/common/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	hashesHolderDisabled "github.com/ElrondNetwork/elrond-go/trie/hashesHolder/disabled"
+	"github.com/ElrondNetwork/elrond-go/trie/stateStatistics"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	workingDirectory    string
+	chainID             string
+	configFile          string
+	rootHash            string
+	shard               string
+	skipDataTries       bool
+	maxIssues           int
+	computeStatistics   bool
+	numLargestDataTries uint
+	exportSnapshot      string
+	importSnapshot      string
+	expectedRootHash    string
+	chunkSizeInMB       uint64
+	epoch               uint
+	logLevel            string
+}
+
+var (
+	trieCheckerHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// workingDirectory defines a flag for the node's working directory, the one holding the db directory
+	workingDirectory = cli.StringFlag{
+		Name:        "working-directory",
+		Usage:       "The node's working directory, the one containing the db directory",
+		Value:       ".",
+		Destination: &argsConfig.workingDirectory,
+	}
+	// chainID defines a flag for the chain ID directory to be checked
+	chainID = cli.StringFlag{
+		Name:        "chain-id",
+		Usage:       "The chain ID, as found in the name of the directory under the db directory. Example: 1",
+		Value:       "1",
+		Destination: &argsConfig.chainID,
+	}
+	// configFile defines a flag for the node's main configuration file, used for the storers' names and types
+	configFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The `filepath` for the node's main configuration file",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// rootHash defines a flag for the accounts trie root hash to be checked
+	rootHash = cli.StringFlag{
+		Name:        "root-hash",
+		Usage:       "The hex encoded accounts trie root hash to be checked. If not set, the root hash of the last block found in the db directory is used",
+		Destination: &argsConfig.rootHash,
+	}
+	// shard defines a flag for the shard whose storers will be used
+	shard = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "The shard whose storers are checked, as found in the Shard_<shard> directories. Example: 0, metachain. Mandatory if the root hash is set",
+		Destination: &argsConfig.shard,
+	}
+	// skipDataTries is the flag that, if active, will only check the accounts trie
+	skipDataTries = cli.BoolFlag{
+		Name:        "skip-data-tries",
+		Usage:       "Boolean option that will only check the accounts trie, without the accounts' data tries",
+		Destination: &argsConfig.skipDataTries,
+	}
+	// maxIssues defines a flag for the maximum number of issues printed
+	maxIssues = cli.IntFlag{
+		Name:        "max-issues",
+		Usage:       "The maximum number of missing or corrupted nodes printed. All of them are counted",
+		Value:       1000,
+		Destination: &argsConfig.maxIssues,
+	}
+	// computeStatistics is the flag that, if active, will compute the state size statistics instead of checking the tries
+	computeStatistics = cli.BoolFlag{
+		Name:        "state-statistics",
+		Usage:       "Boolean option that will print, as JSON, the size of the accounts trie and of the data tries, together with the largest data tries, instead of reporting the missing or corrupted nodes",
+		Destination: &argsConfig.computeStatistics,
+	}
+	// numLargestDataTries defines a flag for the number of the largest data tries printed with the state statistics
+	numLargestDataTries = cli.UintFlag{
+		Name:        "num-largest-data-tries",
+		Usage:       "The number of the largest data tries, by the size of their nodes, printed with the state statistics",
+		Value:       20,
+		Destination: &argsConfig.numLargestDataTries,
+	}
+	// exportSnapshot defines a flag for the state snapshot file to be written
+	exportSnapshot = cli.StringFlag{
+		Name:        "export-snapshot",
+		Usage:       "The `filepath` of a state snapshot file to be written with the leaves of the accounts trie and of the data tries, instead of checking the tries",
+		Destination: &argsConfig.exportSnapshot,
+	}
+	// importSnapshot defines a flag for the state snapshot file to be imported
+	importSnapshot = cli.StringFlag{
+		Name: "import-snapshot",
+		Usage: "The `filepath` of a state snapshot file whose tries are rebuilt in the accounts trie storer of the epoch and shard " +
+			"found in the file, in the db directory. The root hash of every rebuilt trie is checked against the file's manifest",
+		Destination: &argsConfig.importSnapshot,
+	}
+	// expectedRootHash defines a flag for the trusted root hash of an imported state snapshot file
+	expectedRootHash = cli.StringFlag{
+		Name: "expected-root-hash",
+		Usage: "The hex encoded accounts trie root hash of the state held by the imported state snapshot file. Mandatory if the " +
+			"import-snapshot flag is set, the file being rejected if it holds another state",
+		Destination: &argsConfig.expectedRootHash,
+	}
+	// chunkSizeInMB defines a flag for the size of the chunks of an exported state snapshot file
+	chunkSizeInMB = cli.Uint64Flag{
+		Name:        "chunk-size-mb",
+		Usage:       "The maximum size in MB of the uncompressed leaves held by a chunk of the exported state snapshot file",
+		Value:       16,
+		Destination: &argsConfig.chunkSizeInMB,
+	}
+	// epoch defines a flag for the epoch of the provided root hash
+	epoch = cli.UintFlag{
+		Name:        "epoch",
+		Usage:       "The epoch of the provided root hash, written in the exported state snapshot file. Only used if the root hash is set",
+		Destination: &argsConfig.epoch,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("triechecker")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = trieCheckerHelpTemplate
+	app.Name = "Trie integrity checker Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary will check that the accounts trie and the data tries of a stopped node's db directory are complete. " +
+		"It can also compute the state size statistics and export or import the state as a snapshot file"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		workingDirectory,
+		chainID,
+		configFile,
+		rootHash,
+		shard,
+		skipDataTries,
+		maxIssues,
+		computeStatistics,
+		numLargestDataTries,
+		exportSnapshot,
+		importSnapshot,
+		expectedRootHash,
+		chunkSizeInMB,
+		epoch,
+		logLevel,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return checkState()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error checking the tries", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func checkState() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	generalConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalizerFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	pathManager, err := factory.CreatePathManagerFromSinglePathString(
+		filepath.Join(argsConfig.workingDirectory, common.DefaultDBPath, argsConfig.chainID))
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go cancelOnInterrupt(cancel)
+
+	if len(argsConfig.importSnapshot) > 0 {
+		return importStateSnapshot(ctx, generalConfig, pathManager, marshalizer, hasher)
+	}
+
+	stateRootHash, shardID, stateEpoch, err := getRootHashAndShard(generalConfig, pathManager, marshalizer)
+	if err != nil {
+		return err
+	}
+
+	storageManager, err := createStorageManager(generalConfig, pathManager, shardID, marshalizer, hasher)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = storageManager.Close()
+	}()
+
+	if argsConfig.computeStatistics {
+		return computeStateStatistics(ctx, generalConfig, storageManager, marshalizer, hasher, stateRootHash)
+	}
+	if len(argsConfig.exportSnapshot) > 0 {
+		return exportStateSnapshot(ctx, storageManager, marshalizer, hasher, stateRootHash, shardID, stateEpoch)
+	}
+
+	integrityChecker, err := trie.NewIntegrityChecker(trie.ArgsIntegrityChecker{
+		StorageManager: storageManager,
+		Marshalizer:    marshalizer,
+		Hasher:         hasher,
+	})
+	if err != nil {
+		return err
+	}
+
+	checker, err := newStateChecker(integrityChecker, marshalizer, !argsConfig.skipDataTries)
+	if err != nil {
+		return err
+	}
+
+	log.Info("checking state", "root hash", stateRootHash, "shard", shardID, "data tries", !argsConfig.skipDataTries)
+	report, err := checker.check(ctx, stateRootHash)
+	if err != nil {
+		return err
+	}
+
+	return printReport(report)
+}
+
+func getRootHashAndShard(
+	generalConfig *config.Config,
+	pathManager *pathmanager.PathManager,
+	marshalizer marshal.Marshalizer,
+) ([]byte, string, uint32, error) {
+	if len(argsConfig.rootHash) == 0 {
+		latestRootHash, shardID, latestEpoch, err := getLatestRootHash(generalConfig, pathManager, marshalizer)
+		if err != nil {
+			return nil, "", 0, fmt.Errorf("%w while looking for the latest root hash, provide one through the root-hash flag", err)
+		}
+
+		return latestRootHash, core.GetShardIDString(shardID), latestEpoch, nil
+	}
+
+	if len(argsConfig.shard) == 0 {
+		return nil, "", 0, fmt.Errorf("the shard flag is mandatory when the root hash is provided")
+	}
+
+	decodedRootHash, err := hex.DecodeString(argsConfig.rootHash)
+	if err != nil {
+		return nil, "", 0, fmt.Errorf("%w while decoding the root hash", err)
+	}
+
+	return decodedRootHash, argsConfig.shard, uint32(argsConfig.epoch), nil
+}
+
+func createStorageManager(
+	generalConfig *config.Config,
+	pathManager *pathmanager.PathManager,
+	shardID string,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (common.StorageManager, error) {
+	mainDBConfig := generalConfig.AccountsTrieStorage.DB
+	mainStorer, err := newEpochsStorer(pathManager, factory.NewPersisterFactory(mainDBConfig), shardID, mainDBConfig.FilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpointsDBConfig := generalConfig.AccountsTrieCheckpointsStorage.DB
+	checkpointsStorer, err := newEpochsStorer(pathManager, factory.NewPersisterFactory(checkpointsDBConfig), shardID, checkpointsDBConfig.FilePath)
+	if err != nil {
+		log.Debug("no checkpoints storer, only the accounts trie storer will be used", "error", err)
+		checkpointsStorer = &epochsStorer{}
+	}
+
+	return newTrieStorageManager(generalConfig, mainStorer, checkpointsStorer, marshalizer, hasher)
+}
+
+func newTrieStorageManager(
+	generalConfig *config.Config,
+	mainStorer common.DBWriteCacher,
+	checkpointsStorer common.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (common.StorageManager, error) {
+	return trie.NewTrieStorageManager(trie.NewTrieStorageManagerArgs{
+		MainStorer:             mainStorer,
+		CheckpointsStorer:      checkpointsStorer,
+		Marshalizer:            marshalizer,
+		Hasher:                 hasher,
+		GeneralConfig:          generalConfig.TrieStorageManagerConfig,
+		CheckpointHashesHolder: hashesHolderDisabled.NewDisabledCheckpointHashesHolder(),
+		IdleProvider:           disabled.NewProcessStatusHandler(),
+	})
+}
+
+func computeStateStatistics(
+	ctx context.Context,
+	generalConfig *config.Config,
+	storageManager common.StorageManager,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	stateRootHash []byte,
+) error {
+	addressPubkeyConverter, err := commonFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
+	analyzer, err := stateStatistics.NewStateStatistics(stateStatistics.ArgsStateStatistics{
+		StorageManager:         storageManager,
+		Marshalizer:            marshalizer,
+		Hasher:                 hasher,
+		AddressPubkeyConverter: addressPubkeyConverter,
+		NumLargestDataTries:    uint32(argsConfig.numLargestDataTries),
+		NumEpochsInHistory:     1,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("computing state statistics", "root hash", stateRootHash)
+	result, err := analyzer.Analyze(ctx, stateRootHash, 0)
+	if err != nil {
+		return err
+	}
+
+	resultBytes, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(resultBytes))
+
+	if result.NumMissingNodes > 0 {
+		log.Warn("the state is not complete, the statistics only count the found nodes", "num missing or corrupted nodes", result.NumMissingNodes)
+	}
+
+	return nil
+}
+
+func cancelOnInterrupt(cancel context.CancelFunc) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	<-sigs
+	log.Info("interrupted, stopping the check")
+	cancel()
+}
+
+func printReport(report *stateReport) error {
+	for i, issue := range report.issues {
+		if i >= argsConfig.maxIssues {
+			log.Warn("too many issues, the remaining ones are not printed", "num not printed", len(report.issues)-i)
+			break
+		}
+
+		if len(issue.address) == 0 {
+			log.Error(issue.kind+" node in the accounts trie", "issue", issue.String())
+			continue
+		}
+
+		log.Error(issue.kind+" node in a data trie", "address", issue.address, "issue", issue.String())
+	}
+
+	log.Info("check finished",
+		"num nodes", report.numNodes,
+		"num accounts", report.numAccounts,
+		"num data tries", report.numDataTries,
+		"num issues", len(report.issues),
+	)
+
+	if len(report.issues) > 0 {
+		return fmt.Errorf("found %d missing or corrupted trie nodes", len(report.issues))
+	}
+
+	return nil
+}
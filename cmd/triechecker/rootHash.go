@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/factory/directoryhandler"
+	"github.com/ElrondNetwork/elrond-go/storage/latestData"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+)
+
+// getLatestRootHash returns the state root hash of the last block the node committed, together with the shard
+// the node was in, as found by the latestDataProvider, and the block's epoch
+func getLatestRootHash(
+	generalConfig *config.Config,
+	pathManager *pathmanager.PathManager,
+	marshalizer marshal.Marshalizer,
+) ([]byte, uint32, uint32, error) {
+	bootstrapDataProvider, err := factory.NewBootstrapDataProvider(marshalizer)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	latestDataProvider, err := latestData.NewLatestDataProvider(latestData.ArgsLatestDataProvider{
+		GeneralConfig:         *generalConfig,
+		BootstrapDataProvider: bootstrapDataProvider,
+		DirectoryReader:       directoryhandler.NewDirectoryReader(),
+		ParentDir:             pathManager.DatabasePath(),
+		DefaultEpochString:    common.DefaultEpochString,
+		DefaultShardString:    common.DefaultShardString,
+	})
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	latest, err := latestDataProvider.Get()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	parentDir, lastEpoch, err := latestDataProvider.GetParentDirAndLastEpoch()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	shardID := core.GetShardIDString(latest.ShardID)
+	bootstrapPath := filepath.Join(
+		parentDir,
+		fmt.Sprintf("%s_%d", common.DefaultEpochString, lastEpoch),
+		fmt.Sprintf("%s_%s", common.DefaultShardString, shardID),
+		generalConfig.BootstrapStorage.DB.FilePath,
+	)
+	bootstrapData, bootstrapStorer, err := bootstrapDataProvider.LoadForPath(
+		factory.NewPersisterFactory(generalConfig.BootstrapStorage.DB),
+		bootstrapPath,
+	)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	_ = bootstrapStorer.Close()
+
+	headerDBConfig := generalConfig.BlockHeaderStorage.DB
+	if latest.ShardID == core.MetachainShardId {
+		headerDBConfig = generalConfig.MetaBlockStorage.DB
+	}
+
+	headersStorer, err := newEpochsStorer(pathManager, factory.NewPersisterFactory(headerDBConfig), shardID, headerDBConfig.FilePath)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	defer func() {
+		_ = headersStorer.Close()
+	}()
+
+	headerBytes, err := headersStorer.Get(bootstrapData.LastHeader.Hash)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("%w for last header %x", err, bootstrapData.LastHeader.Hash)
+	}
+
+	header, err := unmarshalHeader(marshalizer, latest.ShardID, headerBytes)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	log.Info("found latest header",
+		"shard", shardID,
+		"epoch", header.GetEpoch(),
+		"nonce", header.GetNonce(),
+		"hash", bootstrapData.LastHeader.Hash,
+		"root hash", header.GetRootHash(),
+	)
+
+	return header.GetRootHash(), latest.ShardID, header.GetEpoch(), nil
+}
+
+func unmarshalHeader(marshalizer marshal.Marshalizer, shardID uint32, headerBytes []byte) (data.HeaderHandler, error) {
+	if shardID != core.MetachainShardId {
+		return process.CreateShardHeader(marshalizer, headerBytes)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err := marshalizer.Unmarshal(metaBlock, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return metaBlock, nil
+}
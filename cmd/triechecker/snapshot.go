@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/state/snapshotFile"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+)
+
+const megabyte = 1024 * 1024
+
+// exportStateSnapshot writes the state found under the provided root hash in the export-snapshot file. The file is
+// removed if the export does not finish
+func exportStateSnapshot(
+	ctx context.Context,
+	storageManager common.StorageManager,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	stateRootHash []byte,
+	shardID string,
+	stateEpoch uint32,
+) (err error) {
+	shardIDValue, err := core.ConvertShardIDToUint32(shardID)
+	if err != nil {
+		return err
+	}
+
+	exporter, err := snapshotFile.NewExporter(snapshotFile.ArgsExporter{
+		StorageManager: storageManager,
+		Marshalizer:    marshalizer,
+		Hasher:         hasher,
+		MaxChunkSize:   argsConfig.chunkSizeInMB * megabyte,
+	})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(argsConfig.exportSnapshot)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := file.Close()
+		if err == nil {
+			err = errClose
+		}
+		if err != nil {
+			_ = os.Remove(argsConfig.exportSnapshot)
+		}
+	}()
+
+	log.Info("exporting state snapshot", "root hash", stateRootHash, "shard", shardID, "epoch", stateEpoch, "file", argsConfig.exportSnapshot)
+	writer := bufio.NewWriter(file)
+	manifest, err := exporter.Export(ctx, stateRootHash, shardIDValue, stateEpoch, writer)
+	if err != nil {
+		return err
+	}
+	err = writer.Flush()
+	if err != nil {
+		return err
+	}
+
+	log.Info("state snapshot exported",
+		"file", argsConfig.exportSnapshot,
+		"num accounts trie leaves", manifest.Tries[0].NumLeaves,
+		"num data tries", len(manifest.Tries)-1,
+	)
+
+	return nil
+}
+
+// importStateSnapshot rebuilds the tries of the import-snapshot file in the accounts trie storer of the file's epoch
+// and shard. The file must hold the state of the root hash provided by the operator
+func importStateSnapshot(
+	ctx context.Context,
+	generalConfig *config.Config,
+	pathManager *pathmanager.PathManager,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) error {
+	if len(argsConfig.expectedRootHash) == 0 {
+		return fmt.Errorf("the expected-root-hash flag is mandatory when importing a state snapshot file")
+	}
+	expectedRootHash, err := hex.DecodeString(argsConfig.expectedRootHash)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the expected root hash", err)
+	}
+
+	file, err := os.Open(argsConfig.importSnapshot)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	manifest, err := snapshotFile.ReadManifest(file)
+	if err != nil {
+		return err
+	}
+
+	dbConfig := generalConfig.AccountsTrieStorage.DB
+	path := pathManager.PathForEpoch(core.GetShardIDString(manifest.ShardID), manifest.Epoch, dbConfig.FilePath)
+	persister, err := factory.NewPersisterFactory(dbConfig).Create(path)
+	if err != nil {
+		return err
+	}
+
+	storageManager, err := newTrieStorageManager(generalConfig, persister, memorydb.New(), marshalizer, hasher)
+	if err != nil {
+		_ = persister.Close()
+		return err
+	}
+	defer func() {
+		_ = storageManager.Close()
+	}()
+
+	importer, err := snapshotFile.NewImporter(snapshotFile.ArgsImporter{
+		StorageManager:       storageManager,
+		Marshalizer:          marshalizer,
+		Hasher:               hasher,
+		MaxTrieLevelInMemory: generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("importing state snapshot",
+		"file", argsConfig.importSnapshot,
+		"root hash", manifest.RootHash,
+		"shard", core.GetShardIDString(manifest.ShardID),
+		"epoch", manifest.Epoch,
+		"path", path,
+	)
+	_, err = importer.Import(ctx, file, expectedRootHash)
+	if err != nil {
+		return err
+	}
+
+	log.Info("state snapshot imported", "root hash", manifest.RootHash, "num data tries", len(manifest.Tries)-1)
+
+	return nil
+}
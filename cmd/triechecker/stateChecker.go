@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+const (
+	missingIssue   = "missing"
+	corruptedIssue = "corrupted"
+)
+
+type trieIntegrityChecker interface {
+	Check(ctx context.Context, rootHash []byte, leafHandler func(key []byte, value []byte)) (*trie.IntegrityReport, error)
+	IsInterfaceNil() bool
+}
+
+// stateIssue is a trie issue found either in the main trie, when the address is empty, or in an account's data trie
+type stateIssue struct {
+	kind    string
+	address []byte
+	trie.IntegrityIssue
+}
+
+type stateReport struct {
+	numNodes     uint64
+	numAccounts  uint64
+	numDataTries uint64
+	issues       []stateIssue
+}
+
+type dataTrieRoot struct {
+	address  []byte
+	rootHash []byte
+}
+
+type stateChecker struct {
+	integrityChecker trieIntegrityChecker
+	marshalizer      marshal.Marshalizer
+	checkDataTries   bool
+}
+
+func newStateChecker(integrityChecker trieIntegrityChecker, marshalizer marshal.Marshalizer, checkDataTries bool) (*stateChecker, error) {
+	if check.IfNil(integrityChecker) {
+		return nil, errors.New("nil integrity checker")
+	}
+	if check.IfNil(marshalizer) {
+		return nil, trie.ErrNilMarshalizer
+	}
+
+	return &stateChecker{
+		integrityChecker: integrityChecker,
+		marshalizer:      marshalizer,
+		checkDataTries:   checkDataTries,
+	}, nil
+}
+
+// check walks the accounts trie with the provided root hash and then, if enabled, the data trie of every account
+func (sc *stateChecker) check(ctx context.Context, rootHash []byte) (*stateReport, error) {
+	report := &stateReport{
+		issues: make([]stateIssue, 0),
+	}
+
+	dataTries := make([]dataTrieRoot, 0)
+	mainTrieReport, err := sc.integrityChecker.Check(ctx, rootHash, func(key []byte, value []byte) {
+		account := &state.UserAccountData{}
+		errUnmarshal := sc.marshalizer.Unmarshal(account, value)
+		if errUnmarshal != nil || !bytes.Equal(account.Address, key) {
+			log.Trace("this must be a leaf with code", "key", key)
+			return
+		}
+
+		report.numAccounts++
+		if len(account.RootHash) == 0 {
+			return
+		}
+
+		dataTries = append(dataTries, dataTrieRoot{
+			address:  key,
+			rootHash: account.RootHash,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sc.addToReport(report, nil, mainTrieReport)
+	log.Info("accounts trie checked",
+		"num nodes", mainTrieReport.NumNodes,
+		"num accounts", report.numAccounts,
+		"num data tries", len(dataTries),
+		"num issues", len(report.issues),
+	)
+
+	if !sc.checkDataTries {
+		return report, nil
+	}
+
+	for i, dataTrie := range dataTries {
+		dataTrieReport, errCheck := sc.integrityChecker.Check(ctx, dataTrie.rootHash, nil)
+		if errCheck != nil {
+			return nil, errCheck
+		}
+
+		report.numDataTries++
+		sc.addToReport(report, dataTrie.address, dataTrieReport)
+		if (i+1)%10000 == 0 {
+			log.Info("checking data tries", "checked", i+1, "total", len(dataTries), "num issues", len(report.issues))
+		}
+	}
+
+	return report, nil
+}
+
+func (sc *stateChecker) addToReport(report *stateReport, address []byte, trieReport *trie.IntegrityReport) {
+	report.numNodes += trieReport.NumNodes
+	for _, issue := range trieReport.Missing {
+		report.issues = append(report.issues, stateIssue{
+			kind:           missingIssue,
+			address:        address,
+			IntegrityIssue: issue,
+		})
+	}
+	for _, issue := range trieReport.Corrupted {
+		report.issues = append(report.issues, stateIssue{
+			kind:           corruptedIssue,
+			address:        address,
+			IntegrityIssue: issue,
+		})
+	}
+}
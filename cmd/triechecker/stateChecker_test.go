@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/hashesHolder/disabled"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	marshalizerForTest = &marshal.GogoProtoMarshalizer{}
+	hasherForTest      = blake2b.NewBlake2b()
+)
+
+func createStorageManagerForTest(t *testing.T) common.StorageManager {
+	storageManager, err := trie.NewTrieStorageManager(trie.NewTrieStorageManagerArgs{
+		MainStorer:        testscommon.NewSnapshotPruningStorerMock(),
+		CheckpointsStorer: testscommon.NewSnapshotPruningStorerMock(),
+		Marshalizer:       marshalizerForTest,
+		Hasher:            hasherForTest,
+		GeneralConfig: config.TrieStorageManagerConfig{
+			SnapshotsBufferLen:    10,
+			SnapshotsGoroutineNum: 1,
+		},
+		CheckpointHashesHolder: disabled.NewDisabledCheckpointHashesHolder(),
+		IdleProvider:           &testscommon.ProcessStatusHandlerStub{},
+	})
+	require.Nil(t, err)
+
+	return storageManager
+}
+
+func createCommittedTrieForTest(t *testing.T, storageManager common.StorageManager, leaves map[string][]byte) []byte {
+	tr, err := trie.NewTrie(storageManager, marshalizerForTest, hasherForTest, 5)
+	require.Nil(t, err)
+
+	for key, value := range leaves {
+		require.Nil(t, tr.Update([]byte(key), value))
+	}
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+// createStateForTest creates an accounts trie with two accounts, one of them having a data trie, and a code leaf
+func createStateForTest(t *testing.T, storageManager common.StorageManager) ([]byte, []byte) {
+	dataTrieRootHash := createCommittedTrieForTest(t, storageManager, map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+	})
+
+	addressWithData := []byte("address with data trie..........")
+	addressWithoutData := []byte("address without data trie.......")
+	accountWithData, err := marshalizerForTest.Marshal(&state.UserAccountData{Address: addressWithData, RootHash: dataTrieRootHash})
+	require.Nil(t, err)
+	accountWithoutData, err := marshalizerForTest.Marshal(&state.UserAccountData{Address: addressWithoutData})
+	require.Nil(t, err)
+
+	rootHash := createCommittedTrieForTest(t, storageManager, map[string][]byte{
+		string(addressWithData):    accountWithData,
+		string(addressWithoutData): accountWithoutData,
+		"code hash":                []byte("code"),
+	})
+
+	return rootHash, dataTrieRootHash
+}
+
+func createStateCheckerForTest(t *testing.T, storageManager common.StorageManager, checkDataTries bool) *stateChecker {
+	integrityChecker, err := trie.NewIntegrityChecker(trie.ArgsIntegrityChecker{
+		StorageManager: storageManager,
+		Marshalizer:    marshalizerForTest,
+		Hasher:         hasherForTest,
+	})
+	require.Nil(t, err)
+
+	checker, err := newStateChecker(integrityChecker, marshalizerForTest, checkDataTries)
+	require.Nil(t, err)
+
+	return checker
+}
+
+func TestStateChecker_CompleteStateShouldNotReportIssues(t *testing.T) {
+	t.Parallel()
+
+	storageManager := createStorageManagerForTest(t)
+	rootHash, _ := createStateForTest(t, storageManager)
+
+	report, err := createStateCheckerForTest(t, storageManager, true).check(context.Background(), rootHash)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(report.issues))
+	assert.Equal(t, uint64(2), report.numAccounts)
+	assert.Equal(t, uint64(1), report.numDataTries)
+}
+
+func TestStateChecker_MissingDataTrieNodeShouldBeReported(t *testing.T) {
+	t.Parallel()
+
+	storageManager := createStorageManagerForTest(t)
+	rootHash, dataTrieRootHash := createStateForTest(t, storageManager)
+	require.Nil(t, storageManager.Remove(dataTrieRootHash))
+
+	report, err := createStateCheckerForTest(t, storageManager, false).check(context.Background(), rootHash)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(report.issues))
+	assert.Equal(t, uint64(0), report.numDataTries)
+
+	report, err = createStateCheckerForTest(t, storageManager, true).check(context.Background(), rootHash)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(report.issues))
+	assert.Equal(t, missingIssue, report.issues[0].kind)
+	assert.Equal(t, []byte("address with data trie.........."), report.issues[0].address)
+	assert.Equal(t, dataTrieRootHash, report.issues[0].Hash)
+}
+
+func TestStateChecker_CorruptedAccountsTrieNodeShouldBeReported(t *testing.T) {
+	t.Parallel()
+
+	storageManager := createStorageManagerForTest(t)
+	rootHash, _ := createStateForTest(t, storageManager)
+	require.Nil(t, storageManager.Put(rootHash, []byte("corrupted")))
+
+	report, err := createStateCheckerForTest(t, storageManager, true).check(context.Background(), rootHash)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(report.issues))
+	assert.Equal(t, corruptedIssue, report.issues[0].kind)
+	assert.Nil(t, report.issues[0].address)
+	assert.Equal(t, uint64(0), report.numAccounts)
+}
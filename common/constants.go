@@ -605,6 +605,8 @@ const (
 	NetStatisticsOrder
 	// OldDatabaseCleanOrder defines the order in which oldDatabaseCleaner component is notified of a start of epoch event
 	OldDatabaseCleanOrder
+	// StateStatisticsOrder defines the order in which the state statistics component is notified of a start of epoch event
+	StateStatisticsOrder
 )
 
 // NodeState specifies what type of state a node could have
@@ -647,6 +649,14 @@ const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_cl
 // to process VM queries
 const MetricAreVMQueriesReady = "erd_are_vm_queries_ready"
 
+// MetricOutportQueueBacklog is the metric prefix for monitoring the number of items waiting in a driver's outport queue.
+// The full metric name is suffixed with the driver name
+const MetricOutportQueueBacklog = "erd_outport_queue_backlog"
+
+// MetricOutportQueueDelivered is the metric prefix for monitoring the number of items delivered by a driver's outport queue.
+// The full metric name is suffixed with the driver name
+const MetricOutportQueueDelivered = "erd_outport_queue_delivered"
+
 // HighestRoundFromBootStorage is the key for the highest round that is saved in storage
 const HighestRoundFromBootStorage = "highestRoundFromBootStorage"
 
@@ -823,3 +833,29 @@ const (
 	// ApiOutputFormatProto outport format returns the bytes of the proto object
 	ApiOutputFormatProto ApiOutputFormat = 1
 )
+
+// TxRejectionReason represents the reason for which a transaction sent through the API was not broadcast
+type TxRejectionReason string
+
+const (
+	// TxRejectionNonceTooLow signals that the transaction's nonce was already used by the sender
+	TxRejectionNonceTooLow TxRejectionReason = "nonceTooLow"
+
+	// TxRejectionNonceTooHigh signals that the transaction's nonce is too far ahead of the sender's nonce
+	TxRejectionNonceTooHigh TxRejectionReason = "nonceTooHigh"
+
+	// TxRejectionInsufficientBalance signals that the sender cannot afford the transaction's fee
+	TxRejectionInsufficientBalance TxRejectionReason = "insufficientBalance"
+
+	// TxRejectionInvalidSignature signals that the transaction is not signed by the sender
+	TxRejectionInvalidSignature TxRejectionReason = "invalidSignature"
+
+	// TxRejectionSenderNotFound signals that the sender's account does not exist
+	TxRejectionSenderNotFound TxRejectionReason = "senderNotFound"
+
+	// TxRejectionWrongShard signals that the sender does not belong to the node's shard
+	TxRejectionWrongShard TxRejectionReason = "wrongShard"
+
+	// TxRejectionInvalidTransaction signals any other reason for which the transaction is not valid
+	TxRejectionInvalidTransaction TxRejectionReason = "invalidTransaction"
+)
@@ -1,5 +1,7 @@
 package common
 
+import "math/big"
+
 // GetProofResponse is a struct that stores the response of a GetProof API request
 type GetProofResponse struct {
 	Proof    [][]byte
@@ -13,3 +15,242 @@ type TransactionsPoolAPIResponse struct {
 	SmartContractResults []string `json:"smartContractResults"`
 	Rewards              []string `json:"rewards"`
 }
+
+// TransactionsPoolFilter holds the criteria used when filtering the regular transactions in the pool. Offset and
+// Limit select a page of the sorted matching transactions, a zero Limit meaning no limit
+type TransactionsPoolFilter struct {
+	Sender      string
+	Receiver    string
+	MinGasPrice OptionalUint64
+	MaxGasPrice OptionalUint64
+	Offset      uint64
+	Limit       uint64
+}
+
+// TransactionsPoolEntryAPIResponse holds the main fields of a transaction found in the pool
+type TransactionsPoolEntryAPIResponse struct {
+	Hash                string `json:"hash"`
+	Nonce               uint64 `json:"nonce"`
+	Sender              string `json:"sender"`
+	Receiver            string `json:"receiver"`
+	Value               string `json:"value"`
+	GasPrice            uint64 `json:"gasPrice"`
+	GasLimit            uint64 `json:"gasLimit"`
+	IsBlockedByNonceGap bool   `json:"blockedByNonceGap,omitempty"`
+}
+
+// TransactionsPoolEventAPIResponse holds a change undergone by a transaction of the pool: added, selected, evicted,
+// replaced or removed. A "dropped" event holds no transaction and tells that some changes could not be notified
+type TransactionsPoolEventAPIResponse struct {
+	Event       string                            `json:"event"`
+	Transaction *TransactionsPoolEntryAPIResponse `json:"transaction,omitempty"`
+}
+
+// TransactionsPoolSubscription holds the stream of events of a subscription to the transactions pool, together with
+// the function which ends it. The events channel is closed when the subscription ends, including when the subscriber
+// does not keep up with the events
+type TransactionsPoolSubscription struct {
+	Events      <-chan *TransactionsPoolEventAPIResponse
+	Unsubscribe func()
+}
+
+// TransactionProcessStepAPIResponse holds a step of the processing of a transaction: its execution in a shard or its
+// notarization by the metachain. The block coordinates are known only for the blocks recorded by the node
+type TransactionProcessStepAPIResponse struct {
+	Name       string `json:"name"`
+	Done       bool   `json:"done"`
+	Shard      uint32 `json:"shard"`
+	BlockNonce uint64 `json:"blockNonce,omitempty"`
+	BlockHash  string `json:"blockHash,omitempty"`
+}
+
+// TransactionProcessStatusAPIResponse holds the progress of a transaction through the steps of its processing, in
+// order, together with the first step which is not done yet and the progress of the generated smart contract results
+type TransactionProcessStatusAPIResponse struct {
+	Hash             string                                 `json:"hash"`
+	Type             string                                 `json:"type"`
+	Status           string                                 `json:"status"`
+	SourceShard      uint32                                 `json:"sourceShard"`
+	DestinationShard uint32                                 `json:"destinationShard"`
+	InPool           bool                                   `json:"inPool"`
+	Steps            []*TransactionProcessStepAPIResponse   `json:"steps"`
+	PendingStep      string                                 `json:"pendingStep,omitempty"`
+	Results          []*TransactionProcessStatusAPIResponse `json:"results,omitempty"`
+}
+
+// TransactionSendReceiptAPIResponse holds the outcome of sending a transaction: whether it passed the validation
+// done by the interceptors and was broadcast, the reason of its rejection otherwise and, when waited for, its inclusion
+type TransactionSendReceiptAPIResponse struct {
+	Index     int                              `json:"index"`
+	Hash      string                           `json:"hash,omitempty"`
+	Accepted  bool                             `json:"accepted"`
+	Reason    TxRejectionReason                `json:"reason,omitempty"`
+	Error     string                           `json:"error,omitempty"`
+	Inclusion *TransactionInclusionAPIResponse `json:"inclusion,omitempty"`
+}
+
+// TransactionInclusionAPIResponse holds whether a transaction was included in a block of the node's shard and, when
+// known, in which one. A transaction which is not included yet might still be waiting in the pool
+type TransactionInclusionAPIResponse struct {
+	Included   bool   `json:"included"`
+	InPool     bool   `json:"inPool"`
+	Status     string `json:"status,omitempty"`
+	BlockNonce uint64 `json:"blockNonce,omitempty"`
+	BlockHash  string `json:"blockHash,omitempty"`
+}
+
+// GasPriceSuggestionsAPIResponse holds the gas prices suggested for the transactions of the shard, based on the
+// fullness of the recent blocks, the gas prices they included and the transactions currently waiting in the pool
+type GasPriceSuggestionsAPIResponse struct {
+	ShardID              uint32  `json:"shardID"`
+	MinGasPrice          uint64  `json:"minGasPrice"`
+	Slow                 uint64  `json:"slow"`
+	Normal               uint64  `json:"normal"`
+	Fast                 uint64  `json:"fast"`
+	NumRecentBlocks      uint32  `json:"numRecentBlocks"`
+	AverageBlockFullness float64 `json:"averageBlockFullness"`
+	PendingTransactions  uint64  `json:"pendingTransactions"`
+	PendingBlocks        float64 `json:"pendingBlocks"`
+}
+
+// TrieSizeAPIResponse holds the size of a trie or of a set of tries: the number of nodes, leaves and encoded bytes and
+// the number of nodes on the longest path from a root
+type TrieSizeAPIResponse struct {
+	NumNodes  uint64 `json:"numNodes"`
+	NumLeaves uint64 `json:"numLeaves"`
+	NumBytes  uint64 `json:"numBytes"`
+	MaxDepth  uint32 `json:"maxDepth"`
+}
+
+// DataTrieSizeAPIResponse holds the size of the data trie of an account
+type DataTrieSizeAPIResponse struct {
+	Address string `json:"address"`
+	TrieSizeAPIResponse
+}
+
+// StateSizeAPIResponse holds the size of the state found under a root hash: the accounts trie, all the data tries
+// together and the largest data tries. The nodes which could not be read are only counted
+type StateSizeAPIResponse struct {
+	Epoch            uint32                     `json:"epoch"`
+	RootHash         string                     `json:"rootHash"`
+	NumAccounts      uint64                     `json:"numAccounts"`
+	NumDataTries     uint64                     `json:"numDataTries"`
+	AccountsTrie     TrieSizeAPIResponse        `json:"accountsTrie"`
+	DataTries        TrieSizeAPIResponse        `json:"dataTries"`
+	NumMissingNodes  uint64                     `json:"numMissingNodes"`
+	DurationSeconds  float64                    `json:"durationSeconds"`
+	LargestDataTries []*DataTrieSizeAPIResponse `json:"largestDataTries"`
+}
+
+// StateEpochGrowthAPIResponse holds the total size of the state analyzed in an epoch and its growth since the
+// previously analyzed epoch
+type StateEpochGrowthAPIResponse struct {
+	Epoch       uint32 `json:"epoch"`
+	NumBytes    uint64 `json:"numBytes"`
+	GrowthBytes int64  `json:"growthBytes"`
+}
+
+// StateStatisticsAPIResponse holds the last state size analysis and the growth of the state over the analyzed epochs,
+// the oldest first
+type StateStatisticsAPIResponse struct {
+	InProgress bool                           `json:"inProgress"`
+	Last       *StateSizeAPIResponse          `json:"last,omitempty"`
+	Epochs     []*StateEpochGrowthAPIResponse `json:"epochs"`
+}
+
+// NonceGapAPIResponse holds an interval of nonces (both ends included) missing from the transactions of a sender
+type NonceGapAPIResponse struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+// TransactionsPoolSenderAPIResponse holds the transactions of a sender found in the pool, sorted by nonce, together
+// with the missing nonces which block their selection and the risk of the transactions to be evicted
+type TransactionsPoolSenderAPIResponse struct {
+	Sender              string                              `json:"sender"`
+	AccountNonce        uint64                              `json:"accountNonce"`
+	AccountNonceKnown   bool                                `json:"accountNonceKnown"`
+	Score               uint32                              `json:"score"`
+	NumFailedSelections uint64                              `json:"numFailedSelections"`
+	IsInGracePeriod     bool                                `json:"inGracePeriod"`
+	EvictionRisk        string                              `json:"evictionRisk"`
+	NonceGaps           []*NonceGapAPIResponse              `json:"nonceGaps"`
+	Transactions        []*TransactionsPoolEntryAPIResponse `json:"transactions"`
+}
+
+// AddressTransactionsAPIResponse holds a page of the transactions which touched an address, the most recent first,
+// together with the total number of transactions indexed for that address
+type AddressTransactionsAPIResponse struct {
+	Transactions []*AddressTransactionAPIResponse `json:"transactions"`
+	Total        uint64                           `json:"total"`
+}
+
+// AddressTransactionAPIResponse holds the hash, the type and the block coordinates of a transaction which touched an address
+type AddressTransactionAPIResponse struct {
+	Hash       string `json:"hash"`
+	Type       string `json:"type"`
+	Epoch      uint32 `json:"epoch"`
+	BlockNonce uint64 `json:"blockNonce"`
+	BlockHash  string `json:"blockHash"`
+	Round      uint64 `json:"round"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// LogEventsQuery holds the criteria used when querying the log events emitted by an address
+type LogEventsQuery struct {
+	Identifier string
+	Topic      []byte
+	FromBlock  uint64
+	ToBlock    OptionalUint64
+	From       uint64
+	Size       uint64
+}
+
+// LogEventAPIResponse holds a log event together with the transaction and the block which generated it
+type LogEventAPIResponse struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+	TxHash     string   `json:"txHash"`
+	Epoch      uint32   `json:"epoch"`
+	BlockNonce uint64   `json:"blockNonce"`
+	BlockHash  string   `json:"blockHash"`
+	Round      uint64   `json:"round"`
+	Timestamp  int64    `json:"timestamp"`
+}
+
+// OptionalUint64 holds an uint64 value that might not be set
+type OptionalUint64 struct {
+	Value    uint64
+	HasValue bool
+}
+
+// BlockQueryOptions holds the optional identifiers of a past block against which a state query should be resolved
+type BlockQueryOptions struct {
+	BlockNonce    OptionalUint64
+	BlockHash     []byte
+	BlockRootHash []byte
+}
+
+// IsEmpty returns true if no block identifier was provided, meaning that the current state should be used
+func (options BlockQueryOptions) IsEmpty() bool {
+	return !options.BlockNonce.HasValue && len(options.BlockHash) == 0 && len(options.BlockRootHash) == 0
+}
+
+// BlockInfo holds the identifiers of the block against which a state query was resolved
+type BlockInfo struct {
+	Nonce    uint64 `json:"nonce"`
+	Hash     string `json:"hash"`
+	RootHash string `json:"rootHash"`
+}
+
+// AccountStateOverride holds the values which replace the state of an account for a single simulation or query. The
+// fields which are not set leave the account values unchanged
+type AccountStateOverride struct {
+	Address []byte
+	Balance *big.Int
+	Nonce   OptionalUint64
+	Code    []byte
+	Storage map[string][]byte
+}
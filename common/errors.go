@@ -7,3 +7,6 @@ var ErrInvalidTimeout = errors.New("invalid timeout value")
 
 // ErrNilArwenChangeLocker signals that a nil arwen change locker has been provided
 var ErrNilArwenChangeLocker = errors.New("nil arwen change locker")
+
+// ErrTooManyPoolSubscriptions signals that the maximum number of simultaneous transactions pool subscriptions was reached
+var ErrTooManyPoolSubscriptions = errors.New("too many transactions pool subscriptions")
@@ -2,13 +2,14 @@ package config
 
 // CacheConfig will map the cache configuration
 type CacheConfig struct {
-	Name                 string
-	Type                 string
-	Capacity             uint32
-	SizePerSender        uint32
-	SizeInBytes          uint64
-	SizeInBytesPerSender uint32
-	Shards               uint32
+	Name                      string
+	Type                      string
+	Capacity                  uint32
+	SizePerSender             uint32
+	SizeInBytes               uint64
+	SizeInBytesPerSender      uint32
+	Shards                    uint32
+	MinGasPriceBumpPercentage uint32
 }
 
 // HeadersPoolConfig will map the headers cache configuration
@@ -184,6 +185,8 @@ type Config struct {
 	TrieSync              TrieSyncConfig
 	Resolvers             ResolverConfig
 	VMOutputCacher        CacheConfig
+	GasPriceOracle        GasPriceOracleConfig
+	StateStatistics       StateStatisticsConfig
 
 	PeersRatingConfig PeersRatingConfig
 }
@@ -287,11 +290,16 @@ type EndpointsThrottlersConfig struct {
 
 // WebServerAntifloodConfig will hold the anti-flooding parameters for the web server
 type WebServerAntifloodConfig struct {
-	SimultaneousRequests               uint32
-	SameSourceRequests                 uint32
-	SameSourceResetIntervalInSec       uint32
-	TrieOperationsDeadlineMilliseconds uint32
-	EndpointsThrottlers                []EndpointsThrottlersConfig
+	SimultaneousRequests                 uint32
+	SameSourceRequests                   uint32
+	SameSourceResetIntervalInSec         uint32
+	TrieOperationsDeadlineMilliseconds   uint32
+	MaxBatchSubRequests                  uint32
+	MaxTransactionsInBundle              uint32
+	BundleSimulationDeadlineMilliseconds uint32
+	MaxTransactionsPoolFilterResults     uint32
+	MaxPoolSubscriptions                 uint32
+	EndpointsThrottlers                  []EndpointsThrottlersConfig
 }
 
 // BlackListConfig will hold the p2p peer black list threshold values
@@ -425,6 +433,10 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	AddressHistoryEnabled              bool
+	AddressHistoryStorageConfig        StorageConfig
+	LogEventsIndexEnabled              bool
+	LogEventsStorageConfig             StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -524,6 +536,7 @@ type Configs struct {
 	P2pConfig                *P2PConfig
 	FlagsConfig              *ContextFlagsConfig
 	ImportDbConfig           *ImportDbConfig
+	OutportReplayConfig      *OutportReplayConfig
 	ConfigurationPathsHolder *ConfigurationPathsHolder
 	EpochConfig              *EpochConfig
 	RoundConfig              *RoundConfig
@@ -555,6 +568,18 @@ type TrieSyncConfig struct {
 	TrieSyncerVersion         int
 }
 
+// GasPriceOracleConfig represents the config options used when suggesting gas prices
+type GasPriceOracleConfig struct {
+	NumRecentBlocks uint32
+}
+
+// StateStatisticsConfig represents the config options used when computing the state size statistics
+type StateStatisticsConfig struct {
+	Enabled             bool
+	NumLargestDataTries uint32
+	NumEpochsInHistory  uint32
+}
+
 // ResolverConfig represents the config options to be used when setting up the resolver instances
 type ResolverConfig struct {
 	NumCrossShardPeers  uint32
@@ -32,3 +32,10 @@ type ImportDbConfig struct {
 	ImportDbNoSigCheckFlag        bool
 	ImportDbSaveTrieEpochRootHash bool
 }
+
+// OutportReplayConfig will hold the outport replay parameters
+type OutportReplayConfig struct {
+	IsOutportReplayMode bool
+	FromNonce           uint64
+	ToNonce             uint64
+}
@@ -5,6 +5,9 @@ type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
 	EventNotifierConnector EventNotifierConfig
 	CovalentConnector      CovalentConfig
+	WebSocketConnector     WebSocketDriverConfig
+	FileDriverConnector    FileDriverConfig
+	OutportQueue           OutportQueueConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -26,6 +29,16 @@ type EventNotifierConfig struct {
 	ProxyUrl         string
 	Username         string
 	Password         string
+	Subscriptions    []EventNotifierSubscriptionConfig
+}
+
+// EventNotifierSubscriptionConfig will hold the configuration of an event notifier subscription. The log events
+// matching all the provided criteria are pushed on the subscription's endpoint. The topic prefixes are hex encoded
+type EventNotifierSubscriptionConfig struct {
+	Endpoint      string
+	Addresses     []string
+	Identifiers   []string
+	TopicPrefixes []string
 }
 
 // CovalentConfig will hold the configurations for covalent indexer
@@ -35,3 +48,29 @@ type CovalentConfig struct {
 	RouteSendData        string
 	RouteAcknowledgeData string
 }
+
+// WebSocketDriverConfig will hold the configuration for the web socket outport driver
+type WebSocketDriverConfig struct {
+	Enabled                 bool
+	URL                     string
+	WithAcknowledge         bool
+	AcknowledgeTimeoutInSec int
+	RetainedEventsCount     int
+	MaxSubscribers          int
+}
+
+// FileDriverConfig will hold the configuration for the file outport driver
+type FileDriverConfig struct {
+	Enabled             bool
+	Directory           string
+	Format              string
+	MaxFileSizeInMB     uint64
+	RotateOnEpochChange bool
+}
+
+// OutportQueueConfig will hold the configuration for the durable queues placed in front of the outport drivers
+type OutportQueueConfig struct {
+	Enabled    bool
+	MaxBacklog uint64
+	DB         DBConfig
+}
@@ -45,6 +45,10 @@ func (ut UnitType) String() string {
 		return "TrieEpochRootHashUnit"
 	case ScheduledSCRsUnit:
 		return "ScheduledSCRsUnit"
+	case AddressHistoryUnit:
+		return "AddressHistoryUnit"
+	case LogEventsUnit:
+		return "LogEventsUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -105,6 +109,10 @@ const (
 	PeerAccountsCheckpointsUnit UnitType = 23
 	// ScheduledSCRsUnit is the scheduled SCRs storage unit identifier
 	ScheduledSCRsUnit UnitType = 24
+	// AddressHistoryUnit is the transactions history by address storage unit identifier
+	AddressHistoryUnit UnitType = 25
+	// LogEventsUnit is the log events by emitting address and identifier storage unit identifier
+	LogEventsUnit UnitType = 26
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
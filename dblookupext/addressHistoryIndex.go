@@ -0,0 +1,239 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. addressHistory.proto
+
+package dblookupext
+
+import (
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var txTypeByMiniblockType = map[block.Type]transaction.TxType{
+	block.TxBlock:                  transaction.TxTypeNormal,
+	block.InvalidBlock:             transaction.TxTypeInvalid,
+	block.RewardsBlock:             transaction.TxTypeReward,
+	block.SmartContractResultBlock: transaction.TxTypeUnsigned,
+}
+
+// addressHistoryIndex holds, for each address, the transactions of each block which touched the address
+type addressHistoryIndex struct {
+	selfShardID uint32
+	list        *blockLinkedListIndex
+}
+
+type transactionsByAddress struct {
+	addresses    []string
+	transactions map[string][]*AddressTransaction
+	indexed      map[string]struct{}
+}
+
+func newAddressHistoryIndex(selfShardID uint32, storer storage.Storer, marshalizer marshal.Marshalizer) *addressHistoryIndex {
+	return &addressHistoryIndex{
+		selfShardID: selfShardID,
+		list:        newBlockLinkedListIndex("addressHistoryIndex", storer, marshalizer),
+	}
+}
+
+func newTransactionsByAddress() *transactionsByAddress {
+	return &transactionsByAddress{
+		addresses:    make([]string, 0),
+		transactions: make(map[string][]*AddressTransaction),
+		indexed:      make(map[string]struct{}),
+	}
+}
+
+func (tba *transactionsByAddress) add(address []byte, tx *AddressTransaction) {
+	if len(address) == 0 {
+		return
+	}
+
+	key := string(address) + string(tx.Hash)
+	_, alreadyIndexed := tba.indexed[key]
+	if alreadyIndexed {
+		return
+	}
+	tba.indexed[key] = struct{}{}
+
+	_, found := tba.transactions[string(address)]
+	if !found {
+		tba.addresses = append(tba.addresses, string(address))
+	}
+	tba.transactions[string(address)] = append(tba.transactions[string(address)], tx)
+}
+
+func (ahi *addressHistoryIndex) recordBlock(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	body *block.Body,
+	txsFromPool map[string]data.TransactionHandler,
+	scrResultsFromPool map[string]data.TransactionHandler,
+) error {
+	grouped := ahi.groupTransactionsByAddress(blockHeaderHash, blockHeader, body, txsFromPool, scrResultsFromPool)
+
+	itemsByAddress := make([]*blockItems, 0, len(grouped.addresses))
+	for _, address := range grouped.addresses {
+		txs := grouped.transactions[address]
+		itemsByAddress = append(itemsByAddress, &blockItems{
+			key:      []byte(address),
+			items:    &AddressTransactions{Transactions: txs},
+			numItems: uint64(len(txs)),
+		})
+	}
+
+	return ahi.list.recordBlock(blockHeader.GetEpoch(), blockHeader.GetNonce(), itemsByAddress)
+}
+
+func (ahi *addressHistoryIndex) groupTransactionsByAddress(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	body *block.Body,
+	txsFromPool map[string]data.TransactionHandler,
+	scrResultsFromPool map[string]data.TransactionHandler,
+) *transactionsByAddress {
+	grouped := newTransactionsByAddress()
+	newEntry := func(hash []byte, txType transaction.TxType) *AddressTransaction {
+		return &AddressTransaction{
+			Hash:       hash,
+			Type:       string(txType),
+			Epoch:      blockHeader.GetEpoch(),
+			BlockNonce: blockHeader.GetNonce(),
+			BlockHash:  blockHeaderHash,
+			Round:      blockHeader.GetRound(),
+		}
+	}
+
+	scrsInBody := make(map[string]struct{})
+	for _, miniblock := range body.MiniBlocks {
+		txType, ok := txTypeByMiniblockType[miniblock.Type]
+		if !ok {
+			continue
+		}
+
+		pool := txsFromPool
+		if miniblock.Type == block.SmartContractResultBlock {
+			pool = scrResultsFromPool
+		}
+
+		for _, txHash := range miniblock.TxHashes {
+			tx, found := pool[string(txHash)]
+			if !found {
+				log.Trace("addressHistoryIndex: transaction not found in pool", "hash", txHash)
+				continue
+			}
+			if miniblock.Type == block.SmartContractResultBlock {
+				scrsInBody[string(txHash)] = struct{}{}
+			}
+
+			entry := newEntry(txHash, txType)
+			if miniblock.SenderShardID == ahi.selfShardID {
+				grouped.add(tx.GetSndAddr(), entry)
+			}
+			if miniblock.ReceiverShardID == ahi.selfShardID {
+				grouped.add(tx.GetRcvAddr(), entry)
+			}
+		}
+	}
+
+	// the intra shard smart contract results are not part of the block body
+	intraShardScrsHashes := make([]string, 0)
+	for scrHash := range scrResultsFromPool {
+		_, isInBody := scrsInBody[scrHash]
+		if !isInBody {
+			intraShardScrsHashes = append(intraShardScrsHashes, scrHash)
+		}
+	}
+	sort.Strings(intraShardScrsHashes)
+
+	for _, scrHash := range intraShardScrsHashes {
+		scr := scrResultsFromPool[scrHash]
+		entry := newEntry([]byte(scrHash), transaction.TxTypeUnsigned)
+		grouped.add(scr.GetSndAddr(), entry)
+		grouped.add(scr.GetRcvAddr(), entry)
+	}
+
+	return grouped
+}
+
+func (ahi *addressHistoryIndex) revertBlock(blockHeader data.HeaderHandler) error {
+	return ahi.list.revertBlock(blockHeader.GetEpoch(), blockHeader.GetNonce())
+}
+
+// getTransactions returns the transactions which touched the provided address, the most recent first, skipping the
+// first "from" ones, together with the total number of indexed transactions for that address. As each entry
+// accounts for the transactions of all the older entries, the position of its first transaction is known without
+// unmarshalling its items, so only the entries holding transactions of the requested page are unmarshalled
+func (ahi *addressHistoryIndex) getTransactions(address []byte, from uint64, size uint64) ([]*AddressTransaction, uint64, error) {
+	txs := make([]*AddressTransaction, 0)
+	total := uint64(0)
+	isHead := true
+	// candidate is the oldest walked entry starting at or before the "from" position, the one holding the first
+	// transaction of the page once an entry starting after that position is reached
+	var candidate *BlockLinkedListEntry
+	appendTxs := func(entry *BlockLinkedListEntry) error {
+		entryTxs := &AddressTransactions{}
+		errUnmarshal := ahi.list.unmarshalItems(entry, entryTxs)
+		if errUnmarshal != nil {
+			return errUnmarshal
+		}
+
+		position := total - entry.NumItems
+		for i := len(entryTxs.Transactions) - 1; i >= 0 && uint64(len(txs)) < size; i-- {
+			if position >= from {
+				txs = append(txs, entryTxs.Transactions[i])
+			}
+			position++
+		}
+
+		return nil
+	}
+
+	err := ahi.list.walk(address, func(_ uint64, entry *BlockLinkedListEntry) (bool, error) {
+		if isHead {
+			total = entry.NumItems
+			isHead = false
+		}
+		if size == 0 || from >= total {
+			return false, nil
+		}
+
+		startPosition := total - entry.NumItems
+		if startPosition <= from {
+			candidate = entry
+			return true, nil
+		}
+
+		if candidate != nil {
+			errAppend := appendTxs(candidate)
+			candidate = nil
+			if errAppend != nil {
+				return false, errAppend
+			}
+		}
+		if uint64(len(txs)) >= size {
+			return false, nil
+		}
+
+		errAppend := appendTxs(entry)
+		if errAppend != nil {
+			return false, errAppend
+		}
+
+		return uint64(len(txs)) < size, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if candidate != nil {
+		err = appendTxs(candidate)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return txs, total, nil
+}
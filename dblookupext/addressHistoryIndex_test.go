@@ -0,0 +1,237 @@
+package dblookupext
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	alice = []byte("alice")
+	bob   = []byte("bob")
+	carol = []byte("carol")
+)
+
+func createAddressHistoryIndex() *addressHistoryIndex {
+	return newAddressHistoryIndex(0, testscommon.CreateMemUnit(), &marshal.GogoProtoMarshalizer{})
+}
+
+func recordTransfer(t *testing.T, index *addressHistoryIndex, nonce uint64, txHash string, sender []byte, receiver []byte) {
+	header := &block.Header{Nonce: nonce, Round: nonce + 1, Epoch: uint32(nonce / 10)}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte(txHash)}, Type: block.TxBlock},
+		},
+	}
+	txs := map[string]data.TransactionHandler{
+		txHash: &transaction.Transaction{SndAddr: sender, RcvAddr: receiver},
+	}
+
+	err := index.recordBlock([]byte("block"), header, body, txs, nil)
+	require.Nil(t, err)
+}
+
+func getHashes(t *testing.T, index *addressHistoryIndex, address []byte, from uint64, size uint64) ([]string, uint64) {
+	txs, total, err := index.getTransactions(address, from, size)
+	require.Nil(t, err)
+
+	hashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		hashes = append(hashes, string(tx.Hash))
+	}
+
+	return hashes, total
+}
+
+func TestAddressHistoryIndex_RecordBlockShouldIndexTheAddressesOfSelfShard(t *testing.T) {
+	t.Parallel()
+
+	index := createAddressHistoryIndex()
+	header := &block.Header{Nonce: 7, Round: 8, Epoch: 1}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("intra")}, Type: block.TxBlock, SenderShardID: 0, ReceiverShardID: 0},
+			{TxHashes: [][]byte{[]byte("outgoing")}, Type: block.TxBlock, SenderShardID: 0, ReceiverShardID: 1},
+			{TxHashes: [][]byte{[]byte("incoming"), []byte("missing")}, Type: block.TxBlock, SenderShardID: 1, ReceiverShardID: 0},
+			{TxHashes: [][]byte{[]byte("reward")}, Type: block.RewardsBlock, SenderShardID: core.MetachainShardId, ReceiverShardID: 0},
+			{TxHashes: [][]byte{[]byte("crossScr")}, Type: block.SmartContractResultBlock, SenderShardID: 1, ReceiverShardID: 0},
+			{TxHashes: [][]byte{[]byte("peer")}, Type: block.PeerBlock, SenderShardID: 0, ReceiverShardID: 0},
+		},
+	}
+	txs := map[string]data.TransactionHandler{
+		"intra":    &transaction.Transaction{SndAddr: alice, RcvAddr: alice},
+		"outgoing": &transaction.Transaction{SndAddr: alice, RcvAddr: bob},
+		"incoming": &transaction.Transaction{SndAddr: bob, RcvAddr: carol},
+		"reward":   &rewardTx.RewardTx{RcvAddr: carol},
+	}
+	scrs := map[string]data.TransactionHandler{
+		"crossScr": &smartContractResult.SmartContractResult{SndAddr: bob, RcvAddr: alice},
+		"intraScr": &smartContractResult.SmartContractResult{SndAddr: carol, RcvAddr: alice},
+	}
+
+	err := index.recordBlock([]byte("block"), header, body, txs, scrs)
+	require.Nil(t, err)
+
+	hashes, total := getHashes(t, index, alice, 0, 10)
+	assert.Equal(t, []string{"intraScr", "crossScr", "outgoing", "intra"}, hashes)
+	assert.Equal(t, uint64(4), total)
+
+	hashes, _ = getHashes(t, index, bob, 0, 10)
+	assert.Equal(t, 0, len(hashes))
+
+	hashes, _ = getHashes(t, index, carol, 0, 10)
+	assert.Equal(t, []string{"intraScr", "reward", "incoming"}, hashes)
+
+	aliceTxs, _, _ := index.getTransactions(alice, 3, 1)
+	assert.Equal(t, &AddressTransaction{
+		Hash:       []byte("intra"),
+		Type:       string(transaction.TxTypeNormal),
+		Epoch:      1,
+		BlockNonce: 7,
+		BlockHash:  []byte("block"),
+		Round:      8,
+	}, aliceTxs[0])
+}
+
+func TestAddressHistoryIndex_GetTransactionsShouldPaginateAcrossBlocks(t *testing.T) {
+	t.Parallel()
+
+	index := createAddressHistoryIndex()
+	recordTransfer(t, index, 1, "tx1", alice, bob)
+	recordTransfer(t, index, 2, "tx2", bob, carol)
+	recordTransfer(t, index, 13, "tx3", carol, alice)
+	recordTransfer(t, index, 24, "tx4", alice, bob)
+
+	hashes, total := getHashes(t, index, alice, 0, 10)
+	assert.Equal(t, []string{"tx4", "tx3", "tx1"}, hashes)
+	assert.Equal(t, uint64(3), total)
+
+	hashes, total = getHashes(t, index, bob, 1, 2)
+	assert.Equal(t, []string{"tx2", "tx1"}, hashes)
+	assert.Equal(t, uint64(3), total)
+
+	hashes, _ = getHashes(t, index, bob, 3, 2)
+	assert.Equal(t, 0, len(hashes))
+
+	hashes, total = getHashes(t, index, []byte("dave"), 0, 10)
+	assert.Equal(t, 0, len(hashes))
+	assert.Equal(t, uint64(0), total)
+}
+
+func TestAddressHistoryIndex_GetTransactionsShouldOnlyUnmarshalTheEntriesOfThePage(t *testing.T) {
+	t.Parallel()
+
+	numUnmarshalledEntries := 0
+	gogoMarshalizer := &marshal.GogoProtoMarshalizer{}
+	marshalizer := &testscommon.MarshalizerStub{
+		MarshalCalled: gogoMarshalizer.Marshal,
+		UnmarshalCalled: func(obj interface{}, buff []byte) error {
+			_, isEntryItems := obj.(*AddressTransactions)
+			if isEntryItems {
+				numUnmarshalledEntries++
+			}
+
+			return gogoMarshalizer.Unmarshal(obj, buff)
+		},
+	}
+	index := newAddressHistoryIndex(0, testscommon.CreateMemUnit(), marshalizer)
+
+	// 5 blocks with 3 transactions each, the most recent transaction being "tx15"
+	allHashes := make([]string, 0)
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		body := &block.Body{MiniBlocks: []*block.MiniBlock{{Type: block.TxBlock}}}
+		txs := make(map[string]data.TransactionHandler)
+		for i := uint64(1); i <= 3; i++ {
+			txHash := fmt.Sprintf("tx%d", (nonce-1)*3+i)
+			body.MiniBlocks[0].TxHashes = append(body.MiniBlocks[0].TxHashes, []byte(txHash))
+			txs[txHash] = &transaction.Transaction{SndAddr: alice, RcvAddr: bob}
+			allHashes = append([]string{txHash}, allHashes...)
+		}
+
+		err := index.recordBlock([]byte("block"), &block.Header{Nonce: nonce}, body, txs, nil)
+		require.Nil(t, err)
+	}
+
+	for from := uint64(0); from <= uint64(len(allHashes)); from++ {
+		for size := uint64(1); size <= 4; size++ {
+			numUnmarshalledEntries = 0
+			hashes, total := getHashes(t, index, alice, from, size)
+			assert.Equal(t, uint64(len(allHashes)), total)
+
+			end := from + size
+			if end > uint64(len(allHashes)) {
+				end = uint64(len(allHashes))
+			}
+			expectedHashes := allHashes[from:end]
+			require.Equal(t, expectedHashes, hashes, "from %d, size %d", from, size)
+
+			expectedNumUnmarshalledEntries := 0
+			if len(expectedHashes) > 0 {
+				expectedNumUnmarshalledEntries = int((end-1)/3 - from/3 + 1)
+			}
+			assert.Equal(t, expectedNumUnmarshalledEntries, numUnmarshalledEntries, "from %d, size %d", from, size)
+		}
+	}
+
+	numUnmarshalledEntries = 0
+	hashes, total := getHashes(t, index, alice, 0, 0)
+	assert.Equal(t, 0, len(hashes))
+	assert.Equal(t, uint64(len(allHashes)), total)
+	assert.Equal(t, 0, numUnmarshalledEntries)
+}
+
+func TestAddressHistoryIndex_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	index := createAddressHistoryIndex()
+	recordTransfer(t, index, 1, "tx1", alice, bob)
+	recordTransfer(t, index, 2, "tx2", alice, carol)
+
+	err := index.revertBlock(&block.Header{Nonce: 2})
+	require.Nil(t, err)
+
+	hashes, total := getHashes(t, index, alice, 0, 10)
+	assert.Equal(t, []string{"tx1"}, hashes)
+	assert.Equal(t, uint64(1), total)
+
+	hashes, total = getHashes(t, index, carol, 0, 10)
+	assert.Equal(t, 0, len(hashes))
+	assert.Equal(t, uint64(0), total)
+
+	// reverting a block which was not indexed does nothing
+	err = index.revertBlock(&block.Header{Nonce: 5})
+	assert.Nil(t, err)
+
+	recordTransfer(t, index, 2, "tx2bis", alice, bob)
+	hashes, total = getHashes(t, index, bob, 0, 10)
+	assert.Equal(t, []string{"tx2bis", "tx1"}, hashes)
+	assert.Equal(t, uint64(2), total)
+}
+
+func TestAddressHistoryIndex_RecordBlockShouldReplaceBlocksWhichWereNotReverted(t *testing.T) {
+	t.Parallel()
+
+	index := createAddressHistoryIndex()
+	recordTransfer(t, index, 1, "tx1", alice, bob)
+	recordTransfer(t, index, 2, "fork2", alice, carol)
+	recordTransfer(t, index, 3, "fork3", alice, bob)
+
+	recordTransfer(t, index, 2, "tx2", alice, bob)
+
+	hashes, total := getHashes(t, index, alice, 0, 10)
+	assert.Equal(t, []string{"tx2", "tx1"}, hashes)
+	assert.Equal(t, uint64(2), total)
+
+	hashes, _ = getHashes(t, index, carol, 0, 10)
+	assert.Equal(t, 0, len(hashes))
+}
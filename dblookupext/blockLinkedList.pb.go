@@ -0,0 +1,1016 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blockLinkedList.proto
+
+package dblookupext
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BlockLinkedListEntry holds the items recorded for a key in a block, marshalled by the index using the list, together
+// with a link towards the previous block recorded for the same key. NumItems accounts for the items of all the linked
+// blocks
+type BlockLinkedListEntry struct {
+	Items              []byte `protobuf:"bytes,1,opt,name=Items,proto3" json:"Items,omitempty"`
+	NumItems           uint64 `protobuf:"varint,2,opt,name=NumItems,proto3" json:"NumItems,omitempty"`
+	HasPrevious        bool   `protobuf:"varint,3,opt,name=HasPrevious,proto3" json:"HasPrevious,omitempty"`
+	PreviousEpoch      uint32 `protobuf:"varint,4,opt,name=PreviousEpoch,proto3" json:"PreviousEpoch,omitempty"`
+	PreviousBlockNonce uint64 `protobuf:"varint,5,opt,name=PreviousBlockNonce,proto3" json:"PreviousBlockNonce,omitempty"`
+}
+
+func (m *BlockLinkedListEntry) Reset()      { *m = BlockLinkedListEntry{} }
+func (*BlockLinkedListEntry) ProtoMessage() {}
+func (*BlockLinkedListEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a2b4df71f0296, []int{0}
+}
+func (m *BlockLinkedListEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockLinkedListEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockLinkedListEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockLinkedListEntry.Merge(m, src)
+}
+func (m *BlockLinkedListEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockLinkedListEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockLinkedListEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockLinkedListEntry proto.InternalMessageInfo
+
+func (m *BlockLinkedListEntry) GetItems() []byte {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *BlockLinkedListEntry) GetNumItems() uint64 {
+	if m != nil {
+		return m.NumItems
+	}
+	return 0
+}
+
+func (m *BlockLinkedListEntry) GetHasPrevious() bool {
+	if m != nil {
+		return m.HasPrevious
+	}
+	return false
+}
+
+func (m *BlockLinkedListEntry) GetPreviousEpoch() uint32 {
+	if m != nil {
+		return m.PreviousEpoch
+	}
+	return 0
+}
+
+func (m *BlockLinkedListEntry) GetPreviousBlockNonce() uint64 {
+	if m != nil {
+		return m.PreviousBlockNonce
+	}
+	return 0
+}
+
+// BlockLinkedListHead points to the most recent block recorded for a key
+type BlockLinkedListHead struct {
+	Epoch      uint32 `protobuf:"varint,1,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	BlockNonce uint64 `protobuf:"varint,2,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+}
+
+func (m *BlockLinkedListHead) Reset()      { *m = BlockLinkedListHead{} }
+func (*BlockLinkedListHead) ProtoMessage() {}
+func (*BlockLinkedListHead) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a2b4df71f0296, []int{1}
+}
+func (m *BlockLinkedListHead) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockLinkedListHead) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockLinkedListHead) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockLinkedListHead.Merge(m, src)
+}
+func (m *BlockLinkedListHead) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockLinkedListHead) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockLinkedListHead.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockLinkedListHead proto.InternalMessageInfo
+
+func (m *BlockLinkedListHead) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *BlockLinkedListHead) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+// BlockLinkedListKeysInBlock holds the keys recorded in a block, so that the block can be reverted from the index
+type BlockLinkedListKeysInBlock struct {
+	Keys [][]byte `protobuf:"bytes,1,rep,name=Keys,proto3" json:"Keys,omitempty"`
+}
+
+func (m *BlockLinkedListKeysInBlock) Reset()      { *m = BlockLinkedListKeysInBlock{} }
+func (*BlockLinkedListKeysInBlock) ProtoMessage() {}
+func (*BlockLinkedListKeysInBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a2b4df71f0296, []int{2}
+}
+func (m *BlockLinkedListKeysInBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockLinkedListKeysInBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockLinkedListKeysInBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockLinkedListKeysInBlock.Merge(m, src)
+}
+func (m *BlockLinkedListKeysInBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockLinkedListKeysInBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockLinkedListKeysInBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockLinkedListKeysInBlock proto.InternalMessageInfo
+
+func (m *BlockLinkedListKeysInBlock) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BlockLinkedListEntry)(nil), "proto.BlockLinkedListEntry")
+	proto.RegisterType((*BlockLinkedListHead)(nil), "proto.BlockLinkedListHead")
+	proto.RegisterType((*BlockLinkedListKeysInBlock)(nil), "proto.BlockLinkedListKeysInBlock")
+}
+
+func init() { proto.RegisterFile("blockLinkedList.proto", fileDescriptor_424a2b4df71f0296) }
+
+var fileDescriptor_424a2b4df71f0296 = []byte{
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x6a, 0x32, 0x41,
+	0x10, 0xc7, 0x77, 0x3e, 0xf5, 0x43, 0x46, 0x6d, 0x36, 0x06, 0x0e, 0x8b, 0x61, 0x91, 0x14, 0xd7,
+	0x44, 0x03, 0x79, 0x03, 0x41, 0x50, 0x14, 0x09, 0x57, 0xa6, 0xf3, 0xce, 0x8d, 0x1e, 0xea, 0xad,
+	0x78, 0x7b, 0x21, 0x76, 0x79, 0x84, 0x3c, 0x46, 0x1e, 0x23, 0x65, 0x4a, 0x4b, 0xcb, 0xb8, 0x36,
+	0x29, 0x7d, 0x84, 0xe0, 0x2e, 0x06, 0x95, 0x54, 0x3b, 0xbf, 0xdf, 0xb0, 0x33, 0xfb, 0x67, 0xf1,
+	0x3a, 0x9c, 0xa9, 0x68, 0xda, 0x8f, 0x93, 0xa9, 0x1c, 0xf5, 0xe3, 0x54, 0x37, 0x16, 0x4b, 0xa5,
+	0x15, 0x2f, 0xd8, 0xa3, 0x76, 0x3b, 0x8e, 0xf5, 0x24, 0x0b, 0x1b, 0x91, 0x9a, 0x37, 0xc7, 0x6a,
+	0xac, 0x9a, 0x56, 0x87, 0xd9, 0x93, 0x25, 0x0b, 0xb6, 0x72, 0xb7, 0xea, 0x1f, 0x80, 0xd5, 0xd6,
+	0xf9, 0xbc, 0x76, 0xa2, 0x97, 0x2b, 0x5e, 0xc5, 0x42, 0x57, 0xcb, 0x79, 0xea, 0x81, 0x00, 0xbf,
+	0x1c, 0x38, 0xe0, 0x35, 0x2c, 0x0e, 0xb2, 0xb9, 0x6b, 0xfc, 0x13, 0xe0, 0xe7, 0x83, 0x5f, 0xe6,
+	0x02, 0x4b, 0x9d, 0x61, 0xfa, 0xb0, 0x94, 0xcf, 0xb1, 0xca, 0x52, 0x2f, 0x27, 0xc0, 0x2f, 0x06,
+	0xa7, 0x8a, 0xdf, 0x60, 0xe5, 0x58, 0xb7, 0x17, 0x2a, 0x9a, 0x78, 0x79, 0x01, 0x7e, 0x25, 0x38,
+	0x97, 0xbc, 0x81, 0xfc, 0x28, 0xec, 0xcb, 0x06, 0x2a, 0x89, 0xa4, 0x57, 0xb0, 0xdb, 0xfe, 0xe8,
+	0xd4, 0x7b, 0x78, 0x75, 0x91, 0xa0, 0x23, 0x87, 0xa3, 0x43, 0x00, 0xb7, 0x04, 0xec, 0x12, 0x07,
+	0x9c, 0x10, 0x4f, 0x86, 0xba, 0x08, 0x27, 0xa6, 0x7e, 0x87, 0xb5, 0x8b, 0x61, 0x3d, 0xb9, 0x4a,
+	0xbb, 0x89, 0x95, 0x9c, 0x63, 0xfe, 0x80, 0x1e, 0x88, 0x9c, 0x5f, 0x0e, 0x6c, 0xdd, 0x6a, 0xaf,
+	0xb7, 0xc4, 0x36, 0x5b, 0x62, 0xfb, 0x2d, 0xc1, 0xab, 0x21, 0x78, 0x37, 0x04, 0x9f, 0x86, 0x60,
+	0x6d, 0x08, 0x36, 0x86, 0xe0, 0xcb, 0x10, 0x7c, 0x1b, 0x62, 0x7b, 0x43, 0xf0, 0xb6, 0x23, 0xb6,
+	0xde, 0x11, 0xdb, 0xec, 0x88, 0x3d, 0x96, 0x46, 0xe1, 0x4c, 0xa9, 0x69, 0xb6, 0x90, 0x2f, 0x3a,
+	0xfc, 0x6f, 0xff, 0xe3, 0xfe, 0x67, 0x00, 0xa3, 0xf9, 0x48, 0x1b, 0xde, 0x01, 0x00, 0x00,
+}
+
+func (this *BlockLinkedListEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockLinkedListEntry)
+	if !ok {
+		that2, ok := that.(BlockLinkedListEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Items, that1.Items) {
+		return false
+	}
+	if this.NumItems != that1.NumItems {
+		return false
+	}
+	if this.HasPrevious != that1.HasPrevious {
+		return false
+	}
+	if this.PreviousEpoch != that1.PreviousEpoch {
+		return false
+	}
+	if this.PreviousBlockNonce != that1.PreviousBlockNonce {
+		return false
+	}
+	return true
+}
+func (this *BlockLinkedListHead) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockLinkedListHead)
+	if !ok {
+		that2, ok := that.(BlockLinkedListHead)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	return true
+}
+func (this *BlockLinkedListKeysInBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockLinkedListKeysInBlock)
+	if !ok {
+		that2, ok := that.(BlockLinkedListKeysInBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Keys) != len(that1.Keys) {
+		return false
+	}
+	for i := range this.Keys {
+		if !bytes.Equal(this.Keys[i], that1.Keys[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *BlockLinkedListEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&dblookupext.BlockLinkedListEntry{")
+	s = append(s, "Items: "+fmt.Sprintf("%#v", this.Items)+",\n")
+	s = append(s, "NumItems: "+fmt.Sprintf("%#v", this.NumItems)+",\n")
+	s = append(s, "HasPrevious: "+fmt.Sprintf("%#v", this.HasPrevious)+",\n")
+	s = append(s, "PreviousEpoch: "+fmt.Sprintf("%#v", this.PreviousEpoch)+",\n")
+	s = append(s, "PreviousBlockNonce: "+fmt.Sprintf("%#v", this.PreviousBlockNonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockLinkedListHead) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&dblookupext.BlockLinkedListHead{")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockLinkedListKeysInBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.BlockLinkedListKeysInBlock{")
+	s = append(s, "Keys: "+fmt.Sprintf("%#v", this.Keys)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringBlockLinkedList(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *BlockLinkedListEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockLinkedListEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockLinkedListEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousBlockNonce != 0 {
+		i = encodeVarintBlockLinkedList(dAtA, i, uint64(m.PreviousBlockNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PreviousEpoch != 0 {
+		i = encodeVarintBlockLinkedList(dAtA, i, uint64(m.PreviousEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HasPrevious {
+		i--
+		if m.HasPrevious {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumItems != 0 {
+		i = encodeVarintBlockLinkedList(dAtA, i, uint64(m.NumItems))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Items) > 0 {
+		i -= len(m.Items)
+		copy(dAtA[i:], m.Items)
+		i = encodeVarintBlockLinkedList(dAtA, i, uint64(len(m.Items)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockLinkedListHead) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockLinkedListHead) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockLinkedListHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockNonce != 0 {
+		i = encodeVarintBlockLinkedList(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBlockLinkedList(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockLinkedListKeysInBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockLinkedListKeysInBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockLinkedListKeysInBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintBlockLinkedList(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlockLinkedList(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlockLinkedList(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlockLinkedListEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Items)
+	if l > 0 {
+		n += 1 + l + sovBlockLinkedList(uint64(l))
+	}
+	if m.NumItems != 0 {
+		n += 1 + sovBlockLinkedList(uint64(m.NumItems))
+	}
+	if m.HasPrevious {
+		n += 2
+	}
+	if m.PreviousEpoch != 0 {
+		n += 1 + sovBlockLinkedList(uint64(m.PreviousEpoch))
+	}
+	if m.PreviousBlockNonce != 0 {
+		n += 1 + sovBlockLinkedList(uint64(m.PreviousBlockNonce))
+	}
+	return n
+}
+
+func (m *BlockLinkedListHead) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBlockLinkedList(uint64(m.Epoch))
+	}
+	if m.BlockNonce != 0 {
+		n += 1 + sovBlockLinkedList(uint64(m.BlockNonce))
+	}
+	return n
+}
+
+func (m *BlockLinkedListKeysInBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovBlockLinkedList(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBlockLinkedList(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlockLinkedList(x uint64) (n int) {
+	return sovBlockLinkedList(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *BlockLinkedListEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockLinkedListEntry{`,
+		`Items:` + fmt.Sprintf("%v", this.Items) + `,`,
+		`NumItems:` + fmt.Sprintf("%v", this.NumItems) + `,`,
+		`HasPrevious:` + fmt.Sprintf("%v", this.HasPrevious) + `,`,
+		`PreviousEpoch:` + fmt.Sprintf("%v", this.PreviousEpoch) + `,`,
+		`PreviousBlockNonce:` + fmt.Sprintf("%v", this.PreviousBlockNonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockLinkedListHead) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockLinkedListHead{`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockLinkedListKeysInBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockLinkedListKeysInBlock{`,
+		`Keys:` + fmt.Sprintf("%v", this.Keys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringBlockLinkedList(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *BlockLinkedListEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlockLinkedList
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockLinkedListEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockLinkedListEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockLinkedList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlockLinkedList
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockLinkedList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items[:0], dAtA[iNdEx:postIndex]...)
+			if m.Items == nil {
+				m.Items = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumItems", wireType)
+			}
+			m.NumItems = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockLinkedList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumItems |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPrevious", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockLinkedList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasPrevious = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEpoch", wireType)
+			}
+			m.PreviousEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockLinkedList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBlockNonce", wireType)
+			}
+			m.PreviousBlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockLinkedList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousBlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlockLinkedList(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlockLinkedList
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlockLinkedList
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockLinkedListHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlockLinkedList
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockLinkedListHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockLinkedListHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockLinkedList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockLinkedList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlockLinkedList(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlockLinkedList
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlockLinkedList
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockLinkedListKeysInBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlockLinkedList
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockLinkedListKeysInBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockLinkedListKeysInBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockLinkedList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlockLinkedList
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockLinkedList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlockLinkedList(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlockLinkedList
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlockLinkedList
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlockLinkedList(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlockLinkedList
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlockLinkedList
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlockLinkedList
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlockLinkedList
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlockLinkedList
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlockLinkedList
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlockLinkedList        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlockLinkedList          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlockLinkedList = fmt.Errorf("proto: unexpected end of group")
+)
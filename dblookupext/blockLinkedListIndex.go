@@ -0,0 +1,283 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. blockLinkedList.proto
+
+package dblookupext
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// The index holds, for each key, a linked list of per-block entries, from the most recent block to the oldest one:
+// - headPrefix | key -> BlockLinkedListHead, pointing to the most recent entry
+// - entryPrefix | key | epoch | nonce -> BlockLinkedListEntry
+// - blockPrefix | epoch | nonce -> BlockLinkedListKeysInBlock, used when reverting a block
+const (
+	blockLinkedListHeadPrefix  = byte('h')
+	blockLinkedListEntryPrefix = byte('e')
+	blockLinkedListBlockPrefix = byte('b')
+)
+
+// blockItems holds the items of a block recorded for a key
+type blockItems struct {
+	key      []byte
+	items    interface{}
+	numItems uint64
+}
+
+// blockLinkedListIndex is the storage layout shared by the indexes which record, for a key, the items of each block
+type blockLinkedListIndex struct {
+	name        string
+	marshalizer marshal.Marshalizer
+	storer      storage.Storer
+	mutIndex    sync.RWMutex
+}
+
+func newBlockLinkedListIndex(name string, storer storage.Storer, marshalizer marshal.Marshalizer) *blockLinkedListIndex {
+	return &blockLinkedListIndex{
+		name:        name,
+		marshalizer: marshalizer,
+		storer:      storer,
+	}
+}
+
+// recordBlock appends the items of the block to the list of each key, replacing a previous record of the same nonce
+func (index *blockLinkedListIndex) recordBlock(epoch uint32, nonce uint64, itemsByKey []*blockItems) error {
+	index.mutIndex.Lock()
+	defer index.mutIndex.Unlock()
+
+	// the same block nonce might have been recorded before, on a fork which was not reverted
+	err := index.revertBlockNoLock(epoch, nonce)
+	if err != nil {
+		return err
+	}
+
+	if len(itemsByKey) == 0 {
+		return nil
+	}
+
+	keys := make([][]byte, 0, len(itemsByKey))
+	for _, itemsOfKey := range itemsByKey {
+		err = index.append(itemsOfKey, epoch, nonce)
+		if err != nil {
+			return err
+		}
+
+		keys = append(keys, itemsOfKey.key)
+	}
+
+	return index.put(blockLinkedListBlockKey(epoch, nonce), &BlockLinkedListKeysInBlock{Keys: keys})
+}
+
+func (index *blockLinkedListIndex) append(itemsOfKey *blockItems, epoch uint32, nonce uint64) error {
+	head, err := index.getHead(itemsOfKey.key)
+	if err != nil {
+		return err
+	}
+
+	// drop the entries of blocks with greater nonces, left behind by a rollback which did not revert them
+	for head != nil && head.BlockNonce >= nonce {
+		head, err = index.removeEntry(itemsOfKey.key, head)
+		if err != nil {
+			return err
+		}
+	}
+
+	items, err := index.marshalizer.Marshal(itemsOfKey.items)
+	if err != nil {
+		return err
+	}
+
+	entry := &BlockLinkedListEntry{
+		Items:    items,
+		NumItems: itemsOfKey.numItems,
+	}
+	if head != nil {
+		previous := &BlockLinkedListEntry{}
+		err = index.get(blockLinkedListEntryKey(itemsOfKey.key, head.Epoch, head.BlockNonce), previous)
+		if err != nil {
+			return err
+		}
+
+		entry.NumItems += previous.NumItems
+		entry.HasPrevious = true
+		entry.PreviousEpoch = head.Epoch
+		entry.PreviousBlockNonce = head.BlockNonce
+	}
+
+	err = index.put(blockLinkedListEntryKey(itemsOfKey.key, epoch, nonce), entry)
+	if err != nil {
+		return err
+	}
+
+	return index.put(blockLinkedListHeadKey(itemsOfKey.key), &BlockLinkedListHead{Epoch: epoch, BlockNonce: nonce})
+}
+
+// removeEntry removes the entry the head points to and returns the new head
+func (index *blockLinkedListIndex) removeEntry(key []byte, head *BlockLinkedListHead) (*BlockLinkedListHead, error) {
+	entryKey := blockLinkedListEntryKey(key, head.Epoch, head.BlockNonce)
+	entry := &BlockLinkedListEntry{}
+	err := index.get(entryKey, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	err = index.storer.Remove(entryKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !entry.HasPrevious {
+		return nil, index.storer.Remove(blockLinkedListHeadKey(key))
+	}
+
+	newHead := &BlockLinkedListHead{
+		Epoch:      entry.PreviousEpoch,
+		BlockNonce: entry.PreviousBlockNonce,
+	}
+
+	return newHead, index.put(blockLinkedListHeadKey(key), newHead)
+}
+
+// revertBlock removes the entries recorded for the block with the provided epoch and nonce
+func (index *blockLinkedListIndex) revertBlock(epoch uint32, nonce uint64) error {
+	index.mutIndex.Lock()
+	defer index.mutIndex.Unlock()
+
+	return index.revertBlockNoLock(epoch, nonce)
+}
+
+func (index *blockLinkedListIndex) revertBlockNoLock(epoch uint32, nonce uint64) error {
+	blockKey := blockLinkedListBlockKey(epoch, nonce)
+	if index.storer.Has(blockKey) != nil {
+		return nil
+	}
+
+	keysInBlock := &BlockLinkedListKeysInBlock{}
+	err := index.get(blockKey, keysInBlock)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keysInBlock.Keys {
+		head, errGet := index.getHead(key)
+		if errGet != nil {
+			return errGet
+		}
+
+		isHeadOfReverted := head != nil && head.Epoch == epoch && head.BlockNonce == nonce
+		if !isHeadOfReverted {
+			log.Debug("revertBlock: unexpected head", "index", index.name, "key", key, "nonce", nonce)
+			continue
+		}
+
+		_, err = index.removeEntry(key, head)
+		if err != nil {
+			return err
+		}
+	}
+
+	return index.storer.Remove(blockKey)
+}
+
+// walk calls the handler for the entries of the provided key, from the most recent block to the oldest one, as long
+// as the handler returns true
+func (index *blockLinkedListIndex) walk(key []byte, handler func(nonce uint64, entry *BlockLinkedListEntry) (bool, error)) error {
+	index.mutIndex.RLock()
+	defer index.mutIndex.RUnlock()
+
+	head, err := index.getHead(key)
+	if err != nil || head == nil {
+		return err
+	}
+
+	hasEntry := true
+	epoch, nonce := head.Epoch, head.BlockNonce
+	for hasEntry {
+		entry := &BlockLinkedListEntry{}
+		err = index.get(blockLinkedListEntryKey(key, epoch, nonce), entry)
+		if err != nil {
+			return err
+		}
+
+		shouldContinue, errHandler := handler(nonce, entry)
+		if errHandler != nil || !shouldContinue {
+			return errHandler
+		}
+
+		hasEntry = entry.HasPrevious
+		epoch, nonce = entry.PreviousEpoch, entry.PreviousBlockNonce
+	}
+
+	return nil
+}
+
+// unmarshalItems unmarshalls the items of an entry in the provided object
+func (index *blockLinkedListIndex) unmarshalItems(entry *BlockLinkedListEntry, obj interface{}) error {
+	return index.marshalizer.Unmarshal(obj, entry.Items)
+}
+
+func (index *blockLinkedListIndex) getHead(key []byte) (*BlockLinkedListHead, error) {
+	headKey := blockLinkedListHeadKey(key)
+	if index.storer.Has(headKey) != nil {
+		return nil, nil
+	}
+
+	head := &BlockLinkedListHead{}
+	err := index.get(headKey, head)
+	if err != nil {
+		return nil, err
+	}
+
+	return head, nil
+}
+
+func (index *blockLinkedListIndex) get(key []byte, obj interface{}) error {
+	buff, err := index.storer.Get(key)
+	if err != nil {
+		return err
+	}
+
+	return index.marshalizer.Unmarshal(obj, buff)
+}
+
+func (index *blockLinkedListIndex) put(key []byte, obj interface{}) error {
+	buff, err := index.marshalizer.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	return index.storer.Put(key, buff)
+}
+
+func blockLinkedListHeadKey(key []byte) []byte {
+	headKey := make([]byte, 0, 1+len(key))
+	headKey = append(headKey, blockLinkedListHeadPrefix)
+
+	return append(headKey, key...)
+}
+
+func blockLinkedListEntryKey(key []byte, epoch uint32, nonce uint64) []byte {
+	entryKey := make([]byte, 0, 1+len(key)+4+8)
+	entryKey = append(entryKey, blockLinkedListEntryPrefix)
+	entryKey = append(entryKey, key...)
+
+	return appendEpochAndNonce(entryKey, epoch, nonce)
+}
+
+func blockLinkedListBlockKey(epoch uint32, nonce uint64) []byte {
+	blockKey := make([]byte, 0, 1+4+8)
+	blockKey = append(blockKey, blockLinkedListBlockPrefix)
+
+	return appendEpochAndNonce(blockKey, epoch, nonce)
+}
+
+func appendEpochAndNonce(key []byte, epoch uint32, nonce uint64) []byte {
+	epochAndNonce := make([]byte, 12)
+	binary.BigEndian.PutUint32(epochAndNonce[:4], epoch)
+	binary.BigEndian.PutUint64(epochAndNonce[4:], nonce)
+
+	return append(key, epochAndNonce...)
+}
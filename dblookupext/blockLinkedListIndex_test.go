@@ -0,0 +1,116 @@
+package dblookupext
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBlockLinkedListIndex() *blockLinkedListIndex {
+	return newBlockLinkedListIndex("test", testscommon.CreateMemUnit(), &marshal.GogoProtoMarshalizer{})
+}
+
+func recordItems(t *testing.T, index *blockLinkedListIndex, nonce uint64, key string, hashes ...string) {
+	txs := make([]*AddressTransaction, 0, len(hashes))
+	for _, hash := range hashes {
+		txs = append(txs, &AddressTransaction{Hash: []byte(hash)})
+	}
+
+	err := index.recordBlock(uint32(nonce/10), nonce, []*blockItems{
+		{
+			key:      []byte(key),
+			items:    &AddressTransactions{Transactions: txs},
+			numItems: uint64(len(txs)),
+		},
+	})
+	require.Nil(t, err)
+}
+
+func walkItems(t *testing.T, index *blockLinkedListIndex, key string) ([]uint64, []uint64) {
+	nonces := make([]uint64, 0)
+	numItems := make([]uint64, 0)
+	err := index.walk([]byte(key), func(nonce uint64, entry *BlockLinkedListEntry) (bool, error) {
+		nonces = append(nonces, nonce)
+		numItems = append(numItems, entry.NumItems)
+		return true, nil
+	})
+	require.Nil(t, err)
+
+	return nonces, numItems
+}
+
+func TestBlockLinkedListIndex_RecordBlockShouldLinkEntries(t *testing.T) {
+	t.Parallel()
+
+	index := createBlockLinkedListIndex()
+	recordItems(t, index, 5, "a", "tx1", "tx2")
+	recordItems(t, index, 7, "a", "tx3")
+	recordItems(t, index, 12, "a", "tx4")
+
+	nonces, numItems := walkItems(t, index, "a")
+	assert.Equal(t, []uint64{12, 7, 5}, nonces)
+	assert.Equal(t, []uint64{4, 3, 2}, numItems)
+
+	nonces, _ = walkItems(t, index, "b")
+	assert.Empty(t, nonces)
+}
+
+func TestBlockLinkedListIndex_WalkShouldStopWhenHandlerReturnsFalse(t *testing.T) {
+	t.Parallel()
+
+	index := createBlockLinkedListIndex()
+	recordItems(t, index, 1, "a", "tx1")
+	recordItems(t, index, 2, "a", "tx2")
+	recordItems(t, index, 3, "a", "tx3")
+
+	hashes := make([]string, 0)
+	err := index.walk([]byte("a"), func(nonce uint64, entry *BlockLinkedListEntry) (bool, error) {
+		txs := &AddressTransactions{}
+		errUnmarshal := index.unmarshalItems(entry, txs)
+		require.Nil(t, errUnmarshal)
+
+		hashes = append(hashes, string(txs.Transactions[0].Hash))
+		return nonce > 2, nil
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"tx3", "tx2"}, hashes)
+}
+
+func TestBlockLinkedListIndex_RevertBlockShouldRestorePreviousHead(t *testing.T) {
+	t.Parallel()
+
+	index := createBlockLinkedListIndex()
+	recordItems(t, index, 5, "a", "tx1")
+	recordItems(t, index, 6, "a", "tx2")
+
+	err := index.revertBlock(0, 6)
+	require.Nil(t, err)
+
+	nonces, numItems := walkItems(t, index, "a")
+	assert.Equal(t, []uint64{5}, nonces)
+	assert.Equal(t, []uint64{1}, numItems)
+
+	err = index.revertBlock(0, 5)
+	require.Nil(t, err)
+
+	nonces, _ = walkItems(t, index, "a")
+	assert.Empty(t, nonces)
+}
+
+func TestBlockLinkedListIndex_RecordBlockShouldReplaceSameNonceAndDropGreaterNonces(t *testing.T) {
+	t.Parallel()
+
+	index := createBlockLinkedListIndex()
+	recordItems(t, index, 5, "a", "tx1")
+	recordItems(t, index, 6, "a", "tx2")
+	recordItems(t, index, 7, "a", "tx3")
+
+	recordItems(t, index, 6, "a", "tx4", "tx5")
+
+	nonces, numItems := walkItems(t, index, "a")
+	assert.Equal(t, []uint64{6, 5}, nonces)
+	assert.Equal(t, []uint64{3, 1}, numItems)
+}
@@ -19,7 +19,7 @@ func NewNilHistoryRepository() (*nilHistoryRepository, error) {
 }
 
 // RecordBlock returns a not implemented error
-func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _ map[string]data.TransactionHandler, _ []*data.LogData) error {
+func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _, _ map[string]data.TransactionHandler, _ []*data.LogData) error {
 	return nil
 }
 
@@ -52,6 +52,16 @@ func (nhr *nilHistoryRepository) GetESDTSupply(_ string) (*esdtSupply.SupplyESDT
 	return nil, errorDisabledHistoryRepository
 }
 
+// GetTransactionsByAddress -
+func (nhr *nilHistoryRepository) GetTransactionsByAddress(_ []byte, _ uint64, _ uint64) ([]*dblookupext.AddressTransaction, uint64, error) {
+	return nil, 0, errorDisabledHistoryRepository
+}
+
+// GetLogEvents -
+func (nhr *nilHistoryRepository) GetLogEvents(_ dblookupext.LogEventsFilter) ([]*dblookupext.LogEvent, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
 // GetResultsHashesByTxHash -
 func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*dblookupext.ResultsHashesByTxHash, error) {
 	return nil, nil
@@ -10,6 +10,12 @@ var errCannotCastToBlockBody = errors.New("cannot cast to block body")
 
 var errNilESDTSuppliesHandler = errors.New("nil esdt supplies handler")
 
+// ErrAddressHistoryDisabled signals that the transactions history by address is not enabled
+var ErrAddressHistoryDisabled = errors.New("transactions history by address is disabled")
+
+// ErrLogEventsIndexDisabled signals that the log events index is not enabled
+var ErrLogEventsIndexDisabled = errors.New("log events index is disabled")
+
 func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save epoch num for [%s] hash [%s]: %w", what, hex.EncodeToString(hash), originalErr)
 }
@@ -85,6 +85,10 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
 		ESDTSuppliesHandler:         esdtSuppliesHandler,
+		AddressHistoryEnabled:       hpf.dbLookupExtensionsConfig.AddressHistoryEnabled,
+		AddressHistoryStorer:        hpf.store.GetStorer(dataRetriever.AddressHistoryUnit),
+		LogEventsIndexEnabled:       hpf.dbLookupExtensionsConfig.LogEventsIndexEnabled,
+		LogEventsStorer:             hpf.store.GetStorer(dataRetriever.LogEventsUnit),
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
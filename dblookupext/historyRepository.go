@@ -34,6 +34,10 @@ type HistoryRepositoryArguments struct {
 	Uint64ByteSliceConverter    typeConverters.Uint64ByteSliceConverter
 	EpochByHashStorer           storage.Storer
 	EventsHashesByTxHashStorer  storage.Storer
+	AddressHistoryStorer        storage.Storer
+	AddressHistoryEnabled       bool
+	LogEventsStorer             storage.Storer
+	LogEventsIndexEnabled       bool
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	ESDTSuppliesHandler         SuppliesHandler
@@ -47,6 +51,8 @@ type historyRepository struct {
 	uint64ByteSliceConverter   typeConverters.Uint64ByteSliceConverter
 	epochByHashIndex           *epochByHashIndex
 	eventsHashesByTxHashIndex  *eventsHashesByTxHash
+	addressHistoryIndex        *addressHistoryIndex
+	logEventsIndex             *logEventsIndex
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	esdtSuppliesHandler        SuppliesHandler
@@ -96,12 +102,28 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
+	if arguments.AddressHistoryEnabled && check.IfNil(arguments.AddressHistoryStorer) {
+		return nil, core.ErrNilStore
+	}
+	if arguments.LogEventsIndexEnabled && check.IfNil(arguments.LogEventsStorer) {
+		return nil, core.ErrNilStore
+	}
 
 	hashToEpochIndex := newHashToEpochIndex(arguments.EpochByHashStorer, arguments.Marshalizer)
 	deduplicationCacheForInsertMiniblockMetadata, _ := lrucache.NewCache(sizeOfDeduplicationCache)
 
 	eventsHashesToTxHashIndex := newEventsHashesByTxHash(arguments.EventsHashesByTxHashStorer, arguments.Marshalizer)
 
+	var addressHistory *addressHistoryIndex
+	if arguments.AddressHistoryEnabled {
+		addressHistory = newAddressHistoryIndex(arguments.SelfShardID, arguments.AddressHistoryStorer, arguments.Marshalizer)
+	}
+
+	var logEvents *logEventsIndex
+	if arguments.LogEventsIndexEnabled {
+		logEvents = newLogEventsIndex(arguments.LogEventsStorer, arguments.Marshalizer)
+	}
+
 	return &historyRepository{
 		selfShardID:                           arguments.SelfShardID,
 		miniblocksMetadataStorer:              arguments.MiniblocksMetadataStorer,
@@ -117,6 +139,8 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
 		esdtSuppliesHandler:                          arguments.ESDTSuppliesHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
+		addressHistoryIndex:                          addressHistory,
+		logEventsIndex:                               logEvents,
 	}, nil
 }
 
@@ -125,6 +149,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsFromPool map[string]data.TransactionHandler,
 	scrResultsFromPool map[string]data.TransactionHandler,
 	receiptsFromPool map[string]data.TransactionHandler,
 	logs []*data.LogData) error {
@@ -171,6 +196,20 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	if hr.addressHistoryIndex != nil {
+		err = hr.addressHistoryIndex.recordBlock(blockHeaderHash, blockHeader, body, txsFromPool, scrResultsFromPool)
+		if err != nil {
+			return err
+		}
+	}
+
+	if hr.logEventsIndex != nil {
+		err = hr.logEventsIndex.recordBlock(blockHeaderHash, blockHeader, logs)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -466,7 +505,42 @@ func (hr *historyRepository) IsEnabled() bool {
 
 // RevertBlock will return the modification for the current block header
 func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
-	return hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	err := hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	if err != nil {
+		return err
+	}
+
+	if hr.addressHistoryIndex != nil {
+		err = hr.addressHistoryIndex.revertBlock(blockHeader)
+		if err != nil {
+			return err
+		}
+	}
+
+	if hr.logEventsIndex != nil {
+		return hr.logEventsIndex.revertBlock(blockHeader)
+	}
+
+	return nil
+}
+
+// GetTransactionsByAddress will return a page of the transactions which touched the given address, the most recent first,
+// together with the total number of transactions indexed for that address
+func (hr *historyRepository) GetTransactionsByAddress(address []byte, from uint64, size uint64) ([]*AddressTransaction, uint64, error) {
+	if hr.addressHistoryIndex == nil {
+		return nil, 0, ErrAddressHistoryDisabled
+	}
+
+	return hr.addressHistoryIndex.getTransactions(address, from, size)
+}
+
+// GetLogEvents will return the events matching the provided filter, the most recent first
+func (hr *historyRepository) GetLogEvents(filter LogEventsFilter) ([]*LogEvent, error) {
+	if hr.logEventsIndex == nil {
+		return nil, ErrLogEventsIndexDisabled
+	}
+
+	return hr.logEventsIndex.getEvents(filter)
 }
 
 // GetESDTSupply will return the supply from the storage for the given token
@@ -8,11 +8,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common/mock"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	epochStartMocks "github.com/ElrondNetwork/elrond-go/epochStart/mock"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
@@ -88,6 +90,18 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, process.ErrNilUint64Converter, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.AddressHistoryEnabled = true
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.LogEventsIndexEnabled = true
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
 	args = createMockHistoryRepoArgs(0)
 	repo, err = NewHistoryRepository(args)
 	require.Nil(t, err)
@@ -108,7 +122,7 @@ func TestHistoryRepository_RecordBlockInvalidBlockRoundByHashStorerExpectError(t
 	repo, err := NewHistoryRepository(args)
 	require.Nil(t, err)
 
-	err = repo.RecordBlock([]byte("headerHash"), &block.Header{}, &block.Body{}, nil, nil, nil)
+	err = repo.RecordBlock([]byte("headerHash"), &block.Header{}, &block.Body{}, nil, nil, nil, nil)
 	require.Equal(t, err, errPut)
 }
 
@@ -140,7 +154,7 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 		},
 	}
 
-	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil)
+	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil, nil)
 	require.Nil(t, err)
 	// Two miniblocks
 	require.Equal(t, 2, repo.miniblocksMetadataStorer.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
@@ -176,7 +190,7 @@ func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 				miniblockB,
 			},
 		},
-		nil, nil, nil,
+		nil, nil, nil, nil,
 	)
 
 	metadata, err := repo.GetMiniblockMetadataByTxHash([]byte("txA"))
@@ -214,7 +228,7 @@ func TestHistoryRepository_GetEpochForHash(t *testing.T) {
 			miniblockA,
 			miniblockB,
 		},
-	}, nil, nil, nil)
+	}, nil, nil, nil, nil)
 
 	// Get epoch by block hash
 	epoch, err := repo.GetEpochByHash([]byte("fooblock"))
@@ -272,7 +286,7 @@ func TestHistoryRepository_OnNotarizedBlocks(t *testing.T) {
 				miniblockB,
 				miniblockC,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil,
 	)
 
 	// Check "notarization coordinates"
@@ -472,7 +486,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil,
 	)
 	_ = repo.RecordBlock([]byte("barBlock"),
 		&block.Header{Epoch: 42, Round: 4322},
@@ -480,7 +494,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockB,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil,
 	)
 
 	// Notifications have not been cleared after record block
@@ -527,7 +541,7 @@ func TestHistoryRepository_OnNotarizedBlocksCrossEpoch(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification, in the next epoch
@@ -584,7 +598,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil,
 	)
 
 	// Let's go to next epoch
@@ -597,7 +611,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification
@@ -711,7 +725,7 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 					MiniBlocks: []*block.MiniBlock{
 						miniblock,
 					},
-				}, nil, nil, nil,
+				}, nil, nil, nil, nil,
 			)
 		}
 
@@ -741,3 +755,102 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 	require.Equal(t, 4001, int(metadata.NotarizedAtDestinationInMetaNonce))
 	require.Equal(t, []byte("metablockFoo"), metadata.NotarizedAtDestinationInMetaHash)
 }
+
+func TestHistoryRepository_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled address history should error", func(t *testing.T) {
+		t.Parallel()
+
+		repo, _ := NewHistoryRepository(createMockHistoryRepoArgs(0))
+		txs, total, err := repo.GetTransactionsByAddress([]byte("alice"), 0, 10)
+		assert.Nil(t, txs)
+		assert.Equal(t, uint64(0), total)
+		assert.Equal(t, ErrAddressHistoryDisabled, err)
+	})
+	t.Run("should record and revert blocks", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHistoryRepoArgs(0)
+		args.AddressHistoryEnabled = true
+		args.AddressHistoryStorer = testscommon.CreateMemUnit()
+		repo, _ := NewHistoryRepository(args)
+
+		header := &block.Header{Nonce: 1}
+		body := &block.Body{
+			MiniBlocks: []*block.MiniBlock{
+				{TxHashes: [][]byte{[]byte("tx")}, Type: block.TxBlock},
+			},
+		}
+		txs := map[string]data.TransactionHandler{
+			"tx": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+		}
+		err := repo.RecordBlock([]byte("block"), header, body, txs, nil, nil, nil)
+		require.Nil(t, err)
+
+		addressTxs, total, err := repo.GetTransactionsByAddress([]byte("bob"), 0, 10)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1), total)
+		assert.Equal(t, []byte("tx"), addressTxs[0].Hash)
+
+		err = repo.RevertBlock(header, &block.Body{})
+		require.Nil(t, err)
+
+		addressTxs, total, err = repo.GetTransactionsByAddress([]byte("bob"), 0, 10)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(0), total)
+		assert.Equal(t, 0, len(addressTxs))
+	})
+}
+
+func TestHistoryRepository_GetLogEvents(t *testing.T) {
+	t.Parallel()
+
+	filter := LogEventsFilter{
+		Address:    []byte("contract"),
+		Identifier: []byte("myEvent"),
+		Size:       10,
+	}
+
+	t.Run("disabled log events index should error", func(t *testing.T) {
+		t.Parallel()
+
+		repo, _ := NewHistoryRepository(createMockHistoryRepoArgs(0))
+		events, err := repo.GetLogEvents(filter)
+		assert.Nil(t, events)
+		assert.Equal(t, ErrLogEventsIndexDisabled, err)
+	})
+	t.Run("should record and revert blocks", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHistoryRepoArgs(0)
+		args.LogEventsIndexEnabled = true
+		args.LogEventsStorer = testscommon.CreateMemUnit()
+		repo, _ := NewHistoryRepository(args)
+
+		header := &block.Header{Nonce: 1}
+		logs := []*data.LogData{
+			{
+				TxHash: "tx",
+				LogHandler: &transaction.Log{
+					Address: []byte("contract"),
+					Events:  []*transaction.Event{{Address: []byte("contract"), Identifier: []byte("myEvent")}},
+				},
+			},
+		}
+		err := repo.RecordBlock([]byte("block"), header, &block.Body{}, nil, nil, nil, logs)
+		require.Nil(t, err)
+
+		events, err := repo.GetLogEvents(filter)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(events))
+		assert.Equal(t, []byte("tx"), events[0].TxHash)
+
+		err = repo.RevertBlock(header, &block.Body{})
+		require.Nil(t, err)
+
+		events, err = repo.GetLogEvents(filter)
+		require.Nil(t, err)
+		assert.Equal(t, 0, len(events))
+	})
+}
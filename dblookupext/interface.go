@@ -16,6 +16,7 @@ type HistoryRepository interface {
 	RecordBlock(blockHeaderHash []byte,
 		blockHeader data.HeaderHandler,
 		blockBody data.BodyHandler,
+		txsFromPool map[string]data.TransactionHandler,
 		scrResultsFromPool map[string]data.TransactionHandler,
 		receiptsFromPool map[string]data.TransactionHandler,
 		logs []*data.LogData) error
@@ -25,6 +26,8 @@ type HistoryRepository interface {
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
+	GetTransactionsByAddress(address []byte, from uint64, size uint64) ([]*AddressTransaction, uint64, error)
+	GetLogEvents(filter LogEventsFilter) ([]*LogEvent, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
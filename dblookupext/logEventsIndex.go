@@ -0,0 +1,165 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. logEvents.proto
+
+package dblookupext
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// LogEventsFilter holds the criteria used when querying the log events index
+type LogEventsFilter struct {
+	Address    []byte
+	Identifier []byte
+	Topic      []byte
+	FromNonce  uint64
+	ToNonce    common.OptionalUint64
+	From       uint64
+	Size       uint64
+}
+
+// logEventsIndex holds, for each emitting address and event identifier pair, the events of each block. The pair is the
+// length of the address on 2 bytes, followed by the address and by the identifier
+type logEventsIndex struct {
+	list *blockLinkedListIndex
+}
+
+type eventsByKey struct {
+	keys   []string
+	events map[string][]*LogEvent
+}
+
+func newLogEventsIndex(storer storage.Storer, marshalizer marshal.Marshalizer) *logEventsIndex {
+	return &logEventsIndex{
+		list: newBlockLinkedListIndex("logEventsIndex", storer, marshalizer),
+	}
+}
+
+func (lei *logEventsIndex) recordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, logs []*data.LogData) error {
+	grouped := groupEventsByKey(blockHeaderHash, blockHeader, logs)
+
+	itemsByPair := make([]*blockItems, 0, len(grouped.keys))
+	for _, key := range grouped.keys {
+		events := grouped.events[key]
+		itemsByPair = append(itemsByPair, &blockItems{
+			key:      []byte(key),
+			items:    &LogEvents{Events: events},
+			numItems: uint64(len(events)),
+		})
+	}
+
+	return lei.list.recordBlock(blockHeader.GetEpoch(), blockHeader.GetNonce(), itemsByPair)
+}
+
+func groupEventsByKey(blockHeaderHash []byte, blockHeader data.HeaderHandler, logs []*data.LogData) *eventsByKey {
+	grouped := &eventsByKey{
+		keys:   make([]string, 0),
+		events: make(map[string][]*LogEvent),
+	}
+
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		for _, event := range logData.GetLogEvents() {
+			if check.IfNil(event) || len(event.GetIdentifier()) == 0 {
+				continue
+			}
+
+			key := string(logEventsPair(event.GetAddress(), event.GetIdentifier()))
+			_, found := grouped.events[key]
+			if !found {
+				grouped.keys = append(grouped.keys, key)
+			}
+
+			grouped.events[key] = append(grouped.events[key], &LogEvent{
+				Address:    event.GetAddress(),
+				Identifier: event.GetIdentifier(),
+				Topics:     event.GetTopics(),
+				Data:       event.GetData(),
+				TxHash:     []byte(logData.TxHash),
+				Epoch:      blockHeader.GetEpoch(),
+				BlockNonce: blockHeader.GetNonce(),
+				BlockHash:  blockHeaderHash,
+				Round:      blockHeader.GetRound(),
+			})
+		}
+	}
+
+	return grouped
+}
+
+func (lei *logEventsIndex) revertBlock(blockHeader data.HeaderHandler) error {
+	return lei.list.revertBlock(blockHeader.GetEpoch(), blockHeader.GetNonce())
+}
+
+// getEvents returns the events matching the provided filter, the most recent first, skipping the first filter.From ones
+func (lei *logEventsIndex) getEvents(filter LogEventsFilter) ([]*LogEvent, error) {
+	events := make([]*LogEvent, 0)
+	numSkipped := uint64(0)
+	pair := logEventsPair(filter.Address, filter.Identifier)
+	err := lei.list.walk(pair, func(nonce uint64, entry *BlockLinkedListEntry) (bool, error) {
+		if nonce < filter.FromNonce {
+			return false, nil
+		}
+
+		isInRange := !filter.ToNonce.HasValue || nonce <= filter.ToNonce.Value
+		if !isInRange {
+			return true, nil
+		}
+
+		entryEvents := &LogEvents{}
+		errUnmarshal := lei.list.unmarshalItems(entry, entryEvents)
+		if errUnmarshal != nil {
+			return false, errUnmarshal
+		}
+
+		for i := len(entryEvents.Events) - 1; i >= 0 && uint64(len(events)) < filter.Size; i-- {
+			if !hasTopic(entryEvents.Events[i], filter.Topic) {
+				continue
+			}
+			if numSkipped < filter.From {
+				numSkipped++
+				continue
+			}
+
+			events = append(events, entryEvents.Events[i])
+		}
+
+		return uint64(len(events)) < filter.Size, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func hasTopic(event *LogEvent, topic []byte) bool {
+	if len(topic) == 0 {
+		return true
+	}
+
+	for _, eventTopic := range event.Topics {
+		if bytes.Equal(eventTopic, topic) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func logEventsPair(address []byte, identifier []byte) []byte {
+	pair := make([]byte, 2, 2+len(address)+len(identifier))
+	binary.BigEndian.PutUint16(pair, uint16(len(address)))
+	pair = append(pair, address...)
+
+	return append(pair, identifier...)
+}
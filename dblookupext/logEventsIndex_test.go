@@ -0,0 +1,164 @@
+package dblookupext
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	contract     = []byte("contract")
+	transferID   = []byte("ESDTTransfer")
+	firstToken   = []byte("TKN-aaaaaa")
+	secondToken  = []byte("TKN-bbbbbb")
+	otherEventID = []byte("other")
+)
+
+func createLogEventsIndex() *logEventsIndex {
+	return newLogEventsIndex(testscommon.CreateMemUnit(), &marshal.GogoProtoMarshalizer{})
+}
+
+func createLogData(txHash string, events ...*transaction.Event) *data.LogData {
+	return &data.LogData{
+		TxHash: txHash,
+		LogHandler: &transaction.Log{
+			Address: contract,
+			Events:  events,
+		},
+	}
+}
+
+func recordEvents(t *testing.T, index *logEventsIndex, nonce uint64, logs ...*data.LogData) {
+	header := &block.Header{Nonce: nonce, Round: nonce + 1, Epoch: uint32(nonce / 10)}
+
+	err := index.recordBlock([]byte("block"), header, logs)
+	require.Nil(t, err)
+}
+
+func getEventsTxHashes(t *testing.T, index *logEventsIndex, filter LogEventsFilter) []string {
+	events, err := index.getEvents(filter)
+	require.Nil(t, err)
+
+	hashes := make([]string, 0, len(events))
+	for _, event := range events {
+		hashes = append(hashes, string(event.TxHash))
+	}
+
+	return hashes
+}
+
+func TestLogEventsIndex_RecordBlockShouldGroupByAddressAndIdentifier(t *testing.T) {
+	t.Parallel()
+
+	index := createLogEventsIndex()
+	recordEvents(t, index, 7,
+		createLogData("tx1",
+			&transaction.Event{Address: contract, Identifier: transferID, Topics: [][]byte{firstToken}, Data: []byte("data")},
+			&transaction.Event{Address: contract, Identifier: otherEventID},
+			&transaction.Event{Address: alice, Identifier: transferID},
+			&transaction.Event{Address: contract},
+		),
+		nil,
+		createLogData("tx2", &transaction.Event{Address: contract, Identifier: transferID, Topics: [][]byte{secondToken}}),
+	)
+
+	filter := LogEventsFilter{Address: contract, Identifier: transferID, Size: 10}
+	assert.Equal(t, []string{"tx2", "tx1"}, getEventsTxHashes(t, index, filter))
+
+	filter.Identifier = otherEventID
+	assert.Equal(t, []string{"tx1"}, getEventsTxHashes(t, index, filter))
+
+	filter.Address = alice
+	filter.Identifier = transferID
+	assert.Equal(t, []string{"tx1"}, getEventsTxHashes(t, index, filter))
+
+	events, _ := index.getEvents(LogEventsFilter{Address: contract, Identifier: transferID, From: 1, Size: 1})
+	assert.Equal(t, []*LogEvent{
+		{
+			Address:    contract,
+			Identifier: transferID,
+			Topics:     [][]byte{firstToken},
+			Data:       []byte("data"),
+			TxHash:     []byte("tx1"),
+			BlockNonce: 7,
+			BlockHash:  []byte("block"),
+			Round:      8,
+		},
+	}, events)
+}
+
+func TestLogEventsIndex_GetEventsShouldApplyTheFilter(t *testing.T) {
+	t.Parallel()
+
+	index := createLogEventsIndex()
+	recordEvents(t, index, 1, createLogData("tx1", &transaction.Event{Address: contract, Identifier: transferID, Topics: [][]byte{firstToken}}))
+	recordEvents(t, index, 2, createLogData("tx2", &transaction.Event{Address: contract, Identifier: transferID, Topics: [][]byte{secondToken}}))
+	recordEvents(t, index, 13, createLogData("tx3", &transaction.Event{Address: contract, Identifier: transferID, Topics: [][]byte{firstToken}}))
+	recordEvents(t, index, 24, createLogData("tx4", &transaction.Event{Address: contract, Identifier: transferID, Topics: [][]byte{firstToken}}))
+
+	t.Run("block nonces range", func(t *testing.T) {
+		t.Parallel()
+
+		filter := LogEventsFilter{Address: contract, Identifier: transferID, FromNonce: 2, Size: 10}
+		assert.Equal(t, []string{"tx4", "tx3", "tx2"}, getEventsTxHashes(t, index, filter))
+
+		filter.ToNonce = common.OptionalUint64{Value: 13, HasValue: true}
+		assert.Equal(t, []string{"tx3", "tx2"}, getEventsTxHashes(t, index, filter))
+
+		filter.FromNonce = 25
+		filter.ToNonce = common.OptionalUint64{}
+		assert.Equal(t, 0, len(getEventsTxHashes(t, index, filter)))
+	})
+	t.Run("topic", func(t *testing.T) {
+		t.Parallel()
+
+		filter := LogEventsFilter{Address: contract, Identifier: transferID, Topic: firstToken, Size: 10}
+		assert.Equal(t, []string{"tx4", "tx3", "tx1"}, getEventsTxHashes(t, index, filter))
+	})
+	t.Run("pagination", func(t *testing.T) {
+		t.Parallel()
+
+		filter := LogEventsFilter{Address: contract, Identifier: transferID, Topic: firstToken, From: 1, Size: 1}
+		assert.Equal(t, []string{"tx3"}, getEventsTxHashes(t, index, filter))
+
+		filter.From = 3
+		assert.Equal(t, 0, len(getEventsTxHashes(t, index, filter)))
+	})
+	t.Run("unknown pair", func(t *testing.T) {
+		t.Parallel()
+
+		filter := LogEventsFilter{Address: contract, Identifier: otherEventID, Size: 10}
+		assert.Equal(t, 0, len(getEventsTxHashes(t, index, filter)))
+	})
+}
+
+func TestLogEventsIndex_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	index := createLogEventsIndex()
+	recordEvents(t, index, 1, createLogData("tx1", &transaction.Event{Address: contract, Identifier: transferID}))
+	recordEvents(t, index, 2, createLogData("tx2", &transaction.Event{Address: contract, Identifier: transferID}))
+	recordEvents(t, index, 3, createLogData("fork3", &transaction.Event{Address: contract, Identifier: otherEventID}))
+
+	err := index.revertBlock(&block.Header{Nonce: 2})
+	require.Nil(t, err)
+
+	filter := LogEventsFilter{Address: contract, Identifier: transferID, Size: 10}
+	assert.Equal(t, []string{"tx1"}, getEventsTxHashes(t, index, filter))
+
+	// reverting a block which was not indexed does nothing
+	err = index.revertBlock(&block.Header{Nonce: 5})
+	assert.Nil(t, err)
+
+	recordEvents(t, index, 2, createLogData("tx2bis", &transaction.Event{Address: contract, Identifier: otherEventID}))
+	recordEvents(t, index, 3, createLogData("tx3", &transaction.Event{Address: contract, Identifier: otherEventID}))
+	filter.Identifier = otherEventID
+	assert.Equal(t, []string{"tx3", "tx2bis"}, getEventsTxHashes(t, index, filter))
+}
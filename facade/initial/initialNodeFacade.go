@@ -1,8 +1,10 @@
 package initial
 
 import (
+	"context"
 	"errors"
 	"math/big"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
@@ -74,18 +76,18 @@ func (inf *initialNodeFacade) RestApiInterface() string {
 }
 
 // GetBalance returns nil and error
-func (inf *initialNodeFacade) GetBalance(_ string) (*big.Int, error) {
-	return nil, errNodeStarting
+func (inf *initialNodeFacade) GetBalance(_ string, _ common.BlockQueryOptions) (*big.Int, common.BlockInfo, error) {
+	return nil, common.BlockInfo{}, errNodeStarting
 }
 
 // GetUsername returns empty string and error
-func (inf *initialNodeFacade) GetUsername(_ string) (string, error) {
-	return emptyString, errNodeStarting
+func (inf *initialNodeFacade) GetUsername(_ string, _ common.BlockQueryOptions) (string, common.BlockInfo, error) {
+	return emptyString, common.BlockInfo{}, errNodeStarting
 }
 
 // GetValueForKey returns an empty string and error
-func (inf *initialNodeFacade) GetValueForKey(_ string, _ string) (string, error) {
-	return emptyString, errNodeStarting
+func (inf *initialNodeFacade) GetValueForKey(_ string, _ string, _ common.BlockQueryOptions) (string, common.BlockInfo, error) {
+	return emptyString, common.BlockInfo{}, errNodeStarting
 }
 
 // GetESDTBalance returns empty strings and error
@@ -94,8 +96,8 @@ func (inf *initialNodeFacade) GetESDTBalance(_ string, _ string) (string, string
 }
 
 // GetAllESDTTokens returns nil and error
-func (inf *initialNodeFacade) GetAllESDTTokens(_ string) (map[string]*esdt.ESDigitalToken, error) {
-	return nil, errNodeStarting
+func (inf *initialNodeFacade) GetAllESDTTokens(_ string, _ common.BlockQueryOptions) (map[string]*esdt.ESDigitalToken, common.BlockInfo, error) {
+	return nil, common.BlockInfo{}, errNodeStarting
 }
 
 // GetNFTTokenIDsRegisteredByAddress returns nil and error
@@ -126,6 +128,11 @@ func (inf *initialNodeFacade) CreateTransaction(
 	return nil, nil, errNodeStarting
 }
 
+// CreateRelayedTransaction return nil and error
+func (inf *initialNodeFacade) CreateRelayedTransaction(_ *transaction.Transaction, _ string, _ uint64, _ string) (*transaction.Transaction, []byte, error) {
+	return nil, nil, errNodeStarting
+}
+
 // ValidateTransaction returns error
 func (inf *initialNodeFacade) ValidateTransaction(_ *transaction.Transaction) error {
 	return errNodeStarting
@@ -136,6 +143,11 @@ func (inf *initialNodeFacade) ValidateTransactionForSimulation(_ *transaction.Tr
 	return errNodeStarting
 }
 
+// ValidateTransactionForBundleSimulation returns error
+func (inf *initialNodeFacade) ValidateTransactionForBundleSimulation(_ *transaction.Transaction, _ bool) error {
+	return errNodeStarting
+}
+
 // ValidatorStatisticsApi returns nil and error
 func (inf *initialNodeFacade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error) {
 	return nil, errNodeStarting
@@ -146,11 +158,26 @@ func (inf *initialNodeFacade) SendBulkTransactions(_ []*transaction.Transaction)
 	return uint64(0), errNodeStarting
 }
 
+// SendTransactionsWithReceipts returns nil and error
+func (inf *initialNodeFacade) SendTransactionsWithReceipts(_ []*transaction.Transaction) ([]*common.TransactionSendReceiptAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
 // SimulateTransactionExecution returns nil and error
-func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transaction) (*txSimData.SimulationResults, error) {
+func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
 	return nil, errNodeStarting
 }
 
+// SimulateTransactionsBundle returns nil and error
+func (inf *initialNodeFacade) SimulateTransactionsBundle(_ []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	return nil, errNodeStarting
+}
+
+// GetMaxTransactionsInBundle returns 0
+func (inf *initialNodeFacade) GetMaxTransactionsInBundle() uint32 {
+	return 0
+}
+
 // GetTransaction returns nil and error
 func (inf *initialNodeFacade) GetTransaction(_ string, _ bool) (*transaction.ApiTransactionResult, error) {
 	return nil, errNodeStarting
@@ -162,8 +189,8 @@ func (inf *initialNodeFacade) ComputeTransactionGasLimit(_ *transaction.Transact
 }
 
 // GetAccount returns nil and error
-func (inf *initialNodeFacade) GetAccount(_ string) (api.AccountResponse, error) {
-	return api.AccountResponse{}, errNodeStarting
+func (inf *initialNodeFacade) GetAccount(_ string, _ common.BlockQueryOptions) (api.AccountResponse, common.BlockInfo, error) {
+	return api.AccountResponse{}, common.BlockInfo{}, errNodeStarting
 }
 
 // GetCode returns nil and error
@@ -297,8 +324,8 @@ func (inf *initialNodeFacade) Close() error {
 }
 
 // GetKeyValuePairs nil map
-func (inf *initialNodeFacade) GetKeyValuePairs(_ string) (map[string]string, error) {
-	return nil, errNodeStarting
+func (inf *initialNodeFacade) GetKeyValuePairs(_ string, _ common.BlockQueryOptions) (map[string]string, common.BlockInfo, error) {
+	return nil, common.BlockInfo{}, errNodeStarting
 }
 
 // GetDirectStakedList returns empty slice
@@ -312,8 +339,8 @@ func (inf *initialNodeFacade) GetDelegatorsList() ([]*api.Delegator, error) {
 }
 
 // GetESDTData returns nil and error
-func (inf *initialNodeFacade) GetESDTData(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
-	return nil, errNodeStarting
+func (inf *initialNodeFacade) GetESDTData(_ string, _ string, _ uint64, _ common.BlockQueryOptions) (*esdt.ESDigitalToken, common.BlockInfo, error) {
+	return nil, common.BlockInfo{}, errNodeStarting
 }
 
 // GetESDTsRoles return nil and error
@@ -341,6 +368,56 @@ func (inf *initialNodeFacade) GetTransactionsPool() (*common.TransactionsPoolAPI
 	return nil, errNodeStarting
 }
 
+// GetTransactionsPoolForSender returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsPoolForSender(_ string) (*common.TransactionsPoolSenderAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsPoolByFilter returns a nil slice and error
+func (inf *initialNodeFacade) GetTransactionsPoolByFilter(_ common.TransactionsPoolFilter) ([]*common.TransactionsPoolEntryAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetMaxTransactionsPoolFilterResults returns 0
+func (inf *initialNodeFacade) GetMaxTransactionsPoolFilterResults() uint32 {
+	return 0
+}
+
+// GetTransactionProcessStatus returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionProcessStatus(_ string) (*common.TransactionProcessStatusAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// WaitForTransactionsInclusion returns nil and error
+func (inf *initialNodeFacade) WaitForTransactionsInclusion(_ context.Context, _ []string, _ time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetGasPriceSuggestions returns a nil structure and error
+func (inf *initialNodeFacade) GetGasPriceSuggestions() (*common.GasPriceSuggestionsAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetStateStatistics returns a nil structure and error
+func (inf *initialNodeFacade) GetStateStatistics() (*common.StateStatisticsAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// SubscribeToTransactionsPoolEvents returns a nil subscription and error
+func (inf *initialNodeFacade) SubscribeToTransactionsPoolEvents(_ common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error) {
+	return nil, errNodeStarting
+}
+
+// GetLogEvents returns a nil slice and error
+func (inf *initialNodeFacade) GetLogEvents(_ string, _ common.LogEventsQuery) ([]*common.LogEventAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsByAddress returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsByAddress(_ string, _ uint64, _ uint64) (*common.AddressTransactionsAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (inf *initialNodeFacade) IsInterfaceNil() bool {
 	return inf == nil
@@ -1,11 +1,14 @@
 package initial
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/common"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -29,19 +32,19 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	s1, s2, err := inf.GetESDTBalance("", "")
 	assert.Equal(t, emptyString, s1+s2)
 	assert.Equal(t, errNodeStarting, err)
-	v, err := inf.GetBalance("")
+	v, _, err := inf.GetBalance("", common.BlockQueryOptions{})
 	assert.Nil(t, v)
 	assert.Equal(t, errNodeStarting, err)
 
-	s1, err = inf.GetUsername("")
+	s1, _, err = inf.GetUsername("", common.BlockQueryOptions{})
 	assert.Equal(t, emptyString, s1)
 	assert.Equal(t, errNodeStarting, err)
 
-	s1, err = inf.GetValueForKey("", "")
+	s1, _, err = inf.GetValueForKey("", "", common.BlockQueryOptions{})
 	assert.Equal(t, emptyString, s1)
 	assert.Equal(t, errNodeStarting, err)
 
-	s3, err := inf.GetAllESDTTokens("")
+	s3, _, err := inf.GetAllESDTTokens("", common.BlockQueryOptions{})
 	assert.Nil(t, s3)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -57,6 +60,9 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	err = inf.ValidateTransactionForSimulation(nil, false)
 	assert.Equal(t, errNodeStarting, err)
 
+	err = inf.ValidateTransactionForBundleSimulation(nil, false)
+	assert.Equal(t, errNodeStarting, err)
+
 	v1, err := inf.ValidatorStatisticsApi()
 	assert.Nil(t, v1)
 	assert.Equal(t, errNodeStarting, err)
@@ -65,10 +71,23 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Equal(t, uint64(0), u1)
 	assert.Equal(t, errNodeStarting, err)
 
-	u2, err := inf.SimulateTransactionExecution(nil)
+	relayedTx, relayedTxHash, err := inf.CreateRelayedTransaction(nil, "", 0, "")
+	assert.Nil(t, relayedTx)
+	assert.Nil(t, relayedTxHash)
+	assert.Equal(t, errNodeStarting, err)
+
+	receipts, err := inf.SendTransactionsWithReceipts(nil)
+	assert.Nil(t, receipts)
+	assert.Equal(t, errNodeStarting, err)
+
+	u2, err := inf.SimulateTransactionExecution(nil, txSimData.SimulationOptions{})
 	assert.Nil(t, u2)
 	assert.Equal(t, errNodeStarting, err)
 
+	b1, err := inf.SimulateTransactionsBundle(nil)
+	assert.Nil(t, b1)
+	assert.Equal(t, errNodeStarting, err)
+
 	t1, err := inf.GetTransaction("", false)
 	assert.Nil(t, t1)
 	assert.Equal(t, errNodeStarting, err)
@@ -77,7 +96,7 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, resp)
 	assert.Equal(t, errNodeStarting, err)
 
-	uac, err := inf.GetAccount("")
+	uac, _, err := inf.GetAccount("", common.BlockQueryOptions{})
 	assert.Equal(t, api.AccountResponse{}, uac)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -166,7 +185,7 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, asv)
 	assert.Equal(t, errNodeStarting, err)
 
-	mss, err := inf.GetKeyValuePairs("")
+	mss, _, err := inf.GetKeyValuePairs("", common.BlockQueryOptions{})
 	assert.Nil(t, mss)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -190,5 +209,33 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, txPool)
 	assert.Equal(t, errNodeStarting, err)
 
+	txPoolForSender, err := inf.GetTransactionsPoolForSender("")
+	assert.Nil(t, txPoolForSender)
+	assert.Equal(t, errNodeStarting, err)
+
+	txPoolByFilter, err := inf.GetTransactionsPoolByFilter(common.TransactionsPoolFilter{})
+	assert.Nil(t, txPoolByFilter)
+	assert.Equal(t, errNodeStarting, err)
+
+	txPoolSubscription, err := inf.SubscribeToTransactionsPoolEvents(common.TransactionsPoolFilter{})
+	assert.Nil(t, txPoolSubscription)
+	assert.Equal(t, errNodeStarting, err)
+
+	txProcessStatus, err := inf.GetTransactionProcessStatus("")
+	assert.Nil(t, txProcessStatus)
+	assert.Equal(t, errNodeStarting, err)
+
+	inclusions, err := inf.WaitForTransactionsInclusion(context.Background(), nil, 0)
+	assert.Nil(t, inclusions)
+	assert.Equal(t, errNodeStarting, err)
+
+	gasPriceSuggestions, err := inf.GetGasPriceSuggestions()
+	assert.Nil(t, gasPriceSuggestions)
+	assert.Equal(t, errNodeStarting, err)
+
+	stateStatistics, err := inf.GetStateStatistics()
+	assert.Nil(t, stateStatistics)
+	assert.Equal(t, errNodeStarting, err)
+
 	assert.False(t, check.IfNil(inf))
 }
@@ -3,6 +3,7 @@ package facade
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
@@ -20,23 +21,23 @@ import (
 
 // NodeHandler contains all functions that a node should contain.
 type NodeHandler interface {
-	// GetBalance returns the balance for a specific address
-	GetBalance(address string) (*big.Int, error)
+	// GetBalance returns the balance for a specific address at the block described by the provided options
+	GetBalance(address string, options common.BlockQueryOptions) (*big.Int, common.BlockInfo, error)
 
-	// GetUsername returns the username for a specific address
-	GetUsername(address string) (string, error)
+	// GetUsername returns the username for a specific address at the block described by the provided options
+	GetUsername(address string, options common.BlockQueryOptions) (string, common.BlockInfo, error)
 
-	// GetValueForKey returns the value of a key from a given account
-	GetValueForKey(address string, key string) (string, error)
+	// GetValueForKey returns the value of a key from a given account at the block described by the provided options
+	GetValueForKey(address string, key string, options common.BlockQueryOptions) (string, common.BlockInfo, error)
 
-	// GetKeyValuePairs returns the key-value pairs under a given address
-	GetKeyValuePairs(address string, ctx context.Context) (map[string]string, error)
+	// GetKeyValuePairs returns the key-value pairs under a given address at the block described by the provided options
+	GetKeyValuePairs(address string, options common.BlockQueryOptions, ctx context.Context) (map[string]string, common.BlockInfo, error)
 
 	// GetAllIssuedESDTs returns all the issued esdt tokens from esdt system smart contract
 	GetAllIssuedESDTs(tokenType string, ctx context.Context) ([]string, error)
 
-	// GetESDTData returns the esdt data from a given account, given key and given nonce
-	GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error)
+	// GetESDTData returns the esdt data from a given account, given key and given nonce at the block described by the provided options
+	GetESDTData(address, tokenID string, nonce uint64, options common.BlockQueryOptions) (*esdt.ESDigitalToken, common.BlockInfo, error)
 
 	// GetESDTsRoles returns the the token identifiers and the roles for a given address
 	GetESDTsRoles(address string, ctx context.Context) (map[string][]string, error)
@@ -48,7 +49,7 @@ type NodeHandler interface {
 	GetESDTsWithRole(address string, role string, ctx context.Context) ([]string, error)
 
 	// GetAllESDTTokens returns the value of a key from a given account
-	GetAllESDTTokens(address string, ctx context.Context) (map[string]*esdt.ESDigitalToken, error)
+	GetAllESDTTokens(address string, options common.BlockQueryOptions, ctx context.Context) (map[string]*esdt.ESDigitalToken, common.BlockInfo, error)
 
 	// GetTokenSupply returns the provided token supply from current shard
 	GetTokenSupply(token string) (*api.ESDTSupply, error)
@@ -57,16 +58,23 @@ type NodeHandler interface {
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
 
+	// CreateRelayedTransaction will wrap the user-signed inner transaction into a relayed transaction paid by the relayer
+	CreateRelayedTransaction(innerTx *transaction.Transaction, relayer string, relayerNonce uint64, relayerSignatureHex string) (*transaction.Transaction, []byte, error)
+
 	// ValidateTransaction will validate a transaction
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
+	ValidateTransactionForBundleSimulation(tx *transaction.Transaction, checkSignature bool) error
 
 	// SendBulkTransactions will send a bulk of transactions on the 'send transactions pipe' channel
 	SendBulkTransactions(txs []*transaction.Transaction) (uint64, error)
 
+	// SendTransactionsWithReceipts will validate the transactions, send the valid ones and return a receipt for each one
+	SendTransactionsWithReceipts(txs []*transaction.Transaction) ([]*common.TransactionSendReceiptAPIResponse, error)
+
 	// GetAccount returns an accountResponse containing information
-	//  about the account correlated with provided address
-	GetAccount(address string) (api.AccountResponse, error)
+	//  about the account correlated with provided address at the block described by the provided options
+	GetAccount(address string, options common.BlockQueryOptions) (api.AccountResponse, common.BlockInfo, error)
 
 	// GetCode returns the code for the given code hash
 	GetCode(codeHash []byte) []byte
@@ -96,6 +104,8 @@ type NodeHandler interface {
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
 	ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxWithOptions(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ProcessBundle(ctx context.Context, txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
 	IsInterfaceNil() bool
 }
 
@@ -108,7 +118,16 @@ type ApiResolver interface {
 	GetDirectStakedList(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsList(ctx context.Context) ([]*api.Delegator, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionProcessStatus(txHash string) (*common.TransactionProcessStatusAPIResponse, error)
+	WaitForTransactionsInclusion(ctx context.Context, txsHashes []string, timeout time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error)
 	GetTransactionsPool() (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPoolForSender(sender string) (*common.TransactionsPoolSenderAPIResponse, error)
+	GetTransactionsPoolByFilter(filter common.TransactionsPoolFilter) ([]*common.TransactionsPoolEntryAPIResponse, error)
+	SubscribeToTransactionsPoolEvents(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error)
+	GetGasPriceSuggestions() (*common.GasPriceSuggestionsAPIResponse, error)
+	GetStateStatistics() (*common.StateStatisticsAPIResponse, error)
+	GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error)
+	GetLogEvents(address string, query common.LogEventsQuery) ([]*common.LogEventAPIResponse, error)
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByRound(round uint64, withTxs bool) (*api.Block, error)
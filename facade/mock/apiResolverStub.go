@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
@@ -13,26 +14,35 @@ import (
 
 // ApiResolverStub -
 type ApiResolverStub struct {
-	ExecuteSCQueryHandler                  func(query *process.SCQuery) (*vmcommon.VMOutput, error)
-	StatusMetricsHandler                   func() external.StatusMetricsHandler
-	ComputeTransactionGasLimitHandler      func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	GetTotalStakedValueHandler             func(ctx context.Context) (*api.StakeValues, error)
-	GetDirectStakedListHandler             func(ctx context.Context) ([]*api.DirectStakedValue, error)
-	GetDelegatorsListHandler               func(ctx context.Context) ([]*api.Delegator, error)
-	GetBlockByHashCalled                   func(hash string, withTxs bool) (*api.Block, error)
-	GetBlockByNonceCalled                  func(nonce uint64, withTxs bool) (*api.Block, error)
-	GetBlockByRoundCalled                  func(round uint64, withTxs bool) (*api.Block, error)
-	GetTransactionHandler                  func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
-	GetInternalShardBlockByNonceCalled     func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
-	GetInternalShardBlockByHashCalled      func(format common.ApiOutputFormat, hash string) (interface{}, error)
-	GetInternalShardBlockByRoundCalled     func(format common.ApiOutputFormat, round uint64) (interface{}, error)
-	GetInternalMetaBlockByNonceCalled      func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
-	GetInternalMetaBlockByHashCalled       func(format common.ApiOutputFormat, hash string) (interface{}, error)
-	GetInternalMetaBlockByRoundCalled      func(format common.ApiOutputFormat, round uint64) (interface{}, error)
-	GetInternalMiniBlockCalled             func(format common.ApiOutputFormat, hash string, epoch uint32) (interface{}, error)
-	GetInternalStartOfEpochMetaBlockCalled func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
-	GetGenesisNodesPubKeysCalled           func() (map[uint32][]string, map[uint32][]string)
-	GetTransactionsPoolCalled              func() (*common.TransactionsPoolAPIResponse, error)
+	ExecuteSCQueryHandler                   func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	StatusMetricsHandler                    func() external.StatusMetricsHandler
+	ComputeTransactionGasLimitHandler       func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	GetTotalStakedValueHandler              func(ctx context.Context) (*api.StakeValues, error)
+	GetDirectStakedListHandler              func(ctx context.Context) ([]*api.DirectStakedValue, error)
+	GetDelegatorsListHandler                func(ctx context.Context) ([]*api.Delegator, error)
+	GetBlockByHashCalled                    func(hash string, withTxs bool) (*api.Block, error)
+	GetBlockByNonceCalled                   func(nonce uint64, withTxs bool) (*api.Block, error)
+	GetBlockByRoundCalled                   func(round uint64, withTxs bool) (*api.Block, error)
+	GetTransactionHandler                   func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
+	GetInternalShardBlockByNonceCalled      func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
+	GetInternalShardBlockByHashCalled       func(format common.ApiOutputFormat, hash string) (interface{}, error)
+	GetInternalShardBlockByRoundCalled      func(format common.ApiOutputFormat, round uint64) (interface{}, error)
+	GetInternalMetaBlockByNonceCalled       func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
+	GetInternalMetaBlockByHashCalled        func(format common.ApiOutputFormat, hash string) (interface{}, error)
+	GetInternalMetaBlockByRoundCalled       func(format common.ApiOutputFormat, round uint64) (interface{}, error)
+	GetInternalMiniBlockCalled              func(format common.ApiOutputFormat, hash string, epoch uint32) (interface{}, error)
+	GetInternalStartOfEpochMetaBlockCalled  func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
+	GetGenesisNodesPubKeysCalled            func() (map[uint32][]string, map[uint32][]string)
+	GetTransactionsPoolCalled               func() (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionProcessStatusCalled       func(txHash string) (*common.TransactionProcessStatusAPIResponse, error)
+	WaitForTransactionsInclusionCalled      func(ctx context.Context, txsHashes []string, timeout time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error)
+	GetTransactionsPoolForSenderCalled      func(sender string) (*common.TransactionsPoolSenderAPIResponse, error)
+	GetTransactionsPoolByFilterCalled       func(filter common.TransactionsPoolFilter) ([]*common.TransactionsPoolEntryAPIResponse, error)
+	SubscribeToTransactionsPoolEventsCalled func(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error)
+	GetGasPriceSuggestionsCalled            func() (*common.GasPriceSuggestionsAPIResponse, error)
+	GetStateStatisticsCalled                func() (*common.StateStatisticsAPIResponse, error)
+	GetTransactionsByAddressCalled          func(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error)
+	GetLogEventsCalled                      func(address string, query common.LogEventsQuery) ([]*common.LogEventAPIResponse, error)
 }
 
 // GetTransaction -
@@ -166,6 +176,87 @@ func (ars *ApiResolverStub) GetTransactionsPool() (*common.TransactionsPoolAPIRe
 	return nil, nil
 }
 
+// GetTransactionProcessStatus -
+func (ars *ApiResolverStub) GetTransactionProcessStatus(txHash string) (*common.TransactionProcessStatusAPIResponse, error) {
+	if ars.GetTransactionProcessStatusCalled != nil {
+		return ars.GetTransactionProcessStatusCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// WaitForTransactionsInclusion -
+func (ars *ApiResolverStub) WaitForTransactionsInclusion(ctx context.Context, txsHashes []string, timeout time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error) {
+	if ars.WaitForTransactionsInclusionCalled != nil {
+		return ars.WaitForTransactionsInclusionCalled(ctx, txsHashes, timeout)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolForSender -
+func (ars *ApiResolverStub) GetTransactionsPoolForSender(sender string) (*common.TransactionsPoolSenderAPIResponse, error) {
+	if ars.GetTransactionsPoolForSenderCalled != nil {
+		return ars.GetTransactionsPoolForSenderCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolByFilter -
+func (ars *ApiResolverStub) GetTransactionsPoolByFilter(filter common.TransactionsPoolFilter) ([]*common.TransactionsPoolEntryAPIResponse, error) {
+	if ars.GetTransactionsPoolByFilterCalled != nil {
+		return ars.GetTransactionsPoolByFilterCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// SubscribeToTransactionsPoolEvents -
+func (ars *ApiResolverStub) SubscribeToTransactionsPoolEvents(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error) {
+	if ars.SubscribeToTransactionsPoolEventsCalled != nil {
+		return ars.SubscribeToTransactionsPoolEventsCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// GetGasPriceSuggestions -
+func (ars *ApiResolverStub) GetGasPriceSuggestions() (*common.GasPriceSuggestionsAPIResponse, error) {
+	if ars.GetGasPriceSuggestionsCalled != nil {
+		return ars.GetGasPriceSuggestionsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetStateStatistics -
+func (ars *ApiResolverStub) GetStateStatistics() (*common.StateStatisticsAPIResponse, error) {
+	if ars.GetStateStatisticsCalled != nil {
+		return ars.GetStateStatisticsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetLogEvents -
+func (ars *ApiResolverStub) GetLogEvents(address string, query common.LogEventsQuery) ([]*common.LogEventAPIResponse, error) {
+	if ars.GetLogEventsCalled != nil {
+		return ars.GetLogEventsCalled(address, query)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsByAddress -
+func (ars *ApiResolverStub) GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+	if ars.GetTransactionsByAddressCalled != nil {
+		return ars.GetTransactionsByAddressCalled(address, from, size)
+	}
+
+	return nil, nil
+}
+
 // GetInternalMetaBlockByHash -
 func (ars *ApiResolverStub) GetInternalMetaBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error) {
 	if ars.GetInternalMetaBlockByHashCalled != nil {
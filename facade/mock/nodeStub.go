@@ -19,14 +19,17 @@ import (
 type NodeStub struct {
 	AddressHandler             func() (string, error)
 	ConnectToAddressesHandler  func([]string) error
-	GetBalanceHandler          func(address string) (*big.Int, error)
+	GetBalanceHandler          func(address string, options common.BlockQueryOptions) (*big.Int, common.BlockInfo, error)
 	GenerateTransactionHandler func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version, options uint32) (*transaction.Transaction, []byte, error)
+	CreateRelayedTransactionCalled                 func(innerTx *transaction.Transaction, relayer string, relayerNonce uint64, relayerSignatureHex string) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler                     func(tx *transaction.Transaction) error
 	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction, bypassSignature bool) error
+	ValidateTransactionForBundleSimulationCalled   func(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
-	GetAccountHandler                              func(address string) (api.AccountResponse, error)
+	SendTransactionsWithReceiptsCalled             func(txs []*transaction.Transaction) ([]*common.TransactionSendReceiptAPIResponse, error)
+	GetAccountHandler                              func(address string, options common.BlockQueryOptions) (api.AccountResponse, common.BlockInfo, error)
 	GetCodeCalled                                  func(codeHash []byte) []byte
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
@@ -36,15 +39,15 @@ type NodeStub struct {
 	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTriggerCalled                            func() bool
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                           func(address string, key string) (string, error)
+	GetValueForKeyCalled                           func(address string, key string, options common.BlockQueryOptions) (string, common.BlockInfo, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
-	GetUsernameCalled                              func(address string) (string, error)
-	GetESDTDataCalled                              func(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
-	GetAllESDTTokensCalled                         func(address string, ctx context.Context) (map[string]*esdt.ESDigitalToken, error)
+	GetUsernameCalled                              func(address string, options common.BlockQueryOptions) (string, common.BlockInfo, error)
+	GetESDTDataCalled                              func(address string, key string, nonce uint64, options common.BlockQueryOptions) (*esdt.ESDigitalToken, common.BlockInfo, error)
+	GetAllESDTTokensCalled                         func(address string, options common.BlockQueryOptions, ctx context.Context) (map[string]*esdt.ESDigitalToken, common.BlockInfo, error)
 	GetNFTTokenIDsRegisteredByAddressCalled        func(address string, ctx context.Context) ([]string, error)
 	GetESDTsWithRoleCalled                         func(address string, role string, ctx context.Context) ([]string, error)
 	GetESDTsRolesCalled                            func(address string, ctx context.Context) (map[string][]string, error)
-	GetKeyValuePairsCalled                         func(address string, ctx context.Context) (map[string]string, error)
+	GetKeyValuePairsCalled                         func(address string, options common.BlockQueryOptions, ctx context.Context) (map[string]string, common.BlockInfo, error)
 	GetAllIssuedESDTsCalled                        func(tokenType string, ctx context.Context) ([]string, error)
 	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
@@ -79,30 +82,30 @@ func (ns *NodeStub) VerifyProof(rootHash string, address string, proof [][]byte)
 }
 
 // GetUsername -
-func (ns *NodeStub) GetUsername(address string) (string, error) {
+func (ns *NodeStub) GetUsername(address string, options common.BlockQueryOptions) (string, common.BlockInfo, error) {
 	if ns.GetUsernameCalled != nil {
-		return ns.GetUsernameCalled(address)
+		return ns.GetUsernameCalled(address, options)
 	}
 
-	return "", nil
+	return "", common.BlockInfo{}, nil
 }
 
 // GetKeyValuePairs -
-func (ns *NodeStub) GetKeyValuePairs(address string, ctx context.Context) (map[string]string, error) {
+func (ns *NodeStub) GetKeyValuePairs(address string, options common.BlockQueryOptions, ctx context.Context) (map[string]string, common.BlockInfo, error) {
 	if ns.GetKeyValuePairsCalled != nil {
-		return ns.GetKeyValuePairsCalled(address, ctx)
+		return ns.GetKeyValuePairsCalled(address, options, ctx)
 	}
 
-	return nil, nil
+	return nil, common.BlockInfo{}, nil
 }
 
 // GetValueForKey -
-func (ns *NodeStub) GetValueForKey(address string, key string) (string, error) {
+func (ns *NodeStub) GetValueForKey(address string, key string, options common.BlockQueryOptions) (string, common.BlockInfo, error) {
 	if ns.GetValueForKeyCalled != nil {
-		return ns.GetValueForKeyCalled(address, key)
+		return ns.GetValueForKeyCalled(address, key, options)
 	}
 
-	return "", nil
+	return "", common.BlockInfo{}, nil
 }
 
 // EncodeAddressPubkey -
@@ -116,8 +119,8 @@ func (ns *NodeStub) DecodeAddressPubkey(pk string) ([]byte, error) {
 }
 
 // GetBalance -
-func (ns *NodeStub) GetBalance(address string) (*big.Int, error) {
-	return ns.GetBalanceHandler(address)
+func (ns *NodeStub) GetBalance(address string, options common.BlockQueryOptions) (*big.Int, common.BlockInfo, error) {
+	return ns.GetBalanceHandler(address, options)
 }
 
 // CreateTransaction -
@@ -127,7 +130,16 @@ func (ns *NodeStub) CreateTransaction(nonce uint64, value string, receiver strin
 	return ns.CreateTransactionHandler(nonce, value, receiver, receiverUsername, sender, senderUsername, gasPrice, gasLimit, data, signatureHex, chainID, version, options)
 }
 
-//ValidateTransaction -
+// CreateRelayedTransaction -
+func (ns *NodeStub) CreateRelayedTransaction(innerTx *transaction.Transaction, relayer string, relayerNonce uint64, relayerSignatureHex string) (*transaction.Transaction, []byte, error) {
+	if ns.CreateRelayedTransactionCalled != nil {
+		return ns.CreateRelayedTransactionCalled(innerTx, relayer, relayerNonce, relayerSignatureHex)
+	}
+
+	return nil, nil, nil
+}
+
+// ValidateTransaction -
 func (ns *NodeStub) ValidateTransaction(tx *transaction.Transaction) error {
 	return ns.ValidateTransactionHandler(tx)
 }
@@ -137,14 +149,32 @@ func (ns *NodeStub) ValidateTransactionForSimulation(tx *transaction.Transaction
 	return ns.ValidateTransactionForSimulationCalled(tx, bypassSignature)
 }
 
+// ValidateTransactionForBundleSimulation -
+func (ns *NodeStub) ValidateTransactionForBundleSimulation(tx *transaction.Transaction, checkSignature bool) error {
+	if ns.ValidateTransactionForBundleSimulationCalled != nil {
+		return ns.ValidateTransactionForBundleSimulationCalled(tx, checkSignature)
+	}
+
+	return nil
+}
+
 // SendBulkTransactions -
 func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return ns.SendBulkTransactionsHandler(txs)
 }
 
+// SendTransactionsWithReceipts -
+func (ns *NodeStub) SendTransactionsWithReceipts(txs []*transaction.Transaction) ([]*common.TransactionSendReceiptAPIResponse, error) {
+	if ns.SendTransactionsWithReceiptsCalled != nil {
+		return ns.SendTransactionsWithReceiptsCalled(txs)
+	}
+
+	return nil, nil
+}
+
 // GetAccount -
-func (ns *NodeStub) GetAccount(address string) (api.AccountResponse, error) {
-	return ns.GetAccountHandler(address)
+func (ns *NodeStub) GetAccount(address string, options common.BlockQueryOptions) (api.AccountResponse, common.BlockInfo, error) {
+	return ns.GetAccountHandler(address, options)
 }
 
 // GetCode -
@@ -195,12 +225,12 @@ func (ns *NodeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 }
 
 // GetESDTData -
-func (ns *NodeStub) GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error) {
+func (ns *NodeStub) GetESDTData(address, tokenID string, nonce uint64, options common.BlockQueryOptions) (*esdt.ESDigitalToken, common.BlockInfo, error) {
 	if ns.GetESDTDataCalled != nil {
-		return ns.GetESDTDataCalled(address, tokenID, nonce)
+		return ns.GetESDTDataCalled(address, tokenID, nonce, options)
 	}
 
-	return &esdt.ESDigitalToken{Value: big.NewInt(0)}, nil
+	return &esdt.ESDigitalToken{Value: big.NewInt(0)}, common.BlockInfo{}, nil
 }
 
 // GetESDTsRoles -
@@ -222,12 +252,12 @@ func (ns *NodeStub) GetESDTsWithRole(address string, role string, ctx context.Co
 }
 
 // GetAllESDTTokens -
-func (ns *NodeStub) GetAllESDTTokens(address string, ctx context.Context) (map[string]*esdt.ESDigitalToken, error) {
+func (ns *NodeStub) GetAllESDTTokens(address string, options common.BlockQueryOptions, ctx context.Context) (map[string]*esdt.ESDigitalToken, common.BlockInfo, error) {
 	if ns.GetAllESDTTokensCalled != nil {
-		return ns.GetAllESDTTokensCalled(address, ctx)
+		return ns.GetAllESDTTokensCalled(address, options, ctx)
 	}
 
-	return make(map[string]*esdt.ESDigitalToken), nil
+	return make(map[string]*esdt.ESDigitalToken), common.BlockInfo{}, nil
 }
 
 // GetTokenSupply -
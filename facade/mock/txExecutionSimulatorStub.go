@@ -1,13 +1,17 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 )
 
 // TxExecutionSimulatorStub -
 type TxExecutionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxCalled            func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxWithOptionsCalled func(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ProcessBundleCalled        func(ctx context.Context, txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
 }
 
 // ProcessTx -
@@ -19,6 +23,24 @@ func (t *TxExecutionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*txSi
 	return &txSimData.SimulationResults{}, nil
 }
 
+// ProcessTxWithOptions -
+func (t *TxExecutionSimulatorStub) ProcessTxWithOptions(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	if t.ProcessTxWithOptionsCalled != nil {
+		return t.ProcessTxWithOptionsCalled(tx, options)
+	}
+
+	return &txSimData.SimulationResults{}, nil
+}
+
+// ProcessBundle -
+func (t *TxExecutionSimulatorStub) ProcessBundle(ctx context.Context, txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if t.ProcessBundleCalled != nil {
+		return t.ProcessBundleCalled(ctx, txs)
+	}
+
+	return &txSimData.BundleSimulationResults{}, nil
+}
+
 // IsInterfaceNil -
 func (t *TxExecutionSimulatorStub) IsInterfaceNil() bool {
 	return t == nil
@@ -33,7 +33,8 @@ import (
 const DefaultRestInterface = "localhost:8080"
 
 // DefaultRestPortOff is the default value that should be passed if it is desired
-//  to start the node without a REST endpoint available
+//
+//	to start the node without a REST endpoint available
 const DefaultRestPortOff = "off"
 
 var log = logger.GetOrCreate("facade")
@@ -96,6 +97,15 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if arg.WsAntifloodConfig.TrieOperationsDeadlineMilliseconds == 0 {
 		return nil, fmt.Errorf("%w, TrieOperationsDeadlineMilliseconds should not be 0", ErrInvalidValue)
 	}
+	if arg.WsAntifloodConfig.MaxTransactionsInBundle == 0 {
+		return nil, fmt.Errorf("%w, MaxTransactionsInBundle should not be 0", ErrInvalidValue)
+	}
+	if arg.WsAntifloodConfig.BundleSimulationDeadlineMilliseconds == 0 {
+		return nil, fmt.Errorf("%w, BundleSimulationDeadlineMilliseconds should not be 0", ErrInvalidValue)
+	}
+	if arg.WsAntifloodConfig.MaxTransactionsPoolFilterResults == 0 {
+		return nil, fmt.Errorf("%w, MaxTransactionsPoolFilterResults should not be 0", ErrInvalidValue)
+	}
 	if check.IfNil(arg.AccountsState) {
 		return nil, ErrNilAccountState
 	}
@@ -156,7 +166,8 @@ func (nf *nodeFacade) RestAPIServerDebugMode() bool {
 
 // RestApiInterface returns the interface on which the rest API should start on, based on the config file provided.
 // The API will start on the DefaultRestInterface value unless a correct value is passed or
-//  the value is explicitly set to off, in which case it will not start at all
+//
+//	the value is explicitly set to off, in which case it will not start at all
 func (nf *nodeFacade) RestApiInterface() string {
 	if nf.config.RestApiInterface == "" {
 		return DefaultRestInterface
@@ -165,24 +176,24 @@ func (nf *nodeFacade) RestApiInterface() string {
 	return nf.config.RestApiInterface
 }
 
-// GetBalance gets the current balance for a specified address
-func (nf *nodeFacade) GetBalance(address string) (*big.Int, error) {
-	return nf.node.GetBalance(address)
+// GetBalance gets the balance for a specified address at the block described by the provided options
+func (nf *nodeFacade) GetBalance(address string, options common.BlockQueryOptions) (*big.Int, common.BlockInfo, error) {
+	return nf.node.GetBalance(address, options)
 }
 
-// GetUsername gets the username for a specified address
-func (nf *nodeFacade) GetUsername(address string) (string, error) {
-	return nf.node.GetUsername(address)
+// GetUsername gets the username for a specified address at the block described by the provided options
+func (nf *nodeFacade) GetUsername(address string, options common.BlockQueryOptions) (string, common.BlockInfo, error) {
+	return nf.node.GetUsername(address, options)
 }
 
-// GetValueForKey gets the value for a key in a given address
-func (nf *nodeFacade) GetValueForKey(address string, key string) (string, error) {
-	return nf.node.GetValueForKey(address, key)
+// GetValueForKey gets the value for a key in a given address at the block described by the provided options
+func (nf *nodeFacade) GetValueForKey(address string, key string, options common.BlockQueryOptions) (string, common.BlockInfo, error) {
+	return nf.node.GetValueForKey(address, key, options)
 }
 
-// GetESDTData returns the ESDT data for the given address, tokenID and nonce
-func (nf *nodeFacade) GetESDTData(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error) {
-	return nf.node.GetESDTData(address, key, nonce)
+// GetESDTData returns the ESDT data for the given address, tokenID and nonce at the block described by the provided options
+func (nf *nodeFacade) GetESDTData(address string, key string, nonce uint64, options common.BlockQueryOptions) (*esdt.ESDigitalToken, common.BlockInfo, error) {
+	return nf.node.GetESDTData(address, key, nonce, options)
 }
 
 // GetESDTsRoles returns all the tokens identifiers and roles for the given address
@@ -209,20 +220,20 @@ func (nf *nodeFacade) GetESDTsWithRole(address string, role string) ([]string, e
 	return nf.node.GetESDTsWithRole(address, role, ctx)
 }
 
-// GetKeyValuePairs returns all the key-value pairs under the provided address
-func (nf *nodeFacade) GetKeyValuePairs(address string) (map[string]string, error) {
+// GetKeyValuePairs returns all the key-value pairs under the provided address at the block described by the provided options
+func (nf *nodeFacade) GetKeyValuePairs(address string, options common.BlockQueryOptions) (map[string]string, common.BlockInfo, error) {
 	ctx, cancel := nf.getContextForApiTrieRangeOperations()
 	defer cancel()
 
-	return nf.node.GetKeyValuePairs(address, ctx)
+	return nf.node.GetKeyValuePairs(address, options, ctx)
 }
 
-// GetAllESDTTokens returns all the esdt tokens for a given address
-func (nf *nodeFacade) GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error) {
+// GetAllESDTTokens returns all the esdt tokens for a given address at the block described by the provided options
+func (nf *nodeFacade) GetAllESDTTokens(address string, options common.BlockQueryOptions) (map[string]*esdt.ESDigitalToken, common.BlockInfo, error) {
 	ctx, cancel := nf.getContextForApiTrieRangeOperations()
 	defer cancel()
 
-	return nf.node.GetAllESDTTokens(address, ctx)
+	return nf.node.GetAllESDTTokens(address, options, ctx)
 }
 
 // GetTokenSupply returns the provided token supply
@@ -264,6 +275,17 @@ func (nf *nodeFacade) CreateTransaction(
 	return nf.node.CreateTransaction(nonce, value, receiver, receiverUsername, sender, senderUsername, gasPrice, gasLimit, txData, signatureHex, chainID, version, options)
 }
 
+// CreateRelayedTransaction wraps the user-signed inner transaction into a relayed transaction sent by the relayer,
+// which pays the gas of both transactions
+func (nf *nodeFacade) CreateRelayedTransaction(
+	innerTx *transaction.Transaction,
+	relayer string,
+	relayerNonce uint64,
+	relayerSignatureHex string,
+) (*transaction.Transaction, []byte, error) {
+	return nf.node.CreateRelayedTransaction(innerTx, relayer, relayerNonce, relayerSignatureHex)
+}
+
 // ValidateTransaction will validate a transaction
 func (nf *nodeFacade) ValidateTransaction(tx *transaction.Transaction) error {
 	return nf.node.ValidateTransaction(tx)
@@ -274,6 +296,12 @@ func (nf *nodeFacade) ValidateTransactionForSimulation(tx *transaction.Transacti
 	return nf.node.ValidateTransactionForSimulation(tx, checkSignature)
 }
 
+// ValidateTransactionForBundleSimulation will validate a transaction which is part of a bundle simulation, without
+// checking the accounts
+func (nf *nodeFacade) ValidateTransactionForBundleSimulation(tx *transaction.Transaction, checkSignature bool) error {
+	return nf.node.ValidateTransactionForBundleSimulation(tx, checkSignature)
+}
+
 // ValidatorStatisticsApi will return the statistics for all validators
 func (nf *nodeFacade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error) {
 	return nf.node.ValidatorStatisticsApi()
@@ -284,9 +312,31 @@ func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint
 	return nf.node.SendBulkTransactions(txs)
 }
 
-// SimulateTransactionExecution will simulate a transaction's execution and will return the results
-func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
-	return nf.txSimulatorProc.ProcessTx(tx)
+// SendTransactionsWithReceipts will validate the transactions, send the valid ones and return, for each transaction,
+// whether it was accepted or the reason of its rejection
+func (nf *nodeFacade) SendTransactionsWithReceipts(txs []*transaction.Transaction) ([]*common.TransactionSendReceiptAPIResponse, error) {
+	return nf.node.SendTransactionsWithReceipts(txs)
+}
+
+// SimulateTransactionExecution will simulate a transaction's execution, using the provided options, and will return
+// the results
+func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	return nf.txSimulatorProc.ProcessTxWithOptions(tx, options)
+}
+
+// SimulateTransactionsBundle will simulate the execution of the transactions, one after another, and will return
+// the results of each transaction together with the state changes brought by the whole bundle
+func (nf *nodeFacade) SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	timeout := time.Duration(nf.wsAntifloodConfig.BundleSimulationDeadlineMilliseconds) * time.Millisecond
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return nf.txSimulatorProc.ProcessBundle(ctx, txs)
+}
+
+// GetMaxTransactionsInBundle returns the maximum number of transactions accepted in a bundle simulation
+func (nf *nodeFacade) GetMaxTransactionsInBundle() uint32 {
+	return nf.wsAntifloodConfig.MaxTransactionsInBundle
 }
 
 // GetTransaction gets the transaction with a specified hash
@@ -299,22 +349,77 @@ func (nf *nodeFacade) GetTransactionsPool() (*common.TransactionsPoolAPIResponse
 	return nf.apiResolver.GetTransactionsPool()
 }
 
+// GetTransactionsPoolForSender will return the transactions of the given sender found in the pool, together with the
+// nonce gaps blocking their selection and the risk of the transactions to be evicted
+func (nf *nodeFacade) GetTransactionsPoolForSender(sender string) (*common.TransactionsPoolSenderAPIResponse, error) {
+	return nf.apiResolver.GetTransactionsPoolForSender(sender)
+}
+
+// GetTransactionsPoolByFilter will return the regular transactions in the pool matching the filter
+func (nf *nodeFacade) GetTransactionsPoolByFilter(filter common.TransactionsPoolFilter) ([]*common.TransactionsPoolEntryAPIResponse, error) {
+	return nf.apiResolver.GetTransactionsPoolByFilter(filter)
+}
+
+// GetMaxTransactionsPoolFilterResults returns the maximum number of transactions returned by a pool filtering
+func (nf *nodeFacade) GetMaxTransactionsPoolFilterResults() uint32 {
+	return nf.wsAntifloodConfig.MaxTransactionsPoolFilterResults
+}
+
+// SubscribeToTransactionsPoolEvents will return a subscription to the changes undergone by the transactions of the
+// pool matching the filter
+func (nf *nodeFacade) SubscribeToTransactionsPoolEvents(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error) {
+	return nf.apiResolver.SubscribeToTransactionsPoolEvents(filter)
+}
+
+// GetTransactionProcessStatus will return the progress of the transaction through the steps of its processing,
+// including the progress of the smart contract results it generated
+func (nf *nodeFacade) GetTransactionProcessStatus(txHash string) (*common.TransactionProcessStatusAPIResponse, error) {
+	return nf.apiResolver.GetTransactionProcessStatus(txHash)
+}
+
+// WaitForTransactionsInclusion will wait, at most for the given timeout, for the transactions to be included in blocks
+// and will return the inclusion of each one
+func (nf *nodeFacade) WaitForTransactionsInclusion(ctx context.Context, txsHashes []string, timeout time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error) {
+	return nf.apiResolver.WaitForTransactionsInclusion(ctx, txsHashes, timeout)
+}
+
+// GetGasPriceSuggestions will return the slow, normal and fast gas prices suggested for the transactions of the shard
+func (nf *nodeFacade) GetGasPriceSuggestions() (*common.GasPriceSuggestionsAPIResponse, error) {
+	return nf.apiResolver.GetGasPriceSuggestions()
+}
+
+// GetStateStatistics will return the last computed state size statistics and the state growth per epoch
+func (nf *nodeFacade) GetStateStatistics() (*common.StateStatisticsAPIResponse, error) {
+	return nf.apiResolver.GetStateStatistics()
+}
+
+// GetLogEvents will return the events emitted by the given address which match the query, the most recent first
+func (nf *nodeFacade) GetLogEvents(address string, query common.LogEventsQuery) ([]*common.LogEventAPIResponse, error) {
+	return nf.apiResolver.GetLogEvents(address, query)
+}
+
+// GetTransactionsByAddress will return a page of the transactions which touched the given address, the most recent first
+func (nf *nodeFacade) GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+	return nf.apiResolver.GetTransactionsByAddress(address, from, size)
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
 }
 
 // GetAccount returns a response containing information about the account correlated with provided address
-func (nf *nodeFacade) GetAccount(address string) (apiData.AccountResponse, error) {
-	accountResponse, err := nf.node.GetAccount(address)
+// at the block described by the provided options
+func (nf *nodeFacade) GetAccount(address string, options common.BlockQueryOptions) (apiData.AccountResponse, common.BlockInfo, error) {
+	accountResponse, blockInfo, err := nf.node.GetAccount(address, options)
 	if err != nil {
-		return apiData.AccountResponse{}, err
+		return apiData.AccountResponse{}, common.BlockInfo{}, err
 	}
 
 	codeHash := accountResponse.CodeHash
 	code := nf.node.GetCode(codeHash)
 	accountResponse.Code = hex.EncodeToString(code)
-	return accountResponse, nil
+	return accountResponse, blockInfo, nil
 }
 
 // GetHeartbeats returns the heartbeat status for each public key from initial list or later joined to the network
@@ -26,6 +26,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
@@ -43,10 +44,13 @@ func createMockArguments() ArgNodeFacade {
 		RestAPIServerDebugMode: false,
 		TxSimulatorProcessor:   &mock.TxExecutionSimulatorStub{},
 		WsAntifloodConfig: config.WebServerAntifloodConfig{
-			SimultaneousRequests:               1,
-			SameSourceRequests:                 1,
-			SameSourceResetIntervalInSec:       1,
-			TrieOperationsDeadlineMilliseconds: 1,
+			SimultaneousRequests:                 1,
+			SameSourceRequests:                   1,
+			SameSourceResetIntervalInSec:         1,
+			TrieOperationsDeadlineMilliseconds:   1,
+			MaxTransactionsInBundle:              10,
+			BundleSimulationDeadlineMilliseconds: 100,
+			MaxTransactionsPoolFilterResults:     100,
 		},
 		FacadeConfig: config.FacadeConfig{
 			RestApiInterface: "127.0.0.1:8080",
@@ -129,6 +133,39 @@ func TestNewNodeFacade_WithInvalidSameSourceRequestsShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, ErrInvalidValue))
 }
 
+func TestNewNodeFacade_WithInvalidMaxTransactionsInBundleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.WsAntifloodConfig.MaxTransactionsInBundle = 0
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestNewNodeFacade_WithInvalidBundleSimulationDeadlineShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.WsAntifloodConfig.BundleSimulationDeadlineMilliseconds = 0
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestNewNodeFacade_WithInvalidMaxTransactionsPoolFilterResultsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.WsAntifloodConfig.MaxTransactionsPoolFilterResults = 0
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
 func TestNewNodeFacade_WithInvalidApiRoutesConfigShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -158,11 +195,11 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	balance := big.NewInt(10)
 	addr := "testAddress"
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ common.BlockQueryOptions) (*big.Int, common.BlockInfo, error) {
 			if addr == address {
-				return balance, nil
+				return balance, common.BlockInfo{}, nil
 			}
-			return big.NewInt(0), nil
+			return big.NewInt(0), common.BlockInfo{}, nil
 		},
 	}
 
@@ -170,7 +207,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, _, err := nf.GetBalance(addr, common.BlockQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, balance, amount)
@@ -185,11 +222,11 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ common.BlockQueryOptions) (*big.Int, common.BlockInfo, error) {
 			if addr == address {
-				return balance, nil
+				return balance, common.BlockInfo{}, nil
 			}
-			return big.NewInt(0), nil
+			return big.NewInt(0), common.BlockInfo{}, nil
 		},
 	}
 
@@ -197,7 +234,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(unknownAddr)
+	amount, _, err := nf.GetBalance(unknownAddr, common.BlockQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -209,8 +246,8 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
-			return big.NewInt(0), errors.New("error on getBalance on node")
+		GetBalanceHandler: func(address string, _ common.BlockQueryOptions) (*big.Int, common.BlockInfo, error) {
+			return big.NewInt(0), common.BlockInfo{}, errors.New("error on getBalance on node")
 		},
 	}
 
@@ -218,7 +255,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, _, err := nf.GetBalance(addr, common.BlockQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -283,18 +320,23 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 	t.Parallel()
 
 	getAccountCalled := false
+	providedOptions := common.BlockQueryOptions{BlockHash: []byte("hash")}
+	expectedBlockInfo := common.BlockInfo{Nonce: 37, Hash: "68617368"}
 	node := &mock.NodeStub{}
-	node.GetAccountHandler = func(address string) (api.AccountResponse, error) {
+	node.GetAccountHandler = func(address string, options common.BlockQueryOptions) (api.AccountResponse, common.BlockInfo, error) {
 		getAccountCalled = true
-		return api.AccountResponse{}, nil
+		assert.Equal(t, providedOptions, options)
+		return api.AccountResponse{}, expectedBlockInfo, nil
 	}
 
 	arg := createMockArguments()
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	_, _ = nf.GetAccount("test")
+	_, blockInfo, err := nf.GetAccount("test", providedOptions)
+	assert.Nil(t, err)
 	assert.True(t, getAccountCalled)
+	assert.Equal(t, expectedBlockInfo, blockInfo)
 }
 
 func TestNodeFacade_GetUsername(t *testing.T) {
@@ -302,15 +344,15 @@ func TestNodeFacade_GetUsername(t *testing.T) {
 
 	expectedUsername := "username"
 	node := &mock.NodeStub{}
-	node.GetUsernameCalled = func(address string) (string, error) {
-		return expectedUsername, nil
+	node.GetUsernameCalled = func(address string, options common.BlockQueryOptions) (string, common.BlockInfo, error) {
+		return expectedUsername, common.BlockInfo{}, nil
 	}
 
 	arg := createMockArguments()
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	username, err := nf.GetUsername("test")
+	username, _, err := nf.GetUsername("test", common.BlockQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedUsername, username)
 }
@@ -506,6 +548,25 @@ func TestNodeFacade_CreateTransaction(t *testing.T) {
 	assert.True(t, nodeCreateTxWasCalled)
 }
 
+func TestNodeFacade_CreateRelayedTransaction(t *testing.T) {
+	t.Parallel()
+
+	nodeCreateRelayedTxWasCalled := false
+	node := &mock.NodeStub{
+		CreateRelayedTransactionCalled: func(_ *transaction.Transaction, _ string, _ uint64, _ string) (*transaction.Transaction, []byte, error) {
+			nodeCreateRelayedTxWasCalled = true
+			return nil, nil, nil
+		},
+	}
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	_, _, _ = nf.CreateRelayedTransaction(&transaction.Transaction{}, "relayer", 0, "")
+
+	assert.True(t, nodeCreateRelayedTxWasCalled)
+}
+
 func TestNodeFacade_Trigger(t *testing.T) {
 	t.Parallel()
 
@@ -664,14 +725,14 @@ func TestNodeFacade_GetKeyValuePairs(t *testing.T) {
 	expectedPairs := map[string]string{"k": "v"}
 	arg := createMockArguments()
 	arg.Node = &mock.NodeStub{
-		GetKeyValuePairsCalled: func(address string, _ context.Context) (map[string]string, error) {
-			return expectedPairs, nil
+		GetKeyValuePairsCalled: func(address string, _ common.BlockQueryOptions, _ context.Context) (map[string]string, common.BlockInfo, error) {
+			return expectedPairs, common.BlockInfo{}, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(arg)
 
-	res, err := nf.GetKeyValuePairs("addr")
+	res, _, err := nf.GetKeyValuePairs("addr", common.BlockQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedPairs, res)
 }
@@ -685,14 +746,14 @@ func TestNodeFacade_GetAllESDTTokens(t *testing.T) {
 	}
 	arg := createMockArguments()
 	arg.Node = &mock.NodeStub{
-		GetAllESDTTokensCalled: func(_ string, _ context.Context) (map[string]*esdt.ESDigitalToken, error) {
-			return expectedTokens, nil
+		GetAllESDTTokensCalled: func(_ string, _ common.BlockQueryOptions, _ context.Context) (map[string]*esdt.ESDigitalToken, common.BlockInfo, error) {
+			return expectedTokens, common.BlockInfo{}, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(arg)
 
-	res, err := nf.GetAllESDTTokens("addr")
+	res, _, err := nf.GetAllESDTTokens("addr", common.BlockQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTokens, res)
 }
@@ -705,14 +766,14 @@ func TestNodeFacade_GetESDTData(t *testing.T) {
 	}
 	arg := createMockArguments()
 	arg.Node = &mock.NodeStub{
-		GetESDTDataCalled: func(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
-			return expectedData, nil
+		GetESDTDataCalled: func(_ string, _ string, _ uint64, _ common.BlockQueryOptions) (*esdt.ESDigitalToken, common.BlockInfo, error) {
+			return expectedData, common.BlockInfo{}, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(arg)
 
-	res, err := nf.GetESDTData("addr", "tkn", 0)
+	res, _, err := nf.GetESDTData("addr", "tkn", 0, common.BlockQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedData, res)
 }
@@ -723,14 +784,14 @@ func TestNodeFacade_GetValueForKey(t *testing.T) {
 	expectedValue := "value"
 	arg := createMockArguments()
 	arg.Node = &mock.NodeStub{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
-			return expectedValue, nil
+		GetValueForKeyCalled: func(_ string, _ string, _ common.BlockQueryOptions) (string, common.BlockInfo, error) {
+			return expectedValue, common.BlockInfo{}, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(arg)
 
-	res, err := nf.GetValueForKey("addr", "key")
+	res, _, err := nf.GetValueForKey("addr", "key", common.BlockQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedValue, res)
 }
@@ -1312,3 +1373,25 @@ func TestNodeFacade_GetTransactionsPool(t *testing.T) {
 		require.Equal(t, expectedPool, res)
 	})
 }
+
+func TestNodeFacade_SimulateTransactionsBundleShouldUseDeadline(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.TxSimulatorProcessor = &mock.TxExecutionSimulatorStub{
+		ProcessBundleCalled: func(ctx context.Context, txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+			deadline, ok := ctx.Deadline()
+			require.True(t, ok)
+			require.True(t, time.Until(deadline) <= 100*time.Millisecond)
+			require.Equal(t, 1, len(txs))
+
+			return &txSimData.BundleSimulationResults{}, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	results, err := nf.SimulateTransactionsBundle([]*transaction.Transaction{{Nonce: 1}})
+	require.NoError(t, err)
+	require.NotNil(t, results)
+	require.Equal(t, uint32(10), nf.GetMaxTransactionsInBundle())
+}
@@ -7,16 +7,21 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/blockchain"
+	errorsErd "github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/external/blockAPI"
+	"github.com/ElrondNetwork/elrond-go/node/external/gasPriceOracle"
 	"github.com/ElrondNetwork/elrond-go/node/external/transactionAPI"
 	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
 	trieIteratorsFactory "github.com/ElrondNetwork/elrond-go/node/trieIterators/factory"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/blockInfo"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
@@ -24,11 +29,17 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/process/txstatus"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
+	factoryState "github.com/ElrondNetwork/elrond-go/state/factory"
+	disabledStoragePruningManager "github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/trie/stateStatistics"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	vmcommonBuiltInFunctions "github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
@@ -190,6 +201,7 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		StorageService:           args.DataComponents.StorageService(),
 		DataPool:                 args.DataComponents.Datapool(),
 		Uint64ByteSliceConverter: args.CoreComponents.Uint64ByteSliceConverter(),
+		MaxPoolSubscriptions:     args.Configs.GeneralConfig.Antiflood.WebServer.MaxPoolSubscriptions,
 	}
 	apiTransactionProcessor, err := transactionAPI.NewAPITransactionProcessor(argsAPITransactionProc)
 	if err != nil {
@@ -206,6 +218,24 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	argsGasPriceOracle := &gasPriceOracle.ArgsGasPriceOracle{
+		BlockHandler:     apiBlockProcessor,
+		BlockChain:       args.DataComponents.Blockchain(),
+		DataPool:         args.DataComponents.Datapool(),
+		ShardCoordinator: args.ProcessComponents.ShardCoordinator(),
+		EconomicsHandler: args.CoreComponents.EconomicsData(),
+		NumRecentBlocks:  args.Configs.GeneralConfig.GasPriceOracle.NumRecentBlocks,
+	}
+	gasPriceOracleInstance, err := gasPriceOracle.NewGasPriceOracle(argsGasPriceOracle)
+	if err != nil {
+		return nil, err
+	}
+
+	stateStatisticsHandler, err := createStateStatistics(args)
+	if err != nil {
+		return nil, err
+	}
+
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:           scQueryService,
 		StatusMetricsHandler:     args.CoreComponents.StatusHandlerUtils().Metrics(),
@@ -218,11 +248,39 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		APIInternalBlockHandler:  apiInternalBlockProcessor,
 		GenesisNodesSetupHandler: args.CoreComponents.GenesisNodesSetup(),
 		ValidatorPubKeyConverter: args.CoreComponents.ValidatorPubKeyConverter(),
+		GasPriceOracle:           gasPriceOracleInstance,
+		StateStatisticsHandler:   stateStatisticsHandler,
 	}
 
 	return external.NewNodeApiResolver(argsApiResolver)
 }
 
+func createStateStatistics(args *ApiResolverArgs) (external.StateStatisticsHandler, error) {
+	stateStatisticsConfig := args.Configs.GeneralConfig.StateStatistics
+	storageManager, ok := args.StateComponents.TrieStorageManagers()[trieFactory.UserAccountTrie]
+	if !ok {
+		return nil, errorsErd.ErrNilTrieStorageManager
+	}
+
+	stateStatisticsHandler, err := stateStatistics.NewStateStatistics(stateStatistics.ArgsStateStatistics{
+		StorageManager:         storageManager,
+		Marshalizer:            args.CoreComponents.InternalMarshalizer(),
+		Hasher:                 args.CoreComponents.Hasher(),
+		AddressPubkeyConverter: args.CoreComponents.AddressPubKeyConverter(),
+		NumLargestDataTries:    stateStatisticsConfig.NumLargestDataTries,
+		NumEpochsInHistory:     stateStatisticsConfig.NumEpochsInHistory,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if stateStatisticsConfig.Enabled {
+		args.ProcessComponents.EpochStartNotifier().RegisterHandler(stateStatisticsHandler)
+	}
+
+	return stateStatisticsHandler, nil
+}
+
 func createScQueryService(
 	args *scQueryServiceArgs,
 ) (process.SCQueryService, error) {
@@ -275,10 +333,45 @@ func createScQueryElement(
 	var vmFactory process.VirtualMachinesContainerFactory
 	var err error
 
+	selfShardID := args.processComponents.ShardCoordinator().SelfId()
+	apiBlockChain, err := createBlockChainForScQuery(selfShardID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountsAdapterAPI, err := createAccountsAdapterForScQuery(args, apiBlockChain)
+	if err != nil {
+		return nil, err
+	}
+
+	// the VM reads the state through a wrapper able to redirect the operations towards a disposable accounts adapter,
+	// holding the accounts overridden by a query
+	readOnlyAccountsAPI, err := txsimulator.NewReadOnlyAccountsDB(accountsAdapterAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	stateOverridesHandler, err := createStateOverridesHandlerForScQuery(args, apiBlockChain, readOnlyAccountsAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	argsBlockInfoProvider := blockInfo.ArgsBlockInfoProvider{
+		SelfShardID:              selfShardID,
+		BlockChain:               args.dataComponents.Blockchain(),
+		StorageService:           args.dataComponents.StorageService(),
+		Marshaller:               args.coreComponents.InternalMarshalizer(),
+		Uint64ByteSliceConverter: args.coreComponents.Uint64ByteSliceConverter(),
+	}
+	blockInfoProvider, err := blockInfo.NewBlockInfoProvider(argsBlockInfoProvider)
+	if err != nil {
+		return nil, err
+	}
+
 	builtInFuncs, nftStorageHandler, globalSettingsHandler, err := createBuiltinFuncs(
 		args.gasScheduleNotifier,
 		args.coreComponents.InternalMarshalizer(),
-		args.stateComponents.AccountsAdapterAPI(),
+		readOnlyAccountsAPI,
 		args.processComponents.ShardCoordinator(),
 		args.coreComponents.EpochNotifier(),
 		args.epochConfig.EnableEpochs.ESDTMultiTransferEnableEpoch,
@@ -302,10 +395,10 @@ func createScQueryElement(
 	scStorage := args.generalConfig.SmartContractsStorageForSCQuery
 	scStorage.DB.FilePath += fmt.Sprintf("%d", args.index)
 	argsHook := hooks.ArgBlockChainHook{
-		Accounts:              args.stateComponents.AccountsAdapterAPI(),
+		Accounts:              readOnlyAccountsAPI,
 		PubkeyConv:            args.coreComponents.AddressPubKeyConverter(),
 		StorageService:        args.dataComponents.StorageService(),
-		BlockChain:            args.dataComponents.Blockchain(),
+		BlockChain:            apiBlockChain,
 		ShardCoordinator:      args.processComponents.ShardCoordinator(),
 		Marshalizer:           args.coreComponents.InternalMarshalizer(),
 		Uint64Converter:       args.coreComponents.Uint64ByteSliceConverter(),
@@ -401,6 +494,9 @@ func createScQueryElement(
 		EconomicsFee:             args.coreComponents.EconomicsData(),
 		BlockChainHook:           vmFactory.BlockChainHookImpl(),
 		BlockChain:               args.dataComponents.Blockchain(),
+		APIBlockChain:            apiBlockChain,
+		BlockInfoProvider:        blockInfoProvider,
+		StateOverridesHandler:    stateOverridesHandler,
 		ArwenChangeLocker:        args.coreComponents.ArwenChangeLocker(),
 		Bootstrapper:             args.bootstrapper,
 		AllowExternalQueriesChan: args.allowVMQueriesChan,
@@ -410,6 +506,71 @@ func createScQueryElement(
 	return smartContract.NewSCQueryService(argsNewSCQueryService)
 }
 
+// createBlockChainForScQuery creates a block chain instance that will be pinned by the SC query service on the
+// block each query is executed against
+func createBlockChainForScQuery(selfShardID uint32) (data.ChainHandler, error) {
+	isMetachain := selfShardID == core.MetachainShardId
+	if isMetachain {
+		return blockchain.NewMetaChain(statusHandler.NewNilStatusHandler())
+	}
+
+	return blockchain.NewBlockChain(statusHandler.NewNilStatusHandler())
+}
+
+// createAccountsAdapterForScQuery creates a read-only accounts adapter that follows the root hash of the provided
+// block chain, so each SC query element can recreate the state trie independently of the others
+func createAccountsAdapterForScQuery(args *scQueryElementArgs, chainHandler data.ChainHandler) (state.AccountsAdapter, error) {
+	argsAccountsDB := state.ArgsAccountsDB{
+		Trie:                  args.stateComponents.TriesContainer().Get([]byte(trieFactory.UserAccountTrie)),
+		Hasher:                args.coreComponents.Hasher(),
+		Marshaller:            args.coreComponents.InternalMarshalizer(),
+		AccountFactory:        factoryState.NewAccountCreator(),
+		StoragePruningManager: disabledStoragePruningManager.NewDisabledStoragePruningManager(),
+		ProcessingMode:        common.Normal,
+		ProcessStatusHandler:  args.coreComponents.ProcessStatusHandler(),
+	}
+	accountsAdapter, err := state.NewAccountsDB(argsAccountsDB)
+	if err != nil {
+		return nil, fmt.Errorf("accounts adapter for SC query: %w", err)
+	}
+
+	return state.NewAccountsDBApi(accountsAdapter, chainHandler)
+}
+
+// createStateOverridesHandlerForScQuery creates the component which applies the state overrides of a query on a
+// disposable accounts adapter, created over the root hash the provided block chain is pinned on
+func createStateOverridesHandlerForScQuery(
+	args *scQueryElementArgs,
+	chainHandler data.ChainHandler,
+	bundleAccounts txsimulator.BundleAccountsHandler,
+) (process.StateOverridesHandler, error) {
+	trieStorageManager, ok := args.stateComponents.TrieStorageManagers()[trieFactory.UserAccountTrie]
+	if !ok {
+		return nil, errorsErd.ErrNilTrieStorageManager
+	}
+
+	argsBundleAccountsCreator := txsimulator.ArgsBundleAccountsCreator{
+		TrieStorageManager:   trieStorageManager,
+		MaxTrieLevelInMemory: args.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+		ChainHandler:         chainHandler,
+		Hasher:               args.coreComponents.Hasher(),
+		Marshalizer:          args.coreComponents.InternalMarshalizer(),
+		AccountFactory:       factoryState.NewAccountCreator(),
+		ProcessStatusHandler: args.coreComponents.ProcessStatusHandler(),
+	}
+	bundleAccountsCreator, err := txsimulator.NewBundleAccountsCreator(argsBundleAccountsCreator)
+	if err != nil {
+		return nil, err
+	}
+
+	argsStateOverridesHandler := txsimulator.ArgsStateOverridesHandler{
+		BundleAccounts:        bundleAccounts,
+		BundleAccountsCreator: bundleAccountsCreator,
+	}
+
+	return txsimulator.NewStateOverridesHandler(argsStateOverridesHandler)
+}
+
 func createBuiltinFuncs(
 	gasScheduleNotifier core.GasScheduleNotifier,
 	marshalizer marshal.Marshalizer,
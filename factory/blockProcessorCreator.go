@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
 	metachainEpochStart "github.com/ElrondNetwork/elrond-go/epochStart/metachain"
+	errorsErd "github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/genesis"
 	processDisabled "github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -31,7 +32,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/state"
+	factoryState "github.com/ElrondNetwork/elrond-go/state/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	vmcommonBuiltInFunctions "github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
@@ -942,6 +945,11 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 	}
 
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.BundleAccounts = readOnlyAccountsDB
+	txSimulatorProcessorArgs.BundleAccountsCreator, err = pcf.createBundleAccountsCreator()
+	if err != nil {
+		return nil, err
+	}
 
 	return vmFactory, nil
 }
@@ -1034,10 +1042,34 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 	}
 
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.BundleAccounts = readOnlyAccountsDB
+	txSimulatorProcessorArgs.BundleAccountsCreator, err = pcf.createBundleAccountsCreator()
+	if err != nil {
+		return nil, err
+	}
 
 	return vmFactory, nil
 }
 
+func (pcf *processComponentsFactory) createBundleAccountsCreator() (txsimulator.AccountsAdapterCreator, error) {
+	trieStorageManager, ok := pcf.state.TrieStorageManagers()[trieFactory.UserAccountTrie]
+	if !ok {
+		return nil, errorsErd.ErrNilTrieStorageManager
+	}
+
+	argsBundleAccountsCreator := txsimulator.ArgsBundleAccountsCreator{
+		TrieStorageManager:   trieStorageManager,
+		MaxTrieLevelInMemory: pcf.config.StateTriesConfig.MaxStateTrieLevelInMemory,
+		ChainHandler:         pcf.data.Blockchain(),
+		Hasher:               pcf.coreData.Hasher(),
+		Marshalizer:          pcf.coreData.InternalMarshalizer(),
+		AccountFactory:       factoryState.NewAccountCreator(),
+		ProcessStatusHandler: pcf.coreData.ProcessStatusHandler(),
+	}
+
+	return txsimulator.NewBundleAccountsCreator(argsBundleAccountsCreator)
+}
+
 func (pcf *processComponentsFactory) createVMFactoryShard(
 	accounts state.AccountsAdapter,
 	builtInFuncs vmcommon.BuiltInFunctionContainer,
@@ -228,6 +228,8 @@ type NetworkComponentsHandler interface {
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
 	ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxWithOptions(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ProcessBundle(ctx context.Context, txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
 	IsInterfaceNil() bool
 }
 
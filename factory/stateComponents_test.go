@@ -250,6 +250,18 @@ func getGeneralConfig() config.Config {
 			Type:     "LRU",
 			Shards:   1,
 		},
+		GasPriceOracle: config.GasPriceOracleConfig{
+			NumRecentBlocks: 10,
+		},
+		Antiflood: config.AntifloodConfig{
+			WebServer: config.WebServerAntifloodConfig{
+				MaxPoolSubscriptions: 10,
+			},
+		},
+		StateStatistics: config.StateStatisticsConfig{
+			NumLargestDataTries: 10,
+			NumEpochsInHistory:  10,
+		},
 	}
 }
 
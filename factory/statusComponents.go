@@ -212,6 +212,9 @@ func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandle
 		ElasticIndexerFactoryArgs:  scf.makeElasticIndexerArgs(),
 		EventNotifierFactoryArgs:   scf.makeEventNotifierArgs(),
 		CovalentIndexerFactoryArgs: scf.makeCovalentIndexerArgs(),
+		WebSocketDriverFactoryArgs: scf.makeWebSocketDriverArgs(),
+		FileDriverFactoryArgs:      scf.makeFileDriverArgs(),
+		DriverQueueFactoryArgs:     scf.makeDriverQueueArgs(),
 	}
 
 	return outportDriverFactory.CreateOutport(outportFactoryArgs)
@@ -251,6 +254,47 @@ func (scf *statusComponentsFactory) makeEventNotifierArgs() *outportDriverFactor
 		Marshaller:       scf.coreComponents.InternalMarshalizer(),
 		Hasher:           scf.coreComponents.Hasher(),
 		PubKeyConverter:  scf.coreComponents.AddressPubKeyConverter(),
+		Subscriptions:    eventNotifierConfig.Subscriptions,
+	}
+}
+
+func (scf *statusComponentsFactory) makeWebSocketDriverArgs() *outportDriverFactory.WebSocketDriverFactoryArgs {
+	webSocketConfig := scf.externalConfig.WebSocketConnector
+	return &outportDriverFactory.WebSocketDriverFactoryArgs{
+		Enabled:                 webSocketConfig.Enabled,
+		URL:                     webSocketConfig.URL,
+		WithAcknowledge:         webSocketConfig.WithAcknowledge,
+		AcknowledgeTimeoutInSec: webSocketConfig.AcknowledgeTimeoutInSec,
+		RetainedEventsCount:     webSocketConfig.RetainedEventsCount,
+		MaxSubscribers:          webSocketConfig.MaxSubscribers,
+		Marshaller:              scf.coreComponents.InternalMarshalizer(),
+		Hasher:                  scf.coreComponents.Hasher(),
+	}
+}
+
+func (scf *statusComponentsFactory) makeFileDriverArgs() *outportDriverFactory.FileDriverFactoryArgs {
+	fileDriverConfig := scf.externalConfig.FileDriverConnector
+	return &outportDriverFactory.FileDriverFactoryArgs{
+		Enabled:             fileDriverConfig.Enabled,
+		Directory:           fileDriverConfig.Directory,
+		Format:              fileDriverConfig.Format,
+		MaxFileSizeInMB:     fileDriverConfig.MaxFileSizeInMB,
+		RotateOnEpochChange: fileDriverConfig.RotateOnEpochChange,
+		Marshaller:          scf.coreComponents.InternalMarshalizer(),
+	}
+}
+
+func (scf *statusComponentsFactory) makeDriverQueueArgs() *outportDriverFactory.DriverQueueFactoryArgs {
+	outportQueueConfig := scf.externalConfig.OutportQueue
+	return &outportDriverFactory.DriverQueueFactoryArgs{
+		Enabled:         outportQueueConfig.Enabled,
+		MaxBacklog:      outportQueueConfig.MaxBacklog,
+		RetrialInterval: common.RetrialIntervalForOutportDriver,
+		DBConfig:        outportQueueConfig.DB,
+		PathManager:     scf.coreComponents.PathHandler(),
+		ShardID:         core.GetShardIDString(scf.shardCoordinator.SelfId()),
+		Marshaller:      scf.coreComponents.InternalMarshalizer(),
+		StatusHandler:   scf.coreComponents.StatusHandler(),
 	}
 }
 
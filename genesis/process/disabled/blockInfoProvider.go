@@ -0,0 +1,21 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// BlockInfoProvider implements the BlockInfoProvider interface, it does nothing as it is disabled
+type BlockInfoProvider struct {
+}
+
+// GetBlockInfo returns an error as no historical block can be queried during genesis
+func (bip *BlockInfoProvider) GetBlockInfo(_ common.BlockQueryOptions) (data.HeaderHandler, []byte, []byte, error) {
+	return nil, nil, nil, process.ErrInvalidBlockQueryOptions
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (bip *BlockInfoProvider) IsInterfaceNil() bool {
+	return bip == nil
+}
@@ -0,0 +1,20 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// StateOverridesHandler implements the StateOverridesHandler interface, it does nothing as it is disabled
+type StateOverridesHandler struct {
+}
+
+// RunWithStateOverrides returns an error as the state can not be overridden during genesis
+func (soh *StateOverridesHandler) RunWithStateOverrides(_ []*common.AccountStateOverride, _ func() error) error {
+	return process.ErrStateOverridesNotSupported
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (soh *StateOverridesHandler) IsInterfaceNil() bool {
+	return soh == nil
+}
@@ -70,6 +70,7 @@ func CreateDefaultConfig() *config.Configs {
 	}
 	configs.ConfigurationPathsHolder = configPathsHolder
 	configs.ImportDbConfig = &config.ImportDbConfig{}
+	configs.OutportReplayConfig = &config.OutportReplayConfig{}
 
 	return configs
 }
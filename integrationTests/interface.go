@@ -1,7 +1,9 @@
 package integrationTests
 
 import (
+	"context"
 	"math/big"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	dataApi "github.com/ElrondNetwork/elrond-go-core/data/api"
@@ -53,16 +55,16 @@ type NetworkShardingUpdater interface {
 
 // Facade is the node facade used to decouple the node implementation with the web server. Used in integration tests
 type Facade interface {
-	GetBalance(address string) (*big.Int, error)
-	GetUsername(address string) (string, error)
-	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (dataApi.AccountResponse, error)
-	GetESDTData(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
+	GetBalance(address string, options common.BlockQueryOptions) (*big.Int, common.BlockInfo, error)
+	GetUsername(address string, options common.BlockQueryOptions) (string, common.BlockInfo, error)
+	GetValueForKey(address string, key string, options common.BlockQueryOptions) (string, common.BlockInfo, error)
+	GetAccount(address string, options common.BlockQueryOptions) (dataApi.AccountResponse, common.BlockInfo, error)
+	GetESDTData(address string, key string, nonce uint64, options common.BlockQueryOptions) (*esdt.ESDigitalToken, common.BlockInfo, error)
 	GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error)
 	GetESDTsWithRole(address string, role string) ([]string, error)
-	GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error)
+	GetAllESDTTokens(address string, options common.BlockQueryOptions) (map[string]*esdt.ESDigitalToken, common.BlockInfo, error)
 	GetESDTsRoles(address string) (map[string][]string, error)
-	GetKeyValuePairs(address string) (map[string]string, error)
+	GetKeyValuePairs(address string, options common.BlockQueryOptions) (map[string]string, common.BlockInfo, error)
 	GetBlockByHash(hash string, withTxs bool) (*dataApi.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*dataApi.Block, error)
 	GetBlockByRound(round uint64, withTxs bool) (*dataApi.Block, error)
@@ -79,10 +81,15 @@ type Facade interface {
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
+	CreateRelayedTransaction(innerTx *transaction.Transaction, relayer string, relayerNonce uint64, relayerSignatureHex string) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, bypassSignature bool) error
+	ValidateTransactionForBundleSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SendTransactionsWithReceipts(txs []*transaction.Transaction) ([]*common.TransactionSendReceiptAPIResponse, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	GetMaxTransactionsInBundle() uint32
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
@@ -95,6 +102,16 @@ type Facade interface {
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
 	GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string, error)
+	GetTransactionProcessStatus(txHash string) (*common.TransactionProcessStatusAPIResponse, error)
+	WaitForTransactionsInclusion(ctx context.Context, txsHashes []string, timeout time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error)
 	GetTransactionsPool() (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPoolForSender(sender string) (*common.TransactionsPoolSenderAPIResponse, error)
+	GetTransactionsPoolByFilter(filter common.TransactionsPoolFilter) ([]*common.TransactionsPoolEntryAPIResponse, error)
+	GetMaxTransactionsPoolFilterResults() uint32
+	SubscribeToTransactionsPoolEvents(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error)
+	GetGasPriceSuggestions() (*common.GasPriceSuggestionsAPIResponse, error)
+	GetStateStatistics() (*common.StateStatisticsAPIResponse, error)
+	GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error)
+	GetLogEvents(address string, query common.LogEventsQuery) ([]*common.LogEventAPIResponse, error)
 	IsInterfaceNil() bool
 }
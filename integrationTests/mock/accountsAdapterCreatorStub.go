@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// AccountsAdapterCreatorStub -
+type AccountsAdapterCreatorStub struct {
+	CreateAccountsAdapterCalled func() (state.AccountsAdapter, error)
+}
+
+// CreateAccountsAdapter -
+func (stub *AccountsAdapterCreatorStub) CreateAccountsAdapter() (state.AccountsAdapter, error) {
+	if stub.CreateAccountsAdapterCalled != nil {
+		return stub.CreateAccountsAdapterCalled()
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *AccountsAdapterCreatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
 	"github.com/ElrondNetwork/elrond-go/outport"
@@ -56,6 +58,11 @@ func (n *nilOutport) SubscribeDriver(_ outport.Driver) error {
 	return nil
 }
 
+// Flush -
+func (n *nilOutport) Flush(_ context.Context) error {
+	return nil
+}
+
 // HasDrivers -
 func (n *nilOutport) HasDrivers() bool {
 	return false
@@ -1,13 +1,17 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 )
 
 // TransactionSimulatorStub -
 type TransactionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxCalled            func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxWithOptionsCalled func(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ProcessBundleCalled        func(ctx context.Context, txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
 }
 
 // ProcessTx -
@@ -19,6 +23,24 @@ func (tss *TransactionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*tx
 	return nil, nil
 }
 
+// ProcessTxWithOptions -
+func (tss *TransactionSimulatorStub) ProcessTxWithOptions(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	if tss.ProcessTxWithOptionsCalled != nil {
+		return tss.ProcessTxWithOptionsCalled(tx, options)
+	}
+
+	return nil, nil
+}
+
+// ProcessBundle -
+func (tss *TransactionSimulatorStub) ProcessBundle(ctx context.Context, txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if tss.ProcessBundleCalled != nil {
+		return tss.ProcessBundleCalled(ctx, txs)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (tss *TransactionSimulatorStub) IsInterfaceNil() bool {
 	return tss == nil
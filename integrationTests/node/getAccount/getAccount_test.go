@@ -1,10 +1,14 @@
 package getAccount
 
 import (
+	"encoding/hex"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,7 +30,7 @@ func TestNode_GetAccountAccountDoesNotExistsShouldRetEmpty(t *testing.T) {
 	)
 
 	encodedAddress := integrationTests.TestAddressPubkeyConverter.Encode(integrationTests.CreateRandomBytes(32))
-	recovAccnt, err := n.GetAccount(encodedAddress)
+	recovAccnt, _, err := n.GetAccount(encodedAddress, common.BlockQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(0), recovAccnt.Nonce)
@@ -61,8 +65,54 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 	encodedAddress := integrationTests.TestAddressPubkeyConverter.Encode(addressBytes)
-	recovAccnt, err := n.GetAccount(encodedAddress)
+	recovAccnt, _, err := n.GetAccount(encodedAddress, common.BlockQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, nonce, recovAccnt.Nonce)
 }
+
+func TestNode_GetAccountAtPastRootHashShouldReturnOldState(t *testing.T) {
+	t.Parallel()
+
+	trieStorage, _ := integrationTests.CreateTrieStorageManager(integrationTests.CreateMemUnit())
+	accDB, _ := integrationTests.CreateAccountsDB(0, trieStorage)
+
+	addressBytes := integrationTests.CreateRandomBytes(32)
+	account, _ := accDB.LoadAccount(addressBytes)
+	account.IncreaseNonce(1)
+	_ = accDB.SaveAccount(account)
+	oldRootHash, _ := accDB.Commit()
+
+	account, _ = accDB.LoadAccount(addressBytes)
+	account.IncreaseNonce(1)
+	_ = accDB.SaveAccount(account)
+	_, _ = accDB.Commit()
+
+	coreComponents := integrationTests.GetDefaultCoreComponents()
+	coreComponents.AddressPubKeyConverterField = integrationTests.TestAddressPubkeyConverter
+
+	stateComponents := integrationTests.GetDefaultStateComponents()
+	stateComponents.AccountsAPI = accDB
+
+	blockInfoProvider := &testscommon.BlockInfoProviderStub{
+		GetBlockInfoCalled: func(options common.BlockQueryOptions) (data.HeaderHandler, []byte, []byte, error) {
+			return nil, nil, options.BlockRootHash, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithBlockInfoProvider(blockInfoProvider),
+	)
+	encodedAddress := integrationTests.TestAddressPubkeyConverter.Encode(addressBytes)
+
+	recovAccnt, _, err := n.GetAccount(encodedAddress, common.BlockQueryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), recovAccnt.Nonce)
+
+	recovAccnt, blockInfo, err := n.GetAccount(encodedAddress, common.BlockQueryOptions{BlockRootHash: oldRootHash})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), recovAccnt.Nonce)
+	assert.Equal(t, hex.EncodeToString(oldRootHash), blockInfo.RootHash)
+}
@@ -60,6 +60,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/block/postprocess"
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
+	"github.com/ElrondNetwork/elrond-go/process/blockInfo"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
@@ -558,6 +559,9 @@ func NewTestProcessorNodeWithFullGenesis(
 		EconomicsFee:             tpn.EconomicsData,
 		BlockChainHook:           tpn.BlockchainHook,
 		BlockChain:               tpn.BlockChain,
+		APIBlockChain:            tpn.createAPIBlockChain(),
+		BlockInfoProvider:        tpn.createBlockInfoProvider(),
+		StateOverridesHandler:    &testscommon.StateOverridesHandlerStub{},
 		ArwenChangeLocker:        tpn.ArwenChangeLocker,
 		Bootstrapper:             tpn.Bootstrapper,
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
@@ -765,6 +769,9 @@ func (tpn *TestProcessorNode) initTestNode() {
 		EconomicsFee:             tpn.EconomicsData,
 		BlockChainHook:           tpn.BlockchainHook,
 		BlockChain:               tpn.BlockChain,
+		APIBlockChain:            tpn.createAPIBlockChain(),
+		BlockInfoProvider:        tpn.createBlockInfoProvider(),
+		StateOverridesHandler:    &testscommon.StateOverridesHandlerStub{},
 		ArwenChangeLocker:        tpn.ArwenChangeLocker,
 		Bootstrapper:             tpn.Bootstrapper,
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
@@ -974,6 +981,9 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 		EconomicsFee:             tpn.EconomicsData,
 		BlockChainHook:           vmFactory.BlockChainHookImpl(),
 		BlockChain:               tpn.BlockChain,
+		APIBlockChain:            tpn.createAPIBlockChain(),
+		BlockInfoProvider:        tpn.createBlockInfoProvider(),
+		StateOverridesHandler:    &testscommon.StateOverridesHandlerStub{},
 		ArwenChangeLocker:        tpn.ArwenChangeLocker,
 		Bootstrapper:             tpn.Bootstrapper,
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
@@ -991,6 +1001,9 @@ func (tpn *TestProcessorNode) InitializeProcessors(gasMap map[string]map[string]
 		EconomicsFee:             tpn.EconomicsData,
 		BlockChainHook:           tpn.BlockchainHook,
 		BlockChain:               tpn.BlockChain,
+		APIBlockChain:            tpn.createAPIBlockChain(),
+		BlockInfoProvider:        tpn.createBlockInfoProvider(),
+		StateOverridesHandler:    &testscommon.StateOverridesHandlerStub{},
 		ArwenChangeLocker:        tpn.ArwenChangeLocker,
 		Bootstrapper:             tpn.Bootstrapper,
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
@@ -2307,6 +2320,7 @@ func (tpn *TestProcessorNode) initNode() {
 		node.WithStateComponents(stateComponents),
 		node.WithPeerDenialEvaluator(&mock.PeerDenialEvaluatorStub{}),
 		node.WithHardforkTrigger(&mock.HardforkTriggerStub{}),
+		node.WithBlockInfoProvider(tpn.createBlockInfoProvider()),
 	)
 	log.LogIfError(err)
 
@@ -2762,6 +2776,27 @@ func (tpn *TestProcessorNode) initRequestedItemsHandler() {
 	tpn.RequestedItemsHandler = timecache.NewTimeCache(roundDuration)
 }
 
+func (tpn *TestProcessorNode) createAPIBlockChain() data.ChainHandler {
+	if tpn.ShardCoordinator.SelfId() == core.MetachainShardId {
+		return CreateMetaChain()
+	}
+
+	return CreateShardChain()
+}
+
+func (tpn *TestProcessorNode) createBlockInfoProvider() process.BlockInfoProvider {
+	argsBlockInfoProvider := blockInfo.ArgsBlockInfoProvider{
+		SelfShardID:              tpn.ShardCoordinator.SelfId(),
+		BlockChain:               tpn.BlockChain,
+		StorageService:           tpn.Storage,
+		Marshaller:               TestMarshalizer,
+		Uint64ByteSliceConverter: TestUint64Converter,
+	}
+	blockInfoProvider, _ := blockInfo.NewBlockInfoProvider(argsBlockInfoProvider)
+
+	return blockInfoProvider
+}
+
 func (tpn *TestProcessorNode) initBlockTracker() {
 	argBaseTracker := track.ArgBaseTracker{
 		Hasher:           TestHasher,
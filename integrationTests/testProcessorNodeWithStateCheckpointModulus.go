@@ -136,6 +136,9 @@ func NewTestProcessorNodeWithStateCheckpointModulus(
 		EconomicsFee:             tpn.EconomicsData,
 		BlockChainHook:           tpn.BlockchainHook,
 		BlockChain:               tpn.BlockChain,
+		APIBlockChain:            tpn.createAPIBlockChain(),
+		BlockInfoProvider:        tpn.createBlockInfoProvider(),
+		StateOverridesHandler:    &testscommon.StateOverridesHandlerStub{},
 		ArwenChangeLocker:        tpn.ArwenChangeLocker,
 		Bootstrapper:             tpn.Bootstrapper,
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
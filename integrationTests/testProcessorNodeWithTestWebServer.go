@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/external/blockAPI"
 	"github.com/ElrondNetwork/elrond-go/node/external/transactionAPI"
+	nodeMock "github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
 	"github.com/ElrondNetwork/elrond-go/node/trieIterators/factory"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
@@ -82,11 +83,15 @@ func createFacadeArg(tpn *TestProcessorNode) nodeFacade.ArgNodeFacade {
 		TxSimulatorProcessor:   txSimulator,
 		RestAPIServerDebugMode: false,
 		WsAntifloodConfig: config.WebServerAntifloodConfig{
-			SimultaneousRequests:               1000,
-			SameSourceRequests:                 1000,
-			SameSourceResetIntervalInSec:       1,
-			TrieOperationsDeadlineMilliseconds: 1,
-			EndpointsThrottlers:                []config.EndpointsThrottlersConfig{},
+			SimultaneousRequests:                 1000,
+			SameSourceRequests:                   1000,
+			SameSourceResetIntervalInSec:         1,
+			TrieOperationsDeadlineMilliseconds:   1,
+			MaxTransactionsInBundle:              50,
+			BundleSimulationDeadlineMilliseconds: 1000,
+			MaxTransactionsPoolFilterResults:     1000,
+			MaxPoolSubscriptions:                 10,
+			EndpointsThrottlers:                  []config.EndpointsThrottlersConfig{},
 		},
 		FacadeConfig:    config.FacadeConfig{},
 		ApiRoutesConfig: createTestApiConfig(),
@@ -198,6 +203,7 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		StorageService:           tpn.Storage,
 		DataPool:                 tpn.DataPool,
 		Uint64ByteSliceConverter: TestUint64Converter,
+		MaxPoolSubscriptions:     10,
 	}
 	apiTransactionHandler, err := transactionAPI.NewAPITransactionProcessor(argsApiTransactionProc)
 	log.LogIfError(err)
@@ -234,11 +240,16 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		APIInternalBlockHandler:  apiInternalBlockProcessor,
 		GenesisNodesSetupHandler: &mock.NodesSetupStub{},
 		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
+		GasPriceOracle:           &nodeMock.GasPriceOracleStub{},
+		StateStatisticsHandler:   &nodeMock.StateStatisticsHandlerStub{},
 	}
 
 	apiResolver, err := external.NewNodeApiResolver(argsApiResolver)
 	log.LogIfError(err)
 
+	bundleAccounts, err := txsimulator.NewReadOnlyAccountsDB(tpn.AccntState)
+	log.LogIfError(err)
+
 	argSimulator := txsimulator.ArgsTxSimulator{
 		TransactionProcessor:      tpn.TxProcessor,
 		IntermediateProcContainer: tpn.InterimProcContainer,
@@ -247,6 +258,8 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		Marshalizer:               TestMarshalizer,
 		Hasher:                    TestHasher,
 		VMOutputCacher:            &testscommon.CacherMock{},
+		BundleAccounts:            bundleAccounts,
+		BundleAccountsCreator:     &mock.AccountsAdapterCreatorStub{},
 	}
 
 	txSimulator, err := txsimulator.NewTransactionSimulator(argSimulator)
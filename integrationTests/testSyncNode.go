@@ -155,6 +155,9 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 		EconomicsFee:             tpn.EconomicsData,
 		BlockChainHook:           tpn.BlockchainHook,
 		BlockChain:               tpn.BlockChain,
+		APIBlockChain:            tpn.createAPIBlockChain(),
+		BlockInfoProvider:        tpn.createBlockInfoProvider(),
+		StateOverridesHandler:    &testscommon.StateOverridesHandlerStub{},
 		ArwenChangeLocker:        tpn.ArwenChangeLocker,
 		Bootstrapper:             tpn.Bootstrapper,
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
@@ -150,6 +150,9 @@ func SetupTestContextWithGasSchedulePath(t *testing.T, gasScheduleConfigPath str
 		EconomicsFee:             context.EconomicsFee,
 		BlockChainHook:           context.BlockchainHook,
 		BlockChain:               &testscommon.ChainHandlerStub{},
+		APIBlockChain:            &testscommon.ChainHandlerStub{},
+		BlockInfoProvider:        &testscommon.BlockInfoProviderStub{},
+		StateOverridesHandler:    &testscommon.StateOverridesHandlerStub{},
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             disabled.NewDisabledBootstrapper(),
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
@@ -41,6 +41,9 @@ func TestVmGetShouldReturnValue(t *testing.T) {
 		},
 		BlockChainHook:           &testscommon.BlockChainHookStub{},
 		BlockChain:               &testscommon.ChainHandlerStub{},
+		APIBlockChain:            &testscommon.ChainHandlerStub{},
+		BlockInfoProvider:        &testscommon.BlockInfoProviderStub{},
+		StateOverridesHandler:    &testscommon.StateOverridesHandlerStub{},
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             disabled.NewDisabledBootstrapper(),
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
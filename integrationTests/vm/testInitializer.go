@@ -233,6 +233,9 @@ func (vmTestContext *VMTestContext) GetVMOutputWithTransientVM(funcName string,
 		EconomicsFee:             feeHandler,
 		BlockChainHook:           vmTestContext.BlockchainHook.(process.BlockChainHookHandler),
 		BlockChain:               &testscommon.ChainHandlerStub{},
+		APIBlockChain:            &testscommon.ChainHandlerStub{},
+		BlockInfoProvider:        &testscommon.BlockInfoProviderStub{},
+		StateOverridesHandler:    &testscommon.StateOverridesHandlerStub{},
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             syncDisabled.NewDisabledBootstrapper(),
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
@@ -944,6 +947,8 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	}
 
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.BundleAccounts = readOnlyAccountsDB
+	txSimulatorProcessorArgs.BundleAccountsCreator = &mock.AccountsAdapterCreatorStub{}
 
 	txSimulator, err := txsimulator.NewTransactionSimulator(txSimulatorProcessorArgs)
 	if err != nil {
@@ -1369,6 +1374,9 @@ func GetVmOutput(gasSchedule map[string]map[string]uint64, accnts state.Accounts
 		EconomicsFee:             feeHandler,
 		BlockChainHook:           blockChainHook,
 		BlockChain:               &testscommon.ChainHandlerStub{},
+		APIBlockChain:            &testscommon.ChainHandlerStub{},
+		BlockInfoProvider:        &testscommon.BlockInfoProviderStub{},
+		StateOverridesHandler:    &testscommon.StateOverridesHandlerStub{},
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             syncDisabled.NewDisabledBootstrapper(),
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
@@ -1417,6 +1425,9 @@ func ComputeGasLimit(gasSchedule map[string]map[string]uint64, testContext *VMTe
 				}
 			},
 		},
+		APIBlockChain:            &testscommon.ChainHandlerStub{},
+		BlockInfoProvider:        &testscommon.BlockInfoProviderStub{},
+		StateOverridesHandler:    &testscommon.StateOverridesHandlerStub{},
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             syncDisabled.NewDisabledBootstrapper(),
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
@@ -132,3 +132,12 @@ var ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler = errors.New("ca
 
 // ErrTrieOperationsTimeout signals that a trie operation took too long
 var ErrTrieOperationsTimeout = errors.New("trie operations timeout")
+
+// ErrNilBlockInfoProvider signals that a nil block info provider has been provided
+var ErrNilBlockInfoProvider = errors.New("nil block info provider")
+
+// ErrNilInnerTransaction signals that a nil inner transaction has been provided
+var ErrNilInnerTransaction = errors.New("nil inner transaction")
+
+// ErrMissingInnerTransactionSignature signals that the inner transaction of a relayed transaction is not signed by its sender
+var ErrMissingInnerTransactionSignature = errors.New("the inner transaction is not signed by its sender")
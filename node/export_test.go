@@ -19,3 +19,8 @@ func (n *Node) ComputeProof(rootHash []byte, key []byte) (*common.GetProofRespon
 func (n *Node) AddClosableComponents(components ...factory.Closer) {
 	n.closableComponents = append(n.closableComponents, components...)
 }
+
+// GetTxRejectionReason -
+func GetTxRejectionReason(err error) common.TxRejectionReason {
+	return getTxRejectionReason(err)
+}
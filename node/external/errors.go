@@ -40,3 +40,9 @@ var ErrNilGenesisNodesSetupHandler = errors.New("nil genesis nodes setup handler
 
 // ErrNilValidatorPubKeyConverter signals that a nil validator pubkey converter has been provided
 var ErrNilValidatorPubKeyConverter = errors.New("nil validator public key converter")
+
+// ErrNilGasPriceOracle signals that a nil gas price oracle has been provided
+var ErrNilGasPriceOracle = errors.New("nil gas price oracle")
+
+// ErrNilStateStatisticsHandler signals that a nil state statistics handler has been provided
+var ErrNilStateStatisticsHandler = errors.New("nil state statistics handler")
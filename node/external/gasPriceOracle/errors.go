@@ -0,0 +1,12 @@
+package gasPriceOracle
+
+import "errors"
+
+// ErrNilArgGasPriceOracle signals that a nil arguments structure has been provided
+var ErrNilArgGasPriceOracle = errors.New("nil gas price oracle arg")
+
+// ErrNilBlockHandler signals that a nil api block handler has been provided
+var ErrNilBlockHandler = errors.New("nil api block handler")
+
+// ErrInvalidNumRecentBlocks signals that an invalid number of recent blocks has been provided
+var ErrInvalidNumRecentBlocks = errors.New("invalid number of recent blocks")
@@ -0,0 +1,324 @@
+package gasPriceOracle
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+var log = logger.GetOrCreate("node/external/gasPriceOracle")
+
+const (
+	// congestedBlockFullness is the average fullness of the recent blocks starting from which the gas prices they
+	// included are taken into account. Below it, any transaction paying the minimum gas price is expected to be included
+	congestedBlockFullness = 0.8
+
+	slowPercentile   = 25
+	normalPercentile = 50
+	fastPercentile   = 90
+
+	// the number of blocks within which a transaction is expected to be included, for each suggestion
+	slowNumBlocks   = 10
+	normalNumBlocks = 3
+	fastNumBlocks   = 1
+)
+
+// ArgsGasPriceOracle holds the arguments needed to create a gas price oracle
+type ArgsGasPriceOracle struct {
+	BlockHandler     BlockHandler
+	BlockChain       data.ChainHandler
+	DataPool         dataRetriever.PoolsHolder
+	ShardCoordinator sharding.Coordinator
+	EconomicsHandler EconomicsHandler
+	NumRecentBlocks  uint32
+}
+
+type blockStats struct {
+	hash          string
+	prevBlockHash string
+	gasPrices     []uint64
+	fullness      float64
+}
+
+type pendingTx struct {
+	gasPrice uint64
+	gasLimit uint64
+}
+
+type gasPriceOracle struct {
+	blockHandler     BlockHandler
+	blockChain       data.ChainHandler
+	dataPool         dataRetriever.PoolsHolder
+	shardCoordinator sharding.Coordinator
+	economicsHandler EconomicsHandler
+	numRecentBlocks  uint32
+
+	mutBlocksStats sync.Mutex
+	blocksStats    map[uint64]*blockStats
+
+	mutSuggestions        sync.Mutex
+	suggestions           *common.GasPriceSuggestionsAPIResponse
+	suggestionsHeaderHash []byte
+}
+
+// NewGasPriceOracle creates a component able to suggest gas prices for the transactions of the shard, based on the
+// recent blocks and on the transactions waiting in the pool
+func NewGasPriceOracle(args *ArgsGasPriceOracle) (*gasPriceOracle, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gasPriceOracle{
+		blockHandler:     args.BlockHandler,
+		blockChain:       args.BlockChain,
+		dataPool:         args.DataPool,
+		shardCoordinator: args.ShardCoordinator,
+		economicsHandler: args.EconomicsHandler,
+		numRecentBlocks:  args.NumRecentBlocks,
+		blocksStats:      make(map[uint64]*blockStats),
+	}, nil
+}
+
+func checkArgs(args *ArgsGasPriceOracle) error {
+	if args == nil {
+		return ErrNilArgGasPriceOracle
+	}
+	if check.IfNil(args.BlockHandler) {
+		return ErrNilBlockHandler
+	}
+	if check.IfNil(args.BlockChain) {
+		return process.ErrNilBlockChain
+	}
+	if check.IfNil(args.DataPool) {
+		return process.ErrNilDataPoolHolder
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.EconomicsHandler) {
+		return process.ErrNilEconomicsData
+	}
+	if args.NumRecentBlocks == 0 {
+		return ErrInvalidNumRecentBlocks
+	}
+
+	return nil
+}
+
+// GetGasPriceSuggestions returns the slow, normal and fast gas prices suggested for the transactions of the shard.
+// The recent blocks contribute with the gas prices they included only if they were (almost) full, while the pool
+// contributes with the gas price needed to outbid the transactions which would fill the blocks to come. The suggestions
+// are cached and recomputed only when the current block changes
+func (oracle *gasPriceOracle) GetGasPriceSuggestions() (*common.GasPriceSuggestionsAPIResponse, error) {
+	currentHeaderHash := oracle.blockChain.GetCurrentBlockHeaderHash()
+
+	oracle.mutSuggestions.Lock()
+	defer oracle.mutSuggestions.Unlock()
+
+	isCached := oracle.suggestions != nil && len(currentHeaderHash) > 0 && bytes.Equal(oracle.suggestionsHeaderHash, currentHeaderHash)
+	if !isCached {
+		oracle.suggestions = oracle.computeGasPriceSuggestions()
+		oracle.suggestionsHeaderHash = currentHeaderHash
+	}
+
+	suggestions := *oracle.suggestions
+
+	return &suggestions, nil
+}
+
+func (oracle *gasPriceOracle) computeGasPriceSuggestions() *common.GasPriceSuggestionsAPIResponse {
+	selfShardID := oracle.shardCoordinator.SelfId()
+	minGasPrice := oracle.economicsHandler.MinGasPrice()
+	maxGasLimitPerBlock := oracle.economicsHandler.MaxGasLimitPerBlock(selfShardID)
+
+	recentStats := oracle.getRecentBlocksStats(selfShardID, maxGasLimitPerBlock)
+	recentGasPrices := make([]uint64, 0)
+	averageFullness := 0.0
+	for _, stats := range recentStats {
+		recentGasPrices = append(recentGasPrices, stats.gasPrices...)
+		averageFullness += stats.fullness
+	}
+	if len(recentStats) > 0 {
+		averageFullness /= float64(len(recentStats))
+	}
+	if averageFullness < congestedBlockFullness {
+		recentGasPrices = recentGasPrices[:0]
+	}
+	sort.Slice(recentGasPrices, func(i, j int) bool {
+		return recentGasPrices[i] < recentGasPrices[j]
+	})
+
+	pendingTxs := oracle.getPendingTransactions(selfShardID)
+	sort.Slice(pendingTxs, func(i, j int) bool {
+		return pendingTxs[i].gasPrice > pendingTxs[j].gasPrice
+	})
+	pendingGas := uint64(0)
+	for _, tx := range pendingTxs {
+		pendingGas += tx.gasLimit
+	}
+	pendingBlocks := 0.0
+	if maxGasLimitPerBlock > 0 {
+		pendingBlocks = float64(pendingGas) / float64(maxGasLimitPerBlock)
+	}
+
+	return &common.GasPriceSuggestionsAPIResponse{
+		ShardID:              selfShardID,
+		MinGasPrice:          minGasPrice,
+		Slow:                 maxUint64(minGasPrice, percentile(recentGasPrices, slowPercentile), clearingGasPrice(pendingTxs, slowNumBlocks*maxGasLimitPerBlock)),
+		Normal:               maxUint64(minGasPrice, percentile(recentGasPrices, normalPercentile), clearingGasPrice(pendingTxs, normalNumBlocks*maxGasLimitPerBlock)),
+		Fast:                 maxUint64(minGasPrice, percentile(recentGasPrices, fastPercentile), clearingGasPrice(pendingTxs, fastNumBlocks*maxGasLimitPerBlock)),
+		NumRecentBlocks:      uint32(len(recentStats)),
+		AverageBlockFullness: averageFullness,
+		PendingTransactions:  uint64(len(pendingTxs)),
+		PendingBlocks:        pendingBlocks,
+	}
+}
+
+// getRecentBlocksStats walks the chain backwards, starting from the current block. The stats of the blocks are cached,
+// the cached entries being reused only while they are linked to the current block through the previous block hashes
+func (oracle *gasPriceOracle) getRecentBlocksStats(selfShardID uint32, maxGasLimitPerBlock uint64) []*blockStats {
+	currentHeader := oracle.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return nil
+	}
+
+	oracle.mutBlocksStats.Lock()
+	defer oracle.mutBlocksStats.Unlock()
+
+	expectedHash := hex.EncodeToString(oracle.blockChain.GetCurrentBlockHeaderHash())
+	recentStats := make([]*blockStats, 0, oracle.numRecentBlocks)
+	recentNonces := make(map[uint64]struct{}, oracle.numRecentBlocks)
+	for nonce := currentHeader.GetNonce(); nonce > 0 && len(recentStats) < int(oracle.numRecentBlocks); nonce-- {
+		stats, ok := oracle.blocksStats[nonce]
+		if !ok || stats.hash != expectedHash {
+			apiBlock, err := oracle.blockHandler.GetBlockByNonce(nonce, true)
+			if err != nil {
+				log.Debug("gasPriceOracle.getRecentBlocksStats: cannot get block", "nonce", nonce, "error", err)
+				break
+			}
+
+			stats = computeBlockStats(apiBlock, selfShardID, maxGasLimitPerBlock)
+			oracle.blocksStats[nonce] = stats
+		}
+
+		recentStats = append(recentStats, stats)
+		recentNonces[nonce] = struct{}{}
+		expectedHash = stats.prevBlockHash
+	}
+
+	for nonce := range oracle.blocksStats {
+		_, isRecent := recentNonces[nonce]
+		if !isRecent {
+			delete(oracle.blocksStats, nonce)
+		}
+	}
+
+	return recentStats
+}
+
+func computeBlockStats(apiBlock *api.Block, selfShardID uint32, maxGasLimitPerBlock uint64) *blockStats {
+	stats := &blockStats{
+		hash:          apiBlock.Hash,
+		prevBlockHash: apiBlock.PrevBlockHash,
+		gasPrices:     make([]uint64, 0),
+	}
+
+	usedGas := uint64(0)
+	for _, miniBlock := range apiBlock.MiniBlocks {
+		if miniBlock.Type != block.TxBlock.String() {
+			continue
+		}
+
+		for _, tx := range miniBlock.Transactions {
+			usedGas += tx.GasLimit
+			// only the transactions of the shard competed against the ones the suggestions are made for
+			if miniBlock.SourceShard == selfShardID {
+				stats.gasPrices = append(stats.gasPrices, tx.GasPrice)
+			}
+		}
+	}
+
+	if maxGasLimitPerBlock > 0 {
+		stats.fullness = math.Min(float64(usedGas)/float64(maxGasLimitPerBlock), 1)
+	}
+
+	return stats
+}
+
+func (oracle *gasPriceOracle) getPendingTransactions(selfShardID uint32) []*pendingTx {
+	pendingTxs := make([]*pendingTx, 0)
+
+	cacheID := process.ShardCacherIdentifier(selfShardID, selfShardID)
+	iterator, ok := oracle.dataPool.Transactions().ShardDataStore(cacheID).(txsIterator)
+	if !ok {
+		return pendingTxs
+	}
+
+	iterator.ForEachTransaction(func(_ []byte, wrappedTx *txcache.WrappedTransaction) {
+		pendingTxs = append(pendingTxs, &pendingTx{
+			gasPrice: wrappedTx.Tx.GetGasPrice(),
+			gasLimit: wrappedTx.Tx.GetGasLimit(),
+		})
+	})
+
+	return pendingTxs
+}
+
+// clearingGasPrice returns the gas price outbidding the pending transaction which would not fit anymore in the given
+// gas budget, if the pending transactions were included in blocks in the descending order of their gas prices. It
+// returns 0 if all the pending transactions fit in the budget
+func clearingGasPrice(sortedPendingTxs []*pendingTx, gasBudget uint64) uint64 {
+	usedGas := uint64(0)
+	for _, tx := range sortedPendingTxs {
+		usedGas += tx.gasLimit
+		if usedGas > gasBudget {
+			return tx.gasPrice + 1
+		}
+	}
+
+	return 0
+}
+
+// percentile returns the nearest-rank percentile of the sorted values, or 0 if there are no values
+func percentile(sortedValues []uint64, p int) uint64 {
+	if len(sortedValues) == 0 {
+		return 0
+	}
+
+	rank := int(math.Ceil(float64(p) / 100 * float64(len(sortedValues))))
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sortedValues[rank-1]
+}
+
+func maxUint64(values ...uint64) uint64 {
+	result := uint64(0)
+	for _, value := range values {
+		if value > result {
+			result = value
+		}
+	}
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (oracle *gasPriceOracle) IsInterfaceNil() bool {
+	return oracle == nil
+}
@@ -0,0 +1,355 @@
+package gasPriceOracle
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	minGasPrice         = uint64(1000000000)
+	maxGasLimitPerBlock = uint64(1000000)
+)
+
+func createMockArgs() *ArgsGasPriceOracle {
+	return &ArgsGasPriceOracle{
+		BlockHandler: &mock.BlockAPIHandlerStub{},
+		BlockChain:   &testscommon.ChainHandlerStub{},
+		DataPool: &dataRetrieverMock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &testscommon.ShardedDataStub{
+					ShardDataStoreCalled: func(_ string) storage.Cacher {
+						return nil
+					},
+				}
+			},
+		},
+		ShardCoordinator: &mock.ShardCoordinatorMock{SelfShardId: 0},
+		EconomicsHandler: &economicsmocks.EconomicsHandlerStub{
+			MinGasPriceCalled: func() uint64 {
+				return minGasPrice
+			},
+			MaxGasLimitPerBlockCalled: func(_ uint32) uint64 {
+				return maxGasLimitPerBlock
+			},
+		},
+		NumRecentBlocks: 4,
+	}
+}
+
+func blockHash(nonce uint64) string {
+	return hex.EncodeToString([]byte(fmt.Sprintf("hash-%d", nonce)))
+}
+
+// createChain returns a chain of blocks, each one using the given gas for transactions of the given gas prices
+func createChain(args *ArgsGasPriceOracle, currentNonce uint64, gasLimitPerTx uint64, gasPrices []uint64) *int {
+	numFetches := 0
+	args.BlockChain = &testscommon.ChainHandlerStub{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: currentNonce}
+		},
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			return []byte(fmt.Sprintf("hash-%d", currentNonce))
+		},
+	}
+	args.BlockHandler = &mock.BlockAPIHandlerStub{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			numFetches++
+			txs := make([]*transaction.ApiTransactionResult, 0, len(gasPrices))
+			for _, gasPrice := range gasPrices {
+				txs = append(txs, &transaction.ApiTransactionResult{GasPrice: gasPrice, GasLimit: gasLimitPerTx})
+			}
+
+			return &api.Block{
+				Nonce:         nonce,
+				Hash:          blockHash(nonce),
+				PrevBlockHash: blockHash(nonce - 1),
+				MiniBlocks: []*api.MiniBlock{
+					{Type: block.TxBlock.String(), SourceShard: 0, DestinationShard: 0, Transactions: txs},
+					{Type: block.SmartContractResultBlock.String(), Transactions: txs},
+				},
+			}, nil
+		},
+	}
+
+	return &numFetches
+}
+
+func createPool(t *testing.T, args *ArgsGasPriceOracle, txs ...*transaction.Transaction) {
+	cache, err := txcache.NewTxCache(txcache.ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  4,
+		NumBytesPerSenderThreshold: 1048576,
+		CountPerSenderThreshold:    1000,
+	}, &txcachemocks.TxGasHandlerMock{
+		MinimumGasMove:       50000,
+		MinimumGasPrice:      minGasPrice,
+		GasProcessingDivisor: 100,
+	})
+	require.Nil(t, err)
+
+	for i, tx := range txs {
+		cache.AddTx(&txcache.WrappedTransaction{
+			Tx:     tx,
+			TxHash: []byte(fmt.Sprintf("tx-%d", i)),
+			Size:   128,
+		})
+	}
+
+	args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{
+				ShardDataStoreCalled: func(cacheID string) storage.Cacher {
+					assert.Equal(t, process.ShardCacherIdentifier(0, 0), cacheID)
+					return cache
+				},
+			}
+		},
+	}
+}
+
+func TestNewGasPriceOracle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil args should error", func(t *testing.T) {
+		t.Parallel()
+
+		oracle, err := NewGasPriceOracle(nil)
+		assert.True(t, check.IfNil(oracle))
+		assert.Equal(t, ErrNilArgGasPriceOracle, err)
+	})
+	t.Run("nil block handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.BlockHandler = nil
+		oracle, err := NewGasPriceOracle(args)
+		assert.True(t, check.IfNil(oracle))
+		assert.Equal(t, ErrNilBlockHandler, err)
+	})
+	t.Run("nil blockchain should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.BlockChain = nil
+		oracle, err := NewGasPriceOracle(args)
+		assert.True(t, check.IfNil(oracle))
+		assert.Equal(t, process.ErrNilBlockChain, err)
+	})
+	t.Run("nil data pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.DataPool = nil
+		oracle, err := NewGasPriceOracle(args)
+		assert.True(t, check.IfNil(oracle))
+		assert.Equal(t, process.ErrNilDataPoolHolder, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ShardCoordinator = nil
+		oracle, err := NewGasPriceOracle(args)
+		assert.True(t, check.IfNil(oracle))
+		assert.Equal(t, process.ErrNilShardCoordinator, err)
+	})
+	t.Run("nil economics should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.EconomicsHandler = nil
+		oracle, err := NewGasPriceOracle(args)
+		assert.True(t, check.IfNil(oracle))
+		assert.Equal(t, process.ErrNilEconomicsData, err)
+	})
+	t.Run("zero recent blocks should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.NumRecentBlocks = 0
+		oracle, err := NewGasPriceOracle(args)
+		assert.True(t, check.IfNil(oracle))
+		assert.Equal(t, ErrInvalidNumRecentBlocks, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		oracle, err := NewGasPriceOracle(createMockArgs())
+		assert.False(t, check.IfNil(oracle))
+		assert.Nil(t, err)
+	})
+}
+
+func TestGasPriceOracle_GetGasPriceSuggestions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no blocks and empty pool should suggest the minimum gas price", func(t *testing.T) {
+		t.Parallel()
+
+		oracle, _ := NewGasPriceOracle(createMockArgs())
+		suggestions, err := oracle.GetGasPriceSuggestions()
+		require.Nil(t, err)
+		assert.Equal(t, minGasPrice, suggestions.MinGasPrice)
+		assert.Equal(t, minGasPrice, suggestions.Slow)
+		assert.Equal(t, minGasPrice, suggestions.Normal)
+		assert.Equal(t, minGasPrice, suggestions.Fast)
+		assert.Equal(t, uint32(0), suggestions.NumRecentBlocks)
+	})
+	t.Run("recent blocks which are not full should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		createChain(args, 10, 100000, []uint64{5 * minGasPrice, 6 * minGasPrice})
+		oracle, _ := NewGasPriceOracle(args)
+
+		suggestions, err := oracle.GetGasPriceSuggestions()
+		require.Nil(t, err)
+		assert.Equal(t, uint32(4), suggestions.NumRecentBlocks)
+		assert.InDelta(t, 0.2, suggestions.AverageBlockFullness, 0.0001)
+		assert.Equal(t, minGasPrice, suggestions.Slow)
+		assert.Equal(t, minGasPrice, suggestions.Normal)
+		assert.Equal(t, minGasPrice, suggestions.Fast)
+	})
+	t.Run("full recent blocks should give the percentiles of the included gas prices", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		gasPrices := make([]uint64, 0, 10)
+		for i := uint64(1); i <= 10; i++ {
+			gasPrices = append(gasPrices, i*minGasPrice)
+		}
+		createChain(args, 10, 100000, gasPrices)
+		oracle, _ := NewGasPriceOracle(args)
+
+		suggestions, err := oracle.GetGasPriceSuggestions()
+		require.Nil(t, err)
+		assert.Equal(t, 1.0, suggestions.AverageBlockFullness)
+		assert.Equal(t, 3*minGasPrice, suggestions.Slow)
+		assert.Equal(t, 5*minGasPrice, suggestions.Normal)
+		assert.Equal(t, 9*minGasPrice, suggestions.Fast)
+	})
+	t.Run("crowded pool should give the gas prices clearing the blocks to come", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		txs := make([]*transaction.Transaction, 0)
+		// 20 blocks worth of transactions: the first block goes to the highest paying ones
+		for i := uint64(0); i < 40; i++ {
+			txs = append(txs, &transaction.Transaction{
+				SndAddr:  []byte(fmt.Sprintf("sender-%d", i)),
+				GasPrice: minGasPrice + i,
+				GasLimit: maxGasLimitPerBlock / 2,
+			})
+		}
+		createPool(t, args, txs...)
+		oracle, _ := NewGasPriceOracle(args)
+
+		suggestions, err := oracle.GetGasPriceSuggestions()
+		require.Nil(t, err)
+		assert.Equal(t, uint64(40), suggestions.PendingTransactions)
+		assert.Equal(t, 20.0, suggestions.PendingBlocks)
+		assert.Equal(t, minGasPrice+20, suggestions.Slow)
+		assert.Equal(t, minGasPrice+34, suggestions.Normal)
+		assert.Equal(t, minGasPrice+38, suggestions.Fast)
+	})
+	t.Run("cached blocks should be reused while linked to the current block", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		numFetches := createChain(args, 10, 100000, []uint64{minGasPrice})
+		oracle, _ := NewGasPriceOracle(args)
+
+		_, _ = oracle.GetGasPriceSuggestions()
+		_, _ = oracle.GetGasPriceSuggestions()
+		assert.Equal(t, 4, *numFetches)
+
+		currentNonce := uint64(11)
+		args.BlockChain.(*testscommon.ChainHandlerStub).GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+			return &block.Header{Nonce: currentNonce}
+		}
+		args.BlockChain.(*testscommon.ChainHandlerStub).GetCurrentBlockHeaderHashCalled = func() []byte {
+			return []byte(fmt.Sprintf("hash-%d", currentNonce))
+		}
+		_, _ = oracle.GetGasPriceSuggestions()
+		assert.Equal(t, 5, *numFetches)
+		assert.Equal(t, 4, len(oracle.blocksStats))
+	})
+	t.Run("suggestions should be cached while the current block does not change", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		numFetches := createChain(args, 10, 100000, []uint64{minGasPrice})
+		numPoolReads := 0
+		args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				numPoolReads++
+				return &testscommon.ShardedDataStub{
+					ShardDataStoreCalled: func(_ string) storage.Cacher {
+						return nil
+					},
+				}
+			},
+		}
+		oracle, _ := NewGasPriceOracle(args)
+
+		first, _ := oracle.GetGasPriceSuggestions()
+		first.Fast = 0
+		second, _ := oracle.GetGasPriceSuggestions()
+		assert.Equal(t, minGasPrice, second.Fast)
+		assert.Equal(t, 4, *numFetches)
+		assert.Equal(t, 1, numPoolReads)
+
+		args.BlockChain.(*testscommon.ChainHandlerStub).GetCurrentBlockHeaderHashCalled = func() []byte {
+			return []byte("another hash")
+		}
+		_, _ = oracle.GetGasPriceSuggestions()
+		assert.Equal(t, 2, numPoolReads)
+	})
+	t.Run("block which cannot be fetched should end the recent blocks", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		createChain(args, 10, 100000, []uint64{minGasPrice})
+		getBlock := args.BlockHandler.(*mock.BlockAPIHandlerStub).GetBlockByNonceCalled
+		args.BlockHandler.(*mock.BlockAPIHandlerStub).GetBlockByNonceCalled = func(nonce uint64, withTxs bool) (*api.Block, error) {
+			if nonce == 8 {
+				return nil, errors.New("not found")
+			}
+			return getBlock(nonce, withTxs)
+		}
+		oracle, _ := NewGasPriceOracle(args)
+
+		suggestions, err := oracle.GetGasPriceSuggestions()
+		require.Nil(t, err)
+		assert.Equal(t, uint32(2), suggestions.NumRecentBlocks)
+	})
+}
+
+func TestPercentile(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, uint64(0), percentile(nil, 50))
+	assert.Equal(t, uint64(7), percentile([]uint64{7}, 1))
+	assert.Equal(t, uint64(7), percentile([]uint64{7}, 90))
+	assert.Equal(t, uint64(2), percentile([]uint64{1, 2, 3, 4}, 50))
+	assert.Equal(t, uint64(4), percentile([]uint64{1, 2, 3, 4}, 90))
+}
@@ -0,0 +1,24 @@
+package gasPriceOracle
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+// BlockHandler defines the component able to return the api blocks, together with their transactions
+type BlockHandler interface {
+	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
+	IsInterfaceNil() bool
+}
+
+// EconomicsHandler defines the economics data used when suggesting gas prices
+type EconomicsHandler interface {
+	MinGasPrice() uint64
+	MaxGasLimitPerBlock(shardID uint32) uint64
+	IsInterfaceNil() bool
+}
+
+// txsIterator defines the transactions cache able to iterate over its transactions
+type txsIterator interface {
+	ForEachTransaction(function txcache.ForEachTransaction)
+}
@@ -2,6 +2,7 @@ package external
 
 import (
 	"context"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
@@ -55,10 +56,30 @@ type DelegatedListHandler interface {
 	IsInterfaceNil() bool
 }
 
+// GasPriceOracle defines the behavior of a component able to suggest gas prices
+type GasPriceOracle interface {
+	GetGasPriceSuggestions() (*common.GasPriceSuggestionsAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+// StateStatisticsHandler defines the behavior of a component able to provide the state size statistics
+type StateStatisticsHandler interface {
+	GetStateStatistics() (*common.StateStatisticsAPIResponse, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // APITransactionHandler defines what an API transaction handler should be able to do
 type APITransactionHandler interface {
 	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionProcessStatus(txHash string) (*common.TransactionProcessStatusAPIResponse, error)
+	WaitForTransactionsInclusion(ctx context.Context, txsHashes []string, timeout time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error)
 	GetTransactionsPool() (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPoolForSender(sender string) (*common.TransactionsPoolSenderAPIResponse, error)
+	GetTransactionsPoolByFilter(filter common.TransactionsPoolFilter) ([]*common.TransactionsPoolEntryAPIResponse, error)
+	SubscribeToTransactionsPoolEvents(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error)
+	GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error)
+	GetLogEvents(address string, query common.LogEventsQuery) ([]*common.LogEventAPIResponse, error)
 	UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	UnmarshalReceipt(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	IsInterfaceNil() bool
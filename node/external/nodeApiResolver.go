@@ -3,6 +3,7 @@ package external
 import (
 	"context"
 	"encoding/hex"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -29,6 +30,8 @@ type ArgNodeApiResolver struct {
 	APIInternalBlockHandler  blockAPI.APIInternalBlockHandler
 	GenesisNodesSetupHandler sharding.GenesisNodesSetupHandler
 	ValidatorPubKeyConverter core.PubkeyConverter
+	GasPriceOracle           GasPriceOracle
+	StateStatisticsHandler   StateStatisticsHandler
 }
 
 // nodeApiResolver can resolve API requests
@@ -44,6 +47,8 @@ type nodeApiResolver struct {
 	apiInternalBlockHandler  blockAPI.APIInternalBlockHandler
 	genesisNodesSetupHandler sharding.GenesisNodesSetupHandler
 	validatorPubKeyConverter core.PubkeyConverter
+	gasPriceOracle           GasPriceOracle
+	stateStatisticsHandler   StateStatisticsHandler
 }
 
 // NewNodeApiResolver creates a new nodeApiResolver instance
@@ -81,6 +86,12 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.ValidatorPubKeyConverter) {
 		return nil, ErrNilValidatorPubKeyConverter
 	}
+	if check.IfNil(arg.GasPriceOracle) {
+		return nil, ErrNilGasPriceOracle
+	}
+	if check.IfNil(arg.StateStatisticsHandler) {
+		return nil, ErrNilStateStatisticsHandler
+	}
 
 	return &nodeApiResolver{
 		scQueryService:           arg.SCQueryService,
@@ -94,6 +105,8 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		apiInternalBlockHandler:  arg.APIInternalBlockHandler,
 		genesisNodesSetupHandler: arg.GenesisNodesSetupHandler,
 		validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
+		gasPriceOracle:           arg.GasPriceOracle,
+		stateStatisticsHandler:   arg.StateStatisticsHandler,
 	}, nil
 }
 
@@ -114,7 +127,13 @@ func (nar *nodeApiResolver) ComputeTransactionGasLimit(tx *transaction.Transacti
 
 // Close closes all underlying components
 func (nar *nodeApiResolver) Close() error {
-	return nar.scQueryService.Close()
+	errStateStatistics := nar.stateStatisticsHandler.Close()
+	err := nar.scQueryService.Close()
+	if err != nil {
+		return err
+	}
+
+	return errStateStatistics
 }
 
 // GetTotalStakedValue will return total staked value
@@ -142,6 +161,53 @@ func (nar *nodeApiResolver) GetTransactionsPool() (*common.TransactionsPoolAPIRe
 	return nar.apiTransactionHandler.GetTransactionsPool()
 }
 
+// GetTransactionsPoolForSender will return the transactions of the given sender found in the pool, together with the
+// nonce gaps blocking their selection and the risk of the transactions to be evicted
+func (nar *nodeApiResolver) GetTransactionsPoolForSender(sender string) (*common.TransactionsPoolSenderAPIResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsPoolForSender(sender)
+}
+
+// GetTransactionProcessStatus will return the progress of the transaction through the steps of its processing
+func (nar *nodeApiResolver) GetTransactionProcessStatus(txHash string) (*common.TransactionProcessStatusAPIResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionProcessStatus(txHash)
+}
+
+// WaitForTransactionsInclusion will wait, at most for the given timeout, for the transactions to be included in blocks
+func (nar *nodeApiResolver) WaitForTransactionsInclusion(ctx context.Context, txsHashes []string, timeout time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error) {
+	return nar.apiTransactionHandler.WaitForTransactionsInclusion(ctx, txsHashes, timeout)
+}
+
+// GetGasPriceSuggestions will return the slow, normal and fast gas prices suggested for the transactions of the shard
+func (nar *nodeApiResolver) GetGasPriceSuggestions() (*common.GasPriceSuggestionsAPIResponse, error) {
+	return nar.gasPriceOracle.GetGasPriceSuggestions()
+}
+
+// GetStateStatistics will return the last computed state size statistics and the state growth per epoch
+func (nar *nodeApiResolver) GetStateStatistics() (*common.StateStatisticsAPIResponse, error) {
+	return nar.stateStatisticsHandler.GetStateStatistics()
+}
+
+// GetTransactionsPoolByFilter will return the regular transactions in the pool matching the filter
+func (nar *nodeApiResolver) GetTransactionsPoolByFilter(filter common.TransactionsPoolFilter) ([]*common.TransactionsPoolEntryAPIResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsPoolByFilter(filter)
+}
+
+// SubscribeToTransactionsPoolEvents will return a subscription to the changes undergone by the transactions of the
+// pool matching the filter
+func (nar *nodeApiResolver) SubscribeToTransactionsPoolEvents(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error) {
+	return nar.apiTransactionHandler.SubscribeToTransactionsPoolEvents(filter)
+}
+
+// GetLogEvents will return the events emitted by the given address which match the query, the most recent first
+func (nar *nodeApiResolver) GetLogEvents(address string, query common.LogEventsQuery) ([]*common.LogEventAPIResponse, error) {
+	return nar.apiTransactionHandler.GetLogEvents(address, query)
+}
+
+// GetTransactionsByAddress will return a page of the transactions which touched the given address, the most recent first
+func (nar *nodeApiResolver) GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsByAddress(address, from, size)
+}
+
 // GetBlockByHash will return the block with the given hash and optionally with transactions
 func (nar *nodeApiResolver) GetBlockByHash(hash string, withTxs bool) (*api.Block, error) {
 	decodedHash, err := hex.DecodeString(hash)
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
@@ -34,6 +35,8 @@ func createMockArgs() external.ArgNodeApiResolver {
 		APIInternalBlockHandler:  &mock.InternalBlockApiHandlerStub{},
 		GenesisNodesSetupHandler: &testscommon.NodesSetupStub{},
 		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
+		GasPriceOracle:           &mock.GasPriceOracleStub{},
+		StateStatisticsHandler:   &mock.StateStatisticsHandlerStub{},
 	}
 }
 
@@ -103,6 +106,28 @@ func TestNewNodeApiResolver_NilDelegatedListHandler(t *testing.T) {
 	assert.Equal(t, external.ErrNilDelegatedListHandler, err)
 }
 
+func TestNewNodeApiResolver_NilGasPriceOracle(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.GasPriceOracle = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilGasPriceOracle, err)
+}
+
+func TestNewNodeApiResolver_NilStateStatisticsHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.StateStatisticsHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilStateStatisticsHandler, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -125,11 +150,20 @@ func TestNodeApiResolver_CloseShouldReturnNil(t *testing.T) {
 			return nil
 		},
 	}
+	stateStatisticsCloseCalled := false
+	args.StateStatisticsHandler = &mock.StateStatisticsHandlerStub{
+		CloseCalled: func() error {
+			stateStatisticsCloseCalled = true
+
+			return nil
+		},
+	}
 	nar, _ := external.NewNodeApiResolver(args)
 
 	err := nar.Close()
 	assert.Nil(t, err)
 	assert.True(t, closeCalled)
+	assert.True(t, stateStatisticsCloseCalled)
 }
 
 func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
@@ -340,6 +374,44 @@ func TestNodeApiResolver_APITransactionHandler(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_GetTransactionProcessStatus(t *testing.T) {
+	t.Parallel()
+
+	expectedStatus := &common.TransactionProcessStatusAPIResponse{Hash: "0101", PendingStep: "executedAtDestination"}
+	arg := createMockArgs()
+	arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		GetTransactionProcessStatusCalled: func(txHash string) (*common.TransactionProcessStatusAPIResponse, error) {
+			require.Equal(t, "0101", txHash)
+			return expectedStatus, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	res, err := nar.GetTransactionProcessStatus("0101")
+	require.NoError(t, err)
+	require.Equal(t, expectedStatus, res)
+}
+
+func TestNodeApiResolver_WaitForTransactionsInclusion(t *testing.T) {
+	t.Parallel()
+
+	expectedInclusions := map[string]*common.TransactionInclusionAPIResponse{"0101": {Included: true}}
+	arg := createMockArgs()
+	arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		WaitForTransactionsInclusionCalled: func(ctx context.Context, txsHashes []string, timeout time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error) {
+			require.Equal(t, context.TODO(), ctx)
+			require.Equal(t, []string{"0101"}, txsHashes)
+			require.Equal(t, time.Second, timeout)
+			return expectedInclusions, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	res, err := nar.WaitForTransactionsInclusion(context.TODO(), []string{"0101"}, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, expectedInclusions, res)
+}
+
 func TestNodeApiResolver_GetTransactionsPool(t *testing.T) {
 	t.Parallel()
 
@@ -382,6 +454,50 @@ func TestNodeApiResolver_GetTransactionsPool(t *testing.T) {
 	})
 }
 
+func TestNodeApiResolver_GetGasPriceSuggestions(t *testing.T) {
+	t.Parallel()
+
+	expectedSuggestions := &common.GasPriceSuggestionsAPIResponse{
+		MinGasPrice: 1000000000,
+		Slow:        1000000000,
+		Normal:      1200000000,
+		Fast:        1500000000,
+	}
+	arg := createMockArgs()
+	arg.GasPriceOracle = &mock.GasPriceOracleStub{
+		GetGasPriceSuggestionsCalled: func() (*common.GasPriceSuggestionsAPIResponse, error) {
+			return expectedSuggestions, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	res, err := nar.GetGasPriceSuggestions()
+	require.NoError(t, err)
+	require.Equal(t, expectedSuggestions, res)
+}
+
+func TestNodeApiResolver_GetStateStatistics(t *testing.T) {
+	t.Parallel()
+
+	expectedStatistics := &common.StateStatisticsAPIResponse{
+		Last: &common.StateSizeAPIResponse{
+			Epoch:       5,
+			NumAccounts: 10,
+		},
+	}
+	arg := createMockArgs()
+	arg.StateStatisticsHandler = &mock.StateStatisticsHandlerStub{
+		GetStateStatisticsCalled: func() (*common.StateStatisticsAPIResponse, error) {
+			return expectedStatistics, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	res, err := nar.GetStateStatistics()
+	require.NoError(t, err)
+	require.Equal(t, expectedStatistics, res)
+}
+
 func TestNodeApiResolver_GetGenesisNodesPubKeys(t *testing.T) {
 	t.Parallel()
 
@@ -1,11 +1,16 @@
 package transactionAPI
 
 import (
+	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	rewardTxData "github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
@@ -16,12 +21,16 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/txstatus"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 var log = logger.GetOrCreate("node/transactionAPI")
 
+// inclusionPollingInterval is the interval at which the transactions waited for are looked up in the pool and storage
+const inclusionPollingInterval = time.Millisecond * 200
+
 type apiTransactionProcessor struct {
 	roundDuration               uint64
 	genesisTime                 time.Time
@@ -34,6 +43,9 @@ type apiTransactionProcessor struct {
 	uint64ByteSliceConverter    typeConverters.Uint64ByteSliceConverter
 	txUnmarshaller              *txUnmarshaller
 	transactionResultsProcessor *apiTransactionResultsProcessor
+	poolEventsNotifier          *poolEventsNotifier
+	mutPoolEventsRegistration   sync.Mutex
+	isPoolEventsRegistered      bool
 }
 
 // NewAPITransactionProcessor will create a new instance of apiTransactionProcessor
@@ -53,7 +65,7 @@ func NewAPITransactionProcessor(args *ArgAPITransactionProcessor) (*apiTransacti
 		args.ShardCoordinator.SelfId(),
 	)
 
-	return &apiTransactionProcessor{
+	atp := &apiTransactionProcessor{
 		roundDuration:               args.RoundDuration,
 		genesisTime:                 args.GenesisTime,
 		marshalizer:                 args.Marshalizer,
@@ -65,7 +77,10 @@ func NewAPITransactionProcessor(args *ArgAPITransactionProcessor) (*apiTransacti
 		uint64ByteSliceConverter:    args.Uint64ByteSliceConverter,
 		txUnmarshaller:              txUnmarshalerAndPreparer,
 		transactionResultsProcessor: txResultsProc,
-	}, nil
+	}
+	atp.poolEventsNotifier = newPoolEventsNotifier(args.MaxPoolSubscriptions, atp.createTransactionsPoolEntry)
+
+	return atp, nil
 }
 
 // GetTransaction gets the transaction based on the given hash. It will search in the cache and the storage and
@@ -91,6 +106,143 @@ func (atp *apiTransactionProcessor) GetTransaction(txHash string, withResults bo
 	return atp.getTransactionFromStorage(hash)
 }
 
+// GetTransactionProcessStatus will return the progress of the transaction through the steps of its processing: its
+// execution in the source shard, its notarization by the metachain, its execution in the destination shard and the
+// notarization of the latter, together with the progress of the smart contract results it generated
+func (atp *apiTransactionProcessor) GetTransactionProcessStatus(txHash string) (*common.TransactionProcessStatusAPIResponse, error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, inPool, err := atp.getTransactionForProcessStatus(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	response := createProcessStatus(hash, tx, inPool, atp.shardCoordinator.SelfId())
+	if inPool {
+		return response, nil
+	}
+
+	resultsHashes, err := atp.historyRepository.GetResultsHashesByTxHash(hash, tx.Epoch)
+	if err != nil || resultsHashes == nil {
+		// the transactions which did not generate results have no results hashes recorded
+		return response, nil
+	}
+
+	for _, scResultsHashesAndEpoch := range resultsHashes.ScResultsHashesAndEpoch {
+		for _, scrHash := range scResultsHashesAndEpoch.ScResultsHashes {
+			response.Results = append(response.Results, atp.getResultProcessStatus(scrHash))
+		}
+	}
+
+	return response, nil
+}
+
+func (atp *apiTransactionProcessor) getTransactionForProcessStatus(hash []byte) (*transaction.ApiTransactionResult, bool, error) {
+	tx, err := atp.optionallyGetTransactionFromPool(hash)
+	if err != nil {
+		return nil, false, err
+	}
+	if tx != nil {
+		return tx, true, nil
+	}
+
+	if !atp.historyRepository.IsEnabled() {
+		return nil, false, ErrHistoryRepositoryDisabled
+	}
+
+	tx, err = atp.lookupHistoricalTransaction(hash, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return tx, false, nil
+}
+
+func (atp *apiTransactionProcessor) getResultProcessStatus(scrHash []byte) *common.TransactionProcessStatusAPIResponse {
+	scr, inPool, err := atp.getTransactionForProcessStatus(scrHash)
+	if err != nil {
+		log.Trace("getResultProcessStatus: smart contract result not recorded", "hash", scrHash, "error", err)
+		return createUnknownProcessStatus(scrHash)
+	}
+
+	return createProcessStatus(scrHash, scr, inPool, atp.shardCoordinator.SelfId())
+}
+
+// WaitForTransactionsInclusion waits until all the provided transactions are included in blocks of the node's shard or
+// until the timeout elapses, whichever comes first, and returns the inclusion of each transaction, by its hash. The
+// wait is abandoned with the context error as soon as the context is done
+func (atp *apiTransactionProcessor) WaitForTransactionsInclusion(ctx context.Context, txsHashes []string, timeout time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error) {
+	pendingHashes := make(map[string][]byte, len(txsHashes))
+	for _, txHash := range txsHashes {
+		hash, err := hex.DecodeString(txHash)
+		if err != nil {
+			return nil, err
+		}
+
+		pendingHashes[txHash] = hash
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(inclusionPollingInterval)
+	defer ticker.Stop()
+
+	inclusions := make(map[string]*common.TransactionInclusionAPIResponse, len(pendingHashes))
+	for {
+		for txHash, hash := range pendingHashes {
+			inclusions[txHash] = atp.getTransactionInclusion(hash)
+			if inclusions[txHash].Included {
+				delete(pendingHashes, txHash)
+			}
+		}
+		if len(pendingHashes) == 0 {
+			return inclusions, nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-timer.C:
+			return inclusions, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// getTransactionInclusion considers a transaction as included as soon as it was saved in the storage, which happens
+// when the block holding it is committed. The transactions just sent might not have reached the pool yet
+func (atp *apiTransactionProcessor) getTransactionInclusion(hash []byte) *common.TransactionInclusionAPIResponse {
+	_, _, inPool := atp.getTxObjFromDataPool(hash)
+	if inPool {
+		return &common.TransactionInclusionAPIResponse{
+			InPool: true,
+			Status: string(transaction.TxStatusPending),
+		}
+	}
+
+	var tx *transaction.ApiTransactionResult
+	var err error
+	if atp.historyRepository.IsEnabled() {
+		tx, err = atp.lookupHistoricalTransaction(hash, false)
+	} else {
+		tx, err = atp.getTransactionFromStorage(hash)
+	}
+	if err != nil {
+		return &common.TransactionInclusionAPIResponse{}
+	}
+
+	// the block coordinates are known only when the history repository is enabled
+	return &common.TransactionInclusionAPIResponse{
+		Included:   true,
+		Status:     string(tx.Status),
+		BlockNonce: tx.BlockNonce,
+		BlockHash:  tx.BlockHash,
+	}
+}
+
 // GetTransactionsPool will return a structure containing the transactions pool that is to be returned on API calls
 func (atp *apiTransactionProcessor) GetTransactionsPool() (*common.TransactionsPoolAPIResponse, error) {
 	txsPoolResponse := &common.TransactionsPoolAPIResponse{
@@ -102,6 +254,277 @@ func (atp *apiTransactionProcessor) GetTransactionsPool() (*common.TransactionsP
 	return txsPoolResponse, nil
 }
 
+// GetTransactionsPoolForSender will return the transactions of the given sender found in the pool, sorted by nonce,
+// together with the nonce gaps blocking their selection and the risk of the transactions to be evicted
+func (atp *apiTransactionProcessor) GetTransactionsPoolForSender(sender string) (*common.TransactionsPoolSenderAPIResponse, error) {
+	senderBytes, err := atp.addressPubKeyConverter.Decode(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	selfShardID := atp.shardCoordinator.SelfId()
+	if atp.shardCoordinator.ComputeId(senderBytes) != selfShardID {
+		return nil, ErrSenderNotInSelfShard
+	}
+
+	cacheID := process.ShardCacherIdentifier(selfShardID, selfShardID)
+	inspector, ok := atp.dataPool.Transactions().ShardDataStore(cacheID).(senderInspector)
+	if !ok {
+		return nil, ErrTransactionsPoolNotInspectable
+	}
+
+	response := &common.TransactionsPoolSenderAPIResponse{
+		Sender:       sender,
+		NonceGaps:    make([]*common.NonceGapAPIResponse, 0),
+		Transactions: make([]*common.TransactionsPoolEntryAPIResponse, 0),
+	}
+	inspection, found := inspector.InspectSender(senderBytes)
+	if !found {
+		return response, nil
+	}
+
+	response.AccountNonce = inspection.AccountNonce
+	response.AccountNonceKnown = inspection.AccountNonceKnown
+	response.Score = inspection.Score
+	response.NumFailedSelections = inspection.NumFailedSelections
+	response.IsInGracePeriod = inspection.IsInGracePeriod
+	response.EvictionRisk = inspection.EvictionRisk
+	for _, gap := range inspection.NonceGaps {
+		response.NonceGaps = append(response.NonceGaps, &common.NonceGapAPIResponse{From: gap.From, To: gap.To})
+	}
+	for _, wrappedTx := range inspection.Transactions {
+		entry := atp.createTransactionsPoolEntry(wrappedTx.TxHash, wrappedTx.Tx)
+		// the selection stops at the first missing nonce, so all the following transactions wait for it
+		entry.IsBlockedByNonceGap = len(inspection.NonceGaps) > 0 && entry.Nonce > inspection.NonceGaps[0].From
+		response.Transactions = append(response.Transactions, entry)
+	}
+
+	return response, nil
+}
+
+// GetTransactionsPoolByFilter will return the page selected by the filter's offset and limit out of the regular
+// transactions in the pool matching the filter, sorted by sender and nonce
+func (atp *apiTransactionProcessor) GetTransactionsPoolByFilter(filter common.TransactionsPoolFilter) ([]*common.TransactionsPoolEntryAPIResponse, error) {
+	senderBytes, err := atp.decodeOptionalAddress(filter.Sender)
+	if err != nil {
+		return nil, err
+	}
+	receiverBytes, err := atp.decodeOptionalAddress(filter.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	txsPool := atp.dataPool.Transactions()
+	matchingTxs := make([]*transaction.Transaction, 0)
+	matchingHashes := make(map[*transaction.Transaction][]byte)
+	for _, txHash := range txsPool.Keys() {
+		txObj, found := txsPool.SearchFirstData(txHash)
+		if !found {
+			continue
+		}
+		tx, ok := txObj.(*transaction.Transaction)
+		if !ok || !isTransactionMatchingFilter(tx, senderBytes, receiverBytes, filter) {
+			continue
+		}
+		_, alreadyAdded := matchingHashes[tx]
+		if alreadyAdded {
+			continue
+		}
+
+		matchingTxs = append(matchingTxs, tx)
+		matchingHashes[tx] = txHash
+	}
+
+	sort.Slice(matchingTxs, func(i, j int) bool {
+		senderComparison := bytes.Compare(matchingTxs[i].SndAddr, matchingTxs[j].SndAddr)
+		if senderComparison != 0 {
+			return senderComparison < 0
+		}
+		if matchingTxs[i].Nonce != matchingTxs[j].Nonce {
+			return matchingTxs[i].Nonce < matchingTxs[j].Nonce
+		}
+
+		return bytes.Compare(matchingHashes[matchingTxs[i]], matchingHashes[matchingTxs[j]]) < 0
+	})
+
+	matchingTxs = selectTransactionsPage(matchingTxs, filter.Offset, filter.Limit)
+	response := make([]*common.TransactionsPoolEntryAPIResponse, 0, len(matchingTxs))
+	for _, tx := range matchingTxs {
+		response = append(response, atp.createTransactionsPoolEntry(matchingHashes[tx], tx))
+	}
+
+	return response, nil
+}
+
+// SubscribeToTransactionsPoolEvents will return a subscription to the changes undergone by the transactions of the
+// pool sent from the shard of the node and matching the filter: added, selected, evicted, replaced or removed
+func (atp *apiTransactionProcessor) SubscribeToTransactionsPoolEvents(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error) {
+	senderBytes, err := atp.decodeOptionalAddress(filter.Sender)
+	if err != nil {
+		return nil, err
+	}
+	receiverBytes, err := atp.decodeOptionalAddress(filter.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	err = atp.registerToPoolEvents()
+	if err != nil {
+		return nil, err
+	}
+
+	return atp.poolEventsNotifier.subscribe(senderBytes, receiverBytes, filter)
+}
+
+// registerToPoolEvents lazily registers the notifier on the cache holding the transactions sent from the shard of
+// the node (towards any shard)
+func (atp *apiTransactionProcessor) registerToPoolEvents() error {
+	atp.mutPoolEventsRegistration.Lock()
+	defer atp.mutPoolEventsRegistration.Unlock()
+
+	if atp.isPoolEventsRegistered {
+		return nil
+	}
+
+	selfShardID := atp.shardCoordinator.SelfId()
+	cacheID := process.ShardCacherIdentifier(selfShardID, selfShardID)
+	source, ok := atp.dataPool.Transactions().ShardDataStore(cacheID).(txEventsSource)
+	if !ok {
+		return ErrTransactionsPoolNotObservable
+	}
+
+	source.RegisterEventsHandler(atp.poolEventsNotifier.onTxEvents)
+	atp.isPoolEventsRegistered = true
+
+	return nil
+}
+
+func selectTransactionsPage(txs []*transaction.Transaction, offset uint64, limit uint64) []*transaction.Transaction {
+	if offset >= uint64(len(txs)) {
+		return make([]*transaction.Transaction, 0)
+	}
+
+	txs = txs[offset:]
+	if limit > 0 && limit < uint64(len(txs)) {
+		txs = txs[:limit]
+	}
+
+	return txs
+}
+
+func (atp *apiTransactionProcessor) decodeOptionalAddress(address string) ([]byte, error) {
+	if len(address) == 0 {
+		return nil, nil
+	}
+
+	return atp.addressPubKeyConverter.Decode(address)
+}
+
+func isTransactionMatchingFilter(tx *transaction.Transaction, sender []byte, receiver []byte, filter common.TransactionsPoolFilter) bool {
+	if len(sender) > 0 && !bytes.Equal(tx.SndAddr, sender) {
+		return false
+	}
+	if len(receiver) > 0 && !bytes.Equal(tx.RcvAddr, receiver) {
+		return false
+	}
+	if filter.MinGasPrice.HasValue && tx.GasPrice < filter.MinGasPrice.Value {
+		return false
+	}
+	if filter.MaxGasPrice.HasValue && tx.GasPrice > filter.MaxGasPrice.Value {
+		return false
+	}
+
+	return true
+}
+
+func (atp *apiTransactionProcessor) createTransactionsPoolEntry(txHash []byte, tx data.TransactionHandler) *common.TransactionsPoolEntryAPIResponse {
+	value := ""
+	if tx.GetValue() != nil {
+		value = tx.GetValue().String()
+	}
+
+	return &common.TransactionsPoolEntryAPIResponse{
+		Hash:     hex.EncodeToString(txHash),
+		Nonce:    tx.GetNonce(),
+		Sender:   atp.addressPubKeyConverter.Encode(tx.GetSndAddr()),
+		Receiver: atp.addressPubKeyConverter.Encode(tx.GetRcvAddr()),
+		Value:    value,
+		GasPrice: tx.GetGasPrice(),
+		GasLimit: tx.GetGasLimit(),
+	}
+}
+
+// GetTransactionsByAddress will return a page of the transactions which touched the given address, the most recent first
+func (atp *apiTransactionProcessor) GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+	addressBytes, err := atp.addressPubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, total, err := atp.historyRepository.GetTransactionsByAddress(addressBytes, from, size)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.AddressTransactionsAPIResponse{
+		Transactions: make([]*common.AddressTransactionAPIResponse, 0, len(txs)),
+		Total:        total,
+	}
+	for _, tx := range txs {
+		response.Transactions = append(response.Transactions, &common.AddressTransactionAPIResponse{
+			Hash:       hex.EncodeToString(tx.Hash),
+			Type:       tx.Type,
+			Epoch:      tx.Epoch,
+			BlockNonce: tx.BlockNonce,
+			BlockHash:  hex.EncodeToString(tx.BlockHash),
+			Round:      tx.Round,
+			Timestamp:  atp.computeTimestampForRound(tx.Round),
+		})
+	}
+
+	return response, nil
+}
+
+// GetLogEvents will return the events emitted by the given address which match the query, the most recent first
+func (atp *apiTransactionProcessor) GetLogEvents(address string, query common.LogEventsQuery) ([]*common.LogEventAPIResponse, error) {
+	addressBytes, err := atp.addressPubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := dblookupext.LogEventsFilter{
+		Address:    addressBytes,
+		Identifier: []byte(query.Identifier),
+		Topic:      query.Topic,
+		FromNonce:  query.FromBlock,
+		ToNonce:    query.ToBlock,
+		From:       query.From,
+		Size:       query.Size,
+	}
+	events, err := atp.historyRepository.GetLogEvents(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*common.LogEventAPIResponse, 0, len(events))
+	for _, event := range events {
+		response = append(response, &common.LogEventAPIResponse{
+			Address:    atp.addressPubKeyConverter.Encode(event.Address),
+			Identifier: string(event.Identifier),
+			Topics:     event.Topics,
+			Data:       event.Data,
+			TxHash:     hex.EncodeToString(event.TxHash),
+			Epoch:      event.Epoch,
+			BlockNonce: event.BlockNonce,
+			BlockHash:  hex.EncodeToString(event.BlockHash),
+			Round:      event.Round,
+			Timestamp:  atp.computeTimestampForRound(event.Round),
+		})
+	}
+
+	return response, nil
+}
+
 func txsHashesBytesToString(input [][]byte) []string {
 	result := make([]string, 0, len(input))
 	for _, txHashBytes := range input {
@@ -2,6 +2,7 @@ package transactionAPI
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -17,11 +18,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
 	dblookupextMock "github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
@@ -42,6 +45,7 @@ func createMockArgAPIBlockProcessor() *ArgAPITransactionProcessor {
 		StorageService:           &mock.ChainStorerMock{},
 		DataPool:                 &dataRetrieverMock.PoolsHolderMock{},
 		Uint64ByteSliceConverter: mock.NewNonceHashConverterMock(),
+		MaxPoolSubscriptions:     10,
 	}
 }
 
@@ -124,6 +128,16 @@ func TestNewAPITransactionProcessor(t *testing.T) {
 		_, err := NewAPITransactionProcessor(arguments)
 		require.Equal(t, process.ErrNilUint64Converter, err)
 	})
+
+	t.Run("InvalidMaxPoolSubscriptions", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createMockArgAPIBlockProcessor()
+		arguments.MaxPoolSubscriptions = 0
+
+		_, err := NewAPITransactionProcessor(arguments)
+		require.Equal(t, ErrInvalidMaxPoolSubscriptions, err)
+	})
 }
 
 func TestNode_GetTransactionInvalidHashShouldErr(t *testing.T) {
@@ -355,6 +369,7 @@ func TestNode_GetTransactionWithResultsFromStorage(t *testing.T) {
 		StorageService:           chainStorer,
 		DataPool:                 dataRetrieverMock.NewPoolsHolderMock(),
 		Uint64ByteSliceConverter: mock.NewNonceHashConverterMock(),
+		MaxPoolSubscriptions:     10,
 	}
 	apiTransactionProc, _ := NewAPITransactionProcessor(args)
 
@@ -553,6 +568,239 @@ func TestNode_PutHistoryFieldsInTransaction(t *testing.T) {
 	require.Equal(t, "0c", tx.NotarizedAtDestinationInMetaHash)
 }
 
+func TestApiTransactionProcessor_GetTransactionProcessStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 42, true)
+		res, err := atp.GetTransactionProcessStatus("not hex")
+		assert.Nil(t, res)
+		assert.NotNil(t, err)
+	})
+	t.Run("history repository disabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 42, false)
+		res, err := atp.GetTransactionProcessStatus(hex.EncodeToString([]byte("a")))
+		assert.Nil(t, res)
+		assert.Equal(t, ErrHistoryRepositoryDisabled, err)
+	})
+	t.Run("in pool", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, dataPool, _ := createAPITransactionProc(t, 42, false)
+		// cross-shard, we are source
+		dataPool.Transactions().AddData([]byte("a"), &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")}, 42, "1")
+		// cross-shard, we are destination
+		dataPool.Transactions().AddData([]byte("b"), &transaction.Transaction{SndAddr: []byte("bob"), RcvAddr: []byte("alice")}, 42, "1")
+
+		res, err := atp.GetTransactionProcessStatus(hex.EncodeToString([]byte("a")))
+		require.Nil(t, err)
+		assert.True(t, res.InPool)
+		assert.Equal(t, string(transaction.TxStatusPending), res.Status)
+		assert.Equal(t, stepExecutedAtSource, res.PendingStep)
+		require.Equal(t, 4, len(res.Steps))
+		for _, step := range res.Steps {
+			assert.False(t, step.Done)
+		}
+
+		res, err = atp.GetTransactionProcessStatus(hex.EncodeToString([]byte("b")))
+		require.Nil(t, err)
+		assert.True(t, res.InPool)
+		assert.True(t, res.Steps[0].Done)
+		assert.Equal(t, stepNotarizedAtSourceInMeta, res.PendingStep)
+	})
+	t.Run("cross-shard stuck at destination, with results", func(t *testing.T) {
+		t.Parallel()
+
+		atp, chainStorer, _, historyRepo := createAPITransactionProc(t, 42, true)
+		_ = chainStorer.Transactions.PutWithMarshalizer([]byte("a"), &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")}, atp.marshalizer)
+		_ = chainStorer.Unsigned.PutWithMarshalizer([]byte("scr"), &smartContractResult.SmartContractResult{SndAddr: []byte("alice"), RcvAddr: []byte("alice")}, atp.marshalizer)
+		historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+			switch string(hash) {
+			case "a":
+				return &dblookupext.MiniblockMetadata{
+					Type:                         int32(block.TxBlock),
+					SourceShardID:                1,
+					DestinationShardID:           2,
+					Epoch:                        42,
+					HeaderNonce:                  7,
+					HeaderHash:                   []byte("source block"),
+					NotarizedAtSourceInMetaNonce: 9,
+					NotarizedAtSourceInMetaHash:  []byte("meta block"),
+				}, nil
+			case "scr":
+				return &dblookupext.MiniblockMetadata{
+					Type:                              int32(block.SmartContractResultBlock),
+					SourceShardID:                     1,
+					DestinationShardID:                1,
+					Epoch:                             42,
+					HeaderNonce:                       7,
+					HeaderHash:                        []byte("source block"),
+					NotarizedAtSourceInMetaNonce:      9,
+					NotarizedAtSourceInMetaHash:       []byte("meta block"),
+					NotarizedAtDestinationInMetaNonce: 9,
+					NotarizedAtDestinationInMetaHash:  []byte("meta block"),
+				}, nil
+			}
+			return nil, errors.New("not found")
+		}
+		historyRepo.GetEventsHashesByTxHashCalled = func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+			require.Equal(t, []byte("a"), hash)
+			require.Equal(t, uint32(42), epoch)
+			return &dblookupext.ResultsHashesByTxHash{
+				ScResultsHashesAndEpoch: []*dblookupext.ScResultsHashesAndEpoch{
+					{Epoch: 42, ScResultsHashes: [][]byte{[]byte("scr"), []byte("unknown scr")}},
+				},
+			}, nil
+		}
+
+		res, err := atp.GetTransactionProcessStatus(hex.EncodeToString([]byte("a")))
+		require.Nil(t, err)
+		assert.False(t, res.InPool)
+		assert.Equal(t, stepExecutedAtDestination, res.PendingStep)
+		assert.Equal(t, []*common.TransactionProcessStepAPIResponse{
+			{Name: stepExecutedAtSource, Done: true, Shard: 1, BlockNonce: 7, BlockHash: hex.EncodeToString([]byte("source block"))},
+			{Name: stepNotarizedAtSourceInMeta, Done: true, Shard: core.MetachainShardId, BlockNonce: 9, BlockHash: hex.EncodeToString([]byte("meta block"))},
+			{Name: stepExecutedAtDestination, Done: false, Shard: 2},
+			{Name: stepNotarizedAtDestinationInMeta, Done: false, Shard: core.MetachainShardId},
+		}, res.Steps)
+
+		require.Equal(t, 2, len(res.Results))
+		assert.Equal(t, hex.EncodeToString([]byte("scr")), res.Results[0].Hash)
+		assert.Equal(t, string(transaction.TxStatusSuccess), res.Results[0].Status)
+		assert.Equal(t, 2, len(res.Results[0].Steps))
+		assert.Empty(t, res.Results[0].PendingStep)
+		assert.Equal(t, hex.EncodeToString([]byte("unknown scr")), res.Results[1].Hash)
+		assert.Equal(t, processStatusUnknown, res.Results[1].Status)
+		assert.Empty(t, res.Results[1].Steps)
+	})
+	t.Run("cross-shard, we are destination", func(t *testing.T) {
+		t.Parallel()
+
+		atp, chainStorer, _, historyRepo := createAPITransactionProc(t, 42, true)
+		_ = chainStorer.Transactions.PutWithMarshalizer([]byte("b"), &transaction.Transaction{SndAddr: []byte("bob"), RcvAddr: []byte("alice")}, atp.marshalizer)
+		historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+			return &dblookupext.MiniblockMetadata{
+				Type:                              int32(block.TxBlock),
+				SourceShardID:                     2,
+				DestinationShardID:                1,
+				Epoch:                             42,
+				HeaderNonce:                       8,
+				HeaderHash:                        []byte("destination block"),
+				NotarizedAtSourceInMetaNonce:      9,
+				NotarizedAtSourceInMetaHash:       []byte("meta block"),
+				NotarizedAtDestinationInMetaNonce: 10,
+				NotarizedAtDestinationInMetaHash:  []byte("next meta block"),
+			}, nil
+		}
+
+		res, err := atp.GetTransactionProcessStatus(hex.EncodeToString([]byte("b")))
+		require.Nil(t, err)
+		assert.Empty(t, res.PendingStep)
+		assert.Empty(t, res.Results)
+		assert.Equal(t, []*common.TransactionProcessStepAPIResponse{
+			{Name: stepExecutedAtSource, Done: true, Shard: 2},
+			{Name: stepNotarizedAtSourceInMeta, Done: true, Shard: core.MetachainShardId, BlockNonce: 9, BlockHash: hex.EncodeToString([]byte("meta block"))},
+			{Name: stepExecutedAtDestination, Done: true, Shard: 1, BlockNonce: 8, BlockHash: hex.EncodeToString([]byte("destination block"))},
+			{Name: stepNotarizedAtDestinationInMeta, Done: true, Shard: core.MetachainShardId, BlockNonce: 10, BlockHash: hex.EncodeToString([]byte("next meta block"))},
+		}, res.Steps)
+	})
+}
+
+func TestApiTransactionProcessor_WaitForTransactionsInclusion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 42, false)
+		res, err := atp.WaitForTransactionsInclusion(context.Background(), []string{"not hex"}, time.Second)
+		assert.Nil(t, res)
+		assert.NotNil(t, err)
+	})
+	t.Run("included from storage should not wait", func(t *testing.T) {
+		t.Parallel()
+
+		atp, chainStorer, _, _ := createAPITransactionProc(t, 42, false)
+		_ = chainStorer.Transactions.PutWithMarshalizer([]byte("a"), &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("alice")}, atp.marshalizer)
+
+		start := time.Now()
+		res, err := atp.WaitForTransactionsInclusion(context.Background(), []string{hex.EncodeToString([]byte("a"))}, time.Minute)
+		require.Nil(t, err)
+		assert.True(t, time.Since(start) < time.Minute)
+		assert.Equal(t, &common.TransactionInclusionAPIResponse{
+			Included: true,
+			Status:   string(transaction.TxStatusSuccess),
+		}, res[hex.EncodeToString([]byte("a"))])
+	})
+	t.Run("included later, with history", func(t *testing.T) {
+		t.Parallel()
+
+		atp, chainStorer, dataPool, historyRepo := createAPITransactionProc(t, 42, true)
+		dataPool.Transactions().AddData([]byte("a"), &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("alice")}, 42, "1")
+		historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+			return &dblookupext.MiniblockMetadata{
+				Type:               int32(block.TxBlock),
+				SourceShardID:      1,
+				DestinationShardID: 1,
+				Epoch:              42,
+				HeaderNonce:        7,
+				HeaderHash:         []byte("block"),
+			}, nil
+		}
+
+		go func() {
+			time.Sleep(inclusionPollingInterval)
+			_ = chainStorer.Transactions.PutWithMarshalizer([]byte("a"), &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("alice")}, atp.marshalizer)
+			dataPool.Transactions().RemoveData([]byte("a"), "1")
+		}()
+
+		res, err := atp.WaitForTransactionsInclusion(context.Background(), []string{hex.EncodeToString([]byte("a"))}, time.Second*10)
+		require.Nil(t, err)
+		assert.Equal(t, &common.TransactionInclusionAPIResponse{
+			Included:   true,
+			Status:     string(transaction.TxStatusSuccess),
+			BlockNonce: 7,
+			BlockHash:  hex.EncodeToString([]byte("block")),
+		}, res[hex.EncodeToString([]byte("a"))])
+	})
+	t.Run("timeout should return the pending transactions", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, dataPool, _ := createAPITransactionProc(t, 42, false)
+		dataPool.Transactions().AddData([]byte("a"), &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")}, 42, "1")
+
+		res, err := atp.WaitForTransactionsInclusion(context.Background(), []string{hex.EncodeToString([]byte("a")), hex.EncodeToString([]byte("b"))}, inclusionPollingInterval)
+		require.Nil(t, err)
+		assert.Equal(t, &common.TransactionInclusionAPIResponse{
+			InPool: true,
+			Status: string(transaction.TxStatusPending),
+		}, res[hex.EncodeToString([]byte("a"))])
+		assert.Equal(t, &common.TransactionInclusionAPIResponse{}, res[hex.EncodeToString([]byte("b"))])
+	})
+	t.Run("cancelled context should stop the wait", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, dataPool, _ := createAPITransactionProc(t, 42, false)
+		dataPool.Transactions().AddData([]byte("a"), &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")}, 42, "1")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(inclusionPollingInterval)
+			cancel()
+		}()
+
+		start := time.Now()
+		res, err := atp.WaitForTransactionsInclusion(ctx, []string{hex.EncodeToString([]byte("a"))}, time.Minute)
+		assert.Nil(t, res)
+		assert.Equal(t, context.Canceled, err)
+		assert.True(t, time.Since(start) < time.Minute)
+	})
+}
+
 func TestApiTransactionProcessor_GetTransactionsPool(t *testing.T) {
 	t.Parallel()
 
@@ -595,6 +843,328 @@ func TestApiTransactionProcessor_GetTransactionsPool(t *testing.T) {
 	require.Equal(t, []string{hex.EncodeToString(txHash3)}, res.Rewards)
 }
 
+func TestApiTransactionProcessor_GetTransactionsPoolForSender(t *testing.T) {
+	t.Parallel()
+
+	aliceHex := hex.EncodeToString([]byte("alice"))
+	bobHex := hex.EncodeToString([]byte("bob"))
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 0, false)
+		res, err := atp.GetTransactionsPoolForSender("not hex")
+		assert.Nil(t, res)
+		assert.NotNil(t, err)
+	})
+	t.Run("sender in another shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 0, false)
+		res, err := atp.GetTransactionsPoolForSender(bobHex)
+		assert.Nil(t, res)
+		assert.Equal(t, ErrSenderNotInSelfShard, err)
+	})
+	t.Run("cache without sender views should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 0, false)
+		res, err := atp.GetTransactionsPoolForSender(aliceHex)
+		assert.Nil(t, res)
+		assert.Equal(t, ErrTransactionsPoolNotInspectable, err)
+	})
+	t.Run("sender without transactions should return an empty view", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 0, false)
+		atp.shardCoordinator = &mock.ShardCoordinatorMock{SelfShardId: 0}
+		res, err := atp.GetTransactionsPoolForSender(aliceHex)
+		require.Nil(t, err)
+		assert.Equal(t, aliceHex, res.Sender)
+		assert.Empty(t, res.Transactions)
+		assert.Empty(t, res.NonceGaps)
+	})
+	t.Run("should report the transactions blocked by nonce gaps", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, dataPool, _ := createAPITransactionProc(t, 0, false)
+		// the pools holder mock only knows about shard 0
+		atp.shardCoordinator = &mock.ShardCoordinatorMock{SelfShardId: 0}
+		for _, nonce := range []uint64{5, 6, 8} {
+			tx := &transaction.Transaction{Nonce: nonce, SndAddr: []byte("alice"), RcvAddr: []byte("alice"), Value: big.NewInt(1), GasPrice: 1000000000, GasLimit: 50000}
+			dataPool.Transactions().AddData([]byte(fmt.Sprintf("hash-%d", nonce)), tx, 100, "0")
+		}
+		dataPool.Transactions().ShardDataStore("0").(*txcache.TxCache).NotifyAccountNonce([]byte("alice"), 4)
+
+		res, err := atp.GetTransactionsPoolForSender(aliceHex)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(4), res.AccountNonce)
+		assert.True(t, res.AccountNonceKnown)
+		assert.Equal(t, txcache.EvictionRiskMedium, res.EvictionRisk)
+		assert.Equal(t, []*common.NonceGapAPIResponse{{From: 4, To: 4}, {From: 7, To: 7}}, res.NonceGaps)
+		require.Equal(t, 3, len(res.Transactions))
+		assert.Equal(t, hex.EncodeToString([]byte("hash-5")), res.Transactions[0].Hash)
+		assert.Equal(t, aliceHex, res.Transactions[0].Receiver)
+		assert.Equal(t, "1", res.Transactions[0].Value)
+		for _, entry := range res.Transactions {
+			assert.True(t, entry.IsBlockedByNonceGap)
+		}
+	})
+}
+
+func TestApiTransactionProcessor_SubscribeToTransactionsPoolEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 0, false)
+		subscription, err := atp.SubscribeToTransactionsPoolEvents(common.TransactionsPoolFilter{Sender: "not hex"})
+		assert.Nil(t, subscription)
+		assert.NotNil(t, err)
+	})
+	t.Run("cache without events should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 0, false)
+		subscription, err := atp.SubscribeToTransactionsPoolEvents(common.TransactionsPoolFilter{})
+		assert.Nil(t, subscription)
+		assert.Equal(t, ErrTransactionsPoolNotObservable, err)
+	})
+	t.Run("should stream the events of the matching transactions", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, dataPool, _ := createAPITransactionProc(t, 0, false)
+		// the pools holder mock only knows about shard 0
+		atp.shardCoordinator = &mock.ShardCoordinatorMock{SelfShardId: 0}
+		subscription, err := atp.SubscribeToTransactionsPoolEvents(common.TransactionsPoolFilter{Sender: hex.EncodeToString([]byte("alice"))})
+		require.Nil(t, err)
+		defer subscription.Unsubscribe()
+
+		// a second subscription should not register the notifier twice
+		otherSubscription, err := atp.SubscribeToTransactionsPoolEvents(common.TransactionsPoolFilter{})
+		require.Nil(t, err)
+		otherSubscription.Unsubscribe()
+
+		txBob := &transaction.Transaction{Nonce: 1, SndAddr: []byte("bob"), RcvAddr: []byte("alice"), Value: big.NewInt(0), GasPrice: 1000000000, GasLimit: 50000}
+		dataPool.Transactions().AddData([]byte("hash-bob"), txBob, 100, "0")
+		txAlice := &transaction.Transaction{Nonce: 1, SndAddr: []byte("alice"), RcvAddr: []byte("bob"), Value: big.NewInt(10), GasPrice: 1000000000, GasLimit: 50000}
+		dataPool.Transactions().AddData([]byte("hash-alice"), txAlice, 100, "0")
+		dataPool.Transactions().RemoveData([]byte("hash-alice"), "0")
+
+		event := <-subscription.Events
+		assert.Equal(t, string(txcache.TxEventAdded), event.Event)
+		assert.Equal(t, hex.EncodeToString([]byte("hash-alice")), event.Transaction.Hash)
+		assert.Equal(t, "10", event.Transaction.Value)
+		event = <-subscription.Events
+		assert.Equal(t, string(txcache.TxEventRemoved), event.Event)
+		assert.Equal(t, hex.EncodeToString([]byte("hash-alice")), event.Transaction.Hash)
+	})
+}
+
+func TestApiTransactionProcessor_GetTransactionsPoolByFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid receiver should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 0, false)
+		res, err := atp.GetTransactionsPoolByFilter(common.TransactionsPoolFilter{Receiver: "not hex"})
+		assert.Nil(t, res)
+		assert.NotNil(t, err)
+	})
+	t.Run("should return the matching transactions", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, dataPool, _ := createAPITransactionProc(t, 0, false)
+		txs := map[string]*transaction.Transaction{
+			"a": {Nonce: 2, SndAddr: []byte("alice"), RcvAddr: []byte("bob"), GasPrice: 2000000000},
+			"b": {Nonce: 1, SndAddr: []byte("alice"), RcvAddr: []byte("bob"), GasPrice: 1000000000},
+			"c": {Nonce: 3, SndAddr: []byte("alice"), RcvAddr: []byte("alice"), GasPrice: 2000000000},
+			"d": {Nonce: 4, SndAddr: []byte("alice"), RcvAddr: []byte("bob"), GasPrice: 5000000000},
+		}
+		for hash, tx := range txs {
+			dataPool.Transactions().AddData([]byte(hash), tx, 100, "1")
+		}
+
+		res, err := atp.GetTransactionsPoolByFilter(common.TransactionsPoolFilter{
+			Receiver:    hex.EncodeToString([]byte("bob")),
+			MinGasPrice: common.OptionalUint64{Value: 1000000000, HasValue: true},
+			MaxGasPrice: common.OptionalUint64{Value: 2000000000, HasValue: true},
+		})
+		require.Nil(t, err)
+		require.Equal(t, 2, len(res))
+		assert.Equal(t, hex.EncodeToString([]byte("b")), res[0].Hash)
+		assert.Equal(t, hex.EncodeToString([]byte("a")), res[1].Hash)
+		assert.Equal(t, uint64(2000000000), res[1].GasPrice)
+
+		res, err = atp.GetTransactionsPoolByFilter(common.TransactionsPoolFilter{})
+		require.Nil(t, err)
+		assert.Equal(t, 4, len(res))
+
+		res, err = atp.GetTransactionsPoolByFilter(common.TransactionsPoolFilter{Offset: 1, Limit: 2})
+		require.Nil(t, err)
+		require.Equal(t, 2, len(res))
+		assert.Equal(t, hex.EncodeToString([]byte("a")), res[0].Hash)
+		assert.Equal(t, hex.EncodeToString([]byte("c")), res[1].Hash)
+
+		res, err = atp.GetTransactionsPoolByFilter(common.TransactionsPoolFilter{Offset: 4})
+		require.Nil(t, err)
+		assert.Empty(t, res)
+	})
+}
+
+func TestApiTransactionProcessor_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _ := NewAPITransactionProcessor(createMockArgAPIBlockProcessor())
+		res, err := atp.GetTransactionsByAddress("not hex", 0, 10)
+		assert.Nil(t, res)
+		assert.NotNil(t, err)
+	})
+	t.Run("history repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPIBlockProcessor()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			GetTransactionsByAddressCalled: func(_ []byte, _ uint64, _ uint64) ([]*dblookupext.AddressTransaction, uint64, error) {
+				return nil, 0, dblookupext.ErrAddressHistoryDisabled
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+		res, err := atp.GetTransactionsByAddress("aabb", 0, 10)
+		assert.Nil(t, res)
+		assert.Equal(t, dblookupext.ErrAddressHistoryDisabled, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPIBlockProcessor()
+		args.RoundDuration = 6000
+		args.GenesisTime = time.Unix(1000, 0)
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			GetTransactionsByAddressCalled: func(address []byte, from uint64, size uint64) ([]*dblookupext.AddressTransaction, uint64, error) {
+				assert.Equal(t, []byte{0xaa, 0xbb}, address)
+				assert.Equal(t, uint64(5), from)
+				assert.Equal(t, uint64(1), size)
+
+				return []*dblookupext.AddressTransaction{
+					{
+						Hash:       []byte("tx"),
+						Type:       string(transaction.TxTypeNormal),
+						Epoch:      2,
+						BlockNonce: 10,
+						BlockHash:  []byte("block"),
+						Round:      11,
+					},
+				}, 7, nil
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+		res, err := atp.GetTransactionsByAddress("aabb", 5, 1)
+		require.Nil(t, err)
+		require.Equal(t, uint64(7), res.Total)
+		require.Equal(t, 1, len(res.Transactions))
+		assert.Equal(t, &common.AddressTransactionAPIResponse{
+			Hash:       hex.EncodeToString([]byte("tx")),
+			Type:       "normal",
+			Epoch:      2,
+			BlockNonce: 10,
+			BlockHash:  hex.EncodeToString([]byte("block")),
+			Round:      11,
+			Timestamp:  1066,
+		}, res.Transactions[0])
+	})
+}
+
+func TestApiTransactionProcessor_GetLogEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _ := NewAPITransactionProcessor(createMockArgAPIBlockProcessor())
+		res, err := atp.GetLogEvents("not hex", common.LogEventsQuery{Identifier: "ESDTTransfer", Size: 10})
+		assert.Nil(t, res)
+		assert.NotNil(t, err)
+	})
+	t.Run("history repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPIBlockProcessor()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			GetLogEventsCalled: func(_ dblookupext.LogEventsFilter) ([]*dblookupext.LogEvent, error) {
+				return nil, dblookupext.ErrLogEventsIndexDisabled
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+		res, err := atp.GetLogEvents("aabb", common.LogEventsQuery{Identifier: "ESDTTransfer", Size: 10})
+		assert.Nil(t, res)
+		assert.Equal(t, dblookupext.ErrLogEventsIndexDisabled, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		query := common.LogEventsQuery{
+			Identifier: "ESDTTransfer",
+			Topic:      []byte("topic"),
+			FromBlock:  3,
+			ToBlock:    common.OptionalUint64{Value: 20, HasValue: true},
+			From:       5,
+			Size:       1,
+		}
+		args := createMockArgAPIBlockProcessor()
+		args.RoundDuration = 6000
+		args.GenesisTime = time.Unix(1000, 0)
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			GetLogEventsCalled: func(filter dblookupext.LogEventsFilter) ([]*dblookupext.LogEvent, error) {
+				assert.Equal(t, dblookupext.LogEventsFilter{
+					Address:    []byte{0xaa, 0xbb},
+					Identifier: []byte("ESDTTransfer"),
+					Topic:      []byte("topic"),
+					FromNonce:  3,
+					ToNonce:    common.OptionalUint64{Value: 20, HasValue: true},
+					From:       5,
+					Size:       1,
+				}, filter)
+
+				return []*dblookupext.LogEvent{
+					{
+						Address:    []byte{0xaa, 0xbb},
+						Identifier: []byte("ESDTTransfer"),
+						Topics:     [][]byte{[]byte("topic")},
+						Data:       []byte("data"),
+						TxHash:     []byte("tx"),
+						Epoch:      2,
+						BlockNonce: 10,
+						BlockHash:  []byte("block"),
+						Round:      11,
+					},
+				}, nil
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+		res, err := atp.GetLogEvents("aabb", query)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(res))
+		assert.Equal(t, &common.LogEventAPIResponse{
+			Address:    "aabb",
+			Identifier: "ESDTTransfer",
+			Topics:     [][]byte{[]byte("topic")},
+			Data:       []byte("data"),
+			TxHash:     hex.EncodeToString([]byte("tx")),
+			Epoch:      2,
+			BlockNonce: 10,
+			BlockHash:  hex.EncodeToString([]byte("block")),
+			Round:      11,
+			Timestamp:  1066,
+		}, res[0])
+	})
+}
+
 func createAPITransactionProc(t *testing.T, epoch uint32, withDbLookupExt bool) (*apiTransactionProcessor, *genericMocks.ChainStorerMock, *dataRetrieverMock.PoolsHolderMock, *dblookupextMock.HistoryRepositoryStub) {
 	chainStorer := genericMocks.NewChainStorerMock(epoch)
 	dataPool := dataRetrieverMock.NewPoolsHolderMock()
@@ -615,6 +1185,7 @@ func createAPITransactionProc(t *testing.T, epoch uint32, withDbLookupExt bool)
 		StorageService:           chainStorer,
 		DataPool:                 dataPool,
 		Uint64ByteSliceConverter: mock.NewNonceHashConverterMock(),
+		MaxPoolSubscriptions:     10,
 	}
 	apiTransactionProc, err := NewAPITransactionProcessor(args)
 	require.Nil(t, err)
@@ -30,6 +30,9 @@ func checkNilArgs(arg *ArgAPITransactionProcessor) error {
 	if check.IfNil(arg.Uint64ByteSliceConverter) {
 		return process.ErrNilUint64Converter
 	}
+	if arg.MaxPoolSubscriptions == 0 {
+		return ErrInvalidMaxPoolSubscriptions
+	}
 
 	return nil
 }
@@ -13,3 +13,19 @@ var ErrNilStatusComputer = errors.New("nil transaction status computer")
 
 // ErrNilAPITransactionProcessorArg signals that a nil arguments structure has been provided
 var ErrNilAPITransactionProcessorArg = errors.New("nil api transaction processor arg")
+
+// ErrSenderNotInSelfShard signals that the sender does not belong to the shard of the node
+var ErrSenderNotInSelfShard = errors.New("the sender does not belong to the shard of the node")
+
+// ErrTransactionsPoolNotObservable signals that the transactions pool does not notify about the changes of its transactions
+var ErrTransactionsPoolNotObservable = errors.New("the transactions pool cannot be observed")
+
+// ErrTransactionsPoolNotInspectable signals that the transactions pool does not provide views over the senders
+var ErrTransactionsPoolNotInspectable = errors.New("the transactions pool cannot be inspected")
+
+// ErrHistoryRepositoryDisabled signals that the history repository, needed to follow the processing of the
+// transactions, is not enabled
+var ErrHistoryRepositoryDisabled = errors.New("the history repository is not enabled")
+
+// ErrInvalidMaxPoolSubscriptions signals that an invalid maximum number of transactions pool subscriptions was provided
+var ErrInvalidMaxPoolSubscriptions = errors.New("invalid maximum number of transactions pool subscriptions")
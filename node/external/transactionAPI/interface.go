@@ -0,0 +1,13 @@
+package transactionAPI
+
+import "github.com/ElrondNetwork/elrond-go/storage/txcache"
+
+// senderInspector defines the transactions cache able to provide views over the transactions of a sender
+type senderInspector interface {
+	InspectSender(sender []byte) (*txcache.SenderInspection, bool)
+}
+
+// txEventsSource defines the transactions cache able to notify about the changes undergone by its transactions
+type txEventsSource interface {
+	RegisterEventsHandler(handler txcache.TxEventsHandler)
+}
@@ -0,0 +1,146 @@
+package transactionAPI
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+const poolEventsSubscriptionBufferSize = 1024
+
+type poolEventsSubscription struct {
+	id         uint64
+	sender     []byte
+	receiver   []byte
+	filter     common.TransactionsPoolFilter
+	chEvents   chan *common.TransactionsPoolEventAPIResponse
+	overflowed atomic.Flag
+}
+
+// poolEventsNotifier dispatches the changes undergone by the transactions of the pool to the matching subscriptions.
+// A subscription which does not keep up with the events is ended and, when the pool itself drops some events, each
+// subscription receives a "dropped" event without a transaction, so that the subscribers never miss events silently
+type poolEventsNotifier struct {
+	mutSubscriptions sync.RWMutex
+	subscriptions    map[uint64]*poolEventsSubscription
+	lastID           uint64
+	maxSubscriptions int
+	createEntry      func(txHash []byte, tx data.TransactionHandler) *common.TransactionsPoolEntryAPIResponse
+}
+
+func newPoolEventsNotifier(
+	maxSubscriptions uint32,
+	createEntry func(txHash []byte, tx data.TransactionHandler) *common.TransactionsPoolEntryAPIResponse,
+) *poolEventsNotifier {
+	return &poolEventsNotifier{
+		subscriptions:    make(map[uint64]*poolEventsSubscription),
+		maxSubscriptions: int(maxSubscriptions),
+		createEntry:      createEntry,
+	}
+}
+
+func (notifier *poolEventsNotifier) subscribe(sender []byte, receiver []byte, filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error) {
+	notifier.mutSubscriptions.Lock()
+	if len(notifier.subscriptions) >= notifier.maxSubscriptions {
+		notifier.mutSubscriptions.Unlock()
+		return nil, common.ErrTooManyPoolSubscriptions
+	}
+
+	notifier.lastID++
+	subscription := &poolEventsSubscription{
+		id:       notifier.lastID,
+		sender:   sender,
+		receiver: receiver,
+		filter:   filter,
+		chEvents: make(chan *common.TransactionsPoolEventAPIResponse, poolEventsSubscriptionBufferSize),
+	}
+	notifier.subscriptions[subscription.id] = subscription
+	notifier.mutSubscriptions.Unlock()
+
+	return &common.TransactionsPoolSubscription{
+		Events: subscription.chEvents,
+		Unsubscribe: func() {
+			notifier.unsubscribe(subscription.id)
+		},
+	}, nil
+}
+
+func (notifier *poolEventsNotifier) unsubscribe(id uint64) {
+	notifier.mutSubscriptions.Lock()
+	defer notifier.mutSubscriptions.Unlock()
+
+	subscription, ok := notifier.subscriptions[id]
+	if !ok {
+		return
+	}
+
+	delete(notifier.subscriptions, id)
+	close(subscription.chEvents)
+}
+
+func (notifier *poolEventsNotifier) numSubscriptions() int {
+	notifier.mutSubscriptions.RLock()
+	defer notifier.mutSubscriptions.RUnlock()
+
+	return len(notifier.subscriptions)
+}
+
+// onTxEvents is registered as events handler on the transactions caches. It is called by the events delivery go routine
+// of the cache, thus it should never block, otherwise the cache drops the following events
+func (notifier *poolEventsNotifier) onTxEvents(eventType txcache.TxEventType, txs []*txcache.WrappedTransaction) {
+	notifier.mutSubscriptions.RLock()
+	defer notifier.mutSubscriptions.RUnlock()
+
+	if len(notifier.subscriptions) == 0 {
+		return
+	}
+
+	if eventType == txcache.TxEventDropped {
+		// the dropped events can not be matched against the filters, thus all the subscriptions are notified
+		event := &common.TransactionsPoolEventAPIResponse{Event: string(eventType)}
+		for _, subscription := range notifier.subscriptions {
+			if !subscription.overflowed.IsSet() {
+				notifier.push(subscription, event)
+			}
+		}
+		return
+	}
+
+	for _, wrappedTx := range txs {
+		tx, ok := wrappedTx.Tx.(*transaction.Transaction)
+		if !ok {
+			continue
+		}
+
+		var event *common.TransactionsPoolEventAPIResponse
+		for _, subscription := range notifier.subscriptions {
+			if subscription.overflowed.IsSet() || !isTransactionMatchingFilter(tx, subscription.sender, subscription.receiver, subscription.filter) {
+				continue
+			}
+			if event == nil {
+				event = &common.TransactionsPoolEventAPIResponse{
+					Event:       string(eventType),
+					Transaction: notifier.createEntry(wrappedTx.TxHash, tx),
+				}
+			}
+
+			notifier.push(subscription, event)
+		}
+	}
+}
+
+func (notifier *poolEventsNotifier) push(subscription *poolEventsSubscription, event *common.TransactionsPoolEventAPIResponse) {
+	select {
+	case subscription.chEvents <- event:
+	default:
+		if !subscription.overflowed.SetReturningPrevious() {
+			log.Debug("transactions pool subscription does not keep up with the events, ending it", "id", subscription.id)
+			// the subscriptions can not be altered while they are iterated (under read lock)
+			go notifier.unsubscribe(subscription.id)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package transactionAPI
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPoolEventsNotifierForTest() *poolEventsNotifier {
+	return newPoolEventsNotifier(3, func(txHash []byte, tx data.TransactionHandler) *common.TransactionsPoolEntryAPIResponse {
+		return &common.TransactionsPoolEntryAPIResponse{
+			Hash:  hex.EncodeToString(txHash),
+			Nonce: tx.GetNonce(),
+		}
+	})
+}
+
+func createWrappedTxForTest(hash string, sender string, receiver string, nonce uint64) *txcache.WrappedTransaction {
+	return &txcache.WrappedTransaction{
+		Tx:     &transaction.Transaction{Nonce: nonce, SndAddr: []byte(sender), RcvAddr: []byte(receiver)},
+		TxHash: []byte(hash),
+	}
+}
+
+func TestPoolEventsNotifier_ShouldDispatchToTheMatchingSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	notifier := createPoolEventsNotifierForTest()
+	all, _ := notifier.subscribe(nil, nil, common.TransactionsPoolFilter{})
+	fromAlice, _ := notifier.subscribe([]byte("alice"), nil, common.TransactionsPoolFilter{})
+	toAlice, _ := notifier.subscribe(nil, []byte("alice"), common.TransactionsPoolFilter{})
+
+	notifier.onTxEvents(txcache.TxEventAdded, []*txcache.WrappedTransaction{
+		createWrappedTxForTest("a", "alice", "bob", 1),
+		createWrappedTxForTest("b", "bob", "alice", 7),
+		{Tx: &rewardTx.RewardTx{}, TxHash: []byte("reward")},
+	})
+	notifier.onTxEvents(txcache.TxEventEvicted, []*txcache.WrappedTransaction{
+		createWrappedTxForTest("a", "alice", "bob", 1),
+	})
+
+	requireEvents(t, all, "added:61", "added:62", "evicted:61")
+	requireEvents(t, fromAlice, "added:61", "evicted:61")
+	requireEvents(t, toAlice, "added:62")
+}
+
+func TestPoolEventsNotifier_SubscribeShouldErrWhenTheMaximumIsReached(t *testing.T) {
+	t.Parallel()
+
+	notifier := createPoolEventsNotifierForTest()
+	subscriptions := make([]*common.TransactionsPoolSubscription, 0)
+	for i := 0; i < 3; i++ {
+		subscription, err := notifier.subscribe(nil, nil, common.TransactionsPoolFilter{})
+		require.Nil(t, err)
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	subscription, err := notifier.subscribe(nil, nil, common.TransactionsPoolFilter{})
+	assert.Nil(t, subscription)
+	assert.Equal(t, common.ErrTooManyPoolSubscriptions, err)
+
+	subscriptions[0].Unsubscribe()
+	subscription, err = notifier.subscribe(nil, nil, common.TransactionsPoolFilter{})
+	assert.NotNil(t, subscription)
+	assert.Nil(t, err)
+}
+
+func TestPoolEventsNotifier_UnsubscribeShouldCloseTheEvents(t *testing.T) {
+	t.Parallel()
+
+	notifier := createPoolEventsNotifierForTest()
+	subscription, _ := notifier.subscribe(nil, nil, common.TransactionsPoolFilter{})
+	require.Equal(t, 1, notifier.numSubscriptions())
+
+	subscription.Unsubscribe()
+	subscription.Unsubscribe()
+	assert.Equal(t, 0, notifier.numSubscriptions())
+
+	_, isOpen := <-subscription.Events
+	assert.False(t, isOpen)
+
+	assert.NotPanics(t, func() {
+		notifier.onTxEvents(txcache.TxEventAdded, []*txcache.WrappedTransaction{createWrappedTxForTest("a", "alice", "bob", 1)})
+	})
+}
+
+func TestPoolEventsNotifier_SlowSubscriptionShouldBeEnded(t *testing.T) {
+	t.Parallel()
+
+	notifier := createPoolEventsNotifierForTest()
+	subscription, _ := notifier.subscribe(nil, nil, common.TransactionsPoolFilter{})
+
+	for i := 0; i < poolEventsSubscriptionBufferSize+1; i++ {
+		notifier.onTxEvents(txcache.TxEventAdded, []*txcache.WrappedTransaction{createWrappedTxForTest("a", "alice", "bob", uint64(i))})
+	}
+
+	numEvents := 0
+	timeout := time.After(time.Second * 5)
+	for {
+		select {
+		case _, isOpen := <-subscription.Events:
+			if !isOpen {
+				assert.Equal(t, poolEventsSubscriptionBufferSize, numEvents)
+				assert.Equal(t, 0, notifier.numSubscriptions())
+				return
+			}
+			numEvents++
+		case <-timeout:
+			require.Fail(t, "the slow subscription should have been ended")
+		}
+	}
+}
+
+func TestPoolEventsNotifier_DroppedEventsShouldBeNotifiedToAllTheSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	notifier := createPoolEventsNotifierForTest()
+	all, _ := notifier.subscribe(nil, nil, common.TransactionsPoolFilter{})
+	fromAlice, _ := notifier.subscribe([]byte("alice"), nil, common.TransactionsPoolFilter{})
+
+	notifier.onTxEvents(txcache.TxEventAdded, []*txcache.WrappedTransaction{createWrappedTxForTest("a", "alice", "bob", 1)})
+	notifier.onTxEvents(txcache.TxEventDropped, nil)
+	notifier.onTxEvents(txcache.TxEventAdded, []*txcache.WrappedTransaction{createWrappedTxForTest("b", "bob", "alice", 2)})
+
+	requireEvents(t, all, "added:61", "dropped", "added:62")
+	requireEvents(t, fromAlice, "added:61", "dropped")
+}
+
+func TestPoolEventsNotifier_ShouldNotifyTheEventsDroppedByTheCache(t *testing.T) {
+	t.Parallel()
+
+	cache, err := txcache.NewTxCache(txcache.ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  1,
+		NumBytesPerSenderThreshold: 1048576,
+		CountPerSenderThreshold:    math.MaxUint32,
+	}, &txcachemocks.TxGasHandlerMock{
+		MinimumGasMove:       50000,
+		MinimumGasPrice:      1000000000,
+		GasProcessingDivisor: 100,
+	})
+	require.Nil(t, err)
+
+	notifier := createPoolEventsNotifierForTest()
+	subscription, _ := notifier.subscribe(nil, nil, common.TransactionsPoolFilter{})
+	release := make(chan struct{})
+	cache.RegisterEventsHandler(func(eventType txcache.TxEventType, txs []*txcache.WrappedTransaction) {
+		<-release
+		notifier.onTxEvents(eventType, txs)
+	})
+
+	// more events than the cache queue and the subscription buffer can hold would end the subscription, thus the
+	// number of added transactions only exceeds the capacity of the cache queue
+	numTxs := 1100
+	for i := 0; i < numTxs; i++ {
+		cache.AddTx(createWrappedTxForTest(fmt.Sprintf("tx%d", i), "alice", "bob", uint64(i)))
+	}
+	close(release)
+
+	numDelivered := numTxs - int(cache.GetNumDroppedEvents())
+	require.True(t, numDelivered < numTxs)
+	for i := 0; i < numDelivered; i++ {
+		select {
+		case event := <-subscription.Events:
+			require.Equal(t, string(txcache.TxEventAdded), event.Event)
+		case <-time.After(time.Second):
+			require.Fail(t, "missing added event")
+		}
+	}
+	select {
+	case event := <-subscription.Events:
+		require.Equal(t, string(txcache.TxEventDropped), event.Event)
+		require.Nil(t, event.Transaction)
+	case <-time.After(time.Second):
+		require.Fail(t, "missing dropped event")
+	}
+}
+
+func formatEvent(event *common.TransactionsPoolEventAPIResponse) string {
+	if event.Transaction == nil {
+		return event.Event
+	}
+
+	return event.Event + ":" + event.Transaction.Hash
+}
+
+func requireEvents(t *testing.T, subscription *common.TransactionsPoolSubscription, expected ...string) {
+	for _, expectedEvent := range expected {
+		select {
+		case event := <-subscription.Events:
+			require.Equal(t, expectedEvent, formatEvent(event))
+		default:
+			require.Fail(t, "missing event "+expectedEvent)
+		}
+	}
+
+	select {
+	case event := <-subscription.Events:
+		require.Fail(t, "unexpected event "+formatEvent(event))
+	default:
+	}
+}
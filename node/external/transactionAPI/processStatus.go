@@ -0,0 +1,111 @@
+package transactionAPI
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// The steps of the processing of a transaction, in the order they happen
+const (
+	stepExecutedAtSource             = "executedAtSource"
+	stepNotarizedAtSourceInMeta      = "notarizedAtSourceInMeta"
+	stepExecutedAtDestination        = "executedAtDestination"
+	stepNotarizedAtDestinationInMeta = "notarizedAtDestinationInMeta"
+)
+
+// processStatusUnknown is reported for the results which were not recorded by the node, as they neither originate
+// from its shard nor target it
+const processStatusUnknown = "unknown"
+
+func createProcessStatus(hash []byte, tx *transaction.ApiTransactionResult, inPool bool, selfShardID uint32) *common.TransactionProcessStatusAPIResponse {
+	response := &common.TransactionProcessStatusAPIResponse{
+		Hash:             hex.EncodeToString(hash),
+		Type:             tx.Type,
+		Status:           string(tx.Status),
+		SourceShard:      tx.SourceShard,
+		DestinationShard: tx.DestinationShard,
+		InPool:           inPool,
+		Steps:            createProcessSteps(tx, selfShardID),
+	}
+
+	for _, step := range response.Steps {
+		if !step.Done {
+			response.PendingStep = step.Name
+			break
+		}
+	}
+
+	return response
+}
+
+func createUnknownProcessStatus(hash []byte) *common.TransactionProcessStatusAPIResponse {
+	return &common.TransactionProcessStatusAPIResponse{
+		Hash:   hex.EncodeToString(hash),
+		Type:   string(transaction.TxTypeUnsigned),
+		Status: processStatusUnknown,
+		Steps:  make([]*common.TransactionProcessStepAPIResponse, 0),
+	}
+}
+
+// createProcessSteps relies on the block recorded by the node for the transaction (in the source shard or in the
+// destination shard, depending on the shard of the node) and on the notarization of the miniblock by the metachain,
+// which is tracked for both the source and the destination shards
+func createProcessSteps(tx *transaction.ApiTransactionResult, selfShardID uint32) []*common.TransactionProcessStepAPIResponse {
+	isInBlock := tx.BlockNonce > 0
+	isNotarizedAtSource := tx.NotarizedAtSourceInMetaNonce > 0
+	isNotarizedAtDestination := tx.NotarizedAtDestinationInMetaNonce > 0
+
+	executedAtSource := &common.TransactionProcessStepAPIResponse{
+		Name:  stepExecutedAtSource,
+		Shard: tx.SourceShard,
+	}
+	if tx.SourceShard == selfShardID {
+		setBlockOfStep(executedAtSource, isInBlock, tx.BlockNonce, tx.BlockHash)
+	} else {
+		// the transactions coming from other shards reach the node only after being executed in their source shard
+		executedAtSource.Done = true
+	}
+
+	notarizedAtSource := &common.TransactionProcessStepAPIResponse{
+		Name:  stepNotarizedAtSourceInMeta,
+		Shard: core.MetachainShardId,
+	}
+	setBlockOfStep(notarizedAtSource, isNotarizedAtSource, tx.NotarizedAtSourceInMetaNonce, tx.NotarizedAtSourceInMetaHash)
+
+	isIntraShard := tx.SourceShard == tx.DestinationShard
+	if isIntraShard || tx.Status == transaction.TxStatusInvalid {
+		return []*common.TransactionProcessStepAPIResponse{executedAtSource, notarizedAtSource}
+	}
+
+	executedAtDestination := &common.TransactionProcessStepAPIResponse{
+		Name:  stepExecutedAtDestination,
+		Shard: tx.DestinationShard,
+	}
+	if tx.DestinationShard == selfShardID {
+		setBlockOfStep(executedAtDestination, isInBlock, tx.BlockNonce, tx.BlockHash)
+	} else {
+		// the metachain notarizes the destination block only after it executed the transaction
+		executedAtDestination.Done = isNotarizedAtDestination
+	}
+
+	notarizedAtDestination := &common.TransactionProcessStepAPIResponse{
+		Name:  stepNotarizedAtDestinationInMeta,
+		Shard: core.MetachainShardId,
+	}
+	setBlockOfStep(notarizedAtDestination, isNotarizedAtDestination, tx.NotarizedAtDestinationInMetaNonce, tx.NotarizedAtDestinationInMetaHash)
+
+	return []*common.TransactionProcessStepAPIResponse{executedAtSource, notarizedAtSource, executedAtDestination, notarizedAtDestination}
+}
+
+func setBlockOfStep(step *common.TransactionProcessStepAPIResponse, done bool, blockNonce uint64, blockHash string) {
+	step.Done = done
+	if !done {
+		return
+	}
+
+	step.BlockNonce = blockNonce
+	step.BlockHash = blockHash
+}
@@ -1,16 +1,26 @@
 package mock
 
 import (
+	"context"
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common"
 )
 
 // TransactionAPIHandlerStub -
 type TransactionAPIHandlerStub struct {
-	GetTransactionCalled       func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionsPoolCalled  func() (*common.TransactionsPoolAPIResponse, error)
-	UnmarshalTransactionCalled func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
-	UnmarshalReceiptCalled     func(receiptBytes []byte) (*transaction.ApiReceipt, error)
+	GetTransactionCalled                    func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionsPoolCalled               func() (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionProcessStatusCalled       func(txHash string) (*common.TransactionProcessStatusAPIResponse, error)
+	WaitForTransactionsInclusionCalled      func(ctx context.Context, txsHashes []string, timeout time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error)
+	GetTransactionsPoolForSenderCalled      func(sender string) (*common.TransactionsPoolSenderAPIResponse, error)
+	GetTransactionsPoolByFilterCalled       func(filter common.TransactionsPoolFilter) ([]*common.TransactionsPoolEntryAPIResponse, error)
+	SubscribeToTransactionsPoolEventsCalled func(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error)
+	GetTransactionsByAddressCalled          func(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error)
+	GetLogEventsCalled                      func(address string, query common.LogEventsQuery) ([]*common.LogEventAPIResponse, error)
+	UnmarshalTransactionCalled              func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
+	UnmarshalReceiptCalled                  func(receiptBytes []byte) (*transaction.ApiReceipt, error)
 }
 
 // GetTransaction -
@@ -31,6 +41,69 @@ func (tas *TransactionAPIHandlerStub) GetTransactionsPool() (*common.Transaction
 	return nil, nil
 }
 
+// GetTransactionProcessStatus -
+func (tas *TransactionAPIHandlerStub) GetTransactionProcessStatus(txHash string) (*common.TransactionProcessStatusAPIResponse, error) {
+	if tas.GetTransactionProcessStatusCalled != nil {
+		return tas.GetTransactionProcessStatusCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// WaitForTransactionsInclusion -
+func (tas *TransactionAPIHandlerStub) WaitForTransactionsInclusion(ctx context.Context, txsHashes []string, timeout time.Duration) (map[string]*common.TransactionInclusionAPIResponse, error) {
+	if tas.WaitForTransactionsInclusionCalled != nil {
+		return tas.WaitForTransactionsInclusionCalled(ctx, txsHashes, timeout)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolForSender -
+func (tas *TransactionAPIHandlerStub) GetTransactionsPoolForSender(sender string) (*common.TransactionsPoolSenderAPIResponse, error) {
+	if tas.GetTransactionsPoolForSenderCalled != nil {
+		return tas.GetTransactionsPoolForSenderCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolByFilter -
+func (tas *TransactionAPIHandlerStub) GetTransactionsPoolByFilter(filter common.TransactionsPoolFilter) ([]*common.TransactionsPoolEntryAPIResponse, error) {
+	if tas.GetTransactionsPoolByFilterCalled != nil {
+		return tas.GetTransactionsPoolByFilterCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// SubscribeToTransactionsPoolEvents -
+func (tas *TransactionAPIHandlerStub) SubscribeToTransactionsPoolEvents(filter common.TransactionsPoolFilter) (*common.TransactionsPoolSubscription, error) {
+	if tas.SubscribeToTransactionsPoolEventsCalled != nil {
+		return tas.SubscribeToTransactionsPoolEventsCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// GetLogEvents -
+func (tas *TransactionAPIHandlerStub) GetLogEvents(address string, query common.LogEventsQuery) ([]*common.LogEventAPIResponse, error) {
+	if tas.GetLogEventsCalled != nil {
+		return tas.GetLogEventsCalled(address, query)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsByAddress -
+func (tas *TransactionAPIHandlerStub) GetTransactionsByAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+	if tas.GetTransactionsByAddressCalled != nil {
+		return tas.GetTransactionsByAddressCalled(address, from, size)
+	}
+
+	return nil, nil
+}
+
 // UnmarshalTransaction -
 func (tas *TransactionAPIHandlerStub) UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error) {
 	if tas.UnmarshalTransactionCalled != nil {
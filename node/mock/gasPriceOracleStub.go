@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// GasPriceOracleStub -
+type GasPriceOracleStub struct {
+	GetGasPriceSuggestionsCalled func() (*common.GasPriceSuggestionsAPIResponse, error)
+}
+
+// GetGasPriceSuggestions -
+func (stub *GasPriceOracleStub) GetGasPriceSuggestions() (*common.GasPriceSuggestionsAPIResponse, error) {
+	if stub.GetGasPriceSuggestionsCalled != nil {
+		return stub.GetGasPriceSuggestionsCalled()
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *GasPriceOracleStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// StateStatisticsHandlerStub -
+type StateStatisticsHandlerStub struct {
+	GetStateStatisticsCalled func() (*common.StateStatisticsAPIResponse, error)
+	CloseCalled              func() error
+}
+
+// GetStateStatistics -
+func (stub *StateStatisticsHandlerStub) GetStateStatistics() (*common.StateStatisticsAPIResponse, error) {
+	if stub.GetStateStatisticsCalled != nil {
+		return stub.GetStateStatisticsCalled()
+	}
+
+	return nil, nil
+}
+
+// Close -
+func (stub *StateStatisticsHandlerStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *StateStatisticsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
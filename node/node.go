@@ -14,10 +14,12 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-crypto"
 	disabledSig "github.com/ElrondNetwork/elrond-go-crypto/signing/disabled/singlesig"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
@@ -64,6 +66,7 @@ type Node struct {
 	peerDenialEvaluator p2p.PeerDenialEvaluator
 	hardforkTrigger     HardforkTrigger
 	esdtStorageHandler  vmcommon.ESDTNFTStorageHandler
+	blockInfoProvider   process.BlockInfoProvider
 
 	consensusType       string
 	bootstrapRoundIndex uint64
@@ -155,28 +158,28 @@ func (n *Node) GetConsensusGroupSize() int {
 	return n.consensusGroupSize
 }
 
-// GetBalance gets the balance for a specific address
-func (n *Node) GetBalance(address string) (*big.Int, error) {
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+// GetBalance gets the balance for a specific address at the block described by the provided options
+func (n *Node) GetBalance(address string, options common.BlockQueryOptions) (*big.Int, common.BlockInfo, error) {
+	userAccount, blockInfo, err := n.getAccountHandlerAPIAccountsWithOptions(address, options)
 	if err != nil {
 		if err == ErrCannotCastAccountHandlerToUserAccountHandler {
-			return big.NewInt(0), nil
+			return big.NewInt(0), blockInfo, nil
 		}
-		return nil, err
+		return nil, common.BlockInfo{}, err
 	}
 
-	return userAccount.GetBalance(), nil
+	return userAccount.GetBalance(), blockInfo, nil
 }
 
-// GetUsername gets the username for a specific address
-func (n *Node) GetUsername(address string) (string, error) {
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+// GetUsername gets the username for a specific address at the block described by the provided options
+func (n *Node) GetUsername(address string, options common.BlockQueryOptions) (string, common.BlockInfo, error) {
+	userAccount, blockInfo, err := n.getAccountHandlerAPIAccountsWithOptions(address, options)
 	if err != nil {
-		return "", err
+		return "", common.BlockInfo{}, err
 	}
 
 	username := userAccount.GetUserName()
-	return string(username), nil
+	return string(username), blockInfo, nil
 }
 
 // GetAllIssuedESDTs returns all the issued esdt tokens, works only on metachain
@@ -252,26 +255,26 @@ func (n *Node) getEsdtDataFromLeaf(leaf core.KeyValueHolder, userAccount state.U
 	return esdtToken, true
 }
 
-// GetKeyValuePairs returns all the key-value pairs under the address
-func (n *Node) GetKeyValuePairs(address string, ctx context.Context) (map[string]string, error) {
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+// GetKeyValuePairs returns all the key-value pairs under the address at the block described by the provided options
+func (n *Node) GetKeyValuePairs(address string, options common.BlockQueryOptions, ctx context.Context) (map[string]string, common.BlockInfo, error) {
+	userAccount, blockInfo, err := n.getAccountHandlerAPIAccountsWithOptions(address, options)
 	if err != nil {
-		return nil, err
+		return nil, common.BlockInfo{}, err
 	}
 
 	if check.IfNil(userAccount.DataTrie()) {
-		return map[string]string{}, nil
+		return map[string]string{}, blockInfo, nil
 	}
 
 	rootHash, err := userAccount.DataTrie().RootHash()
 	if err != nil {
-		return nil, err
+		return nil, common.BlockInfo{}, err
 	}
 
 	chLeaves := make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity)
 	err = userAccount.DataTrie().GetAllLeavesOnChannel(chLeaves, ctx, rootHash)
 	if err != nil {
-		return nil, err
+		return nil, common.BlockInfo{}, err
 	}
 
 	mapToReturn := make(map[string]string)
@@ -287,55 +290,55 @@ func (n *Node) GetKeyValuePairs(address string, ctx context.Context) (map[string
 	}
 
 	if common.IsContextDone(ctx) {
-		return nil, ErrTrieOperationsTimeout
+		return nil, common.BlockInfo{}, ErrTrieOperationsTimeout
 	}
 
-	return mapToReturn, nil
+	return mapToReturn, blockInfo, nil
 }
 
-// GetValueForKey will return the value for a key from a given account
-func (n *Node) GetValueForKey(address string, key string) (string, error) {
+// GetValueForKey will return the value for a key from a given account at the block described by the provided options
+func (n *Node) GetValueForKey(address string, key string, options common.BlockQueryOptions) (string, common.BlockInfo, error) {
 	keyBytes, err := hex.DecodeString(key)
 	if err != nil {
-		return "", fmt.Errorf("invalid key: %w", err)
+		return "", common.BlockInfo{}, fmt.Errorf("invalid key: %w", err)
 	}
 
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+	userAccount, blockInfo, err := n.getAccountHandlerAPIAccountsWithOptions(address, options)
 	if err != nil {
-		return "", err
+		return "", common.BlockInfo{}, err
 	}
 
 	valueBytes, err := userAccount.DataTrieTracker().RetrieveValue(keyBytes)
 	if err != nil {
-		return "", fmt.Errorf("fetching value error: %w", err)
+		return "", common.BlockInfo{}, fmt.Errorf("fetching value error: %w", err)
 	}
 
-	return hex.EncodeToString(valueBytes), nil
+	return hex.EncodeToString(valueBytes), blockInfo, nil
 }
 
-// GetESDTData returns the esdt balance and properties from a given account
-func (n *Node) GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error) {
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+// GetESDTData returns the esdt balance and properties from a given account at the block described by the provided options
+func (n *Node) GetESDTData(address, tokenID string, nonce uint64, options common.BlockQueryOptions) (*esdt.ESDigitalToken, common.BlockInfo, error) {
+	userAccount, blockInfo, err := n.getAccountHandlerAPIAccountsWithOptions(address, options)
 	if err != nil {
-		return nil, err
+		return nil, common.BlockInfo{}, err
 	}
 
 	userAccountVmCommon, ok := userAccount.(vmcommon.UserAccountHandler)
 	if !ok {
-		return nil, ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler
+		return nil, common.BlockInfo{}, ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler
 	}
 
 	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + tokenID)
 	esdtToken, _, err := n.esdtStorageHandler.GetESDTNFTTokenOnDestination(userAccountVmCommon, esdtTokenKey, nonce)
 	if err != nil {
-		return nil, err
+		return nil, common.BlockInfo{}, err
 	}
 
 	if esdtToken.TokenMetaData != nil {
 		esdtToken.TokenMetaData.Creator = []byte(n.coreComponents.AddressPubKeyConverter().Encode(esdtToken.TokenMetaData.Creator))
 	}
 
-	return esdtToken, nil
+	return esdtToken, blockInfo, nil
 }
 
 func (n *Node) getTokensIDsWithFilter(
@@ -463,16 +466,16 @@ func bigToString(bigValue *big.Int) string {
 	return bigValue.String()
 }
 
-// GetAllESDTTokens returns all the ESDTs that the given address interacted with
-func (n *Node) GetAllESDTTokens(address string, ctx context.Context) (map[string]*esdt.ESDigitalToken, error) {
-	userAccount, err := n.getAccountHandlerAPIAccounts(address)
+// GetAllESDTTokens returns all the ESDTs that the given address interacted with, at the block described by the provided options
+func (n *Node) GetAllESDTTokens(address string, options common.BlockQueryOptions, ctx context.Context) (map[string]*esdt.ESDigitalToken, common.BlockInfo, error) {
+	userAccount, blockInfo, err := n.getAccountHandlerAPIAccountsWithOptions(address, options)
 	if err != nil {
-		return nil, err
+		return nil, common.BlockInfo{}, err
 	}
 
 	allESDTs := make(map[string]*esdt.ESDigitalToken)
 	if check.IfNil(userAccount.DataTrie()) {
-		return allESDTs, nil
+		return allESDTs, blockInfo, nil
 	}
 
 	esdtPrefix := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
@@ -480,13 +483,13 @@ func (n *Node) GetAllESDTTokens(address string, ctx context.Context) (map[string
 
 	rootHash, err := userAccount.DataTrie().RootHash()
 	if err != nil {
-		return nil, err
+		return nil, common.BlockInfo{}, err
 	}
 
 	chLeaves := make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity)
 	err = userAccount.DataTrie().GetAllLeavesOnChannel(chLeaves, ctx, rootHash)
 	if err != nil {
-		return nil, err
+		return nil, common.BlockInfo{}, err
 	}
 
 	for leaf := range chLeaves {
@@ -500,7 +503,7 @@ func (n *Node) GetAllESDTTokens(address string, ctx context.Context) (map[string
 
 		userAccountVmCommon, ok := userAccount.(vmcommon.UserAccountHandler)
 		if !ok {
-			return nil, ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler
+			return nil, common.BlockInfo{}, ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler
 		}
 
 		tokenID, nonce := common.ExtractTokenIDAndNonceFromTokenStorageKey([]byte(tokenName))
@@ -521,10 +524,10 @@ func (n *Node) GetAllESDTTokens(address string, ctx context.Context) (map[string
 	}
 
 	if common.IsContextDone(ctx) {
-		return nil, ErrTrieOperationsTimeout
+		return nil, common.BlockInfo{}, ErrTrieOperationsTimeout
 	}
 
-	return allESDTs, nil
+	return allESDTs, blockInfo, nil
 }
 
 func adjustNftTokenIdentifier(token string, nonce uint64) string {
@@ -547,32 +550,120 @@ func adjustNftTokenIdentifier(token string, nonce uint64) string {
 }
 
 func (n *Node) getAccountHandlerAPIAccounts(address string) (state.UserAccountHandler, error) {
+	userAccount, _, err := n.getAccountHandlerAPIAccountsWithOptions(address, common.BlockQueryOptions{})
+	return userAccount, err
+}
+
+func (n *Node) getAccountHandlerForPubKey(address []byte) (state.UserAccountHandler, error) {
+	account, err := n.stateComponents.AccountsAdapterAPI().GetExistingAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return nil, ErrCannotCastAccountHandlerToUserAccountHandler
+	}
+
+	return userAccount, nil
+}
+
+func (n *Node) getAccountHandlerAPIAccountsWithOptions(address string, options common.BlockQueryOptions) (state.UserAccountHandler, common.BlockInfo, error) {
 	componentsNotInitialized := check.IfNil(n.coreComponents.AddressPubKeyConverter()) ||
 		check.IfNil(n.stateComponents.AccountsAdapterAPI())
 	if componentsNotInitialized {
-		return nil, errors.New("initialize AccountsAdapterAPI, PubkeyConverter first")
+		return nil, common.BlockInfo{}, errors.New("initialize AccountsAdapterAPI, PubkeyConverter first")
 	}
 
 	addr, err := n.coreComponents.AddressPubKeyConverter().Decode(address)
 	if err != nil {
-		return nil, errors.New("invalid address, could not decode from: " + err.Error())
+		return nil, common.BlockInfo{}, errors.New("invalid address, could not decode from: " + err.Error())
 	}
 
-	return n.getAccountHandlerForPubKey(addr)
-}
-
-func (n *Node) getAccountHandlerForPubKey(address []byte) (state.UserAccountHandler, error) {
-	account, err := n.stateComponents.AccountsAdapterAPI().GetExistingAccount(address)
+	account, blockInfo, err := n.getAccountAPIAccountsWithOptions(addr, options)
 	if err != nil {
-		return nil, err
+		return nil, common.BlockInfo{}, err
 	}
 
 	userAccount, ok := n.castAccountToUserAccount(account)
 	if !ok {
-		return nil, ErrCannotCastAccountHandlerToUserAccountHandler
+		return nil, blockInfo, ErrCannotCastAccountHandlerToUserAccountHandler
 	}
 
-	return userAccount, nil
+	return userAccount, blockInfo, nil
+}
+
+// getAccountAPIAccountsWithOptions returns the account found at the given address, either from the current state or,
+// if any block identifier is provided, from the state committed by that block
+func (n *Node) getAccountAPIAccountsWithOptions(address []byte, options common.BlockQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+	if options.IsEmpty() {
+		account, err := n.stateComponents.AccountsAdapterAPI().GetExistingAccount(address)
+		if err != nil {
+			return nil, common.BlockInfo{}, err
+		}
+
+		return account, n.getCurrentBlockInfo(), nil
+	}
+
+	return n.getAccountAtBlock(address, options)
+}
+
+func (n *Node) getCurrentBlockInfo() common.BlockInfo {
+	if check.IfNil(n.dataComponents) || check.IfNil(n.dataComponents.Blockchain()) {
+		return common.BlockInfo{}
+	}
+
+	blockchain := n.dataComponents.Blockchain()
+
+	return createBlockInfo(blockchain.GetCurrentBlockHeader(), blockchain.GetCurrentBlockHeaderHash(), blockchain.GetCurrentBlockRootHash())
+}
+
+// getAccountAtBlock reads the account through a read-only trie recreated from the root hash of the requested block,
+// leaving the API accounts adapter untouched
+func (n *Node) getAccountAtBlock(address []byte, options common.BlockQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+	if check.IfNil(n.blockInfoProvider) {
+		return nil, common.BlockInfo{}, ErrNilBlockInfoProvider
+	}
+
+	header, headerHash, rootHash, err := n.blockInfoProvider.GetBlockInfo(options)
+	if err != nil {
+		return nil, common.BlockInfo{}, err
+	}
+
+	accountsAdapter := n.stateComponents.AccountsAdapterAPI()
+	mainTrie, err := accountsAdapter.GetTrie(rootHash)
+	if err != nil {
+		return nil, common.BlockInfo{}, err
+	}
+
+	accountBytes, err := mainTrie.Get(address)
+	if err != nil {
+		return nil, common.BlockInfo{}, err
+	}
+
+	blockInfo := createBlockInfo(header, headerHash, rootHash)
+	if len(accountBytes) == 0 {
+		return nil, blockInfo, state.ErrAccNotFound
+	}
+
+	account, err := accountsAdapter.GetAccountFromBytes(address, accountBytes)
+	if err != nil {
+		return nil, common.BlockInfo{}, err
+	}
+
+	return account, blockInfo, nil
+}
+
+func createBlockInfo(header data.HeaderHandler, headerHash []byte, rootHash []byte) common.BlockInfo {
+	blockInfo := common.BlockInfo{
+		Hash:     hex.EncodeToString(headerHash),
+		RootHash: hex.EncodeToString(rootHash),
+	}
+	if !check.IfNil(header) {
+		blockInfo.Nonce = header.GetNonce()
+	}
+
+	return blockInfo
 }
 
 func (n *Node) castAccountToUserAccount(ah vmcommon.AccountHandler) (state.UserAccountHandler, bool) {
@@ -589,8 +680,88 @@ func (n *Node) SendBulkTransactions(txs []*transaction.Transaction) (uint64, err
 	return n.processComponents.TxsSenderHandler().SendBulkTransactions(txs)
 }
 
-// ValidateTransaction will validate a transaction
+// SendTransactionsWithReceipts validates the provided transactions the way the interceptors would, sends the accepted
+// ones as a bulk and returns a receipt for each transaction, in the provided order, holding its hash and whether it
+// was accepted or the reason of its rejection
+func (n *Node) SendTransactionsWithReceipts(txs []*transaction.Transaction) ([]*common.TransactionSendReceiptAPIResponse, error) {
+	receipts := make([]*common.TransactionSendReceiptAPIResponse, 0, len(txs))
+	acceptedTxs := make([]*transaction.Transaction, 0, len(txs))
+	for idx, tx := range txs {
+		receipt := &common.TransactionSendReceiptAPIResponse{
+			Index: idx,
+		}
+		receipts = append(receipts, receipt)
+
+		txHash, err := core.CalculateHash(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher(), tx)
+		if err != nil {
+			receipt.Reason = common.TxRejectionInvalidTransaction
+			receipt.Error = err.Error()
+			continue
+		}
+		receipt.Hash = hex.EncodeToString(txHash)
+
+		err = n.ValidateTransaction(tx)
+		if err != nil {
+			receipt.Reason = getTxRejectionReason(err)
+			receipt.Error = err.Error()
+			continue
+		}
+
+		receipt.Accepted = true
+		acceptedTxs = append(acceptedTxs, tx)
+	}
+
+	if len(acceptedTxs) == 0 {
+		return receipts, nil
+	}
+
+	_, err := n.processComponents.TxsSenderHandler().SendBulkTransactions(acceptedTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	return receipts, nil
+}
+
+func getTxRejectionReason(err error) common.TxRejectionReason {
+	switch {
+	case errors.Is(err, process.ErrLowerNonceInTransaction):
+		return common.TxRejectionNonceTooLow
+	case errors.Is(err, process.ErrHigherNonceInTransaction):
+		return common.TxRejectionNonceTooHigh
+	case errors.Is(err, process.ErrInsufficientFunds):
+		return common.TxRejectionInsufficientBalance
+	case errors.Is(err, process.ErrAccountNotFound):
+		return common.TxRejectionSenderNotFound
+	case errors.Is(err, ErrDifferentSenderShardId):
+		return common.TxRejectionWrongShard
+	case errors.Is(err, crypto.ErrEd25519InvalidSignature),
+		errors.Is(err, crypto.ErrSigNotValid),
+		errors.Is(err, crypto.ErrInvalidPublicKey),
+		errors.Is(err, process.ErrTransactionSignedWithHashIsNotEnabled):
+		return common.TxRejectionInvalidSignature
+	default:
+		return common.TxRejectionInvalidTransaction
+	}
+}
+
+// ValidateTransaction will validate a transaction. The errors of a relayed transaction are prefixed by the failing
+// layer: the relayed transaction itself, its inner transaction or the relayer
 func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
+	err := n.validateRelayedTransactionLayers(tx, true, true)
+	if err != nil {
+		return err
+	}
+
+	err = n.validateTransaction(tx)
+	if err != nil && isRelayedTransaction(tx) {
+		return fmt.Errorf("%s: %w", relayerLayer, err)
+	}
+
+	return err
+}
+
+func (n *Node) validateTransaction(tx *transaction.Transaction) error {
 	err := n.checkSenderIsInShard(tx)
 	if err != nil {
 		return err
@@ -606,6 +777,38 @@ func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
 
 // ValidateTransactionForSimulation will validate a transaction for use in transaction simulation process
 func (n *Node) ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error {
+	err := n.validateRelayedTransactionLayers(tx, checkSignature, true)
+	if err != nil {
+		return err
+	}
+
+	err = n.validateTransactionForSimulation(tx, checkSignature)
+	if err != nil && isRelayedTransaction(tx) {
+		return fmt.Errorf("%s: %w", relayerLayer, err)
+	}
+
+	return err
+}
+
+// ValidateTransactionForBundleSimulation will validate a transaction which is part of a simulated bundle. The accounts
+// are not checked, as the previous transactions of the bundle might change them. The simulator checks each transaction
+// against the state of the bundle, right before executing it
+func (n *Node) ValidateTransactionForBundleSimulation(tx *transaction.Transaction, checkSignature bool) error {
+	err := n.validateRelayedTransactionLayers(tx, checkSignature, false)
+	if err != nil {
+		return err
+	}
+
+	disabledWhiteListHandler := disabled.NewDisabledWhiteListDataVerifier()
+	_, _, err = n.commonTransactionValidation(tx, disabledWhiteListHandler, disabledWhiteListHandler, checkSignature)
+	if err != nil && isRelayedTransaction(tx) {
+		return fmt.Errorf("%s: %w", relayerLayer, err)
+	}
+
+	return err
+}
+
+func (n *Node) validateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error {
 	disabledWhiteListHandler := disabled.NewDisabledWhiteListDataVerifier()
 	txValidator, intTx, err := n.commonTransactionValidation(tx, disabledWhiteListHandler, disabledWhiteListHandler, checkSignature)
 	if err != nil {
@@ -791,35 +994,35 @@ func (n *Node) CreateTransaction(
 	return tx, txHash, nil
 }
 
-// GetAccount will return account details for a given address
-func (n *Node) GetAccount(address string) (api.AccountResponse, error) {
+// GetAccount will return account details for a given address at the block described by the provided options
+func (n *Node) GetAccount(address string, options common.BlockQueryOptions) (api.AccountResponse, common.BlockInfo, error) {
 	if check.IfNil(n.coreComponents.AddressPubKeyConverter()) {
-		return api.AccountResponse{}, ErrNilPubkeyConverter
+		return api.AccountResponse{}, common.BlockInfo{}, ErrNilPubkeyConverter
 	}
 	if check.IfNil(n.stateComponents.AccountsAdapterAPI()) {
-		return api.AccountResponse{}, ErrNilAccountsAdapter
+		return api.AccountResponse{}, common.BlockInfo{}, ErrNilAccountsAdapter
 	}
 
 	addr, err := n.coreComponents.AddressPubKeyConverter().Decode(address)
 	if err != nil {
-		return api.AccountResponse{}, err
+		return api.AccountResponse{}, common.BlockInfo{}, err
 	}
 
-	accWrp, err := n.stateComponents.AccountsAdapterAPI().GetExistingAccount(addr)
+	accWrp, blockInfo, err := n.getAccountAPIAccountsWithOptions(addr, options)
 	if err != nil {
 		if err == state.ErrAccNotFound {
 			return api.AccountResponse{
 				Address:         address,
 				Balance:         "0",
 				DeveloperReward: "0",
-			}, nil
+			}, blockInfo, nil
 		}
-		return api.AccountResponse{}, errors.New("could not fetch sender address from provided param: " + err.Error())
+		return api.AccountResponse{}, common.BlockInfo{}, errors.New("could not fetch sender address from provided param: " + err.Error())
 	}
 
 	account, ok := accWrp.(state.UserAccountHandler)
 	if !ok {
-		return api.AccountResponse{}, errors.New("account is not of type with balance and nonce")
+		return api.AccountResponse{}, common.BlockInfo{}, errors.New("account is not of type with balance and nonce")
 	}
 
 	ownerAddress := ""
@@ -838,7 +1041,7 @@ func (n *Node) GetAccount(address string) (api.AccountResponse, error) {
 		CodeMetadata:    account.GetCodeMetadata(),
 		DeveloperReward: account.GetDeveloperReward().String(),
 		OwnerAddress:    ownerAddress,
-	}, nil
+	}, blockInfo, nil
 }
 
 // GetCode returns the code for the given code hash
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/factory"
 	nodeDisabled "github.com/ElrondNetwork/elrond-go/node/disabled"
 	"github.com/ElrondNetwork/elrond-go/node/nodeDebugFactory"
+	"github.com/ElrondNetwork/elrond-go/process/blockInfo"
 	procFactory "github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
@@ -188,6 +189,17 @@ func CreateNode(
 		return nil, err
 	}
 
+	blockInfoProvider, err := blockInfo.NewBlockInfoProvider(blockInfo.ArgsBlockInfoProvider{
+		SelfShardID:              processComponents.ShardCoordinator().SelfId(),
+		BlockChain:               dataComponents.Blockchain(),
+		StorageService:           dataComponents.StorageService(),
+		Marshaller:               coreComponents.InternalMarshalizer(),
+		Uint64ByteSliceConverter: coreComponents.Uint64ByteSliceConverter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var nd *Node
 	nd, err = NewNode(
 		WithCoreComponents(coreComponents),
@@ -215,6 +227,7 @@ func CreateNode(
 		WithNodeStopChannel(coreComponents.ChanStopNodeProcess()),
 		WithImportMode(isInImportMode),
 		WithESDTNFTStorageHandler(esdtNftStorage),
+		WithBlockInfoProvider(blockInfoProvider),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -11,6 +12,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -37,11 +39,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/node/metrics"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/replay"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/factory/directoryhandler"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
 	"github.com/ElrondNetwork/elrond-go/update"
@@ -356,6 +360,30 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	if configs.OutportReplayConfig.IsOutportReplayMode {
+		err = nr.replayBlocksToOutport(
+			managedCoreComponents,
+			managedBootstrapComponents,
+			managedDataComponents,
+			managedStatusComponents,
+			nodesCoord,
+		)
+		flushOutportAfterReplay(managedStatusComponents.OutportHandler())
+		closeComponentsAfterOutportReplay(
+			healthService,
+			webServerHandler,
+			managedStatusComponents,
+			managedStateComponents,
+			managedDataComponents,
+			managedBootstrapComponents,
+			managedNetworkComponents,
+			managedCryptoComponents,
+			managedCoreComponents,
+		)
+
+		return true, err
+	}
+
 	argsGasScheduleNotifier := forking.ArgsNewGasScheduleNotifier{
 		GasScheduleConfig: configs.EpochConfig.GasSchedule,
 		ConfigDir:         configurationPaths.GasScheduleDirectoryName,
@@ -889,6 +917,113 @@ func (nr *nodeRunner) CreateManagedStatusComponents(
 	return managedStatusComponents, nil
 }
 
+// replayBlocksToOutport pushes the blocks from the configured nonces range, read from the local databases, to the
+// enabled outport drivers
+func (nr *nodeRunner) replayBlocksToOutport(
+	managedCoreComponents mainFactory.CoreComponentsHolder,
+	managedBootstrapComponents mainFactory.BootstrapComponentsHolder,
+	managedDataComponents mainFactory.DataComponentsHolder,
+	managedStatusComponents mainFactory.StatusComponentsHandler,
+	nodesCoord nodesCoordinator.NodesCoordinator,
+) error {
+	outportReplayConfig := nr.configs.OutportReplayConfig
+	outportHandler := managedStatusComponents.OutportHandler()
+	if !outportHandler.HasDrivers() {
+		return fmt.Errorf("outport replay mode: no outport driver is enabled in %s", nr.configs.ConfigurationPathsHolder.External)
+	}
+
+	currentEpoch := managedBootstrapComponents.EpochBootstrapParams().Epoch()
+	startEpoch := getOldestStoredEpoch(managedCoreComponents.PathHandler().DatabasePath(), currentEpoch)
+	blocksReplayer, err := replay.NewBlocksReplayer(replay.ArgsBlocksReplayer{
+		Store:                    managedDataComponents.StorageService(),
+		Marshaller:               managedCoreComponents.InternalMarshalizer(),
+		Uint64ByteSliceConverter: managedCoreComponents.Uint64ByteSliceConverter(),
+		NodesCoordinator:         nodesCoord,
+		EconomicsHandler:         managedCoreComponents.EconomicsData(),
+		OutportHandler:           outportHandler,
+		ShardID:                  managedBootstrapComponents.ShardCoordinator().SelfId(),
+		StartEpoch:               startEpoch,
+		CurrentEpoch:             currentEpoch,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("replaying blocks to the outport drivers",
+		"from nonce", outportReplayConfig.FromNonce,
+		"to nonce", outportReplayConfig.ToNonce,
+		"oldest stored epoch", startEpoch,
+		"current epoch", currentEpoch)
+
+	err = blocksReplayer.Replay(outportReplayConfig.FromNonce, outportReplayConfig.ToNonce)
+	if err != nil {
+		return err
+	}
+
+	log.Info("outport replay finished", "from nonce", outportReplayConfig.FromNonce, "to nonce", outportReplayConfig.ToNonce)
+
+	return nil
+}
+
+// getOldestStoredEpoch returns the oldest epoch directory found in the database path, or the current epoch if none
+// can be read
+func getOldestStoredEpoch(databasePath string, currentEpoch uint32) uint32 {
+	directories, err := directoryhandler.NewDirectoryReader().ListDirectoriesAsString(databasePath)
+	if err != nil {
+		log.Warn("cannot read the database directory", "path", databasePath, "error", err)
+		return currentEpoch
+	}
+
+	oldestEpoch := currentEpoch
+	epochPrefix := common.DefaultEpochString + "_"
+	for _, directory := range directories {
+		if !strings.HasPrefix(directory, epochPrefix) {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(directory, epochPrefix), 10, 32)
+		if errParse != nil {
+			continue
+		}
+		if uint32(epoch) < oldestEpoch {
+			oldestEpoch = uint32(epoch)
+		}
+	}
+
+	return oldestEpoch
+}
+
+// flushOutportAfterReplay waits for the outport queues, if enabled, to deliver the replayed blocks before the
+// components are closed. The wait can be interrupted, the undelivered blocks remaining in the queues
+func flushOutportAfterReplay(outportHandler outport.OutportHandler) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+	go func() {
+		select {
+		case <-sigs:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	log.Info("waiting for the outport drivers to receive the replayed blocks")
+	err := outportHandler.Flush(ctx)
+	if err != nil {
+		log.Warn("the outport drivers did not receive all the replayed blocks, they will be delivered after a restart",
+			"error", err)
+	}
+}
+
+func closeComponentsAfterOutportReplay(closers ...io.Closer) {
+	for _, closer := range closers {
+		log.LogIfError(closer.Close())
+	}
+}
+
 func (nr *nodeRunner) logSessionInformation(
 	workingDir string,
 	sessionInfoFileOutput string,
@@ -136,7 +136,7 @@ func TestGetBalance_NoAddrConverterShouldError(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	_, err := n.GetBalance("address")
+	_, _, err := n.GetBalance("address", common.BlockQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapterAPI, PubkeyConverter first", err.Error())
 }
@@ -151,7 +151,7 @@ func TestGetBalance_NoAccAdapterShouldError(t *testing.T) {
 	n, _ := node.NewNode(
 		node.WithCoreComponents(coreComponents),
 	)
-	_, err := n.GetBalance("address")
+	_, _, err := n.GetBalance("address", common.BlockQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapterAPI, PubkeyConverter first", err.Error())
 }
@@ -181,7 +181,7 @@ func TestGetBalance_GetAccountFailsShouldError(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	_, err := n.GetBalance(createDummyHexAddress(64))
+	_, _, err := n.GetBalance(createDummyHexAddress(64), common.BlockQueryOptions{})
 	assert.Equal(t, expectedErr, err)
 }
 
@@ -220,7 +220,7 @@ func TestGetBalance_GetAccountReturnsNil(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, _, err := n.GetBalance(createDummyHexAddress(64), common.BlockQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(0), balance)
 }
@@ -243,7 +243,7 @@ func TestGetBalance(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, _, err := n.GetBalance(createDummyHexAddress(64), common.BlockQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(100), balance)
 }
@@ -277,7 +277,7 @@ func TestGetUsername(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	username, err := n.GetUsername(createDummyHexAddress(64))
+	username, _, err := n.GetUsername(createDummyHexAddress(64), common.BlockQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, string(expectedUsername), username)
 }
@@ -333,7 +333,7 @@ func TestNode_GetKeyValuePairs(t *testing.T) {
 		node.WithDataComponents(dataComponents),
 	)
 
-	pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64), context.Background())
+	pairs, _, err := n.GetKeyValuePairs(createDummyHexAddress(64), common.BlockQueryOptions{}, context.Background())
 	assert.Nil(t, err)
 	resV1, ok := pairs[hex.EncodeToString(k1)]
 	assert.True(t, ok)
@@ -389,7 +389,7 @@ func TestNode_GetKeyValuePairsContextShouldTimeout(t *testing.T) {
 	ctxWithTimeout, cancel := context.WithTimeout(context.Background(), time.Millisecond)
 	defer cancel()
 
-	pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64), ctxWithTimeout)
+	pairs, _, err := n.GetKeyValuePairs(createDummyHexAddress(64), common.BlockQueryOptions{}, ctxWithTimeout)
 	assert.Nil(t, pairs)
 	assert.Equal(t, node.ErrTrieOperationsTimeout, err)
 }
@@ -424,7 +424,7 @@ func TestNode_GetValueForKey(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	value, err := n.GetValueForKey(createDummyHexAddress(64), hex.EncodeToString(k1))
+	value, _, err := n.GetValueForKey(createDummyHexAddress(64), hex.EncodeToString(k1), common.BlockQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, hex.EncodeToString(v1), value)
 }
@@ -464,7 +464,7 @@ func TestNode_GetESDTData(t *testing.T) {
 		node.WithESDTNFTStorageHandler(esdtStorageStub),
 	)
 
-	esdtTokenData, err := n.GetESDTData(createDummyHexAddress(64), esdtToken, 0)
+	esdtTokenData, _, err := n.GetESDTData(createDummyHexAddress(64), esdtToken, 0, common.BlockQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, esdtData.Value.String(), esdtTokenData.Value.String())
 }
@@ -499,7 +499,7 @@ func TestNode_GetESDTDataForNFT(t *testing.T) {
 		node.WithESDTNFTStorageHandler(esdtStorageStub),
 	)
 
-	esdtTokenData, err := n.GetESDTData(createDummyHexAddress(64), esdtToken, uint64(nonce))
+	esdtTokenData, _, err := n.GetESDTData(createDummyHexAddress(64), esdtToken, uint64(nonce), common.BlockQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, esdtData.Value.String(), esdtTokenData.Value.String())
 }
@@ -561,7 +561,7 @@ func TestNode_GetAllESDTTokens(t *testing.T) {
 		node.WithESDTNFTStorageHandler(esdtStorageStub),
 	)
 
-	value, err := n.GetAllESDTTokens(hexAddress, context.Background())
+	value, _, err := n.GetAllESDTTokens(hexAddress, common.BlockQueryOptions{}, context.Background())
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(value))
 	assert.Equal(t, esdtData, value[esdtToken])
@@ -615,7 +615,7 @@ func TestNode_GetAllESDTTokensContextShouldTimeout(t *testing.T) {
 	ctxWithTimeout, cancel := context.WithTimeout(context.Background(), time.Millisecond)
 	defer cancel()
 
-	value, err := n.GetAllESDTTokens(hexAddress, ctxWithTimeout)
+	value, _, err := n.GetAllESDTTokens(hexAddress, common.BlockQueryOptions{}, ctxWithTimeout)
 	assert.Nil(t, value)
 	assert.Equal(t, node.ErrTrieOperationsTimeout, err)
 }
@@ -699,7 +699,7 @@ func TestNode_GetAllESDTTokensShouldReturnEsdtAndFormattedNft(t *testing.T) {
 		node.WithESDTNFTStorageHandler(esdtStorageStub),
 	)
 
-	tokens, err := n.GetAllESDTTokens(hexAddress, context.Background())
+	tokens, _, err := n.GetAllESDTTokens(hexAddress, common.BlockQueryOptions{}, context.Background())
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(tokens))
 	assert.Equal(t, esdtData, tokens[esdtToken])
@@ -2637,7 +2637,7 @@ func TestNode_GetAccountWithNilAccountsAdapterShouldErr(t *testing.T) {
 	)
 
 	stateComponents.AccountsAPI = nil
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, _, err := n.GetAccount(createDummyHexAddress(64), common.BlockQueryOptions{})
 
 	assert.Empty(t, recovAccnt)
 	assert.Equal(t, node.ErrNilAccountsAdapter, err)
@@ -2661,7 +2661,7 @@ func TestNode_GetAccountWithNilPubkeyConverterShouldErr(t *testing.T) {
 	)
 
 	coreComponents.AddrPubKeyConv = nil
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, _, err := n.GetAccount(createDummyHexAddress(64), common.BlockQueryOptions{})
 
 	assert.Empty(t, recovAccnt)
 	assert.Equal(t, node.ErrNilPubkeyConverter, err)
@@ -2691,7 +2691,7 @@ func TestNode_GetAccountPubkeyConverterFailsShouldErr(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, _, err := n.GetAccount(createDummyHexAddress(64), common.BlockQueryOptions{})
 
 	assert.Empty(t, recovAccnt)
 	assert.Equal(t, errExpected, err)
@@ -2715,7 +2715,7 @@ func TestNode_GetAccountAccountDoesNotExistsShouldRetEmpty(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, _, err := n.GetAccount(createDummyHexAddress(64), common.BlockQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(0), recovAccnt.Nonce)
@@ -2744,7 +2744,7 @@ func TestNode_GetAccountAccountsAdapterFailsShouldErr(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, _, err := n.GetAccount(createDummyHexAddress(64), common.BlockQueryOptions{})
 
 	assert.Empty(t, recovAccnt)
 	assert.NotNil(t, err)
@@ -2778,7 +2778,7 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, _, err := n.GetAccount(createDummyHexAddress(64), common.BlockQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(2), recovAccnt.Nonce)
@@ -2789,6 +2789,162 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 	assert.Equal(t, hex.EncodeToString([]byte("owner address")), recovAccnt.OwnerAddress)
 }
 
+func TestNode_GetAccountAtPastBlock(t *testing.T) {
+	t.Parallel()
+
+	accnt, _ := state.NewUserAccount([]byte("1234"))
+	_ = accnt.AddToBalance(big.NewInt(1))
+	accnt.IncreaseNonce(2)
+
+	blockRootHash := []byte("block root hash")
+	blockHash := []byte("block hash")
+	options := common.BlockQueryOptions{
+		BlockNonce: common.OptionalUint64{Value: 7, HasValue: true},
+	}
+	createNode := func(accDB state.AccountsAdapter, blockInfoProvider process.BlockInfoProvider) *node.Node {
+		coreComponents := getDefaultCoreComponents()
+		coreComponents.AddrPubKeyConv = createMockPubkeyConverter()
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = accDB
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(coreComponents),
+			node.WithStateComponents(stateComponents),
+			node.WithDataComponents(getDefaultDataComponents()),
+			node.WithBlockInfoProvider(blockInfoProvider),
+		)
+
+		return n
+	}
+	blockInfoProvider := &testscommon.BlockInfoProviderStub{
+		GetBlockInfoCalled: func(opts common.BlockQueryOptions) (data.HeaderHandler, []byte, []byte, error) {
+			assert.Equal(t, options, opts)
+			return &block.Header{Nonce: 7}, blockHash, blockRootHash, nil
+		},
+	}
+
+	t.Run("block info provider errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		errExpected := errors.New("expected error")
+		providerWithError := &testscommon.BlockInfoProviderStub{
+			GetBlockInfoCalled: func(_ common.BlockQueryOptions) (data.HeaderHandler, []byte, []byte, error) {
+				return nil, nil, nil, errExpected
+			},
+		}
+		n := createNode(&stateMock.AccountsStub{}, providerWithError)
+
+		recovAccnt, blockInfo, err := n.GetAccount(createDummyHexAddress(64), options)
+		assert.Empty(t, recovAccnt)
+		assert.Empty(t, blockInfo)
+		assert.Contains(t, err.Error(), errExpected.Error())
+	})
+	t.Run("account missing at the given block should return empty account", func(t *testing.T) {
+		t.Parallel()
+
+		accDB := &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				require.Fail(t, "the current state should have not been used")
+				return nil, nil
+			},
+			GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+				assert.Equal(t, blockRootHash, rootHash)
+				return &trieMock.TrieStub{
+					GetCalled: func(_ []byte) ([]byte, error) {
+						return nil, nil
+					},
+				}, nil
+			},
+		}
+		n := createNode(accDB, blockInfoProvider)
+
+		recovAccnt, blockInfo, err := n.GetAccount(createDummyHexAddress(64), options)
+		assert.Nil(t, err)
+		assert.Equal(t, "0", recovAccnt.Balance)
+		assert.Equal(t, uint64(7), blockInfo.Nonce)
+		assert.Equal(t, hex.EncodeToString(blockHash), blockInfo.Hash)
+		assert.Equal(t, hex.EncodeToString(blockRootHash), blockInfo.RootHash)
+	})
+	t.Run("should read the account from the trie of the given block", func(t *testing.T) {
+		t.Parallel()
+
+		accountBytes := []byte("account bytes")
+		accDB := &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				require.Fail(t, "the current state should have not been used")
+				return nil, nil
+			},
+			GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+				assert.Equal(t, blockRootHash, rootHash)
+				return &trieMock.TrieStub{
+					GetCalled: func(_ []byte) ([]byte, error) {
+						return accountBytes, nil
+					},
+				}, nil
+			},
+			GetAccountFromBytesCalled: func(_ []byte, bytes []byte) (vmcommon.AccountHandler, error) {
+				assert.Equal(t, accountBytes, bytes)
+				return accnt, nil
+			},
+		}
+		n := createNode(accDB, blockInfoProvider)
+
+		recovAccnt, blockInfo, err := n.GetAccount(createDummyHexAddress(64), options)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(2), recovAccnt.Nonce)
+		assert.Equal(t, "1", recovAccnt.Balance)
+		assert.Equal(t, uint64(7), blockInfo.Nonce)
+		assert.Equal(t, hex.EncodeToString(blockHash), blockInfo.Hash)
+		assert.Equal(t, hex.EncodeToString(blockRootHash), blockInfo.RootHash)
+
+		balance, blockInfo, err := n.GetBalance(createDummyHexAddress(64), options)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(1), balance)
+		assert.Equal(t, uint64(7), blockInfo.Nonce)
+	})
+}
+
+func TestNode_GetAccountShouldReturnCurrentBlockInfo(t *testing.T) {
+	t.Parallel()
+
+	accnt, _ := state.NewUserAccount([]byte("1234"))
+	accDB := &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (handler vmcommon.AccountHandler, e error) {
+			return accnt, nil
+		},
+	}
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.AddrPubKeyConv = createMockPubkeyConverter()
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = accDB
+	dataComponents := getDefaultDataComponents()
+	dataComponents.BlockChain = &testscommon.ChainHandlerStub{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 37}
+		},
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			return []byte("current hash")
+		},
+		GetCurrentBlockRootHashCalled: func() []byte {
+			return []byte("current root hash")
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithDataComponents(dataComponents),
+	)
+
+	_, blockInfo, err := n.GetAccount(createDummyHexAddress(64), common.BlockQueryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, common.BlockInfo{
+		Nonce:    37,
+		Hash:     hex.EncodeToString([]byte("current hash")),
+		RootHash: hex.EncodeToString([]byte("current root hash")),
+	}, blockInfo)
+}
+
 func TestNode_AppStatusHandlersShouldIncrement(t *testing.T) {
 	t.Parallel()
 
@@ -3203,6 +3359,66 @@ func TestNode_ValidateTransactionForSimulation_CheckSignatureFalse(t *testing.T)
 	require.NoError(t, err)
 }
 
+func TestNode_ValidateTransactionForBundleSimulationShouldNotCheckTheAccounts(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = getMarshalizer()
+	coreComponents.VmMarsh = getMarshalizer()
+	coreComponents.Hash = getHasher()
+	coreComponents.AddrPubKeyConv = mock.NewPubkeyConverterMock(3)
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	bootstrapComponents := getDefaultBootstrapComponents()
+	bootstrapComponents.ShCoordinator = &mock.ShardCoordinatorMock{}
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.ShardCoord = bootstrapComponents.ShCoordinator
+	processComponents.WhiteListHandlerInternal = &testscommon.WhiteListHandlerStub{}
+	processComponents.WhiteListerVerifiedTxsInternal = &testscommon.WhiteListHandlerStub{}
+	processComponents.EpochTrigger = &mock.EpochStartTriggerStub{}
+
+	cryptoComponents := getDefaultCryptoComponents()
+	cryptoComponents.TxKeyGen = &mock.KeyGenMock{
+		PublicKeyFromByteArrayMock: func(b []byte) (crypto.PublicKey, error) {
+			return nil, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithProcessComponents(processComponents),
+		node.WithBootstrapComponents(bootstrapComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithCryptoComponents(cryptoComponents),
+	)
+
+	tx := &transaction.Transaction{
+		Nonce:     11,
+		Value:     big.NewInt(25),
+		RcvAddr:   []byte("rec"),
+		SndAddr:   []byte("snd"),
+		GasPrice:  6,
+		GasLimit:  12,
+		Data:      []byte(""),
+		Signature: []byte("sig1"),
+		ChainID:   []byte(coreComponents.ChainID()),
+	}
+
+	err := n.ValidateTransactionForBundleSimulation(tx, false)
+	require.NoError(t, err)
+
+	tx.ChainID = []byte("invalid chain ID")
+	err = n.ValidateTransactionForBundleSimulation(tx, false)
+	require.Error(t, err)
+}
+
 func TestGetKeyValuePairs_CannotDecodeAddress(t *testing.T) {
 	t.Parallel()
 
@@ -3227,7 +3443,7 @@ func TestGetKeyValuePairs_CannotDecodeAddress(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 	)
 
-	res, err := n.GetKeyValuePairs("addr", context.Background())
+	res, _, err := n.GetKeyValuePairs("addr", common.BlockQueryOptions{}, context.Background())
 	require.Nil(t, res)
 	require.True(t, strings.Contains(fmt.Sprintf("%v", err), expectedErr.Error()))
 }
@@ -3628,6 +3844,269 @@ func TestNode_SendBulkTransactions(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestNode_SendTransactionsWithReceiptsRejectedShouldNotSend(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.Hash = &testscommon.HasherStub{
+		ComputeCalled: func(s string) []byte {
+			return []byte("hash")
+		},
+	}
+	bootstrapComponents := getDefaultBootstrapComponents()
+	bootstrapComponents.ShCoordinator = &mock.ShardCoordinatorMock{
+		ComputeIdCalled: func(address []byte) uint32 {
+			return 1
+		},
+		SelfShardId: 0,
+	}
+	processComponents := getDefaultProcessComponents()
+	processComponents.TxsSenderHandlerField = &txsSenderMock.TxsSenderHandlerMock{
+		SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+			require.Fail(t, "the rejected transactions should not have been sent")
+			return 0, nil
+		},
+	}
+	n, err := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithBootstrapComponents(bootstrapComponents),
+		node.WithProcessComponents(processComponents),
+	)
+	require.Nil(t, err)
+
+	txs := []*transaction.Transaction{{Nonce: 1, SndAddr: []byte("snd")}, {Nonce: 2, SndAddr: []byte("snd")}}
+	receipts, err := n.SendTransactionsWithReceipts(txs)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(receipts))
+	for idx, receipt := range receipts {
+		assert.Equal(t, idx, receipt.Index)
+		assert.Equal(t, hex.EncodeToString([]byte("hash")), receipt.Hash)
+		assert.False(t, receipt.Accepted)
+		assert.Equal(t, common.TxRejectionWrongShard, receipt.Reason)
+		assert.Contains(t, receipt.Error, node.ErrDifferentSenderShardId.Error())
+	}
+}
+
+func TestGetTxRejectionReason(t *testing.T) {
+	t.Parallel()
+
+	expectedReasons := map[error]common.TxRejectionReason{
+		fmt.Errorf("%w, account nonce: 5", process.ErrLowerNonceInTransaction):  common.TxRejectionNonceTooLow,
+		fmt.Errorf("%w, account nonce: 5", process.ErrHigherNonceInTransaction): common.TxRejectionNonceTooHigh,
+		fmt.Errorf("%w, wanted 5", process.ErrInsufficientFunds):                common.TxRejectionInsufficientBalance,
+		fmt.Errorf("%w for address", process.ErrAccountNotFound):                common.TxRejectionSenderNotFound,
+		node.ErrDifferentSenderShardId:                                          common.TxRejectionWrongShard,
+		crypto.ErrEd25519InvalidSignature:                                       common.TxRejectionInvalidSignature,
+		crypto.ErrSigNotValid:                                                   common.TxRejectionInvalidSignature,
+		process.ErrInsufficientGasLimitInTx:                                     common.TxRejectionInvalidTransaction,
+	}
+	for err, expectedReason := range expectedReasons {
+		assert.Equal(t, expectedReason, node.GetTxRejectionReason(err), err.Error())
+	}
+}
+
+func createNodeForRelayedTransactions(accountsNonces map[string]uint64, verifyErr error) *node.Node {
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.Hash = sha256.NewSha256()
+	coreComponents.AddrPubKeyConv = mock.NewPubkeyConverterMock(3)
+	coreComponents.EconomicsHandler = &economicsmocks.EconomicsHandlerMock{
+		ComputeGasLimitCalled: func(tx data.TransactionWithFeeHandler) uint64 {
+			return 50000 + uint64(len(tx.GetData()))
+		},
+	}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			nonce, ok := accountsNonces[string(address)]
+			if !ok {
+				return nil, state.ErrAccNotFound
+			}
+
+			acc, _ := state.NewUserAccount(address)
+			acc.IncreaseNonce(nonce)
+			_ = acc.AddToBalance(big.NewInt(100))
+
+			return acc, nil
+		},
+	}
+	bootstrapComponents := getDefaultBootstrapComponents()
+	bootstrapComponents.ShCoordinator = &mock.ShardCoordinatorMock{}
+	processComponents := getDefaultProcessComponents()
+	processComponents.ShardCoord = bootstrapComponents.ShCoordinator
+	processComponents.WhiteListHandlerInternal = &testscommon.WhiteListHandlerStub{}
+	processComponents.WhiteListerVerifiedTxsInternal = &testscommon.WhiteListHandlerStub{}
+	cryptoComponents := getDefaultCryptoComponents()
+	cryptoComponents.TxSig = &mock.SingleSignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			return verifyErr
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithProcessComponents(processComponents),
+		node.WithBootstrapComponents(bootstrapComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithCryptoComponents(cryptoComponents),
+		node.WithAddressSignatureSize(10),
+	)
+
+	return n
+}
+
+func createInnerTransactionForTest() *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:     5,
+		Value:     big.NewInt(0),
+		RcvAddr:   []byte("rcv"),
+		SndAddr:   []byte("usr"),
+		GasPrice:  1000000000,
+		GasLimit:  60000,
+		Data:      []byte("hello"),
+		Signature: []byte("signature"),
+		ChainID:   []byte("chainID"),
+		Version:   1,
+	}
+}
+
+func TestNode_CreateRelayedTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil inner transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForRelayedTransactions(nil, nil)
+		tx, txHash, err := n.CreateRelayedTransaction(nil, "rly", 0, "")
+		assert.Nil(t, tx)
+		assert.Nil(t, txHash)
+		assert.Equal(t, node.ErrNilInnerTransaction, err)
+	})
+	t.Run("unsigned inner transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		innerTx := createInnerTransactionForTest()
+		innerTx.Signature = nil
+
+		n := createNodeForRelayedTransactions(nil, nil)
+		tx, _, err := n.CreateRelayedTransaction(innerTx, "rly", 0, "")
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, node.ErrMissingInnerTransactionSignature))
+		assert.True(t, strings.HasPrefix(err.Error(), "inner transaction: "))
+	})
+	t.Run("invalid inner transaction signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForRelayedTransactions(nil, crypto.ErrSigNotValid)
+		tx, _, err := n.CreateRelayedTransaction(createInnerTransactionForTest(), hex.EncodeToString([]byte("rly")), 0, "")
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, crypto.ErrSigNotValid))
+		assert.True(t, strings.HasPrefix(err.Error(), "inner transaction: "))
+	})
+	t.Run("invalid relayer signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForRelayedTransactions(nil, nil)
+		tx, _, err := n.CreateRelayedTransaction(createInnerTransactionForTest(), "rly", 0, "not hex")
+		assert.Nil(t, tx)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		innerTx := createInnerTransactionForTest()
+		n := createNodeForRelayedTransactions(nil, nil)
+		tx, txHash, err := n.CreateRelayedTransaction(innerTx, hex.EncodeToString([]byte("rly")), 7, "")
+		require.Nil(t, err)
+		assert.NotNil(t, txHash)
+		assert.Equal(t, uint64(7), tx.Nonce)
+		assert.Equal(t, []byte("rly"), tx.SndAddr)
+		assert.Equal(t, innerTx.SndAddr, tx.RcvAddr)
+		assert.Equal(t, big.NewInt(0), tx.Value)
+		assert.Equal(t, innerTx.GasPrice, tx.GasPrice)
+		assert.Equal(t, 50000+uint64(len(tx.Data))+innerTx.GasLimit, tx.GasLimit)
+		assert.Equal(t, innerTx.ChainID, tx.ChainID)
+		assert.Empty(t, tx.Signature)
+
+		dataParts := strings.Split(string(tx.Data), "@")
+		require.Equal(t, 2, len(dataParts))
+		assert.Equal(t, core.RelayedTransaction, dataParts[0])
+		innerTxBytes, _ := hex.DecodeString(dataParts[1])
+		recoveredInnerTx := &transaction.Transaction{}
+		marshalizer := &testscommon.MarshalizerMock{}
+		_ = marshalizer.Unmarshal(recoveredInnerTx, innerTxBytes)
+		assert.Equal(t, innerTx, recoveredInnerTx)
+	})
+}
+
+func TestNode_ValidateRelayedTransactionShouldPrefixTheFailingLayer(t *testing.T) {
+	t.Parallel()
+
+	accountsNonces := map[string]uint64{"usr": 5, "rly": 7}
+	createRelayedTx := func(innerTx *transaction.Transaction, relayerNonce uint64) *transaction.Transaction {
+		n := createNodeForRelayedTransactions(accountsNonces, nil)
+		tx, _, err := n.CreateRelayedTransaction(innerTx, hex.EncodeToString([]byte("rly")), relayerNonce, hex.EncodeToString([]byte("sig")))
+		require.Nil(t, err)
+		return tx
+	}
+
+	t.Run("inconsistent relayed transaction", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createRelayedTx(createInnerTransactionForTest(), 7)
+		tx.GasPrice++
+
+		n := createNodeForRelayedTransactions(accountsNonces, nil)
+		err := n.ValidateTransactionForSimulation(tx, false)
+		assert.True(t, errors.Is(err, process.ErrRelayedGasPriceMissmatch))
+		assert.True(t, strings.HasPrefix(err.Error(), "relayed transaction: "))
+
+		tx = createRelayedTx(createInnerTransactionForTest(), 7)
+		tx.GasLimit--
+		err = n.ValidateTransactionForSimulation(tx, false)
+		assert.True(t, errors.Is(err, process.ErrRelayedTxGasLimitMissmatch))
+	})
+	t.Run("invalid inner transaction", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForRelayedTransactions(accountsNonces, nil)
+		innerTx := createInnerTransactionForTest()
+		innerTx.Nonce = 4
+		tx, _, err := n.CreateRelayedTransaction(innerTx, hex.EncodeToString([]byte("rly")), 7, "")
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, process.ErrLowerNonceInTransaction))
+		assert.True(t, strings.HasPrefix(err.Error(), "inner transaction: "))
+
+		innerTx = createInnerTransactionForTest()
+		innerTx.Value = big.NewInt(101)
+		tx, _, err = n.CreateRelayedTransaction(innerTx, hex.EncodeToString([]byte("rly")), 7, "")
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, process.ErrInsufficientFunds))
+		assert.True(t, strings.HasPrefix(err.Error(), "inner transaction: "))
+	})
+	t.Run("new inner transaction sender should work", func(t *testing.T) {
+		t.Parallel()
+
+		innerTx := createInnerTransactionForTest()
+		innerTx.SndAddr = []byte("new")
+		innerTx.Nonce = 0
+		tx := createRelayedTx(innerTx, 7)
+
+		n := createNodeForRelayedTransactions(accountsNonces, nil)
+		err := n.ValidateTransactionForSimulation(tx, false)
+		assert.Nil(t, err)
+	})
+	t.Run("invalid relayer nonce", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createRelayedTx(createInnerTransactionForTest(), 6)
+
+		n := createNodeForRelayedTransactions(accountsNonces, nil)
+		err := n.ValidateTransactionForSimulation(tx, false)
+		assert.True(t, errors.Is(err, process.ErrLowerNonceInTransaction))
+		assert.True(t, strings.HasPrefix(err.Error(), "relayer: "))
+	})
+}
+
 func getDefaultCoreComponents() *nodeMockFactory.CoreComponentsMock {
 	return &nodeMockFactory.CoreComponentsMock{
 		IntMarsh:            &testscommon.MarshalizerMock{},
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -332,3 +333,15 @@ func WithESDTNFTStorageHandler(storageHandler vmcommon.ESDTNFTStorageHandler) Op
 		return nil
 	}
 }
+
+// WithBlockInfoProvider sets up the provider used to resolve the blocks targeted by the historical state queries
+func WithBlockInfoProvider(blockInfoProvider process.BlockInfoProvider) Option {
+	return func(node *Node) error {
+		if check.IfNil(blockInfoProvider) {
+			return ErrNilBlockInfoProvider
+		}
+
+		node.blockInfoProvider = blockInfoProvider
+		return nil
+	}
+}
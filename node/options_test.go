@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
 )
@@ -313,3 +314,30 @@ func TestWithESDTNFTStorageHandler(t *testing.T) {
 		assert.Equal(t, esdtStorer, node.esdtStorageHandler)
 	})
 }
+
+func TestWithBlockInfoProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil block info provider, should error", func(t *testing.T) {
+		t.Parallel()
+
+		node, _ := NewNode()
+		opt := WithBlockInfoProvider(nil)
+		err := opt(node)
+
+		assert.Equal(t, ErrNilBlockInfoProvider, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		blockInfoProvider := &testscommon.BlockInfoProviderStub{}
+
+		node, _ := NewNode()
+		opt := WithBlockInfoProvider(blockInfoProvider)
+		err := opt(node)
+
+		assert.NoError(t, err)
+		assert.True(t, node.blockInfoProvider == blockInfoProvider)
+	})
+}
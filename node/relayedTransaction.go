@@ -0,0 +1,219 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node/disabled"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// the prefixes of the validation errors, telling which layer of a relayed transaction failed
+const (
+	relayedTransactionLayer = "relayed transaction"
+	innerTransactionLayer   = "inner transaction"
+	relayerLayer            = "relayer"
+)
+
+// CreateRelayedTransaction wraps the provided inner transaction, signed by its sender, into a relayed transaction
+// sent by the relayer, which pays the gas of both transactions. The relayed transaction and its inner transaction are
+// validated, while the relayer is left to the usual validation. The relayed transaction is left unsigned if no relayer
+// signature is provided, so that it can be handed to the relayer for signing
+func (n *Node) CreateRelayedTransaction(
+	innerTx *transaction.Transaction,
+	relayer string,
+	relayerNonce uint64,
+	relayerSignatureHex string,
+) (*transaction.Transaction, []byte, error) {
+	if innerTx == nil {
+		return nil, nil, ErrNilInnerTransaction
+	}
+	if len(innerTx.Signature) == 0 {
+		return nil, nil, fmt.Errorf("%s: %w", innerTransactionLayer, ErrMissingInnerTransactionSignature)
+	}
+	if len(relayerSignatureHex) > n.addressSignatureHexSize {
+		return nil, nil, fmt.Errorf("%w for relayer", ErrInvalidSignatureLength)
+	}
+	if uint32(len(relayer)) > n.coreComponents.EncodedAddressLen() {
+		return nil, nil, fmt.Errorf("%w for relayer", ErrInvalidAddressLength)
+	}
+
+	relayerAddress, err := n.coreComponents.AddressPubKeyConverter().Decode(relayer)
+	if err != nil {
+		return nil, nil, errors.New("could not create relayer address from provided param")
+	}
+
+	relayerSignatureBytes, err := hex.DecodeString(relayerSignatureHex)
+	if err != nil {
+		return nil, nil, errors.New("could not fetch relayer signature bytes")
+	}
+
+	innerTxBytes, err := n.coreComponents.TxMarshalizer().Marshal(innerTx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx := &transaction.Transaction{
+		Nonce:     relayerNonce,
+		Value:     big.NewInt(0),
+		RcvAddr:   innerTx.SndAddr,
+		SndAddr:   relayerAddress,
+		GasPrice:  innerTx.GasPrice,
+		Data:      []byte(core.RelayedTransaction + "@" + hex.EncodeToString(innerTxBytes)),
+		Signature: relayerSignatureBytes,
+		ChainID:   innerTx.ChainID,
+		Version:   innerTx.Version,
+	}
+	tx.GasLimit = n.coreComponents.EconomicsData().ComputeGasLimit(tx) + innerTx.GasLimit
+
+	err = n.validateRelayedTransactionLayers(tx, true, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txHash, err := core.CalculateHash(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher(), tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tx, txHash, nil
+}
+
+// validateRelayedTransactionLayers validates the relayed transaction itself and its inner transaction, returning an
+// error prefixed by the failing layer. Transactions which are not relayed are left to the usual validation
+func (n *Node) validateRelayedTransactionLayers(tx *transaction.Transaction, checkSignature bool, checkAccounts bool) error {
+	innerTx, isRelayed, err := n.extractInnerTransaction(tx)
+	if !isRelayed {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", relayedTransactionLayer, err)
+	}
+
+	err = n.checkRelayedTransactionConsistency(tx, innerTx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", relayedTransactionLayer, err)
+	}
+
+	err = n.validateInnerTransaction(innerTx, tx.Value, checkSignature, checkAccounts)
+	if err != nil {
+		return fmt.Errorf("%s: %w", innerTransactionLayer, err)
+	}
+
+	return nil
+}
+
+func (n *Node) extractInnerTransaction(tx *transaction.Transaction) (*transaction.Transaction, bool, error) {
+	funcName, args, err := smartContract.NewArgumentParser().ParseCallData(string(tx.Data))
+	if err != nil || funcName != core.RelayedTransaction {
+		return nil, false, nil
+	}
+	if len(args) != 1 {
+		return nil, true, process.ErrInvalidArguments
+	}
+
+	innerTx := &transaction.Transaction{}
+	err = n.coreComponents.TxMarshalizer().Unmarshal(innerTx, args[0])
+	if err != nil {
+		return nil, true, err
+	}
+
+	return innerTx, true, nil
+}
+
+// checkRelayedTransactionConsistency applies the same checks as the transactions processor does on execution
+func (n *Node) checkRelayedTransactionConsistency(tx *transaction.Transaction, innerTx *transaction.Transaction) error {
+	if !bytes.Equal(innerTx.SndAddr, tx.RcvAddr) {
+		return process.ErrRelayedTxBeneficiaryDoesNotMatchReceiver
+	}
+	if innerTx.Value == nil || tx.Value == nil || innerTx.Value.Cmp(tx.Value) < 0 {
+		return process.ErrRelayedTxValueHigherThenUserTxValue
+	}
+	if innerTx.GasPrice != tx.GasPrice {
+		return fmt.Errorf("%w, relayed gas price: %d, inner gas price: %d",
+			process.ErrRelayedGasPriceMissmatch, tx.GasPrice, innerTx.GasPrice)
+	}
+
+	relayerGasLimit := n.coreComponents.EconomicsData().ComputeGasLimit(tx)
+	if tx.GasLimit < relayerGasLimit || innerTx.GasLimit != tx.GasLimit-relayerGasLimit {
+		return fmt.Errorf("%w, relayed gas limit should be %d",
+			process.ErrRelayedTxGasLimitMissmatch, relayerGasLimit+innerTx.GasLimit)
+	}
+
+	return nil
+}
+
+// validateInnerTransaction checks the integrity and the signature of the inner transaction along with, on request, the
+// nonce and the balance of its sender. The fees are not checked as the relayer pays them
+func (n *Node) validateInnerTransaction(
+	innerTx *transaction.Transaction,
+	relayedValue *big.Int,
+	checkSignature bool,
+	checkAccount bool,
+) error {
+	funcName, _, err := smartContract.NewArgumentParser().ParseCallData(string(innerTx.Data))
+	if err == nil && (funcName == core.RelayedTransaction || funcName == core.RelayedTransactionV2) {
+		return process.ErrRecursiveRelayedTxIsNotAllowed
+	}
+
+	disabledWhiteListHandler := disabled.NewDisabledWhiteListDataVerifier()
+	_, _, err = n.commonTransactionValidation(innerTx, disabledWhiteListHandler, disabledWhiteListHandler, checkSignature)
+	if err != nil || !checkAccount {
+		return err
+	}
+
+	shardCoordinator := n.processComponents.ShardCoordinator()
+	if shardCoordinator.ComputeId(innerTx.SndAddr) != shardCoordinator.SelfId() {
+		// the inner transaction is executed in the shard of its sender, whose account can not be checked here
+		return nil
+	}
+
+	accountNonce := uint64(0)
+	accountBalance := big.NewInt(0)
+	accountHandler, err := n.stateComponents.AccountsAdapterAPI().GetExistingAccount(innerTx.SndAddr)
+	switch {
+	case err == nil:
+		account, ok := accountHandler.(state.UserAccountHandler)
+		if !ok {
+			return ErrCannotCastAccountHandlerToUserAccountHandler
+		}
+		accountNonce = account.GetNonce()
+		accountBalance = account.GetBalance()
+	case errors.Is(err, state.ErrAccNotFound):
+		// the account of the inner transaction sender is created by the relayed transaction
+	default:
+		return err
+	}
+
+	sender := n.coreComponents.AddressPubKeyConverter().Encode(innerTx.SndAddr)
+	if innerTx.Nonce < accountNonce {
+		return fmt.Errorf("%w, for address: %s, account nonce: %d, tx nonce: %d",
+			process.ErrLowerNonceInTransaction, sender, accountNonce, innerTx.Nonce)
+	}
+	if innerTx.Nonce > accountNonce+common.MaxTxNonceDeltaAllowed {
+		return fmt.Errorf("%w, for address: %s, account nonce: %d, tx nonce: %d",
+			process.ErrHigherNonceInTransaction, sender, accountNonce, innerTx.Nonce)
+	}
+
+	availableBalance := big.NewInt(0).Add(accountBalance, relayedValue)
+	if availableBalance.Cmp(innerTx.Value) < 0 {
+		return fmt.Errorf("%w, for address: %s, available balance: %s, tx value: %s",
+			process.ErrInsufficientFunds, sender, availableBalance.String(), innerTx.Value.String())
+	}
+
+	return nil
+}
+
+func isRelayedTransaction(tx *transaction.Transaction) bool {
+	funcName, _, err := smartContract.NewArgumentParser().ParseCallData(string(tx.Data))
+
+	return err == nil && funcName == core.RelayedTransaction
+}
@@ -1,6 +1,8 @@
 package disabled
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
 	"github.com/ElrondNetwork/elrond-go/outport"
@@ -56,6 +58,11 @@ func (n *disabledOutport) SubscribeDriver(_ outport.Driver) error {
 	return nil
 }
 
+// Flush does nothing
+func (n *disabledOutport) Flush(_ context.Context) error {
+	return nil
+}
+
 // HasDrivers does nothing
 func (n *disabledOutport) HasDrivers() bool {
 	return false
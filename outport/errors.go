@@ -13,3 +13,6 @@ var ErrInvalidRetrialInterval = errors.New("invalid retrial interval")
 
 // ErrNilPubKeyConverter signals that a nil pubkey converter has been provided
 var ErrNilPubKeyConverter = errors.New("nil pub key converter")
+
+// ErrNilPathManager signals that a nil path manager has been provided
+var ErrNilPathManager = errors.New("nil path manager")
@@ -0,0 +1,63 @@
+package factory
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/queue"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+// DriverQueueFactoryArgs defines the args needed for wrapping the outport drivers in durable queues
+type DriverQueueFactoryArgs struct {
+	Enabled         bool
+	MaxBacklog      uint64
+	RetrialInterval time.Duration
+	DBConfig        config.DBConfig
+	PathManager     storage.PathManagerHandler
+	ShardID         string
+	Marshaller      marshal.Marshalizer
+	StatusHandler   core.AppStatusHandler
+}
+
+// CreateDriverQueue will wrap the provided driver in a durable queue persisted on disk
+func CreateDriverQueue(args *DriverQueueFactoryArgs, name string, driver outport.Driver) (outport.Driver, error) {
+	if check.IfNil(args.PathManager) {
+		return nil, outport.ErrNilPathManager
+	}
+
+	dbConfig := storageFactory.GetDBFromConfig(args.DBConfig)
+	persister, err := storageUnit.NewDB(storageUnit.ArgDB{
+		DBType:            dbConfig.Type,
+		Path:              args.PathManager.PathForStatic(args.ShardID, filepath.Join(args.DBConfig.FilePath, name)),
+		BatchDelaySeconds: dbConfig.BatchDelaySeconds,
+		MaxBatchSize:      dbConfig.MaxBatchSize,
+		MaxOpenFiles:      dbConfig.MaxOpenFiles,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	driverQueue, err := queue.NewDriverQueue(queue.ArgsDriverQueue{
+		Name:            name,
+		Driver:          driver,
+		Persister:       persister,
+		Marshaller:      args.Marshaller,
+		StatusHandler:   args.StatusHandler,
+		MaxBacklog:      args.MaxBacklog,
+		RetrialInterval: args.RetrialInterval,
+	})
+	if err != nil {
+		_ = persister.Close()
+		return nil, err
+	}
+
+	return driverQueue, nil
+}
@@ -0,0 +1,32 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/fileDriver"
+)
+
+const megabyte = 1024 * 1024
+
+// FileDriverFactoryArgs defines the args needed for the file driver creation
+type FileDriverFactoryArgs struct {
+	Enabled             bool
+	Directory           string
+	Format              string
+	MaxFileSizeInMB     uint64
+	RotateOnEpochChange bool
+	Marshaller          marshal.Marshalizer
+}
+
+// CreateFileDriver will create a new file driver instance
+func CreateFileDriver(args *FileDriverFactoryArgs) (outport.Driver, error) {
+	driverArgs := fileDriver.ArgsFileDriver{
+		Directory:           args.Directory,
+		Format:              args.Format,
+		MaxFileSizeInBytes:  args.MaxFileSizeInMB * megabyte,
+		RotateOnEpochChange: args.RotateOnEpochChange,
+		InternalMarshaller:  args.Marshaller,
+	}
+
+	return fileDriver.NewFileDriver(driverArgs)
+}
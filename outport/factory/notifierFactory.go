@@ -1,10 +1,14 @@
 package factory
 
 import (
+	"encoding/hex"
+	"fmt"
+
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/outport/notifier"
 )
@@ -19,6 +23,7 @@ type EventNotifierFactoryArgs struct {
 	Marshaller       marshal.Marshalizer
 	Hasher           hashing.Hasher
 	PubKeyConverter  core.PubkeyConverter
+	Subscriptions    []config.EventNotifierSubscriptionConfig
 }
 
 // CreateEventNotifier will create a new event notifier client instance
@@ -27,6 +32,11 @@ func CreateEventNotifier(args *EventNotifierFactoryArgs) (outport.Driver, error)
 		return nil, err
 	}
 
+	subscriptions, err := createSubscriptions(args.Subscriptions)
+	if err != nil {
+		return nil, err
+	}
+
 	httpClient := notifier.NewHttpClient(notifier.HttpClientArgs{
 		UseAuthorization: args.UseAuthorization,
 		Username:         args.Username,
@@ -39,11 +49,36 @@ func CreateEventNotifier(args *EventNotifierFactoryArgs) (outport.Driver, error)
 		Marshalizer:     args.Marshaller,
 		Hasher:          args.Hasher,
 		PubKeyConverter: args.PubKeyConverter,
+		Subscriptions:   subscriptions,
 	}
 
 	return notifier.NewEventNotifier(notifierArgs)
 }
 
+func createSubscriptions(subscriptionsConfig []config.EventNotifierSubscriptionConfig) ([]notifier.Subscription, error) {
+	subscriptions := make([]notifier.Subscription, 0, len(subscriptionsConfig))
+	for _, subscriptionConfig := range subscriptionsConfig {
+		topicPrefixes := make([][]byte, 0, len(subscriptionConfig.TopicPrefixes))
+		for _, encodedPrefix := range subscriptionConfig.TopicPrefixes {
+			prefix, err := hex.DecodeString(encodedPrefix)
+			if err != nil {
+				return nil, fmt.Errorf("%w for topic prefix %s of subscription %s", err, encodedPrefix, subscriptionConfig.Endpoint)
+			}
+
+			topicPrefixes = append(topicPrefixes, prefix)
+		}
+
+		subscriptions = append(subscriptions, notifier.Subscription{
+			Endpoint:      subscriptionConfig.Endpoint,
+			Addresses:     subscriptionConfig.Addresses,
+			Identifiers:   subscriptionConfig.Identifiers,
+			TopicPrefixes: topicPrefixes,
+		})
+	}
+
+	return subscriptions, nil
+}
+
 func checkInputArgs(args *EventNotifierFactoryArgs) error {
 	if check.IfNil(args.Marshaller) {
 		return core.ErrNilMarshalizer
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/outport/factory"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -60,6 +61,22 @@ func TestCreateEventNotifier(t *testing.T) {
 		require.Equal(t, outport.ErrNilPubKeyConverter, err)
 	})
 
+	t.Run("invalid topic prefix", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNotifierFactoryArgs()
+		args.Subscriptions = []config.EventNotifierSubscriptionConfig{
+			{
+				Endpoint:      "/events/push/dex",
+				TopicPrefixes: []string{"not hex"},
+			},
+		}
+
+		en, err := factory.CreateEventNotifier(args)
+		require.Nil(t, en)
+		require.NotNil(t, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -14,6 +14,9 @@ type OutportFactoryArgs struct {
 	ElasticIndexerFactoryArgs  *indexerFactory.ArgsIndexerFactory
 	EventNotifierFactoryArgs   *EventNotifierFactoryArgs
 	CovalentIndexerFactoryArgs *covalentFactory.ArgsCovalentIndexerFactory
+	WebSocketDriverFactoryArgs *WebSocketDriverFactoryArgs
+	FileDriverFactoryArgs      *FileDriverFactoryArgs
+	DriverQueueFactoryArgs     *DriverQueueFactoryArgs
 }
 
 // CreateOutport will create a new instance of OutportHandler
@@ -37,17 +40,27 @@ func CreateOutport(args *OutportFactoryArgs) (outport.OutportHandler, error) {
 }
 
 func createAndSubscribeDrivers(outport outport.OutportHandler, args *OutportFactoryArgs) error {
-	err := createAndSubscribeElasticDriverIfNeeded(outport, args.ElasticIndexerFactoryArgs)
+	err := createAndSubscribeElasticDriverIfNeeded(outport, args.ElasticIndexerFactoryArgs, args.DriverQueueFactoryArgs)
 	if err != nil {
 		return err
 	}
 
-	err = createAndSubscribeEventNotifierIfNeeded(outport, args.EventNotifierFactoryArgs)
+	err = createAndSubscribeEventNotifierIfNeeded(outport, args.EventNotifierFactoryArgs, args.DriverQueueFactoryArgs)
 	if err != nil {
 		return err
 	}
 
-	err = createAndSubscribeCovalentDriverIfNeeded(outport, args.CovalentIndexerFactoryArgs)
+	err = createAndSubscribeCovalentDriverIfNeeded(outport, args.CovalentIndexerFactoryArgs, args.DriverQueueFactoryArgs)
+	if err != nil {
+		return err
+	}
+
+	err = createAndSubscribeWebSocketDriverIfNeeded(outport, args.WebSocketDriverFactoryArgs, args.DriverQueueFactoryArgs)
+	if err != nil {
+		return err
+	}
+
+	err = createAndSubscribeFileDriverIfNeeded(outport, args.FileDriverFactoryArgs, args.DriverQueueFactoryArgs)
 	if err != nil {
 		return err
 	}
@@ -58,6 +71,7 @@ func createAndSubscribeDrivers(outport outport.OutportHandler, args *OutportFact
 func createAndSubscribeCovalentDriverIfNeeded(
 	outport outport.OutportHandler,
 	args *covalentFactory.ArgsCovalentIndexerFactory,
+	queueArgs *DriverQueueFactoryArgs,
 ) error {
 	if !args.Enabled {
 		return nil
@@ -68,12 +82,13 @@ func createAndSubscribeCovalentDriverIfNeeded(
 		return err
 	}
 
-	return outport.SubscribeDriver(covalentDriver)
+	return subscribeDriver(outport, queueArgs, "covalent", covalentDriver)
 }
 
 func createAndSubscribeElasticDriverIfNeeded(
 	outport outport.OutportHandler,
 	args *indexerFactory.ArgsIndexerFactory,
+	queueArgs *DriverQueueFactoryArgs,
 ) error {
 	if !args.Enabled {
 		return nil
@@ -84,12 +99,13 @@ func createAndSubscribeElasticDriverIfNeeded(
 		return err
 	}
 
-	return outport.SubscribeDriver(elasticDriver)
+	return subscribeDriver(outport, queueArgs, "elastic", elasticDriver)
 }
 
 func createAndSubscribeEventNotifierIfNeeded(
 	outport outport.OutportHandler,
 	args *EventNotifierFactoryArgs,
+	queueArgs *DriverQueueFactoryArgs,
 ) error {
 	if !args.Enabled {
 		return nil
@@ -100,7 +116,61 @@ func createAndSubscribeEventNotifierIfNeeded(
 		return err
 	}
 
-	return outport.SubscribeDriver(eventNotifier)
+	return subscribeDriver(outport, queueArgs, "eventNotifier", eventNotifier)
+}
+
+func createAndSubscribeWebSocketDriverIfNeeded(
+	outport outport.OutportHandler,
+	args *WebSocketDriverFactoryArgs,
+	queueArgs *DriverQueueFactoryArgs,
+) error {
+	if !args.Enabled {
+		return nil
+	}
+
+	webSocketDriver, err := CreateWebSocketDriver(args)
+	if err != nil {
+		return err
+	}
+
+	return subscribeDriver(outport, queueArgs, "webSocket", webSocketDriver)
+}
+
+func createAndSubscribeFileDriverIfNeeded(
+	outport outport.OutportHandler,
+	args *FileDriverFactoryArgs,
+	queueArgs *DriverQueueFactoryArgs,
+) error {
+	if !args.Enabled {
+		return nil
+	}
+
+	fileDriver, err := CreateFileDriver(args)
+	if err != nil {
+		return err
+	}
+
+	return subscribeDriver(outport, queueArgs, "file", fileDriver)
+}
+
+// subscribeDriver subscribes the driver to the outport, wrapping it in a durable queue if this is enabled
+func subscribeDriver(
+	outport outport.OutportHandler,
+	queueArgs *DriverQueueFactoryArgs,
+	name string,
+	driver outport.Driver,
+) error {
+	if queueArgs == nil || !queueArgs.Enabled {
+		return outport.SubscribeDriver(driver)
+	}
+
+	driverQueue, err := CreateDriverQueue(queueArgs, name, driver)
+	if err != nil {
+		_ = driver.Close()
+		return err
+	}
+
+	return outport.SubscribeDriver(driverQueue)
 }
 
 func checkArguments(args *OutportFactoryArgs) error {
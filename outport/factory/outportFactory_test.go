@@ -7,12 +7,16 @@ import (
 
 	covalentFactory "github.com/ElrondNetwork/covalent-indexer-go/factory"
 	indexerFactory "github.com/ElrondNetwork/elastic-indexer-go/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/outport/factory"
 	notifierFactory "github.com/ElrondNetwork/elrond-go/outport/factory"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,6 +35,8 @@ func createMockArgsOutportHandler(indexerEnabled, notifierEnabled, covalentEnabl
 		ElasticIndexerFactoryArgs:  mockElasticArgs,
 		EventNotifierFactoryArgs:   mockNotifierArgs,
 		CovalentIndexerFactoryArgs: mockCovalentArgs,
+		WebSocketDriverFactoryArgs: &factory.WebSocketDriverFactoryArgs{},
+		FileDriverFactoryArgs:      &factory.FileDriverFactoryArgs{},
 	}
 }
 
@@ -136,3 +142,76 @@ func TestCreateOutport_SubscribeNotifierDriver(t *testing.T) {
 	require.True(t, outPort.HasDrivers())
 	require.Nil(t, err)
 }
+
+func TestCreateOutport_SubscribeWebSocketDriver(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+
+	args.WebSocketDriverFactoryArgs = &factory.WebSocketDriverFactoryArgs{
+		Enabled:                 true,
+		URL:                     "127.0.0.1:0",
+		WithAcknowledge:         true,
+		AcknowledgeTimeoutInSec: 10,
+		RetainedEventsCount:     10,
+		MaxSubscribers:          10,
+		Marshaller:              &mock.MarshalizerMock{},
+		Hasher:                  &hashingMocks.HasherMock{},
+	}
+	outPort, err := factory.CreateOutport(args)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+	require.Nil(t, err)
+}
+
+func TestCreateOutport_SubscribeFileDriver(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+
+	args.FileDriverFactoryArgs = &factory.FileDriverFactoryArgs{
+		Enabled:             true,
+		Directory:           t.TempDir(),
+		Format:              "json",
+		MaxFileSizeInMB:     1,
+		RotateOnEpochChange: true,
+		Marshaller:          &mock.MarshalizerMock{},
+	}
+	outPort, err := factory.CreateOutport(args)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+	require.Nil(t, err)
+}
+
+func TestCreateOutport_SubscribeDriverWithQueue(t *testing.T) {
+	args := createMockArgsOutportHandler(false, true, false)
+
+	args.EventNotifierFactoryArgs.Marshaller = &mock.MarshalizerMock{}
+	args.EventNotifierFactoryArgs.Hasher = &hashingMocks.HasherMock{}
+	args.EventNotifierFactoryArgs.PubKeyConverter = &mock.PubkeyConverterMock{}
+	args.DriverQueueFactoryArgs = &factory.DriverQueueFactoryArgs{
+		Enabled:         true,
+		MaxBacklog:      10,
+		RetrialInterval: time.Second,
+		DBConfig: config.DBConfig{
+			FilePath: "OutportQueue",
+			Type:     string(storageUnit.MemoryDB),
+		},
+		PathManager:   &testscommon.PathManagerStub{},
+		ShardID:       "0",
+		Marshaller:    &mock.MarshalizerMock{},
+		StatusHandler: &statusHandler.AppStatusHandlerStub{},
+	}
+	outPort, err := factory.CreateOutport(args)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+	require.Nil(t, err)
+}
@@ -0,0 +1,38 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/websocketDriver"
+)
+
+// WebSocketDriverFactoryArgs defines the args needed for the web socket driver creation
+type WebSocketDriverFactoryArgs struct {
+	Enabled                 bool
+	URL                     string
+	WithAcknowledge         bool
+	AcknowledgeTimeoutInSec int
+	RetainedEventsCount     int
+	MaxSubscribers          int
+	Marshaller              marshal.Marshalizer
+	Hasher                  hashing.Hasher
+}
+
+// CreateWebSocketDriver will create a new web socket driver instance
+func CreateWebSocketDriver(args *WebSocketDriverFactoryArgs) (outport.Driver, error) {
+	driverArgs := websocketDriver.ArgsWebSocketDriver{
+		URL:                 args.URL,
+		WithAcknowledge:     args.WithAcknowledge,
+		AcknowledgeTimeout:  time.Duration(args.AcknowledgeTimeoutInSec) * time.Second,
+		RetainedEventsCount: args.RetainedEventsCount,
+		MaxSubscribers:      args.MaxSubscribers,
+		Marshaller:          &marshal.JsonMarshalizer{},
+		InternalMarshaller:  args.Marshaller,
+		Hasher:              args.Hasher,
+	}
+
+	return websocketDriver.NewWebSocketDriver(driverArgs)
+}
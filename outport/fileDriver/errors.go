@@ -0,0 +1,9 @@
+package fileDriver
+
+import "errors"
+
+// ErrEmptyDirectory signals that an empty directory has been provided
+var ErrEmptyDirectory = errors.New("empty directory")
+
+// ErrDriverClosed signals that the driver was closed
+var ErrDriverClosed = errors.New("file driver is closed")
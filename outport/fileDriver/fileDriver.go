@@ -0,0 +1,258 @@
+package fileDriver
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/outport/record"
+)
+
+var log = logger.GetOrCreate("outport/fileDriver")
+
+const (
+	directoryPermissions = 0755
+	filePermissions      = 0644
+)
+
+// ArgsFileDriver holds the arguments needed to create a new file driver
+type ArgsFileDriver struct {
+	Directory           string
+	Format              string
+	MaxFileSizeInBytes  uint64
+	RotateOnEpochChange bool
+	InternalMarshaller  marshal.Marshalizer
+}
+
+// fileDriver is an outport driver that appends every call, as a record, to local files. The files are rotated when
+// they reach the maximum size or, optionally, when the epoch changes. A new file is always started after a restart
+type fileDriver struct {
+	directory           string
+	format              string
+	maxFileSizeInBytes  uint64
+	rotateOnEpochChange bool
+	converter           recordConverter
+
+	mutFile      sync.Mutex
+	file         *os.File
+	writer       recordWriter
+	fileSize     uint64
+	fileEpoch    uint32
+	lastEpoch    uint32
+	nextSequence uint64
+	isClosed     bool
+}
+
+// NewFileDriver creates a new file driver
+func NewFileDriver(args ArgsFileDriver) (*fileDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	objectMarshaller, err := record.ObjectMarshallerForFormat(args.Format, args.InternalMarshaller)
+	if err != nil {
+		return nil, err
+	}
+	converter, err := record.NewConverter(record.ArgsConverter{
+		ObjectMarshaller: objectMarshaller,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(args.Directory, directoryPermissions)
+	if err != nil {
+		return nil, err
+	}
+	existingFiles, err := GetRecordsFiles(args.Directory)
+	if err != nil {
+		return nil, err
+	}
+
+	fd := &fileDriver{
+		directory:           args.Directory,
+		format:              args.Format,
+		maxFileSizeInBytes:  args.MaxFileSizeInBytes,
+		rotateOnEpochChange: args.RotateOnEpochChange,
+		converter:           converter,
+	}
+	if len(existingFiles) > 0 {
+		lastFile := existingFiles[len(existingFiles)-1]
+		fd.nextSequence = lastFile.Sequence + 1
+		fd.lastEpoch = lastFile.Epoch
+	}
+
+	return fd, nil
+}
+
+func checkArgs(args ArgsFileDriver) error {
+	if len(args.Directory) == 0 {
+		return ErrEmptyDirectory
+	}
+	err := record.CheckFormat(args.Format)
+	if err != nil {
+		return err
+	}
+	if check.IfNil(args.InternalMarshaller) {
+		return core.ErrNilMarshalizer
+	}
+
+	return nil
+}
+
+// SaveBlock appends the saved block record to the current file
+func (fd *fileDriver) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	rec, err := fd.converter.SaveBlockRecord(args)
+	if err != nil {
+		return err
+	}
+
+	return fd.writeWithEpoch(rec, getEpoch(args.Header))
+}
+
+// RevertIndexedBlock appends the reverted block record to the current file
+func (fd *fileDriver) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) error {
+	rec, err := fd.converter.RevertIndexedBlockRecord(header, body)
+	if err != nil {
+		return err
+	}
+
+	return fd.writeWithEpoch(rec, getEpoch(header))
+}
+
+func getEpoch(header data.HeaderHandler) *uint32 {
+	if check.IfNil(header) {
+		return nil
+	}
+
+	epoch := header.GetEpoch()
+	return &epoch
+}
+
+// SaveRoundsInfo appends the rounds info record to the current file
+func (fd *fileDriver) SaveRoundsInfo(roundsInfos []*indexer.RoundInfo) error {
+	return fd.writeWithEpoch(fd.converter.RoundsInfoRecord(roundsInfos), nil)
+}
+
+// SaveValidatorsPubKeys appends the validators public keys record to the current file
+func (fd *fileDriver) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+	return fd.writeWithEpoch(fd.converter.ValidatorsPubKeysRecord(validatorsPubKeys, epoch), nil)
+}
+
+// SaveValidatorsRating appends the validators rating record to the current file
+func (fd *fileDriver) SaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo) error {
+	return fd.writeWithEpoch(fd.converter.ValidatorsRatingRecord(indexID, infoRating), nil)
+}
+
+// SaveAccounts returns nil. The accounts handlers are bound to the live accounts trie so they can not be archived
+func (fd *fileDriver) SaveAccounts(_ uint64, _ []data.UserAccountHandler) error {
+	return nil
+}
+
+// FinalizedBlock appends the finalized block record to the current file
+func (fd *fileDriver) FinalizedBlock(headerHash []byte) error {
+	return fd.writeWithEpoch(fd.converter.FinalizedBlockRecord(headerHash), nil)
+}
+
+// writeWithEpoch appends the record to the current file, rotating it first if needed. The epoch is provided only
+// for the records carrying a header
+func (fd *fileDriver) writeWithEpoch(rec *record.Record, epoch *uint32) error {
+	fd.mutFile.Lock()
+	defer fd.mutFile.Unlock()
+
+	if fd.isClosed {
+		return ErrDriverClosed
+	}
+	if epoch != nil {
+		fd.lastEpoch = *epoch
+	}
+
+	err := fd.rotateIfNeeded()
+	if err != nil {
+		return err
+	}
+
+	numBytes, err := fd.writer.Write(rec)
+	fd.fileSize += uint64(numBytes)
+
+	return err
+}
+
+func (fd *fileDriver) rotateIfNeeded() error {
+	if fd.file != nil {
+		epochChanged := fd.rotateOnEpochChange && fd.lastEpoch != fd.fileEpoch
+		sizeReached := fd.maxFileSizeInBytes > 0 && fd.fileSize >= fd.maxFileSizeInBytes
+		if !epochChanged && !sizeReached {
+			return nil
+		}
+
+		err := fd.closeFile()
+		if err != nil {
+			return err
+		}
+	}
+
+	return fd.openNewFile()
+}
+
+func (fd *fileDriver) openNewFile() error {
+	path := filepath.Join(fd.directory, fileName(fd.nextSequence, fd.lastEpoch, fd.format))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, filePermissions)
+	if err != nil {
+		return err
+	}
+
+	writer, err := record.NewWriter(fd.format, file)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	log.Debug("file driver: new records file", "path", path)
+
+	fd.file = file
+	fd.writer = writer
+	fd.fileSize = 0
+	fd.fileEpoch = fd.lastEpoch
+	fd.nextSequence++
+
+	return nil
+}
+
+func (fd *fileDriver) closeFile() error {
+	if fd.file == nil {
+		return nil
+	}
+
+	err := fd.file.Sync()
+	errClose := fd.file.Close()
+	fd.file = nil
+	fd.writer = nil
+	if err != nil {
+		return err
+	}
+
+	return errClose
+}
+
+// Close syncs and closes the current file
+func (fd *fileDriver) Close() error {
+	fd.mutFile.Lock()
+	defer fd.mutFile.Unlock()
+
+	fd.isClosed = true
+
+	return fd.closeFile()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fd *fileDriver) IsInterfaceNil() bool {
+	return fd == nil
+}
@@ -0,0 +1,196 @@
+package fileDriver
+
+import (
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/outport/record"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsFileDriver(t *testing.T) ArgsFileDriver {
+	return ArgsFileDriver{
+		Directory:           t.TempDir(),
+		Format:              record.ProtoFormat,
+		MaxFileSizeInBytes:  0,
+		RotateOnEpochChange: true,
+		InternalMarshaller:  &testscommon.ProtobufMarshalizerMock{},
+	}
+}
+
+func saveBlock(t *testing.T, fd *fileDriver, nonce uint64, epoch uint32) {
+	err := fd.SaveBlock(&indexer.ArgsSaveBlockData{
+		HeaderHash:       []byte("hash"),
+		Header:           &block.Header{Nonce: nonce, Epoch: epoch},
+		Body:             &block.Body{},
+		TransactionsPool: &indexer.Pool{},
+	})
+	require.Nil(t, err)
+}
+
+func readRecords(t *testing.T, recordsFile *RecordsFile) []*record.Record {
+	file, err := os.Open(recordsFile.Path)
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	reader, err := record.NewReader(recordsFile.Format, file)
+	require.Nil(t, err)
+
+	records := make([]*record.Record, 0)
+	for {
+		rec, errRead := reader.Read()
+		if errRead == io.EOF {
+			return records
+		}
+		require.Nil(t, errRead)
+
+		records = append(records, rec)
+	}
+}
+
+func TestNewFileDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileDriver(t)
+		args.Directory = ""
+		fd, err := NewFileDriver(args)
+		assert.Nil(t, fd)
+		assert.Equal(t, ErrEmptyDirectory, err)
+	})
+	t.Run("unknown format should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileDriver(t)
+		args.Format = "xml"
+		fd, err := NewFileDriver(args)
+		assert.Nil(t, fd)
+		assert.True(t, errors.Is(err, record.ErrUnknownFormat))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileDriver(t)
+		args.InternalMarshaller = nil
+		fd, err := NewFileDriver(args)
+		assert.Nil(t, fd)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fd, err := NewFileDriver(createMockArgsFileDriver(t))
+		assert.Nil(t, err)
+		assert.False(t, fd.IsInterfaceNil())
+		assert.Nil(t, fd.Close())
+	})
+}
+
+func TestFileDriver_ShouldRotateOnEpochChange(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []string{record.JSONFormat, record.ProtoFormat} {
+		args := createMockArgsFileDriver(t)
+		args.Format = format
+		fd, _ := NewFileDriver(args)
+
+		saveBlock(t, fd, 1, 0)
+		require.Nil(t, fd.FinalizedBlock([]byte("hash")))
+		saveBlock(t, fd, 2, 1)
+		require.Nil(t, fd.RevertIndexedBlock(&block.Header{Nonce: 2, Epoch: 1}, &block.Body{}))
+		require.Nil(t, fd.Close())
+
+		files, err := GetRecordsFiles(args.Directory)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(files))
+
+		assert.Equal(t, uint64(0), files[0].Sequence)
+		assert.Equal(t, uint32(0), files[0].Epoch)
+		assert.Equal(t, format, files[0].Format)
+		records := readRecords(t, files[0])
+		require.Equal(t, 2, len(records))
+		assert.Equal(t, record.SaveBlockType, records[0].Type)
+		assert.Equal(t, record.FinalizedBlockType, records[1].Type)
+
+		assert.Equal(t, uint64(1), files[1].Sequence)
+		assert.Equal(t, uint32(1), files[1].Epoch)
+		records = readRecords(t, files[1])
+		require.Equal(t, 2, len(records))
+		assert.Equal(t, record.SaveBlockType, records[0].Type)
+		assert.Equal(t, record.RevertIndexedBlockType, records[1].Type)
+	}
+}
+
+func TestFileDriver_ShouldRotateOnSize(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsFileDriver(t)
+	args.MaxFileSizeInBytes = 1
+	fd, _ := NewFileDriver(args)
+
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		saveBlock(t, fd, nonce, 0)
+	}
+	require.Nil(t, fd.Close())
+
+	files, _ := GetRecordsFiles(args.Directory)
+	require.Equal(t, 3, len(files))
+	for _, recordsFile := range files {
+		assert.Equal(t, 1, len(readRecords(t, recordsFile)))
+	}
+}
+
+func TestFileDriver_RestartShouldStartANewFile(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsFileDriver(t)
+	fd, _ := NewFileDriver(args)
+	saveBlock(t, fd, 1, 4)
+	require.Nil(t, fd.Close())
+
+	err := fd.FinalizedBlock([]byte("hash"))
+	assert.Equal(t, ErrDriverClosed, err)
+
+	fd, _ = NewFileDriver(args)
+	require.Nil(t, fd.FinalizedBlock([]byte("hash")))
+	require.Nil(t, fd.Close())
+
+	files, _ := GetRecordsFiles(args.Directory)
+	require.Equal(t, 2, len(files))
+	assert.Equal(t, uint64(1), files[1].Sequence)
+	assert.Equal(t, uint32(4), files[1].Epoch)
+}
+
+func TestGetRecordsFiles_ShouldIgnoreOtherFiles(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	for _, name := range []string{
+		fileName(10, 1, record.JSONFormat),
+		fileName(2, 0, record.ProtoFormat),
+		"outport_1_epoch_0.txt",
+		"notes.jsonl",
+	} {
+		err := os.WriteFile(directory+"/"+name, nil, filePermissions)
+		require.Nil(t, err)
+	}
+
+	files, err := GetRecordsFiles(directory)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(files))
+	assert.Equal(t, uint64(2), files[0].Sequence)
+	assert.Equal(t, record.ProtoFormat, files[0].Format)
+	assert.Equal(t, uint64(10), files[1].Sequence)
+	assert.Equal(t, record.JSONFormat, files[1].Format)
+}
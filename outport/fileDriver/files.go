@@ -0,0 +1,93 @@
+package fileDriver
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/outport/record"
+)
+
+const (
+	filePrefix         = "outport"
+	jsonFileExtension  = "jsonl"
+	protoFileExtension = "pb"
+)
+
+var fileNameRegex = regexp.MustCompile(`^` + filePrefix + `_(\d+)_epoch_(\d+)\.(` + jsonFileExtension + `|` + protoFileExtension + `)$`)
+
+// RecordsFile holds the details of a file written by the file driver
+type RecordsFile struct {
+	Path     string
+	Sequence uint64
+	Epoch    uint32
+	Format   string
+}
+
+// GetRecordsFiles returns all the records files from the provided directory, in the order they were written
+func GetRecordsFiles(directory string) ([]*RecordsFile, error) {
+	entries, err := ioutil.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]*RecordsFile, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		recordsFile, ok := parseFileName(entry.Name())
+		if !ok {
+			continue
+		}
+
+		recordsFile.Path = filepath.Join(directory, entry.Name())
+		files = append(files, recordsFile)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Sequence < files[j].Sequence
+	})
+
+	return files, nil
+}
+
+func parseFileName(name string) (*RecordsFile, bool) {
+	matches := fileNameRegex.FindStringSubmatch(name)
+	if len(matches) != 4 {
+		return nil, false
+	}
+
+	sequence, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	epoch, err := strconv.ParseUint(matches[2], 10, 32)
+	if err != nil {
+		return nil, false
+	}
+
+	format := record.JSONFormat
+	if matches[3] == protoFileExtension {
+		format = record.ProtoFormat
+	}
+
+	return &RecordsFile{
+		Sequence: sequence,
+		Epoch:    uint32(epoch),
+		Format:   format,
+	}, true
+}
+
+func fileName(sequence uint64, epoch uint32, format string) string {
+	extension := jsonFileExtension
+	if format == record.ProtoFormat {
+		extension = protoFileExtension
+	}
+
+	return fmt.Sprintf("%s_%010d_epoch_%d.%s", filePrefix, sequence, epoch, extension)
+}
@@ -1,6 +1,8 @@
 package outport
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
 )
@@ -31,6 +33,12 @@ type OutportHandler interface {
 	FinalizedBlock(headerHash []byte)
 	SubscribeDriver(driver Driver) error
 	HasDrivers() bool
+	Flush(ctx context.Context) error
 	Close() error
 	IsInterfaceNil() bool
 }
+
+// driverFlusher defines a driver which buffers the calls and is able to wait until all of them were delivered
+type driverFlusher interface {
+	Flush(ctx context.Context) error
+}
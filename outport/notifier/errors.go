@@ -6,3 +6,6 @@ import (
 
 // ErrNilTransactionsPool signals that a nil transactions pool was provided
 var ErrNilTransactionsPool = errors.New("nil transactions pool")
+
+// ErrEmptySubscriptionEndpoint signals that a subscription without an endpoint was provided
+var ErrEmptySubscriptionEndpoint = errors.New("empty subscription endpoint")
@@ -1,8 +1,10 @@
 package notifier
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -35,6 +37,7 @@ type Event struct {
 	Identifier string   `json:"identifier"`
 	Topics     [][]byte `json:"topics"`
 	Data       []byte   `json:"data"`
+	TxHash     string   `json:"txHash,omitempty"`
 }
 
 // RevertBlock holds revert event data
@@ -55,6 +58,11 @@ type eventNotifier struct {
 	marshalizer     marshal.Marshalizer
 	hasher          hashing.Hasher
 	pubKeyConverter core.PubkeyConverter
+	filters         []*eventFilter
+
+	mutDeliveries      sync.Mutex
+	deliveredBlockHash []byte
+	deliveredFilters   map[int]struct{}
 }
 
 // ArgsEventNotifier defines the arguments needed for event notifier creation
@@ -63,16 +71,26 @@ type ArgsEventNotifier struct {
 	Marshalizer     marshal.Marshalizer
 	Hasher          hashing.Hasher
 	PubKeyConverter core.PubkeyConverter
+	Subscriptions   []Subscription
 }
 
 // NewEventNotifier creates a new instance of the eventNotifier
 // It implements all methods of process.Indexer
+// If subscriptions are provided, the block data is no longer pushed as a whole, but each subscription receives only
+// the matching log events, together with the transactions and smart contract results that generated them
 func NewEventNotifier(args ArgsEventNotifier) (*eventNotifier, error) {
+	filters, err := newEventFilters(args.Subscriptions)
+	if err != nil {
+		return nil, err
+	}
+
 	return &eventNotifier{
-		httpClient:      args.HttpClient,
-		marshalizer:     args.Marshalizer,
-		hasher:          args.Hasher,
-		pubKeyConverter: args.PubKeyConverter,
+		httpClient:       args.HttpClient,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		pubKeyConverter:  args.PubKeyConverter,
+		filters:          filters,
+		deliveredFilters: make(map[int]struct{}),
 	}, nil
 }
 
@@ -89,6 +107,10 @@ func (en *eventNotifier) SaveBlock(args *indexer.ArgsSaveBlockData) error {
 	events := en.getLogEventsFromTransactionsPool(args.TransactionsPool.Logs)
 	log.Debug("eventNotifier: extracted events from block logs", "num events", len(events))
 
+	if len(en.filters) > 0 {
+		return en.pushFilteredEvents(args, events)
+	}
+
 	blockData := SaveBlockData{
 		Hash:      hex.EncodeToString(args.HeaderHash),
 		Txs:       args.TransactionsPool.Txs,
@@ -104,8 +126,73 @@ func (en *eventNotifier) SaveBlock(args *indexer.ArgsSaveBlockData) error {
 	return nil
 }
 
+// pushFilteredEvents pushes, for each subscription, the matching events on the subscription's endpoint. Blocks
+// without matching events are not pushed. The subscriptions that already received the block are remembered, so a
+// block retried by the outport after a failed push is not delivered twice to the same subscription
+func (en *eventNotifier) pushFilteredEvents(args *indexer.ArgsSaveBlockData, events []Event) error {
+	en.mutDeliveries.Lock()
+	defer en.mutDeliveries.Unlock()
+
+	if !bytes.Equal(en.deliveredBlockHash, args.HeaderHash) {
+		en.deliveredBlockHash = args.HeaderHash
+		en.deliveredFilters = make(map[int]struct{})
+	}
+
+	for index, filter := range en.filters {
+		_, delivered := en.deliveredFilters[index]
+		if delivered {
+			continue
+		}
+
+		blockData := SaveBlockData{
+			Hash:      hex.EncodeToString(args.HeaderHash),
+			Txs:       make(map[string]nodeData.TransactionHandler),
+			Scrs:      make(map[string]nodeData.TransactionHandler),
+			LogEvents: make([]Event, 0),
+		}
+
+		for _, event := range events {
+			if !filter.matches(event) {
+				continue
+			}
+
+			blockData.LogEvents = append(blockData.LogEvents, event)
+			addTransactionIfFound(blockData.Txs, args.TransactionsPool.Txs, event.TxHash)
+			addTransactionIfFound(blockData.Scrs, args.TransactionsPool.Scrs, event.TxHash)
+		}
+
+		if len(blockData.LogEvents) > 0 {
+			err := en.httpClient.Post(filter.endpoint, blockData, nil)
+			if err != nil {
+				return fmt.Errorf("%w in eventNotifier.SaveBlock while posting block data on %s", err, filter.endpoint)
+			}
+		}
+
+		en.deliveredFilters[index] = struct{}{}
+	}
+
+	return nil
+}
+
+func addTransactionIfFound(destination map[string]nodeData.TransactionHandler, source map[string]nodeData.TransactionHandler, encodedTxHash string) {
+	txHash, err := hex.DecodeString(encodedTxHash)
+	if err != nil {
+		return
+	}
+
+	tx, found := source[string(txHash)]
+	if found {
+		destination[string(txHash)] = tx
+	}
+}
+
+type logEvent struct {
+	txHash       string
+	eventHandler nodeData.EventHandler
+}
+
 func (en *eventNotifier) getLogEventsFromTransactionsPool(logs []*nodeData.LogData) []Event {
-	var logEvents []nodeData.EventHandler
+	var logEvents []logEvent
 	for _, logData := range logs {
 		if logData == nil {
 			continue
@@ -114,7 +201,12 @@ func (en *eventNotifier) getLogEventsFromTransactionsPool(logs []*nodeData.LogDa
 			continue
 		}
 
-		logEvents = append(logEvents, logData.LogHandler.GetLogEvents()...)
+		for _, eventHandler := range logData.LogHandler.GetLogEvents() {
+			logEvents = append(logEvents, logEvent{
+				txHash:       logData.TxHash,
+				eventHandler: eventHandler,
+			})
+		}
 	}
 
 	if len(logEvents) == 0 {
@@ -122,8 +214,9 @@ func (en *eventNotifier) getLogEventsFromTransactionsPool(logs []*nodeData.LogDa
 	}
 
 	var events []Event
-	for _, eventHandler := range logEvents {
-		if !eventHandler.IsInterfaceNil() {
+	for _, logEv := range logEvents {
+		eventHandler := logEv.eventHandler
+		if !check.IfNil(eventHandler) {
 			bech32Address := en.pubKeyConverter.Encode(eventHandler.GetAddress())
 			eventIdentifier := string(eventHandler.GetIdentifier())
 
@@ -137,6 +230,7 @@ func (en *eventNotifier) getLogEventsFromTransactionsPool(logs []*nodeData.LogDa
 				Identifier: eventIdentifier,
 				Topics:     eventHandler.GetTopics(),
 				Data:       eventHandler.GetData(),
+				TxHash:     hex.EncodeToString([]byte(logEv.txHash)),
 			})
 		}
 	}
@@ -1,12 +1,15 @@
 package notifier_test
 
 import (
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/outport/mock"
 	"github.com/ElrondNetwork/elrond-go/outport/notifier"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -66,6 +69,165 @@ func TestSaveBlock(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestNewEventNotifier_EmptySubscriptionEndpointShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEventNotifierArgs()
+	args.Subscriptions = []notifier.Subscription{
+		{Endpoint: "/events/push/dex"},
+		{Endpoint: ""},
+	}
+
+	en, err := notifier.NewEventNotifier(args)
+	require.Nil(t, en)
+	require.True(t, errors.Is(err, notifier.ErrEmptySubscriptionEndpoint))
+}
+
+func TestSaveBlock_WithSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	contractAddress := []byte("contract")
+	otherAddress := []byte("other")
+	txHash := "txHash"
+	otherTxHash := "otherTxHash"
+
+	args := createMockEventNotifierArgs()
+	args.Subscriptions = []notifier.Subscription{
+		{
+			Endpoint:    "/events/push/contract",
+			Addresses:   []string{hex.EncodeToString(contractAddress)},
+			Identifiers: []string{"swap"},
+		},
+		{
+			Endpoint:      "/events/push/topics",
+			TopicPrefixes: [][]byte{[]byte("tok")},
+		},
+		{
+			Endpoint:    "/events/push/none",
+			Identifiers: []string{"missing"},
+		},
+	}
+
+	pushed := make(map[string]notifier.SaveBlockData)
+	args.HttpClient = &mock.HTTPClientStub{
+		PostCalled: func(route string, payload, response interface{}) error {
+			pushed[route] = payload.(notifier.SaveBlockData)
+			return nil
+		},
+	}
+
+	en, _ := notifier.NewEventNotifier(args)
+
+	tx := &transaction.Transaction{Nonce: 1}
+	otherTx := &transaction.Transaction{Nonce: 2}
+	saveBlockData := &indexer.ArgsSaveBlockData{
+		HeaderHash: []byte("headerHash"),
+		TransactionsPool: &indexer.Pool{
+			Txs: map[string]data.TransactionHandler{
+				txHash:      tx,
+				otherTxHash: otherTx,
+			},
+			Scrs: map[string]data.TransactionHandler{},
+			Logs: []*data.LogData{
+				{
+					TxHash: txHash,
+					LogHandler: &transaction.Log{
+						Events: []*transaction.Event{
+							{Address: contractAddress, Identifier: []byte("swap")},
+							{Address: contractAddress, Identifier: []byte("transfer")},
+						},
+					},
+				},
+				{
+					TxHash: otherTxHash,
+					LogHandler: &transaction.Log{
+						Events: []*transaction.Event{
+							{Address: otherAddress, Identifier: []byte("swap"), Topics: [][]byte{[]byte("token-1")}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := en.SaveBlock(saveBlockData)
+	require.Nil(t, err)
+
+	require.Equal(t, 2, len(pushed))
+
+	contractData := pushed["/events/push/contract"]
+	require.Equal(t, 1, len(contractData.LogEvents))
+	require.Equal(t, "swap", contractData.LogEvents[0].Identifier)
+	require.Equal(t, hex.EncodeToString([]byte(txHash)), contractData.LogEvents[0].TxHash)
+	require.Equal(t, map[string]data.TransactionHandler{txHash: tx}, contractData.Txs)
+
+	topicsData := pushed["/events/push/topics"]
+	require.Equal(t, 1, len(topicsData.LogEvents))
+	require.Equal(t, hex.EncodeToString(otherAddress), topicsData.LogEvents[0].Address)
+	require.Equal(t, map[string]data.TransactionHandler{otherTxHash: otherTx}, topicsData.Txs)
+}
+
+func TestSaveBlock_WithSubscriptionsRetriedShouldNotPushTwice(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEventNotifierArgs()
+	args.Subscriptions = []notifier.Subscription{
+		{
+			Endpoint:    "/events/push/first",
+			Identifiers: []string{"swap"},
+		},
+		{
+			Endpoint:    "/events/push/second",
+			Identifiers: []string{"swap"},
+		},
+	}
+
+	expectedErr := errors.New("expected error")
+	shouldFail := true
+	numPushes := make(map[string]int)
+	args.HttpClient = &mock.HTTPClientStub{
+		PostCalled: func(route string, payload, response interface{}) error {
+			if route == "/events/push/second" && shouldFail {
+				return expectedErr
+			}
+
+			numPushes[route]++
+			return nil
+		},
+	}
+
+	en, _ := notifier.NewEventNotifier(args)
+
+	createSaveBlockData := func(headerHash string) *indexer.ArgsSaveBlockData {
+		return &indexer.ArgsSaveBlockData{
+			HeaderHash: []byte(headerHash),
+			TransactionsPool: &indexer.Pool{
+				Logs: []*data.LogData{
+					{
+						TxHash: "txHash",
+						LogHandler: &transaction.Log{
+							Events: []*transaction.Event{{Address: []byte("address"), Identifier: []byte("swap")}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	err := en.SaveBlock(createSaveBlockData("headerHash"))
+	require.True(t, errors.Is(err, expectedErr))
+	require.Equal(t, map[string]int{"/events/push/first": 1}, numPushes)
+
+	shouldFail = false
+	err = en.SaveBlock(createSaveBlockData("headerHash"))
+	require.Nil(t, err)
+	require.Equal(t, map[string]int{"/events/push/first": 1, "/events/push/second": 1}, numPushes)
+
+	err = en.SaveBlock(createSaveBlockData("nextHeaderHash"))
+	require.Nil(t, err)
+	require.Equal(t, map[string]int{"/events/push/first": 2, "/events/push/second": 2}, numPushes)
+}
+
 func TestRevertIndexedBlock(t *testing.T) {
 	t.Parallel()
 
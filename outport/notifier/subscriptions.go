@@ -0,0 +1,86 @@
+package notifier
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Subscription defines a filter over the log events pushed on a dedicated endpoint. An event matches the subscription
+// if its address is among the addresses, its identifier is among the identifiers and at least one of its topics
+// starts with one of the topic prefixes. An empty criteria matches all the events
+type Subscription struct {
+	Endpoint      string
+	Addresses     []string
+	Identifiers   []string
+	TopicPrefixes [][]byte
+}
+
+type eventFilter struct {
+	endpoint      string
+	addresses     map[string]struct{}
+	identifiers   map[string]struct{}
+	topicPrefixes [][]byte
+}
+
+func newEventFilters(subscriptions []Subscription) ([]*eventFilter, error) {
+	filters := make([]*eventFilter, 0, len(subscriptions))
+	for idx, subscription := range subscriptions {
+		if len(subscription.Endpoint) == 0 {
+			return nil, fmt.Errorf("%w for subscription at index %d", ErrEmptySubscriptionEndpoint, idx)
+		}
+
+		filters = append(filters, &eventFilter{
+			endpoint:      subscription.Endpoint,
+			addresses:     sliceToSet(subscription.Addresses),
+			identifiers:   sliceToSet(subscription.Identifiers),
+			topicPrefixes: subscription.TopicPrefixes,
+		})
+	}
+
+	return filters, nil
+}
+
+func sliceToSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+
+	return set
+}
+
+func (ef *eventFilter) matches(event Event) bool {
+	if !matchesSet(ef.addresses, event.Address) {
+		return false
+	}
+	if !matchesSet(ef.identifiers, event.Identifier) {
+		return false
+	}
+
+	return ef.matchesTopics(event.Topics)
+}
+
+func matchesSet(set map[string]struct{}, value string) bool {
+	if len(set) == 0 {
+		return true
+	}
+
+	_, found := set[value]
+	return found
+}
+
+func (ef *eventFilter) matchesTopics(topics [][]byte) bool {
+	if len(ef.topicPrefixes) == 0 {
+		return true
+	}
+
+	for _, topic := range topics {
+		for _, prefix := range ef.topicPrefixes {
+			if bytes.HasPrefix(topic, prefix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -1,6 +1,7 @@
 package outport
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
@@ -261,6 +262,26 @@ func (o *outport) Close() error {
 	return err
 }
 
+// Flush waits for the drivers which buffer the calls, like the durable queues, to deliver all the buffered calls
+func (o *outport) Flush(ctx context.Context) error {
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	for _, driver := range o.drivers {
+		flusher, ok := driver.(driverFlusher)
+		if !ok {
+			continue
+		}
+
+		err := flusher.Flush(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // HasDrivers returns true if there is at least one driver in the outport
 func (o *outport) HasDrivers() bool {
 	o.mutex.RLock()
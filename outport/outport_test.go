@@ -1,6 +1,7 @@
 package outport
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"testing"
@@ -302,6 +303,45 @@ func TestOutport_Close(t *testing.T) {
 	require.Equal(t, localErr, err)
 }
 
+type flushableDriverStub struct {
+	mock.DriverStub
+	flushCalled func(ctx context.Context) error
+}
+
+func (stub *flushableDriverStub) Flush(ctx context.Context) error {
+	return stub.flushCalled(ctx)
+}
+
+func TestOutport_Flush(t *testing.T) {
+	t.Parallel()
+
+	outportHandler, _ := NewOutport(minimumRetrialInterval)
+
+	numFlushes := 0
+	localErr := errors.New("local err")
+	_ = outportHandler.SubscribeDriver(&mock.DriverStub{})
+	_ = outportHandler.SubscribeDriver(&flushableDriverStub{
+		flushCalled: func(ctx context.Context) error {
+			numFlushes++
+			return nil
+		},
+	})
+
+	err := outportHandler.Flush(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 1, numFlushes)
+
+	_ = outportHandler.SubscribeDriver(&flushableDriverStub{
+		flushCalled: func(ctx context.Context) error {
+			return localErr
+		},
+	})
+
+	err = outportHandler.Flush(context.Background())
+	require.Equal(t, localErr, err)
+	require.Equal(t, 2, numFlushes)
+}
+
 func TestOutport_CloseWhileDriverIsStuckInContinuousErrors(t *testing.T) {
 	t.Parallel()
 
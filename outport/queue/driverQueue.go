@@ -0,0 +1,380 @@
+package queue
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/record"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("outport/queue")
+
+const (
+	minimumRetrialInterval = time.Millisecond * 10
+	closeTimeout           = time.Second * 10
+	flushCheckInterval     = time.Millisecond * 100
+	keyLength              = 8
+)
+
+// ArgsDriverQueue holds the arguments needed to create a new driver queue
+type ArgsDriverQueue struct {
+	Name            string
+	Driver          outport.Driver
+	Persister       storage.Persister
+	Marshaller      marshal.Marshalizer
+	StatusHandler   core.AppStatusHandler
+	MaxBacklog      uint64
+	RetrialInterval time.Duration
+}
+
+// driverQueue is an outport driver decorator that persists every call in a write-ahead queue and delivers it
+// asynchronously, in order, to the wrapped driver. A failing driver is retried without blocking the caller, as long
+// as the backlog does not reach the configured maximum
+type driverQueue struct {
+	name             string
+	driver           outport.Driver
+	persister        storage.Persister
+	converter        recordConverter
+	statusHandler    core.AppStatusHandler
+	maxBacklog       uint64
+	retrialInterval  time.Duration
+	backlogMetric    string
+	deliveredMetric  string
+	mutQueue         sync.Mutex
+	head             uint64
+	tail             uint64
+	isClosed         bool
+	chNewItem        chan struct{}
+	chClose          chan struct{}
+	chDeliveryClosed chan struct{}
+	closeOnce        sync.Once
+}
+
+// NewDriverQueue creates a new driver queue and starts delivering the items that might have remained in the
+// persister from a previous run
+func NewDriverQueue(args ArgsDriverQueue) (*driverQueue, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	converter, err := record.NewConverter(record.ArgsConverter{
+		ObjectMarshaller: args.Marshaller,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dq := &driverQueue{
+		name:             args.Name,
+		driver:           args.Driver,
+		persister:        args.Persister,
+		converter:        converter,
+		statusHandler:    args.StatusHandler,
+		maxBacklog:       args.MaxBacklog,
+		retrialInterval:  args.RetrialInterval,
+		backlogMetric:    fmt.Sprintf("%s_%s", common.MetricOutportQueueBacklog, args.Name),
+		deliveredMetric:  fmt.Sprintf("%s_%s", common.MetricOutportQueueDelivered, args.Name),
+		chNewItem:        make(chan struct{}, 1),
+		chClose:          make(chan struct{}),
+		chDeliveryClosed: make(chan struct{}),
+	}
+
+	dq.loadBounds()
+	dq.initMetrics()
+	if dq.tail > dq.head {
+		log.Info("outport queue resumed", "driver", dq.name, "backlog", dq.tail-dq.head)
+	}
+
+	go dq.deliverContinuously()
+
+	return dq, nil
+}
+
+func checkArgs(args ArgsDriverQueue) error {
+	if len(args.Name) == 0 {
+		return ErrEmptyName
+	}
+	if check.IfNil(args.Driver) {
+		return outport.ErrNilDriver
+	}
+	if check.IfNil(args.Persister) {
+		return ErrNilPersister
+	}
+	if check.IfNil(args.Marshaller) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilStatusHandler
+	}
+	if args.MaxBacklog == 0 {
+		return fmt.Errorf("%w, provided: %d", ErrInvalidMaxBacklog, args.MaxBacklog)
+	}
+	if args.RetrialInterval < minimumRetrialInterval {
+		return fmt.Errorf("%w, provided: %d, minimum: %d", outport.ErrInvalidRetrialInterval, args.RetrialInterval, minimumRetrialInterval)
+	}
+
+	return nil
+}
+
+// loadBounds finds the oldest and the newest items left in the persister
+func (dq *driverQueue) loadBounds() {
+	isEmpty := true
+	dq.persister.RangeKeys(func(key []byte, _ []byte) bool {
+		if len(key) != keyLength {
+			return true
+		}
+
+		index := binary.BigEndian.Uint64(key)
+		if isEmpty || index < dq.head {
+			dq.head = index
+		}
+		if isEmpty || index >= dq.tail {
+			dq.tail = index + 1
+		}
+		isEmpty = false
+
+		return true
+	})
+}
+
+func indexToKey(index uint64) []byte {
+	key := make([]byte, keyLength)
+	binary.BigEndian.PutUint64(key, index)
+
+	return key
+}
+
+func (dq *driverQueue) enqueue(rec *record.Record) error {
+	buff, err := record.Encode(record.ProtoFormat, rec)
+	if err != nil {
+		return err
+	}
+
+	dq.mutQueue.Lock()
+	defer dq.mutQueue.Unlock()
+
+	if dq.isClosed {
+		return ErrQueueClosed
+	}
+	backlog := dq.tail - dq.head
+	if backlog >= dq.maxBacklog {
+		return fmt.Errorf("%w for driver %s, backlog: %d", ErrQueueFull, dq.name, backlog)
+	}
+
+	err = dq.persister.Put(indexToKey(dq.tail), buff)
+	if err != nil {
+		return err
+	}
+
+	dq.tail++
+	dq.statusHandler.SetUInt64Value(dq.backlogMetric, dq.tail-dq.head)
+
+	select {
+	case dq.chNewItem <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+func (dq *driverQueue) deliverContinuously() {
+	defer close(dq.chDeliveryClosed)
+
+	for {
+		index, buff, found := dq.peek()
+		if !found {
+			select {
+			case <-dq.chNewItem:
+				continue
+			case <-dq.chClose:
+				return
+			}
+		}
+
+		shouldStop := dq.deliverWithRetrial(index, buff)
+		if shouldStop {
+			return
+		}
+
+		dq.pop(index)
+	}
+}
+
+func (dq *driverQueue) peek() (uint64, []byte, bool) {
+	dq.mutQueue.Lock()
+	defer dq.mutQueue.Unlock()
+
+	for dq.head < dq.tail {
+		buff, err := dq.persister.Get(indexToKey(dq.head))
+		if err == nil {
+			return dq.head, buff, true
+		}
+
+		log.Error("outport queue: missing item, skipping", "driver", dq.name, "index", dq.head, "error", err)
+		dq.head++
+	}
+
+	return 0, nil, false
+}
+
+func (dq *driverQueue) pop(index uint64) {
+	dq.mutQueue.Lock()
+	defer dq.mutQueue.Unlock()
+
+	err := dq.persister.Remove(indexToKey(index))
+	if err != nil {
+		log.Warn("outport queue: cannot remove delivered item", "driver", dq.name, "index", index, "error", err)
+	}
+
+	dq.head = index + 1
+	dq.statusHandler.SetUInt64Value(dq.backlogMetric, dq.tail-dq.head)
+	dq.statusHandler.Increment(dq.deliveredMetric)
+}
+
+// deliverWithRetrial calls the wrapped driver until the item is accepted. Returns true if the queue was closed
+func (dq *driverQueue) deliverWithRetrial(index uint64, buff []byte) bool {
+	rec, err := record.Decode(record.ProtoFormat, buff)
+	if err != nil {
+		log.Error("outport queue: cannot decode item, skipping", "driver", dq.name, "index", index, "error", err)
+		return false
+	}
+
+	for {
+		err = dq.converter.Deliver(rec, dq.driver)
+		if err == nil {
+			return false
+		}
+
+		log.Error("outport queue: error delivering item, will retry",
+			"driver", dq.name,
+			"index", index,
+			"type", rec.Type,
+			"retrial in", dq.retrialInterval,
+			"error", err)
+
+		select {
+		case <-dq.chClose:
+			return true
+		case <-time.After(dq.retrialInterval):
+		}
+	}
+}
+
+func (dq *driverQueue) initMetrics() {
+	dq.statusHandler.SetUInt64Value(dq.backlogMetric, dq.tail-dq.head)
+	dq.statusHandler.SetUInt64Value(dq.deliveredMetric, 0)
+}
+
+// SaveBlock enqueues the block to be saved by the wrapped driver
+func (dq *driverQueue) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	rec, err := dq.converter.SaveBlockRecord(args)
+	if err != nil {
+		return err
+	}
+
+	return dq.enqueue(rec)
+}
+
+// RevertIndexedBlock enqueues the block to be reverted by the wrapped driver
+func (dq *driverQueue) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) error {
+	rec, err := dq.converter.RevertIndexedBlockRecord(header, body)
+	if err != nil {
+		return err
+	}
+
+	return dq.enqueue(rec)
+}
+
+// SaveRoundsInfo enqueues the rounds info to be saved by the wrapped driver
+func (dq *driverQueue) SaveRoundsInfo(roundsInfos []*indexer.RoundInfo) error {
+	return dq.enqueue(dq.converter.RoundsInfoRecord(roundsInfos))
+}
+
+// SaveValidatorsPubKeys enqueues the validators public keys to be saved by the wrapped driver
+func (dq *driverQueue) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+	return dq.enqueue(dq.converter.ValidatorsPubKeysRecord(validatorsPubKeys, epoch))
+}
+
+// SaveValidatorsRating enqueues the validators rating to be saved by the wrapped driver
+func (dq *driverQueue) SaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo) error {
+	return dq.enqueue(dq.converter.ValidatorsRatingRecord(indexID, infoRating))
+}
+
+// SaveAccounts directly calls the wrapped driver. The accounts handlers are bound to the live accounts trie so they
+// can not be persisted. This is called only when saving the genesis accounts, when the queue is empty
+func (dq *driverQueue) SaveAccounts(blockTimestamp uint64, acc []data.UserAccountHandler) error {
+	return dq.driver.SaveAccounts(blockTimestamp, acc)
+}
+
+// FinalizedBlock enqueues the finalized block hash to be delivered to the wrapped driver
+func (dq *driverQueue) FinalizedBlock(headerHash []byte) error {
+	return dq.enqueue(dq.converter.FinalizedBlockRecord(headerHash))
+}
+
+// Flush waits until all the enqueued items were delivered to the wrapped driver or the context is done
+func (dq *driverQueue) Flush(ctx context.Context) error {
+	for {
+		dq.mutQueue.Lock()
+		isClosed := dq.isClosed
+		backlog := dq.tail - dq.head
+		dq.mutQueue.Unlock()
+
+		if isClosed {
+			return ErrQueueClosed
+		}
+		if backlog == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w while flushing driver %s, backlog: %d", ctx.Err(), dq.name, backlog)
+		case <-time.After(flushCheckInterval):
+		}
+	}
+}
+
+// Close stops the delivery and closes both the wrapped driver and the persister. The items not yet delivered
+// remain in the persister and will be delivered after a restart
+func (dq *driverQueue) Close() error {
+	var err error
+	dq.closeOnce.Do(func() {
+		dq.mutQueue.Lock()
+		dq.isClosed = true
+		dq.mutQueue.Unlock()
+
+		close(dq.chClose)
+		err = dq.driver.Close()
+
+		select {
+		case <-dq.chDeliveryClosed:
+		case <-time.After(closeTimeout):
+			log.Warn("outport queue: timeout waiting for the delivery to stop", "driver", dq.name)
+		}
+
+		errPersister := dq.persister.Close()
+		if err == nil {
+			err = errPersister
+		}
+	})
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dq *driverQueue) IsInterfaceNil() bool {
+	return dq == nil
+}
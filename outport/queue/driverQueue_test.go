@@ -0,0 +1,386 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/mock"
+	"github.com/ElrondNetwork/elrond-go/outport/record"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const waitTimeout = time.Second * 5
+
+func createMockArgsDriverQueue() ArgsDriverQueue {
+	return ArgsDriverQueue{
+		Name:            "driver",
+		Driver:          &mock.DriverStub{},
+		Persister:       memorydb.New(),
+		Marshaller:      &testscommon.ProtobufMarshalizerMock{},
+		StatusHandler:   statusHandler.NewAppStatusHandlerMock(),
+		MaxBacklog:      10,
+		RetrialInterval: minimumRetrialInterval,
+	}
+}
+
+func waitForChannel(t *testing.T, ch chan struct{}) {
+	select {
+	case <-ch:
+	case <-time.After(waitTimeout):
+		require.Fail(t, "timeout waiting for the driver to be called")
+	}
+}
+
+func TestNewDriverQueue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDriverQueue()
+		args.Name = ""
+		dq, err := NewDriverQueue(args)
+		assert.Nil(t, dq)
+		assert.Equal(t, ErrEmptyName, err)
+	})
+	t.Run("nil driver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDriverQueue()
+		args.Driver = nil
+		dq, err := NewDriverQueue(args)
+		assert.Nil(t, dq)
+		assert.Equal(t, outport.ErrNilDriver, err)
+	})
+	t.Run("nil persister should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDriverQueue()
+		args.Persister = nil
+		dq, err := NewDriverQueue(args)
+		assert.Nil(t, dq)
+		assert.Equal(t, ErrNilPersister, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDriverQueue()
+		args.Marshaller = nil
+		dq, err := NewDriverQueue(args)
+		assert.Nil(t, dq)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDriverQueue()
+		args.StatusHandler = nil
+		dq, err := NewDriverQueue(args)
+		assert.Nil(t, dq)
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("invalid max backlog should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDriverQueue()
+		args.MaxBacklog = 0
+		dq, err := NewDriverQueue(args)
+		assert.Nil(t, dq)
+		assert.True(t, errors.Is(err, ErrInvalidMaxBacklog))
+	})
+	t.Run("invalid retrial interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDriverQueue()
+		args.RetrialInterval = time.Millisecond
+		dq, err := NewDriverQueue(args)
+		assert.Nil(t, dq)
+		assert.True(t, errors.Is(err, outport.ErrInvalidRetrialInterval))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dq, err := NewDriverQueue(createMockArgsDriverQueue())
+		assert.Nil(t, err)
+		assert.False(t, dq.IsInterfaceNil())
+		assert.Nil(t, dq.Close())
+	})
+}
+
+func TestDriverQueue_SaveBlockShouldDeliverTheSameData(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDriverQueue()
+	saveBlockArgs := &indexer.ArgsSaveBlockData{
+		HeaderHash: []byte("header hash"),
+		Header:     &block.Header{Nonce: 37, RootHash: []byte("root hash")},
+		Body: &block.Body{MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("tx hash")}},
+		}},
+		SignersIndexes:         []uint64{1, 2},
+		NotarizedHeadersHashes: []string{"aa"},
+		HeaderGasConsumption:   indexer.HeaderGasConsumption{GasProvided: 10},
+		TransactionsPool: &indexer.Pool{
+			Txs: map[string]data.TransactionHandler{
+				"\xff\x00tx hash": &transaction.Transaction{Nonce: 1, Value: big.NewInt(100)},
+			},
+			Scrs: map[string]data.TransactionHandler{
+				"scr hash": &smartContractResult.SmartContractResult{Nonce: 2, Value: big.NewInt(5)},
+			},
+			Rewards:  map[string]data.TransactionHandler{},
+			Invalid:  map[string]data.TransactionHandler{},
+			Receipts: map[string]data.TransactionHandler{},
+			Logs: []*data.LogData{
+				{TxHash: "\xff\x00tx hash", LogHandler: &transaction.Log{Address: []byte("address")}},
+			},
+		},
+		AlteredAccounts: map[string]*indexer.AlteredAccount{
+			"erd1": {Address: "erd1", Balance: "10"},
+		},
+	}
+
+	chCalled := make(chan struct{})
+	var received *indexer.ArgsSaveBlockData
+	args.Driver = &mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			received = args
+			close(chCalled)
+			return nil
+		},
+	}
+	dq, _ := NewDriverQueue(args)
+	defer func() {
+		_ = dq.Close()
+	}()
+
+	err := dq.SaveBlock(saveBlockArgs)
+	require.Nil(t, err)
+
+	waitForChannel(t, chCalled)
+	assert.Equal(t, saveBlockArgs, received)
+}
+
+func TestDriverQueue_FailingDriverShouldNotBlockTheCaller(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDriverQueue()
+	args.MaxBacklog = 3
+	appStatusHandler := statusHandler.NewAppStatusHandlerMock()
+	args.StatusHandler = appStatusHandler
+
+	mutFinalized := sync.Mutex{}
+	shouldFail := true
+	finalized := make([][]byte, 0)
+	chAllDelivered := make(chan struct{})
+	args.Driver = &mock.DriverStub{
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			mutFinalized.Lock()
+			defer mutFinalized.Unlock()
+
+			if shouldFail {
+				return errors.New("sink is down")
+			}
+			finalized = append(finalized, headerHash)
+			if len(finalized) == 3 {
+				close(chAllDelivered)
+			}
+
+			return nil
+		},
+	}
+	dq, _ := NewDriverQueue(args)
+	defer func() {
+		_ = dq.Close()
+	}()
+
+	for _, hash := range []string{"h1", "h2", "h3"} {
+		err := dq.FinalizedBlock([]byte(hash))
+		require.Nil(t, err)
+	}
+
+	err := dq.FinalizedBlock([]byte("h4"))
+	assert.True(t, errors.Is(err, ErrQueueFull))
+	assert.Equal(t, uint64(3), appStatusHandler.GetUint64(common.MetricOutportQueueBacklog+"_driver"))
+
+	mutFinalized.Lock()
+	shouldFail = false
+	mutFinalized.Unlock()
+
+	waitForChannel(t, chAllDelivered)
+	assert.Equal(t, [][]byte{[]byte("h1"), []byte("h2"), []byte("h3")}, finalized)
+	assert.Equal(t, uint64(3), appStatusHandler.GetUint64(common.MetricOutportQueueDelivered+"_driver"))
+}
+
+func TestDriverQueue_Flush(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should wait for the items to be delivered", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDriverQueue()
+		mutFinalized := sync.Mutex{}
+		shouldFail := true
+		numFinalized := 0
+		args.Driver = &mock.DriverStub{
+			FinalizedBlockCalled: func(headerHash []byte) error {
+				mutFinalized.Lock()
+				defer mutFinalized.Unlock()
+
+				if shouldFail {
+					return errors.New("sink is down")
+				}
+				numFinalized++
+
+				return nil
+			},
+		}
+		dq, _ := NewDriverQueue(args)
+		defer func() {
+			_ = dq.Close()
+		}()
+
+		for _, hash := range []string{"h1", "h2"} {
+			err := dq.FinalizedBlock([]byte(hash))
+			require.Nil(t, err)
+		}
+
+		go func() {
+			time.Sleep(flushCheckInterval)
+			mutFinalized.Lock()
+			shouldFail = false
+			mutFinalized.Unlock()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), waitTimeout)
+		defer cancel()
+		err := dq.Flush(ctx)
+		require.Nil(t, err)
+
+		mutFinalized.Lock()
+		assert.Equal(t, 2, numFinalized)
+		mutFinalized.Unlock()
+	})
+	t.Run("done context should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDriverQueue()
+		args.Driver = &mock.DriverStub{
+			FinalizedBlockCalled: func(headerHash []byte) error {
+				return errors.New("sink is down")
+			},
+		}
+		dq, _ := NewDriverQueue(args)
+		defer func() {
+			_ = dq.Close()
+		}()
+
+		err := dq.FinalizedBlock([]byte("h1"))
+		require.Nil(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), flushCheckInterval)
+		defer cancel()
+		err = dq.Flush(ctx)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+	t.Run("closed queue should error", func(t *testing.T) {
+		t.Parallel()
+
+		dq, _ := NewDriverQueue(createMockArgsDriverQueue())
+		_ = dq.Close()
+
+		err := dq.Flush(context.Background())
+		assert.Equal(t, ErrQueueClosed, err)
+	})
+}
+
+func TestDriverQueue_ShouldResumeFromPersister(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDriverQueue()
+	persister := memorydb.New()
+	args.Persister = persister
+	args.Driver = &mock.DriverStub{
+		SaveRoundsInfoCalled: func(roundsInfos []*indexer.RoundInfo) error {
+			return errors.New("sink is down")
+		},
+	}
+	dq, _ := NewDriverQueue(args)
+
+	roundsInfo := []*indexer.RoundInfo{{Index: 5, SignersIndexes: []uint64{1}, ShardId: 1}}
+	err := dq.SaveRoundsInfo(roundsInfo)
+	require.Nil(t, err)
+	err = dq.SaveValidatorsPubKeys(map[uint32][][]byte{0: {[]byte("pk")}}, 2)
+	require.Nil(t, err)
+	_ = dq.Close()
+
+	chCalled := make(chan struct{})
+	var receivedRounds []*indexer.RoundInfo
+	args.Driver = &mock.DriverStub{
+		SaveRoundsInfoCalled: func(roundsInfos []*indexer.RoundInfo) error {
+			receivedRounds = roundsInfos
+			return nil
+		},
+		SaveValidatorsPubKeysCalled: func(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+			assert.Equal(t, map[uint32][][]byte{0: {[]byte("pk")}}, validatorsPubKeys)
+			assert.Equal(t, uint32(2), epoch)
+			close(chCalled)
+			return nil
+		},
+	}
+	dq, _ = NewDriverQueue(args)
+	defer func() {
+		_ = dq.Close()
+	}()
+
+	waitForChannel(t, chCalled)
+	assert.Equal(t, roundsInfo, receivedRounds)
+}
+
+func TestDriverQueue_UnknownObjectTypeShouldError(t *testing.T) {
+	t.Parallel()
+
+	dq, _ := NewDriverQueue(createMockArgsDriverQueue())
+	defer func() {
+		_ = dq.Close()
+	}()
+
+	err := dq.RevertIndexedBlock(&testscommon.HeaderHandlerStub{}, &block.Body{})
+	assert.True(t, errors.Is(err, record.ErrUnknownObjectType))
+}
+
+func TestDriverQueue_CloseShouldRejectNewItems(t *testing.T) {
+	t.Parallel()
+
+	closeCalled := false
+	args := createMockArgsDriverQueue()
+	args.Driver = &mock.DriverStub{
+		CloseCalled: func() error {
+			closeCalled = true
+			return nil
+		},
+	}
+	dq, _ := NewDriverQueue(args)
+
+	err := dq.Close()
+	assert.Nil(t, err)
+	assert.True(t, closeCalled)
+
+	err = dq.FinalizedBlock([]byte("hash"))
+	assert.Equal(t, ErrQueueClosed, err)
+}
@@ -0,0 +1,21 @@
+package queue
+
+import "errors"
+
+// ErrNilPersister signals that a nil persister has been provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrEmptyName signals that an empty driver name has been provided
+var ErrEmptyName = errors.New("empty driver name")
+
+// ErrInvalidMaxBacklog signals that an invalid maximum backlog has been provided
+var ErrInvalidMaxBacklog = errors.New("invalid max backlog")
+
+// ErrQueueFull signals that the queue reached the maximum backlog and can not accept new items
+var ErrQueueFull = errors.New("outport queue is full")
+
+// ErrQueueClosed signals that the queue was closed
+var ErrQueueClosed = errors.New("outport queue is closed")
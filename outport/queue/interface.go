@@ -0,0 +1,19 @@
+package queue
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/record"
+)
+
+type recordConverter interface {
+	SaveBlockRecord(args *indexer.ArgsSaveBlockData) (*record.Record, error)
+	RevertIndexedBlockRecord(header data.HeaderHandler, body data.BodyHandler) (*record.Record, error)
+	RoundsInfoRecord(roundsInfos []*indexer.RoundInfo) *record.Record
+	ValidatorsPubKeysRecord(validatorsPubKeys map[uint32][][]byte, epoch uint32) *record.Record
+	ValidatorsRatingRecord(indexID string, infoRating []*indexer.ValidatorRatingInfo) *record.Record
+	FinalizedBlockRecord(headerHash []byte) *record.Record
+	Deliver(rec *record.Record, driver outport.Driver) error
+	IsInterfaceNil() bool
+}
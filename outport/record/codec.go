@@ -0,0 +1,168 @@
+package record
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	// JSONFormat stores the records as JSON lines, the objects being marshalled as JSON as well
+	JSONFormat = "json"
+	// ProtoFormat stores the records as length prefixed protobuf messages, the objects being marshalled with the
+	// node's internal marshaller
+	ProtoFormat = "proto"
+
+	maxRecordSize = 1 << 30
+)
+
+// CheckFormat returns an error if the provided records format is unknown
+func CheckFormat(format string) error {
+	switch format {
+	case JSONFormat, ProtoFormat:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+}
+
+// ObjectMarshallerForFormat returns the marshaller that should be used by the converter for the provided format
+func ObjectMarshallerForFormat(format string, internalMarshaller marshal.Marshalizer) (marshal.Marshalizer, error) {
+	switch format {
+	case JSONFormat:
+		return &marshal.JsonMarshalizer{}, nil
+	case ProtoFormat:
+		return internalMarshaller, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+}
+
+// Encode serializes a single record in the provided format, without any delimiter or length prefix
+func Encode(format string, rec *Record) ([]byte, error) {
+	switch format {
+	case JSONFormat:
+		return json.Marshal(rec)
+	case ProtoFormat:
+		return proto.Marshal(rec)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+}
+
+// Decode deserializes a single record, previously serialized with Encode
+func Decode(format string, buff []byte) (*Record, error) {
+	rec := &Record{}
+	var err error
+	switch format {
+	case JSONFormat:
+		err = json.Unmarshal(buff, rec)
+	case ProtoFormat:
+		err = proto.Unmarshal(buff, rec)
+	default:
+		err = fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return rec, nil
+}
+
+type writer struct {
+	format string
+	w      io.Writer
+}
+
+// NewWriter creates a records stream writer. The JSON records are separated by new lines while the protobuf
+// records are prefixed by their length, encoded as an unsigned varint
+func NewWriter(format string, w io.Writer) (*writer, error) {
+	err := CheckFormat(format)
+	if err != nil {
+		return nil, err
+	}
+
+	return &writer{
+		format: format,
+		w:      w,
+	}, nil
+}
+
+// Write appends the record to the stream and returns the number of written bytes
+func (wr *writer) Write(rec *Record) (int, error) {
+	buff, err := Encode(wr.format, rec)
+	if err != nil {
+		return 0, err
+	}
+
+	var frame []byte
+	if wr.format == JSONFormat {
+		frame = append(buff, '\n')
+	} else {
+		frame = make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(buff))
+		prefixLen := binary.PutUvarint(frame, uint64(len(buff)))
+		frame = append(frame[:prefixLen], buff...)
+	}
+
+	return wr.w.Write(frame)
+}
+
+type reader struct {
+	format string
+	r      *bufio.Reader
+}
+
+// NewReader creates a records stream reader, able to read the streams created by a writer with the same format
+func NewReader(format string, r io.Reader) (*reader, error) {
+	err := CheckFormat(format)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reader{
+		format: format,
+		r:      bufio.NewReader(r),
+	}, nil
+}
+
+// Read returns the next record from the stream or io.EOF when the stream ended
+func (rd *reader) Read() (*Record, error) {
+	buff, err := rd.readFrame()
+	if err != nil {
+		return nil, err
+	}
+
+	return Decode(rd.format, buff)
+}
+
+func (rd *reader) readFrame() ([]byte, error) {
+	if rd.format == JSONFormat {
+		line, err := rd.r.ReadBytes('\n')
+		if err == io.EOF && len(line) > 0 {
+			return nil, io.ErrUnexpectedEOF
+		}
+
+		return line, err
+	}
+
+	size, err := binary.ReadUvarint(rd.r)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxRecordSize {
+		return nil, fmt.Errorf("%w: %d bytes", ErrRecordTooLarge, size)
+	}
+
+	buff := make([]byte, size)
+	_, err = io.ReadFull(rd.r, buff)
+	if err == io.EOF {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return buff, err
+}
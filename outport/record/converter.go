@@ -0,0 +1,639 @@
+package record
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport"
+)
+
+const (
+	headerObjectType              = "header"
+	headerV2ObjectType            = "headerV2"
+	metaBlockObjectType           = "metaBlock"
+	bodyObjectType                = "body"
+	transactionObjectType         = "transaction"
+	smartContractResultObjectType = "smartContractResult"
+	rewardTxObjectType            = "rewardTx"
+	receiptObjectType             = "receipt"
+	logObjectType                 = "log"
+	esdtMetaDataObjectType        = "esdtMetaData"
+)
+
+// ArgsConverter holds the arguments needed to create a new records converter
+type ArgsConverter struct {
+	ObjectMarshaller marshal.Marshalizer
+}
+
+// converter transforms the outport drivers arguments into records and back. The headers, bodies, transactions and
+// logs are marshalled with the provided object marshaller
+type converter struct {
+	objectMarshaller marshal.Marshalizer
+}
+
+// NewConverter creates a new records converter
+func NewConverter(args ArgsConverter) (*converter, error) {
+	if check.IfNil(args.ObjectMarshaller) {
+		return nil, core.ErrNilMarshalizer
+	}
+
+	return &converter{
+		objectMarshaller: args.ObjectMarshaller,
+	}, nil
+}
+
+// SaveBlockRecord creates the record of a SaveBlock call
+func (c *converter) SaveBlockRecord(args *indexer.ArgsSaveBlockData) (*Record, error) {
+	if args == nil {
+		return nil, ErrNilSaveBlockArgs
+	}
+
+	header, err := c.toObject(args.Header)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.toObject(args.Body)
+	if err != nil {
+		return nil, err
+	}
+	alteredAccounts, err := c.toAlteredAccounts(args.AlteredAccounts)
+	if err != nil {
+		return nil, err
+	}
+
+	saveBlock := &SaveBlock{
+		HeaderHash:             args.HeaderHash,
+		Header:                 header,
+		Body:                   body,
+		SignersIndexes:         args.SignersIndexes,
+		NotarizedHeadersHashes: args.NotarizedHeadersHashes,
+		HeaderGasConsumption: &HeaderGasConsumption{
+			GasProvided:    args.HeaderGasConsumption.GasProvided,
+			GasRefunded:    args.HeaderGasConsumption.GasRefunded,
+			GasPenalized:   args.HeaderGasConsumption.GasPenalized,
+			MaxGasPerBlock: args.HeaderGasConsumption.MaxGasPerBlock,
+		},
+		AlteredAccounts: alteredAccounts,
+	}
+
+	pool := args.TransactionsPool
+	if pool != nil {
+		saveBlock.HasPool = true
+		err = c.fillPool(saveBlock, pool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Record{
+		Type:      SaveBlockType,
+		SaveBlock: saveBlock,
+	}, nil
+}
+
+func (c *converter) fillPool(saveBlock *SaveBlock, pool *indexer.Pool) error {
+	var err error
+	saveBlock.Txs, err = c.toObjectsMap(pool.Txs)
+	if err != nil {
+		return err
+	}
+	saveBlock.Scrs, err = c.toObjectsMap(pool.Scrs)
+	if err != nil {
+		return err
+	}
+	saveBlock.Rewards, err = c.toObjectsMap(pool.Rewards)
+	if err != nil {
+		return err
+	}
+	saveBlock.Invalid, err = c.toObjectsMap(pool.Invalid)
+	if err != nil {
+		return err
+	}
+	saveBlock.Receipts, err = c.toObjectsMap(pool.Receipts)
+	if err != nil {
+		return err
+	}
+
+	saveBlock.Logs = make([]*LogEntry, 0, len(pool.Logs))
+	for _, logData := range pool.Logs {
+		if logData == nil {
+			continue
+		}
+
+		logObject, errConvert := c.toObject(logData.LogHandler)
+		if errConvert != nil {
+			return errConvert
+		}
+		saveBlock.Logs = append(saveBlock.Logs, &LogEntry{
+			TxHash: []byte(logData.TxHash),
+			Log:    logObject,
+		})
+	}
+
+	return nil
+}
+
+// RevertIndexedBlockRecord creates the record of a RevertIndexedBlock call
+func (c *converter) RevertIndexedBlockRecord(header data.HeaderHandler, body data.BodyHandler) (*Record, error) {
+	headerObject, err := c.toObject(header)
+	if err != nil {
+		return nil, err
+	}
+	bodyObject, err := c.toObject(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Record{
+		Type:   RevertIndexedBlockType,
+		Header: headerObject,
+		Body:   bodyObject,
+	}, nil
+}
+
+// RoundsInfoRecord creates the record of a SaveRoundsInfo call
+func (c *converter) RoundsInfoRecord(roundsInfos []*indexer.RoundInfo) *Record {
+	rounds := make([]*RoundInfo, 0, len(roundsInfos))
+	for _, roundInfo := range roundsInfos {
+		if roundInfo == nil {
+			continue
+		}
+
+		rounds = append(rounds, &RoundInfo{
+			Index:            roundInfo.Index,
+			SignersIndexes:   roundInfo.SignersIndexes,
+			BlockWasProposed: roundInfo.BlockWasProposed,
+			ShardId:          roundInfo.ShardId,
+			Epoch:            roundInfo.Epoch,
+			Timestamp:        int64(roundInfo.Timestamp),
+		})
+	}
+
+	return &Record{
+		Type:       SaveRoundsInfoType,
+		RoundsInfo: rounds,
+	}
+}
+
+// ValidatorsPubKeysRecord creates the record of a SaveValidatorsPubKeys call
+func (c *converter) ValidatorsPubKeysRecord(validatorsPubKeys map[uint32][][]byte, epoch uint32) *Record {
+	pubKeys := make(map[uint32]*PubKeys, len(validatorsPubKeys))
+	for shardID, keys := range validatorsPubKeys {
+		pubKeys[shardID] = &PubKeys{Keys: keys}
+	}
+
+	return &Record{
+		Type:              SaveValidatorsPubKeysType,
+		ValidatorsPubKeys: pubKeys,
+		Epoch:             epoch,
+	}
+}
+
+// ValidatorsRatingRecord creates the record of a SaveValidatorsRating call
+func (c *converter) ValidatorsRatingRecord(indexID string, infoRating []*indexer.ValidatorRatingInfo) *Record {
+	ratings := make([]*ValidatorRatingInfo, 0, len(infoRating))
+	for _, rating := range infoRating {
+		if rating == nil {
+			continue
+		}
+
+		ratings = append(ratings, &ValidatorRatingInfo{
+			PublicKey: rating.PublicKey,
+			Rating:    rating.Rating,
+		})
+	}
+
+	return &Record{
+		Type:             SaveValidatorsRatingType,
+		IndexID:          indexID,
+		ValidatorsRating: ratings,
+	}
+}
+
+// FinalizedBlockRecord creates the record of a FinalizedBlock call
+func (c *converter) FinalizedBlockRecord(headerHash []byte) *Record {
+	return &Record{
+		Type:       FinalizedBlockType,
+		HeaderHash: headerHash,
+	}
+}
+
+// Deliver calls the driver method corresponding to the record type, with the arguments stored in the record
+func (c *converter) Deliver(rec *Record, driver outport.Driver) error {
+	if rec == nil {
+		return ErrNilRecord
+	}
+	if check.IfNil(driver) {
+		return outport.ErrNilDriver
+	}
+
+	switch rec.Type {
+	case SaveBlockType:
+		args, err := c.SaveBlockArgs(rec)
+		if err != nil {
+			return err
+		}
+		return driver.SaveBlock(args)
+	case RevertIndexedBlockType:
+		header, err := c.toHeader(rec.Header)
+		if err != nil {
+			return err
+		}
+		body, err := c.toBody(rec.Body)
+		if err != nil {
+			return err
+		}
+		return driver.RevertIndexedBlock(header, body)
+	case SaveRoundsInfoType:
+		return driver.SaveRoundsInfo(fromRoundsInfo(rec.RoundsInfo))
+	case SaveValidatorsPubKeysType:
+		return driver.SaveValidatorsPubKeys(fromValidatorsPubKeys(rec.ValidatorsPubKeys), rec.Epoch)
+	case SaveValidatorsRatingType:
+		return driver.SaveValidatorsRating(rec.IndexID, fromValidatorsRating(rec.ValidatorsRating))
+	case FinalizedBlockType:
+		return driver.FinalizedBlock(rec.HeaderHash)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownRecordType, rec.Type)
+	}
+}
+
+// SaveBlockArgs rebuilds the SaveBlock arguments stored in a record
+func (c *converter) SaveBlockArgs(rec *Record) (*indexer.ArgsSaveBlockData, error) {
+	if rec == nil || rec.SaveBlock == nil {
+		return nil, ErrNilSaveBlockArgs
+	}
+
+	saveBlock := rec.SaveBlock
+	header, err := c.toHeader(saveBlock.Header)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.toBody(saveBlock.Body)
+	if err != nil {
+		return nil, err
+	}
+	alteredAccounts, err := c.fromAlteredAccounts(saveBlock.AlteredAccounts)
+	if err != nil {
+		return nil, err
+	}
+
+	args := &indexer.ArgsSaveBlockData{
+		HeaderHash:             saveBlock.HeaderHash,
+		Body:                   body,
+		Header:                 header,
+		SignersIndexes:         saveBlock.SignersIndexes,
+		NotarizedHeadersHashes: saveBlock.NotarizedHeadersHashes,
+		AlteredAccounts:        alteredAccounts,
+	}
+	if saveBlock.HeaderGasConsumption != nil {
+		args.HeaderGasConsumption = indexer.HeaderGasConsumption{
+			GasProvided:    saveBlock.HeaderGasConsumption.GasProvided,
+			GasRefunded:    saveBlock.HeaderGasConsumption.GasRefunded,
+			GasPenalized:   saveBlock.HeaderGasConsumption.GasPenalized,
+			MaxGasPerBlock: saveBlock.HeaderGasConsumption.MaxGasPerBlock,
+		}
+	}
+	if !saveBlock.HasPool {
+		return args, nil
+	}
+
+	args.TransactionsPool, err = c.toPool(saveBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return args, nil
+}
+
+func (c *converter) toPool(saveBlock *SaveBlock) (*indexer.Pool, error) {
+	var err error
+	pool := &indexer.Pool{}
+	pool.Txs, err = c.fromObjectsMap(saveBlock.Txs)
+	if err != nil {
+		return nil, err
+	}
+	pool.Scrs, err = c.fromObjectsMap(saveBlock.Scrs)
+	if err != nil {
+		return nil, err
+	}
+	pool.Rewards, err = c.fromObjectsMap(saveBlock.Rewards)
+	if err != nil {
+		return nil, err
+	}
+	pool.Invalid, err = c.fromObjectsMap(saveBlock.Invalid)
+	if err != nil {
+		return nil, err
+	}
+	pool.Receipts, err = c.fromObjectsMap(saveBlock.Receipts)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.Logs = make([]*data.LogData, 0, len(saveBlock.Logs))
+	for _, entry := range saveBlock.Logs {
+		if entry == nil {
+			continue
+		}
+
+		obj, errConvert := c.fromObject(entry.Log)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+		logHandler, ok := obj.(data.LogHandler)
+		if !ok {
+			return nil, fmt.Errorf("%w, %s is not a log", ErrUnknownObjectType, entry.Log.GetType())
+		}
+
+		pool.Logs = append(pool.Logs, &data.LogData{
+			LogHandler: logHandler,
+			TxHash:     string(entry.TxHash),
+		})
+	}
+
+	return pool, nil
+}
+
+func (c *converter) toObjectsMap(txs map[string]data.TransactionHandler) (map[string]*Object, error) {
+	objects := make(map[string]*Object, len(txs))
+	for txHash, tx := range txs {
+		object, err := c.toObject(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		objects[hex.EncodeToString([]byte(txHash))] = object
+	}
+
+	return objects, nil
+}
+
+func (c *converter) fromObjectsMap(objects map[string]*Object) (map[string]data.TransactionHandler, error) {
+	txs := make(map[string]data.TransactionHandler, len(objects))
+	for encodedHash, object := range objects {
+		txHash, err := hex.DecodeString(encodedHash)
+		if err != nil {
+			return nil, err
+		}
+
+		obj, err := c.fromObject(object)
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			txs[string(txHash)] = nil
+			continue
+		}
+
+		tx, ok := obj.(data.TransactionHandler)
+		if !ok {
+			return nil, fmt.Errorf("%w, %s is not a transaction", ErrUnknownObjectType, object.Type)
+		}
+		txs[string(txHash)] = tx
+	}
+
+	return txs, nil
+}
+
+func (c *converter) toAlteredAccounts(alteredAccounts map[string]*indexer.AlteredAccount) (map[string]*AlteredAccount, error) {
+	if alteredAccounts == nil {
+		return nil, nil
+	}
+
+	accounts := make(map[string]*AlteredAccount, len(alteredAccounts))
+	for address, alteredAccount := range alteredAccounts {
+		if alteredAccount == nil {
+			continue
+		}
+
+		account := &AlteredAccount{
+			Address: alteredAccount.Address,
+			Balance: alteredAccount.Balance,
+			Nonce:   alteredAccount.Nonce,
+		}
+		for _, token := range alteredAccount.Tokens {
+			if token == nil {
+				continue
+			}
+
+			metaData, err := c.toObject(token.MetaData)
+			if err != nil {
+				return nil, err
+			}
+			account.Tokens = append(account.Tokens, &AccountTokenData{
+				Identifier: token.Identifier,
+				Balance:    token.Balance,
+				Nonce:      token.Nonce,
+				Properties: token.Properties,
+				MetaData:   metaData,
+			})
+		}
+
+		accounts[address] = account
+	}
+
+	return accounts, nil
+}
+
+func (c *converter) fromAlteredAccounts(accounts map[string]*AlteredAccount) (map[string]*indexer.AlteredAccount, error) {
+	if accounts == nil {
+		return nil, nil
+	}
+
+	alteredAccounts := make(map[string]*indexer.AlteredAccount, len(accounts))
+	for address, account := range accounts {
+		alteredAccount := &indexer.AlteredAccount{
+			Address: account.Address,
+			Balance: account.Balance,
+			Nonce:   account.Nonce,
+		}
+		for _, token := range account.Tokens {
+			obj, err := c.fromObject(token.MetaData)
+			if err != nil {
+				return nil, err
+			}
+			metaData, _ := obj.(*esdt.MetaData)
+
+			alteredAccount.Tokens = append(alteredAccount.Tokens, &indexer.AccountTokenData{
+				Identifier: token.Identifier,
+				Balance:    token.Balance,
+				Nonce:      token.Nonce,
+				Properties: token.Properties,
+				MetaData:   metaData,
+			})
+		}
+
+		alteredAccounts[address] = alteredAccount
+	}
+
+	return alteredAccounts, nil
+}
+
+func fromRoundsInfo(rounds []*RoundInfo) []*indexer.RoundInfo {
+	roundsInfo := make([]*indexer.RoundInfo, 0, len(rounds))
+	for _, round := range rounds {
+		roundsInfo = append(roundsInfo, &indexer.RoundInfo{
+			Index:            round.Index,
+			SignersIndexes:   round.SignersIndexes,
+			BlockWasProposed: round.BlockWasProposed,
+			ShardId:          round.ShardId,
+			Epoch:            round.Epoch,
+			Timestamp:        time.Duration(round.Timestamp),
+		})
+	}
+
+	return roundsInfo
+}
+
+func fromValidatorsPubKeys(pubKeys map[uint32]*PubKeys) map[uint32][][]byte {
+	validatorsPubKeys := make(map[uint32][][]byte, len(pubKeys))
+	for shardID, keys := range pubKeys {
+		if keys == nil {
+			validatorsPubKeys[shardID] = nil
+			continue
+		}
+
+		validatorsPubKeys[shardID] = keys.Keys
+	}
+
+	return validatorsPubKeys
+}
+
+func fromValidatorsRating(ratings []*ValidatorRatingInfo) []*indexer.ValidatorRatingInfo {
+	infoRating := make([]*indexer.ValidatorRatingInfo, 0, len(ratings))
+	for _, rating := range ratings {
+		infoRating = append(infoRating, &indexer.ValidatorRatingInfo{
+			PublicKey: rating.PublicKey,
+			Rating:    rating.Rating,
+		})
+	}
+
+	return infoRating
+}
+
+func (c *converter) toHeader(object *Object) (data.HeaderHandler, error) {
+	obj, err := c.fromObject(object)
+	if err != nil || obj == nil {
+		return nil, err
+	}
+
+	header, ok := obj.(data.HeaderHandler)
+	if !ok {
+		return nil, fmt.Errorf("%w, %s is not a header", ErrUnknownObjectType, object.Type)
+	}
+
+	return header, nil
+}
+
+func (c *converter) toBody(object *Object) (data.BodyHandler, error) {
+	obj, err := c.fromObject(object)
+	if err != nil || obj == nil {
+		return nil, err
+	}
+
+	body, ok := obj.(data.BodyHandler)
+	if !ok {
+		return nil, fmt.Errorf("%w, %s is not a body", ErrUnknownObjectType, object.Type)
+	}
+
+	return body, nil
+}
+
+// toObject marshals one of the known headers, bodies, transactions, logs or token metadata. A nil value results
+// in an empty object
+func (c *converter) toObject(obj interface{}) (*Object, error) {
+	if check.IfNilReflect(obj) {
+		return &Object{}, nil
+	}
+
+	var objectType string
+	switch typedObj := obj.(type) {
+	case *block.Header:
+		objectType = headerObjectType
+	case *block.HeaderV2:
+		objectType = headerV2ObjectType
+	case *block.MetaBlock:
+		objectType = metaBlockObjectType
+	case *block.Body:
+		objectType = bodyObjectType
+	case *transaction.Transaction:
+		objectType = transactionObjectType
+	case *smartContractResult.SmartContractResult:
+		objectType = smartContractResultObjectType
+	case *rewardTx.RewardTx:
+		objectType = rewardTxObjectType
+	case *receipt.Receipt:
+		objectType = receiptObjectType
+	case *transaction.Log:
+		objectType = logObjectType
+	case *esdt.MetaData:
+		objectType = esdtMetaDataObjectType
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnknownObjectType, typedObj)
+	}
+
+	buff, err := c.objectMarshaller.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Object{
+		Type: objectType,
+		Data: buff,
+	}, nil
+}
+
+func (c *converter) fromObject(object *Object) (interface{}, error) {
+	if object == nil || len(object.Type) == 0 {
+		return nil, nil
+	}
+
+	var obj interface{}
+	switch object.Type {
+	case headerObjectType:
+		obj = &block.Header{}
+	case headerV2ObjectType:
+		obj = &block.HeaderV2{}
+	case metaBlockObjectType:
+		obj = &block.MetaBlock{}
+	case bodyObjectType:
+		obj = &block.Body{}
+	case transactionObjectType:
+		obj = &transaction.Transaction{}
+	case smartContractResultObjectType:
+		obj = &smartContractResult.SmartContractResult{}
+	case rewardTxObjectType:
+		obj = &rewardTx.RewardTx{}
+	case receiptObjectType:
+		obj = &receipt.Receipt{}
+	case logObjectType:
+		obj = &transaction.Log{}
+	case esdtMetaDataObjectType:
+		obj = &esdt.MetaData{}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownObjectType, object.Type)
+	}
+
+	err := c.objectMarshaller.Unmarshal(obj, object.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *converter) IsInterfaceNil() bool {
+	return c == nil
+}
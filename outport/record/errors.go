@@ -0,0 +1,21 @@
+package record
+
+import "errors"
+
+// ErrNilRecord signals that a nil record has been provided
+var ErrNilRecord = errors.New("nil record")
+
+// ErrNilSaveBlockArgs signals that nil save block arguments have been provided
+var ErrNilSaveBlockArgs = errors.New("nil save block args")
+
+// ErrUnknownRecordType signals that a record has an unknown type
+var ErrUnknownRecordType = errors.New("unknown record type")
+
+// ErrUnknownObjectType signals that an object of an unknown type was provided or read
+var ErrUnknownObjectType = errors.New("unknown object type")
+
+// ErrUnknownFormat signals that an unknown records format has been provided
+var ErrUnknownFormat = errors.New("unknown records format")
+
+// ErrRecordTooLarge signals that a read record exceeds the maximum allowed size
+var ErrRecordTooLarge = errors.New("record too large")
@@ -0,0 +1,17 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. record.proto
+package record
+
+const (
+	// SaveBlockType is the type of the records holding a saved block
+	SaveBlockType = "saveBlock"
+	// RevertIndexedBlockType is the type of the records holding a reverted block
+	RevertIndexedBlockType = "revertIndexedBlock"
+	// SaveRoundsInfoType is the type of the records holding rounds info
+	SaveRoundsInfoType = "saveRoundsInfo"
+	// SaveValidatorsPubKeysType is the type of the records holding validators public keys
+	SaveValidatorsPubKeysType = "saveValidatorsPubKeys"
+	// SaveValidatorsRatingType is the type of the records holding validators rating
+	SaveValidatorsRatingType = "saveValidatorsRating"
+	// FinalizedBlockType is the type of the records holding a finalized block hash
+	FinalizedBlockType = "finalizedBlock"
+)
@@ -0,0 +1,5820 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: record.proto
+
+package record
+
+import (
+	bytes "bytes"
+	encoding_binary "encoding/binary"
+	encoding_json "encoding/json"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Record holds the arguments of one outport driver call. Only the fields relevant for the record type are set
+type Record struct {
+	Type              string                 `protobuf:"bytes,1,opt,name=Type,proto3" json:"type"`
+	SaveBlock         *SaveBlock             `protobuf:"bytes,2,opt,name=SaveBlock,proto3" json:"saveBlock,omitempty"`
+	Header            *Object                `protobuf:"bytes,3,opt,name=Header,proto3" json:"header,omitempty"`
+	Body              *Object                `protobuf:"bytes,4,opt,name=Body,proto3" json:"body,omitempty"`
+	RoundsInfo        []*RoundInfo           `protobuf:"bytes,5,rep,name=RoundsInfo,proto3" json:"roundsInfo,omitempty"`
+	ValidatorsPubKeys map[uint32]*PubKeys    `protobuf:"bytes,6,rep,name=ValidatorsPubKeys,proto3" json:"validatorsPubKeys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Epoch             uint32                 `protobuf:"varint,7,opt,name=Epoch,proto3" json:"epoch,omitempty"`
+	IndexID           string                 `protobuf:"bytes,8,opt,name=IndexID,proto3" json:"indexID,omitempty"`
+	ValidatorsRating  []*ValidatorRatingInfo `protobuf:"bytes,9,rep,name=ValidatorsRating,proto3" json:"validatorsRating,omitempty"`
+	HeaderHash        []byte                 `protobuf:"bytes,10,opt,name=HeaderHash,proto3" json:"headerHash,omitempty"`
+}
+
+func (m *Record) Reset()      { *m = Record{} }
+func (*Record) ProtoMessage() {}
+func (*Record) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf94fd919e302a1d, []int{0}
+}
+func (m *Record) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Record) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Record) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Record.Merge(m, src)
+}
+func (m *Record) XXX_Size() int {
+	return m.Size()
+}
+func (m *Record) XXX_DiscardUnknown() {
+	xxx_messageInfo_Record.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Record proto.InternalMessageInfo
+
+func (m *Record) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Record) GetSaveBlock() *SaveBlock {
+	if m != nil {
+		return m.SaveBlock
+	}
+	return nil
+}
+
+func (m *Record) GetHeader() *Object {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *Record) GetBody() *Object {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *Record) GetRoundsInfo() []*RoundInfo {
+	if m != nil {
+		return m.RoundsInfo
+	}
+	return nil
+}
+
+func (m *Record) GetValidatorsPubKeys() map[uint32]*PubKeys {
+	if m != nil {
+		return m.ValidatorsPubKeys
+	}
+	return nil
+}
+
+func (m *Record) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *Record) GetIndexID() string {
+	if m != nil {
+		return m.IndexID
+	}
+	return ""
+}
+
+func (m *Record) GetValidatorsRating() []*ValidatorRatingInfo {
+	if m != nil {
+		return m.ValidatorsRating
+	}
+	return nil
+}
+
+func (m *Record) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+// Object holds a header, a body, a transaction or a log marshalled together with its concrete type. An object
+// with an empty type stands for a nil value
+type Object struct {
+	Type string                   `protobuf:"bytes,1,opt,name=Type,proto3" json:"type"`
+	Data encoding_json.RawMessage `protobuf:"bytes,2,opt,name=Data,proto3,casttype=encoding/json.RawMessage" json:"data"`
+}
+
+func (m *Object) Reset()      { *m = Object{} }
+func (*Object) ProtoMessage() {}
+func (*Object) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf94fd919e302a1d, []int{1}
+}
+func (m *Object) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Object) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Object) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Object.Merge(m, src)
+}
+func (m *Object) XXX_Size() int {
+	return m.Size()
+}
+func (m *Object) XXX_DiscardUnknown() {
+	xxx_messageInfo_Object.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Object proto.InternalMessageInfo
+
+func (m *Object) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Object) GetData() encoding_json.RawMessage {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// SaveBlock is the serializable form of indexer.ArgsSaveBlockData. All the maps are keyed by hex encoded hashes. The logs
+// are kept as a list so that their order in the pool is preserved
+type SaveBlock struct {
+	HeaderHash             []byte                     `protobuf:"bytes,1,opt,name=HeaderHash,proto3" json:"headerHash"`
+	Header                 *Object                    `protobuf:"bytes,2,opt,name=Header,proto3" json:"header"`
+	Body                   *Object                    `protobuf:"bytes,3,opt,name=Body,proto3" json:"body"`
+	SignersIndexes         []uint64                   `protobuf:"varint,4,rep,packed,name=SignersIndexes,proto3" json:"signersIndexes"`
+	NotarizedHeadersHashes []string                   `protobuf:"bytes,5,rep,name=NotarizedHeadersHashes,proto3" json:"notarizedHeadersHashes"`
+	HeaderGasConsumption   *HeaderGasConsumption      `protobuf:"bytes,6,opt,name=HeaderGasConsumption,proto3" json:"headerGasConsumption"`
+	HasPool                bool                       `protobuf:"varint,7,opt,name=HasPool,proto3" json:"hasPool"`
+	Txs                    map[string]*Object         `protobuf:"bytes,8,rep,name=Txs,proto3" json:"txs" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Scrs                   map[string]*Object         `protobuf:"bytes,9,rep,name=Scrs,proto3" json:"scrs" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Rewards                map[string]*Object         `protobuf:"bytes,10,rep,name=Rewards,proto3" json:"rewards" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Invalid                map[string]*Object         `protobuf:"bytes,11,rep,name=Invalid,proto3" json:"invalid" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Receipts               map[string]*Object         `protobuf:"bytes,12,rep,name=Receipts,proto3" json:"receipts" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Logs                   []*LogEntry                `protobuf:"bytes,13,rep,name=Logs,proto3" json:"logs"`
+	AlteredAccounts        map[string]*AlteredAccount `protobuf:"bytes,14,rep,name=AlteredAccounts,proto3" json:"alteredAccounts" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *SaveBlock) Reset()      { *m = SaveBlock{} }
+func (*SaveBlock) ProtoMessage() {}
+func (*SaveBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf94fd919e302a1d, []int{2}
+}
+func (m *SaveBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SaveBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SaveBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveBlock.Merge(m, src)
+}
+func (m *SaveBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *SaveBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveBlock proto.InternalMessageInfo
+
+func (m *SaveBlock) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+func (m *SaveBlock) GetHeader() *Object {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SaveBlock) GetBody() *Object {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *SaveBlock) GetSignersIndexes() []uint64 {
+	if m != nil {
+		return m.SignersIndexes
+	}
+	return nil
+}
+
+func (m *SaveBlock) GetNotarizedHeadersHashes() []string {
+	if m != nil {
+		return m.NotarizedHeadersHashes
+	}
+	return nil
+}
+
+func (m *SaveBlock) GetHeaderGasConsumption() *HeaderGasConsumption {
+	if m != nil {
+		return m.HeaderGasConsumption
+	}
+	return nil
+}
+
+func (m *SaveBlock) GetHasPool() bool {
+	if m != nil {
+		return m.HasPool
+	}
+	return false
+}
+
+func (m *SaveBlock) GetTxs() map[string]*Object {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *SaveBlock) GetScrs() map[string]*Object {
+	if m != nil {
+		return m.Scrs
+	}
+	return nil
+}
+
+func (m *SaveBlock) GetRewards() map[string]*Object {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *SaveBlock) GetInvalid() map[string]*Object {
+	if m != nil {
+		return m.Invalid
+	}
+	return nil
+}
+
+func (m *SaveBlock) GetReceipts() map[string]*Object {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+func (m *SaveBlock) GetLogs() []*LogEntry {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *SaveBlock) GetAlteredAccounts() map[string]*AlteredAccount {
+	if m != nil {
+		return m.AlteredAccounts
+	}
+	return nil
+}
+
+// LogEntry is the serializable form of data.LogData
+type LogEntry struct {
+	TxHash []byte  `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"txHash"`
+	Log    *Object `protobuf:"bytes,2,opt,name=Log,proto3" json:"log"`
+}
+
+func (m *LogEntry) Reset()      { *m = LogEntry{} }
+func (*LogEntry) ProtoMessage() {}
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf94fd919e302a1d, []int{3}
+}
+func (m *LogEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogEntry.Merge(m, src)
+}
+func (m *LogEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogEntry proto.InternalMessageInfo
+
+func (m *LogEntry) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *LogEntry) GetLog() *Object {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+// HeaderGasConsumption is the serializable form of indexer.HeaderGasConsumption
+type HeaderGasConsumption struct {
+	GasProvided    uint64 `protobuf:"varint,1,opt,name=GasProvided,proto3" json:"gasProvided"`
+	GasRefunded    uint64 `protobuf:"varint,2,opt,name=GasRefunded,proto3" json:"gasRefunded"`
+	GasPenalized   uint64 `protobuf:"varint,3,opt,name=GasPenalized,proto3" json:"gasPenalized"`
+	MaxGasPerBlock uint64 `protobuf:"varint,4,opt,name=MaxGasPerBlock,proto3" json:"maxGasPerBlock"`
+}
+
+func (m *HeaderGasConsumption) Reset()      { *m = HeaderGasConsumption{} }
+func (*HeaderGasConsumption) ProtoMessage() {}
+func (*HeaderGasConsumption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf94fd919e302a1d, []int{4}
+}
+func (m *HeaderGasConsumption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeaderGasConsumption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HeaderGasConsumption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderGasConsumption.Merge(m, src)
+}
+func (m *HeaderGasConsumption) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeaderGasConsumption) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderGasConsumption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderGasConsumption proto.InternalMessageInfo
+
+func (m *HeaderGasConsumption) GetGasProvided() uint64 {
+	if m != nil {
+		return m.GasProvided
+	}
+	return 0
+}
+
+func (m *HeaderGasConsumption) GetGasRefunded() uint64 {
+	if m != nil {
+		return m.GasRefunded
+	}
+	return 0
+}
+
+func (m *HeaderGasConsumption) GetGasPenalized() uint64 {
+	if m != nil {
+		return m.GasPenalized
+	}
+	return 0
+}
+
+func (m *HeaderGasConsumption) GetMaxGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxGasPerBlock
+	}
+	return 0
+}
+
+// AlteredAccount is the serializable form of indexer.AlteredAccount
+type AlteredAccount struct {
+	Address string              `protobuf:"bytes,1,opt,name=Address,proto3" json:"address"`
+	Balance string              `protobuf:"bytes,2,opt,name=Balance,proto3" json:"balance"`
+	Nonce   uint64              `protobuf:"varint,3,opt,name=Nonce,proto3" json:"nonce"`
+	Tokens  []*AccountTokenData `protobuf:"bytes,4,rep,name=Tokens,proto3" json:"tokens"`
+}
+
+func (m *AlteredAccount) Reset()      { *m = AlteredAccount{} }
+func (*AlteredAccount) ProtoMessage() {}
+func (*AlteredAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf94fd919e302a1d, []int{5}
+}
+func (m *AlteredAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlteredAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AlteredAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlteredAccount.Merge(m, src)
+}
+func (m *AlteredAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlteredAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlteredAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlteredAccount proto.InternalMessageInfo
+
+func (m *AlteredAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AlteredAccount) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *AlteredAccount) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *AlteredAccount) GetTokens() []*AccountTokenData {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+// AccountTokenData is the serializable form of indexer.AccountTokenData
+type AccountTokenData struct {
+	Identifier string  `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"identifier"`
+	Balance    string  `protobuf:"bytes,2,opt,name=Balance,proto3" json:"balance"`
+	Nonce      uint64  `protobuf:"varint,3,opt,name=Nonce,proto3" json:"nonce"`
+	Properties string  `protobuf:"bytes,4,opt,name=Properties,proto3" json:"properties"`
+	MetaData   *Object `protobuf:"bytes,5,opt,name=MetaData,proto3" json:"metadata"`
+}
+
+func (m *AccountTokenData) Reset()      { *m = AccountTokenData{} }
+func (*AccountTokenData) ProtoMessage() {}
+func (*AccountTokenData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf94fd919e302a1d, []int{6}
+}
+func (m *AccountTokenData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountTokenData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountTokenData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTokenData.Merge(m, src)
+}
+func (m *AccountTokenData) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountTokenData) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTokenData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTokenData proto.InternalMessageInfo
+
+func (m *AccountTokenData) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *AccountTokenData) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *AccountTokenData) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *AccountTokenData) GetProperties() string {
+	if m != nil {
+		return m.Properties
+	}
+	return ""
+}
+
+func (m *AccountTokenData) GetMetaData() *Object {
+	if m != nil {
+		return m.MetaData
+	}
+	return nil
+}
+
+// RoundInfo is the serializable form of indexer.RoundInfo
+type RoundInfo struct {
+	Index            uint64   `protobuf:"varint,1,opt,name=Index,proto3" json:"index"`
+	SignersIndexes   []uint64 `protobuf:"varint,2,rep,packed,name=SignersIndexes,proto3" json:"signersIndexes"`
+	BlockWasProposed bool     `protobuf:"varint,3,opt,name=BlockWasProposed,proto3" json:"blockWasProposed"`
+	ShardId          uint32   `protobuf:"varint,4,opt,name=ShardId,proto3" json:"shardId"`
+	Epoch            uint32   `protobuf:"varint,5,opt,name=Epoch,proto3" json:"epoch"`
+	Timestamp        int64    `protobuf:"varint,6,opt,name=Timestamp,proto3" json:"timestamp"`
+}
+
+func (m *RoundInfo) Reset()      { *m = RoundInfo{} }
+func (*RoundInfo) ProtoMessage() {}
+func (*RoundInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf94fd919e302a1d, []int{7}
+}
+func (m *RoundInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoundInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RoundInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoundInfo.Merge(m, src)
+}
+func (m *RoundInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoundInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoundInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoundInfo proto.InternalMessageInfo
+
+func (m *RoundInfo) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RoundInfo) GetSignersIndexes() []uint64 {
+	if m != nil {
+		return m.SignersIndexes
+	}
+	return nil
+}
+
+func (m *RoundInfo) GetBlockWasProposed() bool {
+	if m != nil {
+		return m.BlockWasProposed
+	}
+	return false
+}
+
+func (m *RoundInfo) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *RoundInfo) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *RoundInfo) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// ValidatorRatingInfo is the serializable form of indexer.ValidatorRatingInfo
+type ValidatorRatingInfo struct {
+	PublicKey string  `protobuf:"bytes,1,opt,name=PublicKey,proto3" json:"publicKey"`
+	Rating    float32 `protobuf:"fixed32,2,opt,name=Rating,proto3" json:"rating"`
+}
+
+func (m *ValidatorRatingInfo) Reset()      { *m = ValidatorRatingInfo{} }
+func (*ValidatorRatingInfo) ProtoMessage() {}
+func (*ValidatorRatingInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf94fd919e302a1d, []int{8}
+}
+func (m *ValidatorRatingInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRatingInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ValidatorRatingInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRatingInfo.Merge(m, src)
+}
+func (m *ValidatorRatingInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRatingInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRatingInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRatingInfo proto.InternalMessageInfo
+
+func (m *ValidatorRatingInfo) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *ValidatorRatingInfo) GetRating() float32 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+// PubKeys holds the validators public keys of a shard
+type PubKeys struct {
+	Keys [][]byte `protobuf:"bytes,1,rep,name=Keys,proto3" json:"keys"`
+}
+
+func (m *PubKeys) Reset()      { *m = PubKeys{} }
+func (*PubKeys) ProtoMessage() {}
+func (*PubKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf94fd919e302a1d, []int{9}
+}
+func (m *PubKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PubKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeys.Merge(m, src)
+}
+func (m *PubKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeys proto.InternalMessageInfo
+
+func (m *PubKeys) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Record)(nil), "proto.Record")
+	proto.RegisterMapType((map[uint32]*PubKeys)(nil), "proto.Record.ValidatorsPubKeysEntry")
+	proto.RegisterType((*Object)(nil), "proto.Object")
+	proto.RegisterType((*SaveBlock)(nil), "proto.SaveBlock")
+	proto.RegisterMapType((map[string]*AlteredAccount)(nil), "proto.SaveBlock.AlteredAccountsEntry")
+	proto.RegisterMapType((map[string]*Object)(nil), "proto.SaveBlock.InvalidEntry")
+	proto.RegisterMapType((map[string]*Object)(nil), "proto.SaveBlock.ReceiptsEntry")
+	proto.RegisterMapType((map[string]*Object)(nil), "proto.SaveBlock.RewardsEntry")
+	proto.RegisterMapType((map[string]*Object)(nil), "proto.SaveBlock.ScrsEntry")
+	proto.RegisterMapType((map[string]*Object)(nil), "proto.SaveBlock.TxsEntry")
+	proto.RegisterType((*LogEntry)(nil), "proto.LogEntry")
+	proto.RegisterType((*HeaderGasConsumption)(nil), "proto.HeaderGasConsumption")
+	proto.RegisterType((*AlteredAccount)(nil), "proto.AlteredAccount")
+	proto.RegisterType((*AccountTokenData)(nil), "proto.AccountTokenData")
+	proto.RegisterType((*RoundInfo)(nil), "proto.RoundInfo")
+	proto.RegisterType((*ValidatorRatingInfo)(nil), "proto.ValidatorRatingInfo")
+	proto.RegisterType((*PubKeys)(nil), "proto.PubKeys")
+}
+
+func init() { proto.RegisterFile("record.proto", fileDescriptor_bf94fd919e302a1d) }
+
+var fileDescriptor_bf94fd919e302a1d = []byte{
+	// 1408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0x36, 0xad, 0x8b, 0xa5, 0xb1, 0x7c, 0xc9, 0xc4, 0x49, 0xf8, 0x3b, 0xf9, 0x49, 0x41, 0x4d,
+	0x50, 0x15, 0x69, 0x64, 0x24, 0x4d, 0xd1, 0x20, 0xdd, 0x24, 0x6c, 0xd2, 0x58, 0xb9, 0xd5, 0x18,
+	0x0b, 0xbd, 0xad, 0x3a, 0x12, 0xc7, 0x14, 0x63, 0x89, 0x23, 0x70, 0x28, 0x47, 0xea, 0xaa, 0x8f,
+	0xd0, 0x37, 0xe8, 0xb6, 0x0f, 0xd1, 0x07, 0xe8, 0x32, 0xcb, 0xac, 0x88, 0x46, 0xe9, 0xa2, 0xe0,
+	0xa2, 0x08, 0xd0, 0x4d, 0xd1, 0x55, 0x31, 0x67, 0x48, 0x89, 0x92, 0x18, 0xb4, 0x80, 0xbb, 0xb2,
+	0xf8, 0x9d, 0xef, 0xfb, 0xc8, 0x99, 0x39, 0xe7, 0xcc, 0x31, 0xaa, 0xf8, 0xac, 0xc3, 0x7d, 0xbb,
+	0x31, 0xf0, 0x79, 0xc0, 0x71, 0x01, 0xfe, 0xec, 0x5e, 0x73, 0xdc, 0xa0, 0x3b, 0x6c, 0x37, 0x3a,
+	0xbc, 0xbf, 0xe7, 0x70, 0x87, 0xef, 0x01, 0xdc, 0x1e, 0x1e, 0xc1, 0x13, 0x3c, 0xc0, 0x2f, 0xa5,
+	0xaa, 0xfd, 0x59, 0x40, 0x45, 0x02, 0x36, 0xf8, 0x12, 0xca, 0xb7, 0xc6, 0x03, 0xa6, 0x6b, 0x55,
+	0xad, 0x5e, 0xb6, 0x4a, 0x51, 0x68, 0xe6, 0x83, 0xf1, 0x80, 0x11, 0x40, 0xf1, 0x03, 0x54, 0x3e,
+	0xa4, 0x27, 0xcc, 0xea, 0xf1, 0xce, 0xb1, 0xbe, 0x5a, 0xd5, 0xea, 0xeb, 0x37, 0xb6, 0x95, 0x47,
+	0x63, 0x8a, 0x5b, 0x17, 0xa2, 0xd0, 0x3c, 0x2b, 0x92, 0xc7, 0xf7, 0x79, 0xdf, 0x0d, 0x58, 0x7f,
+	0x10, 0x8c, 0xc9, 0x4c, 0x8b, 0x3f, 0x46, 0xc5, 0x7d, 0x46, 0x6d, 0xe6, 0xeb, 0x39, 0x70, 0xd9,
+	0x88, 0x5d, 0x3e, 0x6b, 0x3f, 0x63, 0x9d, 0xc0, 0xda, 0x89, 0x42, 0x73, 0xbb, 0x0b, 0x84, 0x94,
+	0x3e, 0x96, 0xe0, 0x0f, 0x51, 0xde, 0xe2, 0xf6, 0x58, 0xcf, 0x67, 0x49, 0x71, 0x14, 0x9a, 0x9b,
+	0x6d, 0x6e, 0x8f, 0x53, 0x42, 0xa0, 0xe3, 0x87, 0x08, 0x11, 0x3e, 0xf4, 0x6c, 0xd1, 0xf4, 0x8e,
+	0xb8, 0x5e, 0xa8, 0xe6, 0x52, 0x5f, 0x0f, 0x01, 0x89, 0x5b, 0x7a, 0x14, 0x9a, 0x3b, 0xfe, 0x94,
+	0x97, 0x72, 0x49, 0xa9, 0xb1, 0x40, 0x67, 0x3e, 0xa7, 0x3d, 0xd7, 0xa6, 0x01, 0xf7, 0xc5, 0xc1,
+	0xb0, 0xfd, 0x88, 0x8d, 0x85, 0x5e, 0x04, 0xcb, 0xcb, 0x89, 0xa5, 0x3a, 0x97, 0x25, 0xda, 0x7d,
+	0x2f, 0xf0, 0xc7, 0x96, 0x19, 0x85, 0xe6, 0xc5, 0x93, 0xc5, 0x58, 0xea, 0x6d, 0xcb, 0xfe, 0xf8,
+	0x3d, 0x54, 0xb8, 0x3f, 0xe0, 0x9d, 0xae, 0xbe, 0x56, 0xd5, 0xea, 0x1b, 0xd6, 0xd9, 0x28, 0x34,
+	0xb7, 0x98, 0x04, 0x52, 0x32, 0xc5, 0xc0, 0x7b, 0x68, 0xad, 0xe9, 0xd9, 0x6c, 0xd4, 0xbc, 0xa7,
+	0x97, 0xe0, 0x24, 0xcf, 0x45, 0xa1, 0x79, 0xc6, 0x55, 0x50, 0x8a, 0x9e, 0xb0, 0xf0, 0x11, 0xda,
+	0x9e, 0xbd, 0x90, 0xd0, 0xc0, 0xf5, 0x1c, 0xbd, 0x0c, 0xeb, 0xd9, 0x8d, 0xd7, 0x33, 0x0d, 0xab,
+	0x28, 0x6c, 0x96, 0x11, 0x85, 0xe6, 0xee, 0xc9, 0x82, 0x2e, 0x65, 0xbf, 0xe4, 0x89, 0x6f, 0x21,
+	0xa4, 0x4e, 0x71, 0x9f, 0x8a, 0xae, 0x8e, 0xaa, 0x5a, 0xbd, 0xa2, 0xb6, 0xbc, 0x3b, 0x45, 0xd3,
+	0x5b, 0x3e, 0xe3, 0xee, 0xb6, 0xd0, 0xf9, 0xec, 0xbd, 0xc4, 0xdb, 0x28, 0x77, 0xcc, 0xc6, 0x90,
+	0xb2, 0x1b, 0x44, 0xfe, 0xc4, 0x97, 0x51, 0xe1, 0x84, 0xf6, 0x86, 0x2c, 0xce, 0xd1, 0xcd, 0x78,
+	0x09, 0xb1, 0x8a, 0xa8, 0xe0, 0xed, 0xd5, 0x5b, 0x5a, 0xed, 0x1b, 0x54, 0x54, 0x89, 0xf3, 0x0f,
+	0x99, 0x7f, 0x0b, 0xe5, 0xef, 0xd1, 0x80, 0x82, 0x61, 0xc5, 0xba, 0x2c, 0xa3, 0x36, 0x0d, 0xe8,
+	0x5f, 0xa1, 0xa9, 0x33, 0xaf, 0xc3, 0x6d, 0xd7, 0x73, 0xf6, 0x9e, 0x09, 0xee, 0x35, 0x08, 0x7d,
+	0xfe, 0x84, 0x09, 0x41, 0x1d, 0x46, 0x40, 0x51, 0xfb, 0x15, 0xa5, 0x8a, 0x06, 0x37, 0xe6, 0xd6,
+	0xaf, 0x81, 0xdb, 0x66, 0x14, 0x9a, 0x68, 0xb6, 0xfe, 0xf4, 0xaa, 0xf1, 0xf5, 0x69, 0xa1, 0xac,
+	0x66, 0x65, 0x3b, 0x8a, 0x42, 0xb3, 0xa8, 0xa4, 0xd3, 0xf2, 0xb8, 0x1a, 0x97, 0x47, 0x66, 0x65,
+	0xc1, 0xba, 0x64, 0x79, 0xc4, 0x45, 0x71, 0x1b, 0x6d, 0x1e, 0xba, 0x8e, 0xc7, 0x7c, 0x01, 0x99,
+	0xc0, 0x84, 0x9e, 0xaf, 0xe6, 0xea, 0x79, 0x55, 0x46, 0x62, 0x2e, 0x42, 0x16, 0x98, 0x98, 0xa0,
+	0xf3, 0x4f, 0x79, 0x40, 0x7d, 0xf7, 0x5b, 0x66, 0xab, 0x77, 0x0b, 0xf9, 0xcd, 0x4c, 0x40, 0x71,
+	0x95, 0xad, 0xdd, 0x28, 0x34, 0xcf, 0x7b, 0x99, 0x0c, 0xf2, 0x16, 0x25, 0x76, 0xd1, 0x8e, 0x02,
+	0x1e, 0x50, 0xf1, 0x09, 0xf7, 0xc4, 0xb0, 0x3f, 0x08, 0x5c, 0xee, 0xe9, 0x45, 0x58, 0xcc, 0xc5,
+	0x78, 0x31, 0x59, 0x94, 0x74, 0x1a, 0xcd, 0x47, 0x48, 0xa6, 0x25, 0xbe, 0x82, 0xd6, 0xf6, 0xa9,
+	0x38, 0xe0, 0xbc, 0x07, 0x05, 0x55, 0xb2, 0xd6, 0xa3, 0xd0, 0x5c, 0xeb, 0x2a, 0x88, 0x24, 0x31,
+	0x7c, 0x13, 0xe5, 0x5a, 0x23, 0xa1, 0x97, 0xa0, 0x18, 0xfe, 0xb7, 0xd8, 0xed, 0x1a, 0xad, 0x51,
+	0x5c, 0xd1, 0x6b, 0x51, 0x68, 0xe6, 0x82, 0x91, 0x20, 0x92, 0x2e, 0xf3, 0xe5, 0xb0, 0xe3, 0x8b,
+	0x85, 0x1a, 0x9a, 0xc9, 0x64, 0x50, 0xe9, 0xe0, 0x44, 0x44, 0xc7, 0x17, 0x04, 0x14, 0xf8, 0x1e,
+	0x5a, 0x23, 0xec, 0x39, 0xf5, 0x6d, 0xa1, 0x23, 0x10, 0xff, 0x7f, 0x49, 0x1c, 0xc7, 0x95, 0x1e,
+	0xbe, 0xda, 0x57, 0x08, 0x49, 0xa4, 0xd2, 0xa5, 0xe9, 0x41, 0x65, 0xea, 0xeb, 0x6f, 0x71, 0x89,
+	0xe3, 0x29, 0x17, 0x57, 0x21, 0x24, 0x91, 0xe2, 0x7d, 0x54, 0x22, 0xac, 0xc3, 0xdc, 0x41, 0x20,
+	0xf4, 0x0a, 0xd8, 0x18, 0x19, 0x1f, 0xa3, 0x08, 0xca, 0xa7, 0x12, 0x85, 0x66, 0xc9, 0x8f, 0x21,
+	0x32, 0x55, 0xe3, 0x6b, 0x28, 0xff, 0x98, 0x3b, 0x42, 0xdf, 0x00, 0x97, 0xad, 0xd8, 0xe5, 0x31,
+	0x77, 0x52, 0x9b, 0xd0, 0xe3, 0x8e, 0x20, 0x40, 0xc3, 0x36, 0xda, 0xba, 0xdb, 0x0b, 0x98, 0xcf,
+	0xec, 0xbb, 0x9d, 0x0e, 0x1f, 0x7a, 0x81, 0xd0, 0x37, 0x41, 0x79, 0x65, 0xe9, 0xfd, 0x0b, 0x3c,
+	0xe5, 0x07, 0xbd, 0x91, 0xce, 0x47, 0xc8, 0xa2, 0xe5, 0xee, 0x7d, 0x54, 0x6a, 0x8d, 0x96, 0x9b,
+	0x48, 0x59, 0x35, 0x91, 0x77, 0xe6, 0x9b, 0xc8, 0x7c, 0x21, 0xa5, 0x7a, 0xc8, 0xee, 0xa7, 0xa8,
+	0x3c, 0x3d, 0xce, 0xd3, 0xf8, 0x34, 0x51, 0x25, 0x7d, 0xb2, 0xa7, 0xb4, 0x4a, 0x1f, 0xef, 0x69,
+	0xac, 0x1e, 0xa2, 0x8d, 0xb9, 0x23, 0x3e, 0x8d, 0xd7, 0x57, 0x68, 0x27, 0xeb, 0xb8, 0x32, 0x2c,
+	0xaf, 0xce, 0x5b, 0x9e, 0x8b, 0x2d, 0xe7, 0xd5, 0xe9, 0x46, 0xfe, 0x25, 0x2a, 0x25, 0xd9, 0x84,
+	0x6b, 0xa8, 0xd8, 0x1a, 0xa5, 0x1a, 0x2c, 0x74, 0xc9, 0x00, 0x10, 0x12, 0x47, 0x70, 0x1d, 0xe5,
+	0x1e, 0x73, 0x27, 0xbb, 0xab, 0x42, 0x29, 0xf7, 0xb8, 0x43, 0x24, 0xa5, 0xf6, 0xbb, 0x96, 0xdd,
+	0x93, 0xf0, 0x75, 0xb4, 0xfe, 0x80, 0x8a, 0x03, 0x9f, 0x9f, 0xb8, 0x36, 0xb3, 0xe1, 0x5d, 0x79,
+	0x6b, 0x2b, 0x0a, 0xcd, 0x75, 0x67, 0x06, 0x93, 0x34, 0x27, 0x96, 0x10, 0x76, 0x34, 0xf4, 0xa4,
+	0x64, 0x75, 0x4e, 0x92, 0xc0, 0x24, 0xcd, 0xc1, 0x37, 0x51, 0x45, 0x3a, 0x30, 0x8f, 0xf6, 0x64,
+	0xbb, 0x84, 0xb6, 0x9e, 0xb7, 0xb6, 0xa3, 0xd0, 0xac, 0x38, 0x29, 0x9c, 0xcc, 0xb1, 0x64, 0x5f,
+	0x7f, 0x42, 0x47, 0x00, 0xf9, 0x6a, 0x5c, 0xcb, 0x57, 0xb5, 0xa4, 0xaf, 0xf7, 0xe7, 0x22, 0x64,
+	0x81, 0x59, 0xfb, 0x49, 0x43, 0x9b, 0xf3, 0x1b, 0x2d, 0x7b, 0xe5, 0x5d, 0xdb, 0xf6, 0x99, 0x10,
+	0xf1, 0xfd, 0x08, 0xfd, 0x82, 0x2a, 0x88, 0x24, 0x31, 0x49, 0xb3, 0x68, 0x8f, 0x7a, 0x1d, 0x75,
+	0x6e, 0x31, 0xad, 0xad, 0x20, 0x92, 0xc4, 0xb0, 0x89, 0x0a, 0x4f, 0xb9, 0x24, 0xa9, 0xb5, 0x94,
+	0xa3, 0xd0, 0x2c, 0x78, 0x12, 0x20, 0x0a, 0x97, 0xe3, 0x61, 0x8b, 0x1f, 0x33, 0x4f, 0xdd, 0x46,
+	0xeb, 0x37, 0x2e, 0x24, 0xc7, 0xaf, 0x3e, 0x07, 0x62, 0xf2, 0x72, 0x8d, 0x4f, 0x16, 0xa8, 0x24,
+	0x96, 0xd4, 0xfe, 0xd0, 0xd0, 0xf6, 0x22, 0x51, 0xde, 0xbb, 0x4d, 0x9b, 0x79, 0x81, 0x7b, 0xe4,
+	0x32, 0x3f, 0x5e, 0x03, 0xdc, 0xbb, 0xee, 0x14, 0x25, 0x29, 0xc6, 0x7f, 0xb6, 0x92, 0x06, 0x42,
+	0x07, 0x3e, 0x1f, 0x30, 0x3f, 0x70, 0xe1, 0x6e, 0x9d, 0xbe, 0x77, 0x30, 0x45, 0x49, 0x8a, 0x81,
+	0x3f, 0x42, 0xa5, 0x27, 0x2c, 0xa0, 0x30, 0x6b, 0x14, 0xb2, 0x72, 0x13, 0x1a, 0x6c, 0x9f, 0x05,
+	0x54, 0x8e, 0x1f, 0x64, 0x4a, 0xae, 0xfd, 0xb0, 0x8a, 0xca, 0xd3, 0x29, 0x56, 0x7e, 0x17, 0xdc,
+	0xd2, 0xba, 0x36, 0xfb, 0x2e, 0x98, 0xfe, 0x88, 0xc2, 0x33, 0xee, 0xfd, 0xd5, 0x7f, 0x7d, 0xef,
+	0xdf, 0x41, 0xdb, 0x90, 0x28, 0x5f, 0x40, 0x62, 0x0f, 0xb8, 0x88, 0xb3, 0xb2, 0xa4, 0xe6, 0xf6,
+	0xf6, 0x42, 0x8c, 0x2c, 0xb1, 0xe5, 0xee, 0x1e, 0x76, 0xa9, 0x6f, 0x37, 0x6d, 0xd8, 0x92, 0x0d,
+	0xb5, 0xbb, 0x42, 0x41, 0x24, 0x89, 0xc9, 0x55, 0xa8, 0x81, 0xb7, 0x00, 0x24, 0x58, 0x05, 0x0c,
+	0xbc, 0xc9, 0x98, 0x7b, 0x15, 0x95, 0x5b, 0x6e, 0x9f, 0x89, 0x80, 0xf6, 0x07, 0x30, 0x22, 0xe4,
+	0xac, 0x8d, 0x28, 0x34, 0xcb, 0x41, 0x02, 0x92, 0x59, 0xbc, 0x76, 0x84, 0xce, 0x66, 0xcc, 0xb0,
+	0xd2, 0xe3, 0x60, 0xd8, 0xee, 0xb9, 0x9d, 0x47, 0x49, 0x07, 0x52, 0x1e, 0x83, 0x04, 0x24, 0xb3,
+	0xb8, 0xec, 0x2c, 0x4a, 0x0a, 0x59, 0xb1, 0xaa, 0xf2, 0xcf, 0x07, 0x84, 0xc4, 0x91, 0xda, 0xbb,
+	0x68, 0x2d, 0x99, 0xd8, 0x2f, 0xa1, 0xbc, 0xfc, 0xab, 0x6b, 0xd5, 0x5c, 0xbd, 0xa2, 0x2e, 0xb9,
+	0x63, 0x39, 0x80, 0x02, 0x6a, 0xdd, 0x79, 0xf1, 0xca, 0x58, 0x79, 0xf9, 0xca, 0x58, 0x79, 0xf3,
+	0xca, 0xd0, 0xbe, 0x9b, 0x18, 0xda, 0x8f, 0x13, 0x43, 0xfb, 0x79, 0x62, 0x68, 0x2f, 0x26, 0x86,
+	0xf6, 0x72, 0x62, 0x68, 0xbf, 0x4c, 0x0c, 0xed, 0xb7, 0x89, 0xb1, 0xf2, 0x66, 0x62, 0x68, 0xdf,
+	0xbf, 0x36, 0x56, 0x5e, 0xbc, 0x36, 0x56, 0x5e, 0xbe, 0x36, 0x56, 0xbe, 0x2e, 0xaa, 0x7f, 0xfa,
+	0xda, 0x45, 0x48, 0x8d, 0x0f, 0xfe, 0x1e, 0x00, 0xc7, 0x61, 0x03, 0xd2, 0x05, 0x0e, 0x00, 0x00,
+}
+
+func (this *Record) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Record)
+	if !ok {
+		that2, ok := that.(Record)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !this.SaveBlock.Equal(that1.SaveBlock) {
+		return false
+	}
+	if !this.Header.Equal(that1.Header) {
+		return false
+	}
+	if !this.Body.Equal(that1.Body) {
+		return false
+	}
+	if len(this.RoundsInfo) != len(that1.RoundsInfo) {
+		return false
+	}
+	for i := range this.RoundsInfo {
+		if !this.RoundsInfo[i].Equal(that1.RoundsInfo[i]) {
+			return false
+		}
+	}
+	if len(this.ValidatorsPubKeys) != len(that1.ValidatorsPubKeys) {
+		return false
+	}
+	for i := range this.ValidatorsPubKeys {
+		if !this.ValidatorsPubKeys[i].Equal(that1.ValidatorsPubKeys[i]) {
+			return false
+		}
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.IndexID != that1.IndexID {
+		return false
+	}
+	if len(this.ValidatorsRating) != len(that1.ValidatorsRating) {
+		return false
+	}
+	for i := range this.ValidatorsRating {
+		if !this.ValidatorsRating[i].Equal(that1.ValidatorsRating[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	return true
+}
+func (this *Object) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Object)
+	if !ok {
+		that2, ok := that.(Object)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *SaveBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SaveBlock)
+	if !ok {
+		that2, ok := that.(SaveBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	if !this.Header.Equal(that1.Header) {
+		return false
+	}
+	if !this.Body.Equal(that1.Body) {
+		return false
+	}
+	if len(this.SignersIndexes) != len(that1.SignersIndexes) {
+		return false
+	}
+	for i := range this.SignersIndexes {
+		if this.SignersIndexes[i] != that1.SignersIndexes[i] {
+			return false
+		}
+	}
+	if len(this.NotarizedHeadersHashes) != len(that1.NotarizedHeadersHashes) {
+		return false
+	}
+	for i := range this.NotarizedHeadersHashes {
+		if this.NotarizedHeadersHashes[i] != that1.NotarizedHeadersHashes[i] {
+			return false
+		}
+	}
+	if !this.HeaderGasConsumption.Equal(that1.HeaderGasConsumption) {
+		return false
+	}
+	if this.HasPool != that1.HasPool {
+		return false
+	}
+	if len(this.Txs) != len(that1.Txs) {
+		return false
+	}
+	for i := range this.Txs {
+		if !this.Txs[i].Equal(that1.Txs[i]) {
+			return false
+		}
+	}
+	if len(this.Scrs) != len(that1.Scrs) {
+		return false
+	}
+	for i := range this.Scrs {
+		if !this.Scrs[i].Equal(that1.Scrs[i]) {
+			return false
+		}
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(that1.Rewards[i]) {
+			return false
+		}
+	}
+	if len(this.Invalid) != len(that1.Invalid) {
+		return false
+	}
+	for i := range this.Invalid {
+		if !this.Invalid[i].Equal(that1.Invalid[i]) {
+			return false
+		}
+	}
+	if len(this.Receipts) != len(that1.Receipts) {
+		return false
+	}
+	for i := range this.Receipts {
+		if !this.Receipts[i].Equal(that1.Receipts[i]) {
+			return false
+		}
+	}
+	if len(this.Logs) != len(that1.Logs) {
+		return false
+	}
+	for i := range this.Logs {
+		if !this.Logs[i].Equal(that1.Logs[i]) {
+			return false
+		}
+	}
+	if len(this.AlteredAccounts) != len(that1.AlteredAccounts) {
+		return false
+	}
+	for i := range this.AlteredAccounts {
+		if !this.AlteredAccounts[i].Equal(that1.AlteredAccounts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *LogEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LogEntry)
+	if !ok {
+		that2, ok := that.(LogEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if !this.Log.Equal(that1.Log) {
+		return false
+	}
+	return true
+}
+func (this *HeaderGasConsumption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HeaderGasConsumption)
+	if !ok {
+		that2, ok := that.(HeaderGasConsumption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.GasProvided != that1.GasProvided {
+		return false
+	}
+	if this.GasRefunded != that1.GasRefunded {
+		return false
+	}
+	if this.GasPenalized != that1.GasPenalized {
+		return false
+	}
+	if this.MaxGasPerBlock != that1.MaxGasPerBlock {
+		return false
+	}
+	return true
+}
+func (this *AlteredAccount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AlteredAccount)
+	if !ok {
+		that2, ok := that.(AlteredAccount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Balance != that1.Balance {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AccountTokenData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountTokenData)
+	if !ok {
+		that2, ok := that.(AccountTokenData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Identifier != that1.Identifier {
+		return false
+	}
+	if this.Balance != that1.Balance {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Properties != that1.Properties {
+		return false
+	}
+	if !this.MetaData.Equal(that1.MetaData) {
+		return false
+	}
+	return true
+}
+func (this *RoundInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RoundInfo)
+	if !ok {
+		that2, ok := that.(RoundInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if len(this.SignersIndexes) != len(that1.SignersIndexes) {
+		return false
+	}
+	for i := range this.SignersIndexes {
+		if this.SignersIndexes[i] != that1.SignersIndexes[i] {
+			return false
+		}
+	}
+	if this.BlockWasProposed != that1.BlockWasProposed {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	return true
+}
+func (this *ValidatorRatingInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorRatingInfo)
+	if !ok {
+		that2, ok := that.(ValidatorRatingInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PublicKey != that1.PublicKey {
+		return false
+	}
+	if this.Rating != that1.Rating {
+		return false
+	}
+	return true
+}
+func (this *PubKeys) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PubKeys)
+	if !ok {
+		that2, ok := that.(PubKeys)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Keys) != len(that1.Keys) {
+		return false
+	}
+	for i := range this.Keys {
+		if !bytes.Equal(this.Keys[i], that1.Keys[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Record) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&record.Record{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.SaveBlock != nil {
+		s = append(s, "SaveBlock: "+fmt.Sprintf("%#v", this.SaveBlock)+",\n")
+	}
+	if this.Header != nil {
+		s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	}
+	if this.Body != nil {
+		s = append(s, "Body: "+fmt.Sprintf("%#v", this.Body)+",\n")
+	}
+	if this.RoundsInfo != nil {
+		s = append(s, "RoundsInfo: "+fmt.Sprintf("%#v", this.RoundsInfo)+",\n")
+	}
+	keysForValidatorsPubKeys := make([]uint32, 0, len(this.ValidatorsPubKeys))
+	for k, _ := range this.ValidatorsPubKeys {
+		keysForValidatorsPubKeys = append(keysForValidatorsPubKeys, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Uint32s(keysForValidatorsPubKeys)
+	mapStringForValidatorsPubKeys := "map[uint32]*PubKeys{"
+	for _, k := range keysForValidatorsPubKeys {
+		mapStringForValidatorsPubKeys += fmt.Sprintf("%#v: %#v,", k, this.ValidatorsPubKeys[k])
+	}
+	mapStringForValidatorsPubKeys += "}"
+	if this.ValidatorsPubKeys != nil {
+		s = append(s, "ValidatorsPubKeys: "+mapStringForValidatorsPubKeys+",\n")
+	}
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "IndexID: "+fmt.Sprintf("%#v", this.IndexID)+",\n")
+	if this.ValidatorsRating != nil {
+		s = append(s, "ValidatorsRating: "+fmt.Sprintf("%#v", this.ValidatorsRating)+",\n")
+	}
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Object) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&record.Object{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SaveBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 18)
+	s = append(s, "&record.SaveBlock{")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	if this.Header != nil {
+		s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	}
+	if this.Body != nil {
+		s = append(s, "Body: "+fmt.Sprintf("%#v", this.Body)+",\n")
+	}
+	s = append(s, "SignersIndexes: "+fmt.Sprintf("%#v", this.SignersIndexes)+",\n")
+	s = append(s, "NotarizedHeadersHashes: "+fmt.Sprintf("%#v", this.NotarizedHeadersHashes)+",\n")
+	if this.HeaderGasConsumption != nil {
+		s = append(s, "HeaderGasConsumption: "+fmt.Sprintf("%#v", this.HeaderGasConsumption)+",\n")
+	}
+	s = append(s, "HasPool: "+fmt.Sprintf("%#v", this.HasPool)+",\n")
+	keysForTxs := make([]string, 0, len(this.Txs))
+	for k, _ := range this.Txs {
+		keysForTxs = append(keysForTxs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTxs)
+	mapStringForTxs := "map[string]*Object{"
+	for _, k := range keysForTxs {
+		mapStringForTxs += fmt.Sprintf("%#v: %#v,", k, this.Txs[k])
+	}
+	mapStringForTxs += "}"
+	if this.Txs != nil {
+		s = append(s, "Txs: "+mapStringForTxs+",\n")
+	}
+	keysForScrs := make([]string, 0, len(this.Scrs))
+	for k, _ := range this.Scrs {
+		keysForScrs = append(keysForScrs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForScrs)
+	mapStringForScrs := "map[string]*Object{"
+	for _, k := range keysForScrs {
+		mapStringForScrs += fmt.Sprintf("%#v: %#v,", k, this.Scrs[k])
+	}
+	mapStringForScrs += "}"
+	if this.Scrs != nil {
+		s = append(s, "Scrs: "+mapStringForScrs+",\n")
+	}
+	keysForRewards := make([]string, 0, len(this.Rewards))
+	for k, _ := range this.Rewards {
+		keysForRewards = append(keysForRewards, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRewards)
+	mapStringForRewards := "map[string]*Object{"
+	for _, k := range keysForRewards {
+		mapStringForRewards += fmt.Sprintf("%#v: %#v,", k, this.Rewards[k])
+	}
+	mapStringForRewards += "}"
+	if this.Rewards != nil {
+		s = append(s, "Rewards: "+mapStringForRewards+",\n")
+	}
+	keysForInvalid := make([]string, 0, len(this.Invalid))
+	for k, _ := range this.Invalid {
+		keysForInvalid = append(keysForInvalid, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForInvalid)
+	mapStringForInvalid := "map[string]*Object{"
+	for _, k := range keysForInvalid {
+		mapStringForInvalid += fmt.Sprintf("%#v: %#v,", k, this.Invalid[k])
+	}
+	mapStringForInvalid += "}"
+	if this.Invalid != nil {
+		s = append(s, "Invalid: "+mapStringForInvalid+",\n")
+	}
+	keysForReceipts := make([]string, 0, len(this.Receipts))
+	for k, _ := range this.Receipts {
+		keysForReceipts = append(keysForReceipts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForReceipts)
+	mapStringForReceipts := "map[string]*Object{"
+	for _, k := range keysForReceipts {
+		mapStringForReceipts += fmt.Sprintf("%#v: %#v,", k, this.Receipts[k])
+	}
+	mapStringForReceipts += "}"
+	if this.Receipts != nil {
+		s = append(s, "Receipts: "+mapStringForReceipts+",\n")
+	}
+	if this.Logs != nil {
+		s = append(s, "Logs: "+fmt.Sprintf("%#v", this.Logs)+",\n")
+	}
+	keysForAlteredAccounts := make([]string, 0, len(this.AlteredAccounts))
+	for k, _ := range this.AlteredAccounts {
+		keysForAlteredAccounts = append(keysForAlteredAccounts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAlteredAccounts)
+	mapStringForAlteredAccounts := "map[string]*AlteredAccount{"
+	for _, k := range keysForAlteredAccounts {
+		mapStringForAlteredAccounts += fmt.Sprintf("%#v: %#v,", k, this.AlteredAccounts[k])
+	}
+	mapStringForAlteredAccounts += "}"
+	if this.AlteredAccounts != nil {
+		s = append(s, "AlteredAccounts: "+mapStringForAlteredAccounts+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LogEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&record.LogEntry{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	if this.Log != nil {
+		s = append(s, "Log: "+fmt.Sprintf("%#v", this.Log)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HeaderGasConsumption) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&record.HeaderGasConsumption{")
+	s = append(s, "GasProvided: "+fmt.Sprintf("%#v", this.GasProvided)+",\n")
+	s = append(s, "GasRefunded: "+fmt.Sprintf("%#v", this.GasRefunded)+",\n")
+	s = append(s, "GasPenalized: "+fmt.Sprintf("%#v", this.GasPenalized)+",\n")
+	s = append(s, "MaxGasPerBlock: "+fmt.Sprintf("%#v", this.MaxGasPerBlock)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AlteredAccount) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&record.AlteredAccount{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountTokenData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&record.AccountTokenData{")
+	s = append(s, "Identifier: "+fmt.Sprintf("%#v", this.Identifier)+",\n")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Properties: "+fmt.Sprintf("%#v", this.Properties)+",\n")
+	if this.MetaData != nil {
+		s = append(s, "MetaData: "+fmt.Sprintf("%#v", this.MetaData)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RoundInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&record.RoundInfo{")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "SignersIndexes: "+fmt.Sprintf("%#v", this.SignersIndexes)+",\n")
+	s = append(s, "BlockWasProposed: "+fmt.Sprintf("%#v", this.BlockWasProposed)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidatorRatingInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&record.ValidatorRatingInfo{")
+	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
+	s = append(s, "Rating: "+fmt.Sprintf("%#v", this.Rating)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PubKeys) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&record.PubKeys{")
+	s = append(s, "Keys: "+fmt.Sprintf("%#v", this.Keys)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRecord(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *Record) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Record) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ValidatorsRating) > 0 {
+		for iNdEx := len(m.ValidatorsRating) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorsRating[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.IndexID) > 0 {
+		i -= len(m.IndexID)
+		copy(dAtA[i:], m.IndexID)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.IndexID)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ValidatorsPubKeys) > 0 {
+		keysForValidatorsPubKeys := make([]uint32, 0, len(m.ValidatorsPubKeys))
+		for k := range m.ValidatorsPubKeys {
+			keysForValidatorsPubKeys = append(keysForValidatorsPubKeys, uint32(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Uint32s(keysForValidatorsPubKeys)
+		for iNdEx := len(keysForValidatorsPubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.ValidatorsPubKeys[uint32(keysForValidatorsPubKeys[iNdEx])]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRecord(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintRecord(dAtA, i, uint64(keysForValidatorsPubKeys[iNdEx]))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintRecord(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RoundsInfo) > 0 {
+		for iNdEx := len(m.RoundsInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoundsInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Body != nil {
+		{
+			size, err := m.Body.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SaveBlock != nil {
+		{
+			size, err := m.SaveBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Object) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Object) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Object) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SaveBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SaveBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SaveBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AlteredAccounts) > 0 {
+		keysForAlteredAccounts := make([]string, 0, len(m.AlteredAccounts))
+		for k := range m.AlteredAccounts {
+			keysForAlteredAccounts = append(keysForAlteredAccounts, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAlteredAccounts)
+		for iNdEx := len(keysForAlteredAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.AlteredAccounts[string(keysForAlteredAccounts[iNdEx])]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRecord(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(keysForAlteredAccounts[iNdEx])
+			copy(dAtA[i:], keysForAlteredAccounts[iNdEx])
+			i = encodeVarintRecord(dAtA, i, uint64(len(keysForAlteredAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRecord(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Receipts) > 0 {
+		keysForReceipts := make([]string, 0, len(m.Receipts))
+		for k := range m.Receipts {
+			keysForReceipts = append(keysForReceipts, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForReceipts)
+		for iNdEx := len(keysForReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Receipts[string(keysForReceipts[iNdEx])]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRecord(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(keysForReceipts[iNdEx])
+			copy(dAtA[i:], keysForReceipts[iNdEx])
+			i = encodeVarintRecord(dAtA, i, uint64(len(keysForReceipts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRecord(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Invalid) > 0 {
+		keysForInvalid := make([]string, 0, len(m.Invalid))
+		for k := range m.Invalid {
+			keysForInvalid = append(keysForInvalid, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForInvalid)
+		for iNdEx := len(keysForInvalid) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Invalid[string(keysForInvalid[iNdEx])]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRecord(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(keysForInvalid[iNdEx])
+			copy(dAtA[i:], keysForInvalid[iNdEx])
+			i = encodeVarintRecord(dAtA, i, uint64(len(keysForInvalid[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRecord(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Rewards) > 0 {
+		keysForRewards := make([]string, 0, len(m.Rewards))
+		for k := range m.Rewards {
+			keysForRewards = append(keysForRewards, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForRewards)
+		for iNdEx := len(keysForRewards) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Rewards[string(keysForRewards[iNdEx])]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRecord(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(keysForRewards[iNdEx])
+			copy(dAtA[i:], keysForRewards[iNdEx])
+			i = encodeVarintRecord(dAtA, i, uint64(len(keysForRewards[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRecord(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Scrs) > 0 {
+		keysForScrs := make([]string, 0, len(m.Scrs))
+		for k := range m.Scrs {
+			keysForScrs = append(keysForScrs, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForScrs)
+		for iNdEx := len(keysForScrs) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Scrs[string(keysForScrs[iNdEx])]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRecord(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(keysForScrs[iNdEx])
+			copy(dAtA[i:], keysForScrs[iNdEx])
+			i = encodeVarintRecord(dAtA, i, uint64(len(keysForScrs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRecord(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Txs) > 0 {
+		keysForTxs := make([]string, 0, len(m.Txs))
+		for k := range m.Txs {
+			keysForTxs = append(keysForTxs, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForTxs)
+		for iNdEx := len(keysForTxs) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Txs[string(keysForTxs[iNdEx])]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRecord(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(keysForTxs[iNdEx])
+			copy(dAtA[i:], keysForTxs[iNdEx])
+			i = encodeVarintRecord(dAtA, i, uint64(len(keysForTxs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRecord(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.HasPool {
+		i--
+		if m.HasPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HeaderGasConsumption != nil {
+		{
+			size, err := m.HeaderGasConsumption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NotarizedHeadersHashes) > 0 {
+		for iNdEx := len(m.NotarizedHeadersHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NotarizedHeadersHashes[iNdEx])
+			copy(dAtA[i:], m.NotarizedHeadersHashes[iNdEx])
+			i = encodeVarintRecord(dAtA, i, uint64(len(m.NotarizedHeadersHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SignersIndexes) > 0 {
+		dAtA13 := make([]byte, len(m.SignersIndexes)*10)
+		var j12 int
+		for _, num := range m.SignersIndexes {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintRecord(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Body != nil {
+		{
+			size, err := m.Body.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Log != nil {
+		{
+			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeaderGasConsumption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeaderGasConsumption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeaderGasConsumption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGasPerBlock != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.MaxGasPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasPenalized != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.GasPenalized))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasRefunded != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.GasRefunded))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GasProvided != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.GasProvided))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlteredAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlteredAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlteredAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountTokenData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountTokenData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountTokenData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MetaData != nil {
+		{
+			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Properties) > 0 {
+		i -= len(m.Properties)
+		copy(dAtA[i:], m.Properties)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Properties)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoundInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoundInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoundInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockWasProposed {
+		i--
+		if m.BlockWasProposed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SignersIndexes) > 0 {
+		dAtA19 := make([]byte, len(m.SignersIndexes)*10)
+		var j18 int
+		for _, num := range m.SignersIndexes {
+			for num >= 1<<7 {
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j18++
+			}
+			dAtA19[j18] = uint8(num)
+			j18++
+		}
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintRecord(dAtA, i, uint64(j18))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRatingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRatingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRatingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rating != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rating))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PubKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintRecord(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Record) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.SaveBlock != nil {
+		l = m.SaveBlock.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.Body != nil {
+		l = m.Body.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if len(m.RoundsInfo) > 0 {
+		for _, e := range m.RoundsInfo {
+			l = e.Size()
+			n += 1 + l + sovRecord(uint64(l))
+		}
+	}
+	if len(m.ValidatorsPubKeys) > 0 {
+		for k, v := range m.ValidatorsPubKeys {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRecord(uint64(l))
+			}
+			mapEntrySize := 1 + sovRecord(uint64(k)) + l
+			n += mapEntrySize + 1 + sovRecord(uint64(mapEntrySize))
+		}
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovRecord(uint64(m.Epoch))
+	}
+	l = len(m.IndexID)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if len(m.ValidatorsRating) > 0 {
+		for _, e := range m.ValidatorsRating {
+			l = e.Size()
+			n += 1 + l + sovRecord(uint64(l))
+		}
+	}
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	return n
+}
+
+func (m *Object) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	return n
+}
+
+func (m *SaveBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.Body != nil {
+		l = m.Body.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if len(m.SignersIndexes) > 0 {
+		l = 0
+		for _, e := range m.SignersIndexes {
+			l += sovRecord(uint64(e))
+		}
+		n += 1 + sovRecord(uint64(l)) + l
+	}
+	if len(m.NotarizedHeadersHashes) > 0 {
+		for _, s := range m.NotarizedHeadersHashes {
+			l = len(s)
+			n += 1 + l + sovRecord(uint64(l))
+		}
+	}
+	if m.HeaderGasConsumption != nil {
+		l = m.HeaderGasConsumption.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.HasPool {
+		n += 2
+	}
+	if len(m.Txs) > 0 {
+		for k, v := range m.Txs {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRecord(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRecord(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRecord(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Scrs) > 0 {
+		for k, v := range m.Scrs {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRecord(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRecord(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRecord(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for k, v := range m.Rewards {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRecord(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRecord(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRecord(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Invalid) > 0 {
+		for k, v := range m.Invalid {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRecord(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRecord(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRecord(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for k, v := range m.Receipts {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRecord(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRecord(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRecord(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovRecord(uint64(l))
+		}
+	}
+	if len(m.AlteredAccounts) > 0 {
+		for k, v := range m.AlteredAccounts {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRecord(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRecord(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRecord(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *LogEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.Log != nil {
+		l = m.Log.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	return n
+}
+
+func (m *HeaderGasConsumption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasProvided != 0 {
+		n += 1 + sovRecord(uint64(m.GasProvided))
+	}
+	if m.GasRefunded != 0 {
+		n += 1 + sovRecord(uint64(m.GasRefunded))
+	}
+	if m.GasPenalized != 0 {
+		n += 1 + sovRecord(uint64(m.GasPenalized))
+	}
+	if m.MaxGasPerBlock != 0 {
+		n += 1 + sovRecord(uint64(m.MaxGasPerBlock))
+	}
+	return n
+}
+
+func (m *AlteredAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovRecord(uint64(m.Nonce))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovRecord(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountTokenData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovRecord(uint64(m.Nonce))
+	}
+	l = len(m.Properties)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.MetaData != nil {
+		l = m.MetaData.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	return n
+}
+
+func (m *RoundInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovRecord(uint64(m.Index))
+	}
+	if len(m.SignersIndexes) > 0 {
+		l = 0
+		for _, e := range m.SignersIndexes {
+			l += sovRecord(uint64(e))
+		}
+		n += 1 + sovRecord(uint64(l)) + l
+	}
+	if m.BlockWasProposed {
+		n += 2
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRecord(uint64(m.ShardId))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovRecord(uint64(m.Epoch))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovRecord(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *ValidatorRatingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.Rating != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *PubKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovRecord(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRecord(x uint64) (n int) {
+	return sovRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Record) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRoundsInfo := "[]*RoundInfo{"
+	for _, f := range this.RoundsInfo {
+		repeatedStringForRoundsInfo += strings.Replace(f.String(), "RoundInfo", "RoundInfo", 1) + ","
+	}
+	repeatedStringForRoundsInfo += "}"
+	repeatedStringForValidatorsRating := "[]*ValidatorRatingInfo{"
+	for _, f := range this.ValidatorsRating {
+		repeatedStringForValidatorsRating += strings.Replace(f.String(), "ValidatorRatingInfo", "ValidatorRatingInfo", 1) + ","
+	}
+	repeatedStringForValidatorsRating += "}"
+	keysForValidatorsPubKeys := make([]uint32, 0, len(this.ValidatorsPubKeys))
+	for k, _ := range this.ValidatorsPubKeys {
+		keysForValidatorsPubKeys = append(keysForValidatorsPubKeys, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Uint32s(keysForValidatorsPubKeys)
+	mapStringForValidatorsPubKeys := "map[uint32]*PubKeys{"
+	for _, k := range keysForValidatorsPubKeys {
+		mapStringForValidatorsPubKeys += fmt.Sprintf("%v: %v,", k, this.ValidatorsPubKeys[k])
+	}
+	mapStringForValidatorsPubKeys += "}"
+	s := strings.Join([]string{`&Record{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`SaveBlock:` + strings.Replace(this.SaveBlock.String(), "SaveBlock", "SaveBlock", 1) + `,`,
+		`Header:` + strings.Replace(this.Header.String(), "Object", "Object", 1) + `,`,
+		`Body:` + strings.Replace(this.Body.String(), "Object", "Object", 1) + `,`,
+		`RoundsInfo:` + repeatedStringForRoundsInfo + `,`,
+		`ValidatorsPubKeys:` + mapStringForValidatorsPubKeys + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`IndexID:` + fmt.Sprintf("%v", this.IndexID) + `,`,
+		`ValidatorsRating:` + repeatedStringForValidatorsRating + `,`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Object) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Object{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SaveBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForLogs := "[]*LogEntry{"
+	for _, f := range this.Logs {
+		repeatedStringForLogs += strings.Replace(f.String(), "LogEntry", "LogEntry", 1) + ","
+	}
+	repeatedStringForLogs += "}"
+	keysForTxs := make([]string, 0, len(this.Txs))
+	for k, _ := range this.Txs {
+		keysForTxs = append(keysForTxs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTxs)
+	mapStringForTxs := "map[string]*Object{"
+	for _, k := range keysForTxs {
+		mapStringForTxs += fmt.Sprintf("%v: %v,", k, this.Txs[k])
+	}
+	mapStringForTxs += "}"
+	keysForScrs := make([]string, 0, len(this.Scrs))
+	for k, _ := range this.Scrs {
+		keysForScrs = append(keysForScrs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForScrs)
+	mapStringForScrs := "map[string]*Object{"
+	for _, k := range keysForScrs {
+		mapStringForScrs += fmt.Sprintf("%v: %v,", k, this.Scrs[k])
+	}
+	mapStringForScrs += "}"
+	keysForRewards := make([]string, 0, len(this.Rewards))
+	for k, _ := range this.Rewards {
+		keysForRewards = append(keysForRewards, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRewards)
+	mapStringForRewards := "map[string]*Object{"
+	for _, k := range keysForRewards {
+		mapStringForRewards += fmt.Sprintf("%v: %v,", k, this.Rewards[k])
+	}
+	mapStringForRewards += "}"
+	keysForInvalid := make([]string, 0, len(this.Invalid))
+	for k, _ := range this.Invalid {
+		keysForInvalid = append(keysForInvalid, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForInvalid)
+	mapStringForInvalid := "map[string]*Object{"
+	for _, k := range keysForInvalid {
+		mapStringForInvalid += fmt.Sprintf("%v: %v,", k, this.Invalid[k])
+	}
+	mapStringForInvalid += "}"
+	keysForReceipts := make([]string, 0, len(this.Receipts))
+	for k, _ := range this.Receipts {
+		keysForReceipts = append(keysForReceipts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForReceipts)
+	mapStringForReceipts := "map[string]*Object{"
+	for _, k := range keysForReceipts {
+		mapStringForReceipts += fmt.Sprintf("%v: %v,", k, this.Receipts[k])
+	}
+	mapStringForReceipts += "}"
+	keysForAlteredAccounts := make([]string, 0, len(this.AlteredAccounts))
+	for k, _ := range this.AlteredAccounts {
+		keysForAlteredAccounts = append(keysForAlteredAccounts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAlteredAccounts)
+	mapStringForAlteredAccounts := "map[string]*AlteredAccount{"
+	for _, k := range keysForAlteredAccounts {
+		mapStringForAlteredAccounts += fmt.Sprintf("%v: %v,", k, this.AlteredAccounts[k])
+	}
+	mapStringForAlteredAccounts += "}"
+	s := strings.Join([]string{`&SaveBlock{`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`Header:` + strings.Replace(this.Header.String(), "Object", "Object", 1) + `,`,
+		`Body:` + strings.Replace(this.Body.String(), "Object", "Object", 1) + `,`,
+		`SignersIndexes:` + fmt.Sprintf("%v", this.SignersIndexes) + `,`,
+		`NotarizedHeadersHashes:` + fmt.Sprintf("%v", this.NotarizedHeadersHashes) + `,`,
+		`HeaderGasConsumption:` + strings.Replace(this.HeaderGasConsumption.String(), "HeaderGasConsumption", "HeaderGasConsumption", 1) + `,`,
+		`HasPool:` + fmt.Sprintf("%v", this.HasPool) + `,`,
+		`Txs:` + mapStringForTxs + `,`,
+		`Scrs:` + mapStringForScrs + `,`,
+		`Rewards:` + mapStringForRewards + `,`,
+		`Invalid:` + mapStringForInvalid + `,`,
+		`Receipts:` + mapStringForReceipts + `,`,
+		`Logs:` + repeatedStringForLogs + `,`,
+		`AlteredAccounts:` + mapStringForAlteredAccounts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LogEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LogEntry{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`Log:` + strings.Replace(this.Log.String(), "Object", "Object", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HeaderGasConsumption) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HeaderGasConsumption{`,
+		`GasProvided:` + fmt.Sprintf("%v", this.GasProvided) + `,`,
+		`GasRefunded:` + fmt.Sprintf("%v", this.GasRefunded) + `,`,
+		`GasPenalized:` + fmt.Sprintf("%v", this.GasPenalized) + `,`,
+		`MaxGasPerBlock:` + fmt.Sprintf("%v", this.MaxGasPerBlock) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AlteredAccount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTokens := "[]*AccountTokenData{"
+	for _, f := range this.Tokens {
+		repeatedStringForTokens += strings.Replace(f.String(), "AccountTokenData", "AccountTokenData", 1) + ","
+	}
+	repeatedStringForTokens += "}"
+	s := strings.Join([]string{`&AlteredAccount{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Tokens:` + repeatedStringForTokens + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountTokenData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountTokenData{`,
+		`Identifier:` + fmt.Sprintf("%v", this.Identifier) + `,`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Properties:` + fmt.Sprintf("%v", this.Properties) + `,`,
+		`MetaData:` + strings.Replace(this.MetaData.String(), "Object", "Object", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RoundInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RoundInfo{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`SignersIndexes:` + fmt.Sprintf("%v", this.SignersIndexes) + `,`,
+		`BlockWasProposed:` + fmt.Sprintf("%v", this.BlockWasProposed) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidatorRatingInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidatorRatingInfo{`,
+		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
+		`Rating:` + fmt.Sprintf("%v", this.Rating) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PubKeys) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PubKeys{`,
+		`Keys:` + fmt.Sprintf("%v", this.Keys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRecord(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Record) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Record: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Record: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaveBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SaveBlock == nil {
+				m.SaveBlock = &SaveBlock{}
+			}
+			if err := m.SaveBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Object{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Body == nil {
+				m.Body = &Object{}
+			}
+			if err := m.Body.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundsInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundsInfo = append(m.RoundsInfo, &RoundInfo{})
+			if err := m.RoundsInfo[len(m.RoundsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPubKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorsPubKeys == nil {
+				m.ValidatorsPubKeys = make(map[uint32]*PubKeys)
+			}
+			var mapkey uint32
+			var mapvalue *PubKeys
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRecord
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRecord
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &PubKeys{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRecord(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ValidatorsPubKeys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsRating", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorsRating = append(m.ValidatorsRating, &ValidatorRatingInfo{})
+			if err := m.ValidatorsRating[len(m.ValidatorsRating)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Object) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SaveBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SaveBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SaveBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Object{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Body == nil {
+				m.Body = &Object{}
+			}
+			if err := m.Body.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRecord
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SignersIndexes = append(m.SignersIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRecord
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRecord
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRecord
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SignersIndexes) == 0 {
+					m.SignersIndexes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SignersIndexes = append(m.SignersIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignersIndexes", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotarizedHeadersHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotarizedHeadersHashes = append(m.NotarizedHeadersHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderGasConsumption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeaderGasConsumption == nil {
+				m.HeaderGasConsumption = &HeaderGasConsumption{}
+			}
+			if err := m.HeaderGasConsumption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasPool = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Txs == nil {
+				m.Txs = make(map[string]*Object)
+			}
+			var mapkey string
+			var mapvalue *Object
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRecord
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRecord
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRecord
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Object{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRecord(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Txs[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scrs == nil {
+				m.Scrs = make(map[string]*Object)
+			}
+			var mapkey string
+			var mapvalue *Object
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRecord
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRecord
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRecord
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Object{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRecord(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Scrs[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rewards == nil {
+				m.Rewards = make(map[string]*Object)
+			}
+			var mapkey string
+			var mapvalue *Object
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRecord
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRecord
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRecord
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Object{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRecord(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Rewards[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Invalid == nil {
+				m.Invalid = make(map[string]*Object)
+			}
+			var mapkey string
+			var mapvalue *Object
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRecord
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRecord
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRecord
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Object{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRecord(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Invalid[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Receipts == nil {
+				m.Receipts = make(map[string]*Object)
+			}
+			var mapkey string
+			var mapvalue *Object
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRecord
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRecord
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRecord
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Object{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRecord(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Receipts[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &LogEntry{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlteredAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AlteredAccounts == nil {
+				m.AlteredAccounts = make(map[string]*AlteredAccount)
+			}
+			var mapkey string
+			var mapvalue *AlteredAccount
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRecord
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRecord
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRecord
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &AlteredAccount{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRecord(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRecord
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AlteredAccounts[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Log == nil {
+				m.Log = &Object{}
+			}
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeaderGasConsumption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeaderGasConsumption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeaderGasConsumption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasProvided", wireType)
+			}
+			m.GasProvided = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasProvided |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRefunded", wireType)
+			}
+			m.GasRefunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasRefunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPenalized", wireType)
+			}
+			m.GasPenalized = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPenalized |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerBlock", wireType)
+			}
+			m.MaxGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlteredAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlteredAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlteredAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &AccountTokenData{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountTokenData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountTokenData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountTokenData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Properties = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MetaData == nil {
+				m.MetaData = &Object{}
+			}
+			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoundInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoundInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoundInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRecord
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SignersIndexes = append(m.SignersIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRecord
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRecord
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRecord
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SignersIndexes) == 0 {
+					m.SignersIndexes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SignersIndexes = append(m.SignersIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignersIndexes", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockWasProposed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockWasProposed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRatingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRatingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRatingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Rating = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRecord = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,281 @@
+package record
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSaveBlockArgs() *indexer.ArgsSaveBlockData {
+	return &indexer.ArgsSaveBlockData{
+		HeaderHash: []byte("header hash"),
+		Header: &block.MetaBlock{
+			Nonce:    37,
+			Epoch:    2,
+			RootHash: []byte("root hash"),
+		},
+		Body: &block.Body{MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("tx hash")}, SenderShardID: 1},
+		}},
+		SignersIndexes:         []uint64{1, 2},
+		NotarizedHeadersHashes: []string{"aa"},
+		HeaderGasConsumption:   indexer.HeaderGasConsumption{GasProvided: 10, MaxGasPerBlock: 100},
+		TransactionsPool: &indexer.Pool{
+			Txs: map[string]data.TransactionHandler{
+				"\xff\x00tx hash": &transaction.Transaction{Nonce: 1, Value: big.NewInt(100), Data: []byte("data")},
+			},
+			Scrs: map[string]data.TransactionHandler{
+				"scr hash": &smartContractResult.SmartContractResult{Nonce: 2, Value: big.NewInt(5)},
+			},
+			Rewards: map[string]data.TransactionHandler{
+				"reward hash": &rewardTx.RewardTx{Round: 3, Value: big.NewInt(7)},
+			},
+			Invalid: map[string]data.TransactionHandler{},
+			Receipts: map[string]data.TransactionHandler{
+				"receipt hash": &receipt.Receipt{Value: big.NewInt(8), TxHash: []byte("tx")},
+			},
+			Logs: []*data.LogData{
+				{
+					TxHash: "\xff\x00tx hash",
+					LogHandler: &transaction.Log{
+						Address: []byte("address"),
+						Events:  []*transaction.Event{{Identifier: []byte("transfer"), Topics: [][]byte{[]byte("t")}}},
+					},
+				},
+			},
+		},
+		AlteredAccounts: map[string]*indexer.AlteredAccount{
+			"erd1": {
+				Address: "erd1",
+				Balance: "10",
+				Tokens: []*indexer.AccountTokenData{
+					{Identifier: "NFT-abcdef", Nonce: 1, MetaData: &esdt.MetaData{Name: []byte("name")}},
+					{Identifier: "TKN-abcdef", Balance: "5"},
+				},
+			},
+		},
+	}
+}
+
+func TestNewConverter(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewConverter(ArgsConverter{})
+	assert.Nil(t, c)
+	assert.Equal(t, core.ErrNilMarshalizer, err)
+
+	c, err = NewConverter(ArgsConverter{ObjectMarshaller: &testscommon.ProtobufMarshalizerMock{}})
+	assert.Nil(t, err)
+	assert.False(t, c.IsInterfaceNil())
+}
+
+func TestConverter_RoundTripThroughStream(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []string{JSONFormat, ProtoFormat} {
+		format := format
+		t.Run(format, func(t *testing.T) {
+			t.Parallel()
+
+			objectMarshaller, err := ObjectMarshallerForFormat(format, &testscommon.ProtobufMarshalizerMock{})
+			require.Nil(t, err)
+			c, _ := NewConverter(ArgsConverter{ObjectMarshaller: objectMarshaller})
+
+			saveBlockArgs := createSaveBlockArgs()
+			header := &block.Header{Nonce: 4, Round: 5}
+			body := &block.Body{}
+			roundsInfo := []*indexer.RoundInfo{{Index: 5, SignersIndexes: []uint64{1}, ShardId: 1, Timestamp: time.Second}}
+			validatorsPubKeys := map[uint32][][]byte{0: {[]byte("pk0")}, core.MetachainShardId: {[]byte("pk1")}}
+			ratings := []*indexer.ValidatorRatingInfo{{PublicKey: "pk", Rating: 50.5}}
+
+			saveBlockRecord, err := c.SaveBlockRecord(saveBlockArgs)
+			require.Nil(t, err)
+			revertRecord, err := c.RevertIndexedBlockRecord(header, body)
+			require.Nil(t, err)
+
+			buff := bytes.NewBuffer(nil)
+			w, _ := NewWriter(format, buff)
+			for _, rec := range []*Record{
+				saveBlockRecord,
+				revertRecord,
+				c.RoundsInfoRecord(roundsInfo),
+				c.ValidatorsPubKeysRecord(validatorsPubKeys, 3),
+				c.ValidatorsRatingRecord("0_3", ratings),
+				c.FinalizedBlockRecord([]byte("header hash")),
+			} {
+				_, err = w.Write(rec)
+				require.Nil(t, err)
+			}
+
+			calls := make([]string, 0)
+			driver := &mock.DriverStub{
+				SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+					assert.Equal(t, saveBlockArgs, args)
+					calls = append(calls, SaveBlockType)
+					return nil
+				},
+				RevertBlockCalled: func(h data.HeaderHandler, b data.BodyHandler) error {
+					assert.Equal(t, header, h)
+					assert.Equal(t, body, b)
+					calls = append(calls, RevertIndexedBlockType)
+					return nil
+				},
+				SaveRoundsInfoCalled: func(infos []*indexer.RoundInfo) error {
+					assert.Equal(t, roundsInfo, infos)
+					calls = append(calls, SaveRoundsInfoType)
+					return nil
+				},
+				SaveValidatorsPubKeysCalled: func(pubKeys map[uint32][][]byte, epoch uint32) error {
+					assert.Equal(t, validatorsPubKeys, pubKeys)
+					assert.Equal(t, uint32(3), epoch)
+					calls = append(calls, SaveValidatorsPubKeysType)
+					return nil
+				},
+				SaveValidatorsRatingCalled: func(indexID string, infoRating []*indexer.ValidatorRatingInfo) error {
+					assert.Equal(t, "0_3", indexID)
+					assert.Equal(t, ratings, infoRating)
+					calls = append(calls, SaveValidatorsRatingType)
+					return nil
+				},
+				FinalizedBlockCalled: func(headerHash []byte) error {
+					assert.Equal(t, []byte("header hash"), headerHash)
+					calls = append(calls, FinalizedBlockType)
+					return nil
+				},
+			}
+
+			r, _ := NewReader(format, buff)
+			for {
+				rec, errRead := r.Read()
+				if errRead == io.EOF {
+					break
+				}
+				require.Nil(t, errRead)
+
+				err = c.Deliver(rec, driver)
+				require.Nil(t, err)
+			}
+
+			expectedCalls := []string{
+				SaveBlockType,
+				RevertIndexedBlockType,
+				SaveRoundsInfoType,
+				SaveValidatorsPubKeysType,
+				SaveValidatorsRatingType,
+				FinalizedBlockType,
+			}
+			assert.Equal(t, expectedCalls, calls)
+		})
+	}
+}
+
+func TestConverter_RoundTripShouldKeepTheLogsOrder(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []string{JSONFormat, ProtoFormat} {
+		format := format
+		t.Run(format, func(t *testing.T) {
+			t.Parallel()
+
+			objectMarshaller, _ := ObjectMarshallerForFormat(format, &testscommon.ProtobufMarshalizerMock{})
+			c, _ := NewConverter(ArgsConverter{ObjectMarshaller: objectMarshaller})
+
+			saveBlockArgs := createSaveBlockArgs()
+			saveBlockArgs.TransactionsPool.Logs = []*data.LogData{
+				{TxHash: "z hash", LogHandler: &transaction.Log{Address: []byte("address 1")}},
+				{TxHash: "a hash", LogHandler: &transaction.Log{Address: []byte("address 2")}},
+				{TxHash: "m hash", LogHandler: &transaction.Log{Address: []byte("address 3")}},
+				{TxHash: "a hash", LogHandler: &transaction.Log{Address: []byte("address 4")}},
+				{TxHash: "\xff\x00tx hash", LogHandler: &transaction.Log{Address: []byte("address 5")}},
+			}
+
+			rec, err := c.SaveBlockRecord(saveBlockArgs)
+			require.Nil(t, err)
+
+			buff := bytes.NewBuffer(nil)
+			w, _ := NewWriter(format, buff)
+			_, err = w.Write(rec)
+			require.Nil(t, err)
+
+			r, _ := NewReader(format, buff)
+			readRecord, err := r.Read()
+			require.Nil(t, err)
+
+			args, err := c.SaveBlockArgs(readRecord)
+			require.Nil(t, err)
+			assert.Equal(t, saveBlockArgs.TransactionsPool.Logs, args.TransactionsPool.Logs)
+		})
+	}
+}
+
+func TestConverter_Errors(t *testing.T) {
+	t.Parallel()
+
+	c, _ := NewConverter(ArgsConverter{ObjectMarshaller: &testscommon.ProtobufMarshalizerMock{}})
+
+	_, err := c.SaveBlockRecord(nil)
+	assert.Equal(t, ErrNilSaveBlockArgs, err)
+
+	_, err = c.RevertIndexedBlockRecord(&testscommon.HeaderHandlerStub{}, nil)
+	assert.True(t, errors.Is(err, ErrUnknownObjectType))
+
+	err = c.Deliver(nil, &mock.DriverStub{})
+	assert.Equal(t, ErrNilRecord, err)
+
+	err = c.Deliver(&Record{}, nil)
+	assert.Equal(t, outport.ErrNilDriver, err)
+
+	err = c.Deliver(&Record{Type: "unknown"}, &mock.DriverStub{})
+	assert.True(t, errors.Is(err, ErrUnknownRecordType))
+
+	err = c.Deliver(&Record{Type: RevertIndexedBlockType, Header: &Object{Type: "unknown"}}, &mock.DriverStub{})
+	assert.True(t, errors.Is(err, ErrUnknownObjectType))
+}
+
+func TestReader_TruncatedStreamShouldError(t *testing.T) {
+	t.Parallel()
+
+	c, _ := NewConverter(ArgsConverter{ObjectMarshaller: &testscommon.ProtobufMarshalizerMock{}})
+	for _, format := range []string{JSONFormat, ProtoFormat} {
+		buff := bytes.NewBuffer(nil)
+		w, _ := NewWriter(format, buff)
+		_, err := w.Write(c.FinalizedBlockRecord([]byte("hash")))
+		require.Nil(t, err)
+
+		truncated := buff.Bytes()[:buff.Len()-1]
+		r, _ := NewReader(format, bytes.NewReader(truncated))
+		_, err = r.Read()
+		assert.Equal(t, io.ErrUnexpectedEOF, err, format)
+	}
+}
+
+func TestCheckFormat(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, CheckFormat(JSONFormat))
+	assert.Nil(t, CheckFormat(ProtoFormat))
+	assert.True(t, errors.Is(CheckFormat("xml"), ErrUnknownFormat))
+
+	_, err := NewWriter("xml", bytes.NewBuffer(nil))
+	assert.True(t, errors.Is(err, ErrUnknownFormat))
+	_, err = NewReader("xml", bytes.NewBuffer(nil))
+	assert.True(t, errors.Is(err, ErrUnknownFormat))
+}
@@ -0,0 +1,524 @@
+package replay
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/batch"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("outport/replay")
+
+// ArgsBlocksReplayer holds the arguments needed to create a new blocks replayer
+type ArgsBlocksReplayer struct {
+	Store                    dataRetriever.StorageService
+	Marshaller               marshal.Marshalizer
+	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	NodesCoordinator         NodesCoordinator
+	EconomicsHandler         EconomicsHandler
+	OutportHandler           outport.OutportHandler
+	ShardID                  uint32
+	StartEpoch               uint32
+	CurrentEpoch             uint32
+}
+
+// blocksReplayer rebuilds, from the node's storers, the data that was pushed to the outport drivers when the
+// blocks were committed and pushes it again, in order, through the outport handler. The replayed data is not
+// identical to the live one, as some of it is never persisted:
+//   - the header gas consumption only holds the maximum gas per block, as the provided, refunded and penalized
+//     gas of the block are not stored
+//   - the altered accounts are not set, as they are read from the accounts state at the time of the commit
+//   - the logs are ordered as their transactions appear in the miniblocks, not in their generation order
+type blocksReplayer struct {
+	store                    dataRetriever.StorageService
+	marshaller               marshal.Marshalizer
+	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	nodesCoordinator         NodesCoordinator
+	economicsHandler         EconomicsHandler
+	outportHandler           outport.OutportHandler
+	shardID                  uint32
+	currentEpoch             uint32
+	epochCursor              uint32
+	headerUnit               dataRetriever.UnitType
+	hdrNonceHashDataUnit     dataRetriever.UnitType
+}
+
+// NewBlocksReplayer creates a new blocks replayer
+func NewBlocksReplayer(args ArgsBlocksReplayer) (*blocksReplayer, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	br := &blocksReplayer{
+		store:                    args.Store,
+		marshaller:               args.Marshaller,
+		uint64ByteSliceConverter: args.Uint64ByteSliceConverter,
+		nodesCoordinator:         args.NodesCoordinator,
+		economicsHandler:         args.EconomicsHandler,
+		outportHandler:           args.OutportHandler,
+		shardID:                  args.ShardID,
+		currentEpoch:             args.CurrentEpoch,
+		epochCursor:              args.StartEpoch,
+		headerUnit:               dataRetriever.BlockHeaderUnit,
+		hdrNonceHashDataUnit:     dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(args.ShardID),
+	}
+	if args.ShardID == core.MetachainShardId {
+		br.headerUnit = dataRetriever.MetaBlockUnit
+		br.hdrNonceHashDataUnit = dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	for _, unit := range []dataRetriever.UnitType{br.headerUnit, br.hdrNonceHashDataUnit, dataRetriever.MiniBlockUnit} {
+		if check.IfNil(br.store.GetStorer(unit)) {
+			return nil, fmt.Errorf("%w for %s", ErrMissingStorer, unit.String())
+		}
+	}
+
+	return br, nil
+}
+
+func checkArgs(args ArgsBlocksReplayer) error {
+	if check.IfNil(args.Store) {
+		return ErrNilStorageService
+	}
+	if check.IfNil(args.Marshaller) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return ErrNilUint64ByteSliceConverter
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.EconomicsHandler) {
+		return ErrNilEconomicsHandler
+	}
+	if check.IfNil(args.OutportHandler) {
+		return ErrNilOutportHandler
+	}
+	if args.StartEpoch > args.CurrentEpoch {
+		return fmt.Errorf("%w, start epoch: %d, current epoch: %d", ErrInvalidEpochsRange, args.StartEpoch, args.CurrentEpoch)
+	}
+
+	return nil
+}
+
+// Replay pushes the blocks with the nonces in the provided closed interval through the outport handler. Each block
+// is saved and then marked as finalized, as it is already final in storage
+func (br *blocksReplayer) Replay(fromNonce uint64, toNonce uint64) error {
+	if fromNonce > toNonce {
+		return fmt.Errorf("%w, from nonce: %d, to nonce: %d", ErrInvalidNoncesRange, fromNonce, toNonce)
+	}
+
+	for nonce := fromNonce; nonce <= toNonce; nonce++ {
+		args, err := br.createSaveBlockArgs(nonce)
+		if err != nil {
+			return err
+		}
+
+		br.outportHandler.SaveBlock(args)
+		br.outportHandler.FinalizedBlock(args.HeaderHash)
+
+		log.Debug("replayed block",
+			"nonce", nonce,
+			"hash", args.HeaderHash,
+			"epoch", args.Header.GetEpoch(),
+			"num txs", len(args.TransactionsPool.Txs),
+			"num scrs", len(args.TransactionsPool.Scrs))
+	}
+
+	return nil
+}
+
+func (br *blocksReplayer) createSaveBlockArgs(nonce uint64) (*indexer.ArgsSaveBlockData, error) {
+	headerHash, err := br.store.Get(br.hdrNonceHashDataUnit, br.uint64ByteSliceConverter.ToByteSlice(nonce))
+	if err != nil {
+		return nil, fmt.Errorf("%w, nonce: %d, error: %v", ErrBlockNotFound, nonce, err)
+	}
+
+	header, err := br.getHeader(headerHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w, nonce: %d, hash: %s, error: %v", ErrBlockNotFound, nonce, hex.EncodeToString(headerHash), err)
+	}
+
+	body, err := br.getBody(header)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := br.getTransactionsPool(body, header.GetEpoch())
+
+	return &indexer.ArgsSaveBlockData{
+		HeaderHash:     headerHash,
+		Body:           body,
+		Header:         header,
+		SignersIndexes: br.getSignersIndexes(header),
+		HeaderGasConsumption: indexer.HeaderGasConsumption{
+			MaxGasPerBlock: br.economicsHandler.MaxGasLimitPerBlock(br.shardID),
+		},
+		NotarizedHeadersHashes: getNotarizedHeadersHashes(header),
+		TransactionsPool:       pool,
+	}, nil
+}
+
+// getHeader searches the header starting with the epoch of the previously replayed block, as the epochs of the
+// consecutive blocks are never decreasing
+func (br *blocksReplayer) getHeader(headerHash []byte) (data.HeaderHandler, error) {
+	storer := br.store.GetStorer(br.headerUnit)
+
+	var err error
+	var headerBytes []byte
+	for epoch := br.epochCursor; epoch <= br.currentEpoch; epoch++ {
+		headerBytes, err = storer.GetFromEpoch(headerHash, epoch)
+		if err == nil && len(headerBytes) > 0 {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(headerBytes) == 0 {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	header, err := br.unmarshalHeader(headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	br.epochCursor = header.GetEpoch()
+
+	return header, nil
+}
+
+func (br *blocksReplayer) unmarshalHeader(headerBytes []byte) (data.HeaderHandler, error) {
+	if br.shardID != core.MetachainShardId {
+		return process.CreateShardHeader(br.marshaller, headerBytes)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err := br.marshaller.Unmarshal(metaBlock, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return metaBlock, nil
+}
+
+func (br *blocksReplayer) getBody(header data.HeaderHandler) (*block.Body, error) {
+	epoch := header.GetEpoch()
+	body := &block.Body{}
+	for _, miniBlockHeader := range header.GetMiniBlockHeaderHandlers() {
+		miniBlockHash := miniBlockHeader.GetHash()
+		miniBlockBytes, err := br.getFromEpoch(dataRetriever.MiniBlockUnit, miniBlockHash, epoch)
+		if err != nil {
+			return nil, fmt.Errorf("%w, hash: %s, header nonce: %d, error: %v",
+				ErrMiniBlockNotFound, hex.EncodeToString(miniBlockHash), header.GetNonce(), err)
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = br.marshaller.Unmarshal(miniBlock, miniBlockBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	}
+
+	body.MiniBlocks = append(body.MiniBlocks, br.getIntraShardMiniBlocks(header.GetReceiptsHash(), epoch)...)
+
+	return body, nil
+}
+
+// getIntraShardMiniBlocks returns the miniblocks created in shard while processing the block (the intra shard
+// smart contract results and the receipts), which are not referenced by the header
+func (br *blocksReplayer) getIntraShardMiniBlocks(receiptsHash []byte, epoch uint32) []*block.MiniBlock {
+	if len(receiptsHash) == 0 {
+		return nil
+	}
+
+	batchBytes, err := br.getFromEpoch(dataRetriever.ReceiptsUnit, receiptsHash, epoch)
+	if err != nil {
+		return nil
+	}
+
+	batchWithMiniBlocks := &batch.Batch{}
+	err = br.marshaller.Unmarshal(batchWithMiniBlocks, batchBytes)
+	if err != nil {
+		log.Warn("blocksReplayer: cannot unmarshal the intra shard miniblocks", "receipts hash", receiptsHash, "error", err)
+		return nil
+	}
+
+	miniBlocks := make([]*block.MiniBlock, 0, len(batchWithMiniBlocks.Data))
+	for _, miniBlockBytes := range batchWithMiniBlocks.Data {
+		miniBlock := &block.MiniBlock{}
+		err = br.marshaller.Unmarshal(miniBlock, miniBlockBytes)
+		if err != nil {
+			log.Warn("blocksReplayer: cannot unmarshal intra shard miniblock", "receipts hash", receiptsHash, "error", err)
+			continue
+		}
+
+		miniBlocks = append(miniBlocks, miniBlock)
+	}
+
+	return miniBlocks
+}
+
+func (br *blocksReplayer) getTransactionsPool(body *block.Body, epoch uint32) *indexer.Pool {
+	pool := &indexer.Pool{
+		Txs:      make(map[string]data.TransactionHandler),
+		Scrs:     make(map[string]data.TransactionHandler),
+		Rewards:  make(map[string]data.TransactionHandler),
+		Invalid:  make(map[string]data.TransactionHandler),
+		Receipts: make(map[string]data.TransactionHandler),
+		Logs:     make([]*data.LogData, 0),
+	}
+
+	for _, miniBlock := range body.MiniBlocks {
+		switch miniBlock.Type {
+		case block.TxBlock:
+			br.putTransactions(pool.Txs, dataRetriever.TransactionUnit, miniBlock.TxHashes, epoch, newTransaction)
+		case block.InvalidBlock:
+			br.putTransactions(pool.Invalid, dataRetriever.TransactionUnit, miniBlock.TxHashes, epoch, newTransaction)
+		case block.SmartContractResultBlock:
+			br.putTransactions(pool.Scrs, dataRetriever.UnsignedTransactionUnit, miniBlock.TxHashes, epoch, newSmartContractResult)
+		case block.RewardsBlock:
+			br.putTransactions(pool.Rewards, dataRetriever.RewardTransactionUnit, miniBlock.TxHashes, epoch, newRewardTx)
+		case block.ReceiptBlock:
+			br.putTransactions(pool.Receipts, dataRetriever.UnsignedTransactionUnit, miniBlock.TxHashes, epoch, newReceipt)
+		}
+	}
+
+	pool.Logs = br.getLogs(body, pool, epoch)
+
+	return pool
+}
+
+func newTransaction() data.TransactionHandler {
+	return &transaction.Transaction{}
+}
+
+func newSmartContractResult() data.TransactionHandler {
+	return &smartContractResult.SmartContractResult{}
+}
+
+func newRewardTx() data.TransactionHandler {
+	return &rewardTx.RewardTx{}
+}
+
+func newReceipt() data.TransactionHandler {
+	return &receipt.Receipt{}
+}
+
+func (br *blocksReplayer) putTransactions(
+	destination map[string]data.TransactionHandler,
+	unit dataRetriever.UnitType,
+	hashes [][]byte,
+	epoch uint32,
+	newTxHandler func() data.TransactionHandler,
+) {
+	txsBytes := br.getBulkFromEpoch(unit, hashes, epoch)
+	for _, hash := range hashes {
+		txBytes, found := txsBytes[string(hash)]
+		if !found {
+			log.Warn("blocksReplayer: transaction not found in storage", "unit", unit.String(), "hash", hash)
+			continue
+		}
+
+		tx := newTxHandler()
+		err := br.marshaller.Unmarshal(tx, txBytes)
+		if err != nil {
+			log.Warn("blocksReplayer: cannot unmarshal transaction", "unit", unit.String(), "hash", hash, "error", err)
+			continue
+		}
+
+		destination[string(hash)] = tx
+	}
+}
+
+// getLogs returns the logs of the transactions and of the smart contract results in the pool, in the order in which
+// they appear in the block's miniblocks
+func (br *blocksReplayer) getLogs(body *block.Body, pool *indexer.Pool, epoch uint32) []*data.LogData {
+	if check.IfNil(br.store.GetStorer(dataRetriever.TxLogsUnit)) {
+		return make([]*data.LogData, 0)
+	}
+
+	hashes := make([][]byte, 0, len(pool.Txs)+len(pool.Scrs))
+	seenHashes := make(map[string]struct{}, len(pool.Txs)+len(pool.Scrs))
+	for _, miniBlock := range body.MiniBlocks {
+		txs := pool.Txs
+		if miniBlock.Type == block.SmartContractResultBlock {
+			txs = pool.Scrs
+		} else if miniBlock.Type != block.TxBlock {
+			continue
+		}
+
+		for _, hash := range miniBlock.TxHashes {
+			_, isInPool := txs[string(hash)]
+			_, isSeen := seenHashes[string(hash)]
+			if !isInPool || isSeen {
+				continue
+			}
+
+			seenHashes[string(hash)] = struct{}{}
+			hashes = append(hashes, hash)
+		}
+	}
+
+	logs := make([]*data.LogData, 0)
+	if len(hashes) == 0 {
+		return logs
+	}
+
+	logsBytes := br.getBulkFromEpoch(dataRetriever.TxLogsUnit, hashes, epoch)
+	for _, hash := range hashes {
+		logBytes, found := logsBytes[string(hash)]
+		if !found {
+			continue
+		}
+
+		txLog := &transaction.Log{}
+		err := br.marshaller.Unmarshal(txLog, logBytes)
+		if err != nil {
+			log.Warn("blocksReplayer: cannot unmarshal log", "hash", hash, "error", err)
+			continue
+		}
+
+		logs = append(logs, &data.LogData{
+			LogHandler: txLog,
+			TxHash:     string(hash),
+		})
+	}
+
+	return logs
+}
+
+func (br *blocksReplayer) getSignersIndexes(header data.HeaderHandler) []uint64 {
+	epoch := header.GetEpoch()
+	if header.IsStartOfEpochBlock() && epoch > 0 {
+		epoch = epoch - 1
+	}
+
+	publicKeys, err := br.nodesCoordinator.GetConsensusValidatorsPublicKeys(
+		header.GetPrevRandSeed(),
+		header.GetRound(),
+		br.shardID,
+		epoch,
+	)
+	if err != nil {
+		log.Debug("blocksReplayer: cannot compute the consensus group, the signers will not be set",
+			"nonce", header.GetNonce(),
+			"epoch", epoch,
+			"error", err)
+		return nil
+	}
+
+	signersIndexes, err := br.nodesCoordinator.GetValidatorsIndexes(publicKeys, epoch)
+	if err != nil {
+		log.Debug("blocksReplayer: cannot compute the signers indexes",
+			"nonce", header.GetNonce(),
+			"epoch", epoch,
+			"error", err)
+		return nil
+	}
+
+	return signersIndexes
+}
+
+func getNotarizedHeadersHashes(header data.HeaderHandler) []string {
+	metaBlock, ok := header.(*block.MetaBlock)
+	if !ok {
+		return nil
+	}
+
+	notarizedHeadersHashes := make([]string, 0, len(metaBlock.ShardInfo))
+	for _, shardData := range metaBlock.ShardInfo {
+		notarizedHeadersHashes = append(notarizedHeadersHashes, hex.EncodeToString(shardData.HeaderHash))
+	}
+
+	return notarizedHeadersHashes
+}
+
+// getFromEpoch searches the key in the provided epoch and in the next one, as the data committed in the last
+// blocks of an epoch might have been saved in the storers of the next epoch
+func (br *blocksReplayer) getFromEpoch(unit dataRetriever.UnitType, key []byte, epoch uint32) ([]byte, error) {
+	storer := br.store.GetStorer(unit)
+	if check.IfNil(storer) {
+		return nil, fmt.Errorf("%w for %s", ErrMissingStorer, unit.String())
+	}
+
+	buff, err := storer.GetFromEpoch(key, epoch)
+	if err == nil && len(buff) > 0 {
+		return buff, nil
+	}
+	if epoch >= br.currentEpoch {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	buff, err = storer.GetFromEpoch(key, epoch+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(buff) == 0 {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	return buff, nil
+}
+
+func (br *blocksReplayer) getBulkFromEpoch(unit dataRetriever.UnitType, keys [][]byte, epoch uint32) map[string][]byte {
+	result := make(map[string][]byte)
+	storer := br.store.GetStorer(unit)
+	if check.IfNil(storer) || len(keys) == 0 {
+		return result
+	}
+
+	for _, epochToSearch := range []uint32{epoch, epoch + 1} {
+		if epochToSearch > br.currentEpoch {
+			break
+		}
+
+		missingKeys := make([][]byte, 0, len(keys))
+		for _, key := range keys {
+			_, found := result[string(key)]
+			if !found {
+				missingKeys = append(missingKeys, key)
+			}
+		}
+		if len(missingKeys) == 0 {
+			break
+		}
+
+		values, err := storer.GetBulkFromEpoch(missingKeys, epochToSearch)
+		if err != nil {
+			log.Debug("blocksReplayer: cannot get from epoch", "unit", unit.String(), "epoch", epochToSearch, "error", err)
+			continue
+		}
+		for key, value := range values {
+			result[key] = value
+		}
+	}
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (br *blocksReplayer) IsInterfaceNil() bool {
+	return br == nil
+}
@@ -0,0 +1,428 @@
+package replay
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/batch"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/shardingMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const maxGasLimitPerBlock = 1500000000
+
+type storedBlock struct {
+	hash              []byte
+	header            data.HeaderHandler
+	miniBlocks        []*block.MiniBlock
+	intraMiniBlocks   []*block.MiniBlock
+	txs               map[string]*transaction.Transaction
+	scrs              map[string]*smartContractResult.SmartContractResult
+	receipts          map[string]*receipt.Receipt
+	logs              map[string]*transaction.Log
+	storedInNextEpoch bool
+}
+
+func createChainStorer(shardID uint32) *dataRetriever.ChainStorer {
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.BlockHeaderUnit, genericMocks.NewStorerMock("BlockHeaders", 0))
+	store.AddStorer(dataRetriever.MetaBlockUnit, genericMocks.NewStorerMock("MetaBlocks", 0))
+	store.AddStorer(dataRetriever.MiniBlockUnit, genericMocks.NewStorerMock("MiniBlocks", 0))
+	store.AddStorer(dataRetriever.ReceiptsUnit, genericMocks.NewStorerMock("Receipts", 0))
+	store.AddStorer(dataRetriever.TransactionUnit, genericMocks.NewStorerMock("Transactions", 0))
+	store.AddStorer(dataRetriever.UnsignedTransactionUnit, genericMocks.NewStorerMock("Unsigned", 0))
+	store.AddStorer(dataRetriever.RewardTransactionUnit, genericMocks.NewStorerMock("Rewards", 0))
+	store.AddStorer(dataRetriever.TxLogsUnit, genericMocks.NewStorerMock("Logs", 0))
+	store.AddStorer(dataRetriever.MetaHdrNonceHashDataUnit, genericMocks.NewStorerMock("MetaHdrNonce", 0))
+	store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit+dataRetriever.UnitType(shardID), genericMocks.NewStorerMock("ShardHdrNonce", 0))
+
+	return store
+}
+
+func createMockArgsBlocksReplayer() ArgsBlocksReplayer {
+	return ArgsBlocksReplayer{
+		Store:                    createChainStorer(0),
+		Marshaller:               &testscommon.MarshalizerMock{},
+		Uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+		NodesCoordinator:         &shardingMocks.NodesCoordinatorStub{},
+		EconomicsHandler: &economicsmocks.EconomicsHandlerStub{
+			MaxGasLimitPerBlockCalled: func(_ uint32) uint64 {
+				return maxGasLimitPerBlock
+			},
+		},
+		OutportHandler: &testscommon.OutportStub{},
+		ShardID:        0,
+		StartEpoch:     0,
+		CurrentEpoch:   2,
+	}
+}
+
+func putInEpoch(t *testing.T, args ArgsBlocksReplayer, unit dataRetriever.UnitType, key []byte, value interface{}, epoch uint32) {
+	buff, err := args.Marshaller.Marshal(value)
+	require.Nil(t, err)
+
+	err = args.Store.GetStorer(unit).PutInEpoch(key, buff, epoch)
+	require.Nil(t, err)
+}
+
+func storeBlock(t *testing.T, args ArgsBlocksReplayer, headerUnit dataRetriever.UnitType, nonceUnit dataRetriever.UnitType, sb *storedBlock) {
+	epoch := sb.header.GetEpoch()
+	if sb.storedInNextEpoch {
+		epoch++
+	}
+
+	err := args.Store.Put(nonceUnit, args.Uint64ByteSliceConverter.ToByteSlice(sb.header.GetNonce()), sb.hash)
+	require.Nil(t, err)
+	putInEpoch(t, args, headerUnit, sb.hash, sb.header, epoch)
+	for i, miniBlockHeader := range sb.header.GetMiniBlockHeaderHandlers() {
+		if i < len(sb.miniBlocks) {
+			putInEpoch(t, args, dataRetriever.MiniBlockUnit, miniBlockHeader.GetHash(), sb.miniBlocks[i], epoch)
+		}
+	}
+	if len(sb.intraMiniBlocks) > 0 {
+		intraBatch := &batch.Batch{}
+		for _, miniBlock := range sb.intraMiniBlocks {
+			buff, _ := args.Marshaller.Marshal(miniBlock)
+			intraBatch.Data = append(intraBatch.Data, buff)
+		}
+		putInEpoch(t, args, dataRetriever.ReceiptsUnit, sb.header.GetReceiptsHash(), intraBatch, epoch)
+	}
+	for hash, tx := range sb.txs {
+		putInEpoch(t, args, dataRetriever.TransactionUnit, []byte(hash), tx, epoch)
+	}
+	for hash, scr := range sb.scrs {
+		putInEpoch(t, args, dataRetriever.UnsignedTransactionUnit, []byte(hash), scr, epoch)
+	}
+	for hash, rec := range sb.receipts {
+		putInEpoch(t, args, dataRetriever.UnsignedTransactionUnit, []byte(hash), rec, epoch)
+	}
+	for hash, txLog := range sb.logs {
+		putInEpoch(t, args, dataRetriever.TxLogsUnit, []byte(hash), txLog, epoch)
+	}
+}
+
+// createShardBlock creates a block with one transaction generating one smart contract result, a log and a receipt
+func createShardBlock(nonce uint64, epoch uint32) *storedBlock {
+	txHash := []byte("tx" + string(rune('0'+nonce)))
+	scrHash := []byte("scr" + string(rune('0'+nonce)))
+	receiptHash := []byte("receipt" + string(rune('0'+nonce)))
+	txMiniBlockHash := []byte("txMb" + string(rune('0'+nonce)))
+
+	return &storedBlock{
+		hash: []byte("hash" + string(rune('0'+nonce))),
+		header: &block.Header{
+			Nonce:        nonce,
+			Epoch:        epoch,
+			Round:        nonce + 10,
+			ReceiptsHash: []byte("receipts" + string(rune('0'+nonce))),
+			MiniBlockHeaders: []block.MiniBlockHeader{
+				{Hash: txMiniBlockHash, Type: block.TxBlock, TxCount: 1},
+			},
+		},
+		miniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{txHash}, Type: block.TxBlock, ReceiverShardID: 0},
+		},
+		intraMiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{scrHash}, Type: block.SmartContractResultBlock},
+			{TxHashes: [][]byte{receiptHash}, Type: block.ReceiptBlock},
+		},
+		txs: map[string]*transaction.Transaction{
+			string(txHash): {Nonce: nonce, Value: big.NewInt(10), Data: []byte("call")},
+		},
+		scrs: map[string]*smartContractResult.SmartContractResult{
+			string(scrHash): {Nonce: nonce, Value: big.NewInt(1), OriginalTxHash: txHash},
+		},
+		receipts: map[string]*receipt.Receipt{
+			string(receiptHash): {Value: big.NewInt(2), TxHash: txHash},
+		},
+		logs: map[string]*transaction.Log{
+			string(txHash): {Address: []byte("sc"), Events: []*transaction.Event{{Identifier: []byte("event")}}},
+		},
+	}
+}
+
+func storeShardBlock(t *testing.T, args ArgsBlocksReplayer, sb *storedBlock) {
+	storeBlock(t, args, dataRetriever.BlockHeaderUnit, dataRetriever.ShardHdrNonceHashDataUnit, sb)
+}
+
+func TestNewBlocksReplayer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer()
+		args.Store = nil
+		br, err := NewBlocksReplayer(args)
+		assert.Nil(t, br)
+		assert.Equal(t, ErrNilStorageService, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer()
+		args.Marshaller = nil
+		br, err := NewBlocksReplayer(args)
+		assert.Nil(t, br)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer()
+		args.Uint64ByteSliceConverter = nil
+		br, err := NewBlocksReplayer(args)
+		assert.Nil(t, br)
+		assert.Equal(t, ErrNilUint64ByteSliceConverter, err)
+	})
+	t.Run("nil nodes coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer()
+		args.NodesCoordinator = nil
+		br, err := NewBlocksReplayer(args)
+		assert.Nil(t, br)
+		assert.Equal(t, ErrNilNodesCoordinator, err)
+	})
+	t.Run("nil economics handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer()
+		args.EconomicsHandler = nil
+		br, err := NewBlocksReplayer(args)
+		assert.Nil(t, br)
+		assert.Equal(t, ErrNilEconomicsHandler, err)
+	})
+	t.Run("nil outport handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer()
+		args.OutportHandler = nil
+		br, err := NewBlocksReplayer(args)
+		assert.Nil(t, br)
+		assert.Equal(t, ErrNilOutportHandler, err)
+	})
+	t.Run("invalid epochs range should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer()
+		args.StartEpoch = args.CurrentEpoch + 1
+		br, err := NewBlocksReplayer(args)
+		assert.Nil(t, br)
+		assert.True(t, errors.Is(err, ErrInvalidEpochsRange))
+	})
+	t.Run("missing storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer()
+		args.ShardID = 1
+		br, err := NewBlocksReplayer(args)
+		assert.Nil(t, br)
+		assert.True(t, errors.Is(err, ErrMissingStorer))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		br, err := NewBlocksReplayer(createMockArgsBlocksReplayer())
+		assert.Nil(t, err)
+		assert.False(t, br.IsInterfaceNil())
+	})
+}
+
+func TestBlocksReplayer_ReplayShouldPushTheStoredBlocks(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsBlocksReplayer()
+	blocks := []*storedBlock{
+		createShardBlock(1, 0),
+		createShardBlock(2, 1),
+		createShardBlock(3, 1),
+	}
+	blocks[2].storedInNextEpoch = true
+	for _, sb := range blocks {
+		storeShardBlock(t, args, sb)
+	}
+
+	args.NodesCoordinator = &shardingMocks.NodesCoordinatorStub{
+		GetValidatorsPublicKeysCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]string, error) {
+			return []string{"pk"}, nil
+		},
+	}
+	saved := make([]*indexer.ArgsSaveBlockData, 0)
+	finalized := make([][]byte, 0)
+	args.OutportHandler = &testscommon.OutportStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) {
+			saved = append(saved, args)
+		},
+		FinalizedBlockCalled: func(headerHash []byte) {
+			finalized = append(finalized, headerHash)
+		},
+	}
+	br, _ := NewBlocksReplayer(args)
+
+	err := br.Replay(1, 3)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(saved))
+	require.Equal(t, [][]byte{blocks[0].hash, blocks[1].hash, blocks[2].hash}, finalized)
+
+	for i, sb := range blocks {
+		args := saved[i]
+		assert.Equal(t, sb.hash, args.HeaderHash)
+		assert.Equal(t, sb.header, args.Header)
+		assert.Equal(t, uint64(maxGasLimitPerBlock), args.HeaderGasConsumption.MaxGasPerBlock)
+
+		body := args.Body.(*block.Body)
+		require.Equal(t, 3, len(body.MiniBlocks))
+		assert.Equal(t, sb.miniBlocks[0], body.MiniBlocks[0])
+		assert.Equal(t, sb.intraMiniBlocks, body.MiniBlocks[1:])
+
+		pool := args.TransactionsPool
+		require.Equal(t, 1, len(pool.Txs))
+		for hash, tx := range sb.txs {
+			assert.Equal(t, tx, pool.Txs[hash])
+		}
+		require.Equal(t, 1, len(pool.Scrs))
+		for hash, scr := range sb.scrs {
+			assert.Equal(t, scr, pool.Scrs[hash])
+		}
+		require.Equal(t, 1, len(pool.Receipts))
+		for hash, rec := range sb.receipts {
+			assert.Equal(t, rec, pool.Receipts[hash])
+		}
+		require.Equal(t, 1, len(pool.Logs))
+		for hash, txLog := range sb.logs {
+			assert.Equal(t, hash, pool.Logs[0].TxHash)
+			assert.Equal(t, txLog, pool.Logs[0].LogHandler)
+		}
+	}
+}
+
+func TestBlocksReplayer_ReplayShouldOrderTheLogsAsTheMiniBlocks(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsBlocksReplayer()
+	sb := createShardBlock(1, 0)
+	txHashes := [][]byte{[]byte("tx c"), []byte("tx a"), []byte("tx b")}
+	scrHashes := [][]byte{[]byte("scr z"), []byte("scr y")}
+	sb.miniBlocks = []*block.MiniBlock{{TxHashes: txHashes, Type: block.TxBlock}}
+	sb.intraMiniBlocks = []*block.MiniBlock{{TxHashes: scrHashes, Type: block.SmartContractResultBlock}}
+	sb.txs = make(map[string]*transaction.Transaction)
+	sb.scrs = make(map[string]*smartContractResult.SmartContractResult)
+	sb.logs = make(map[string]*transaction.Log)
+	for i, hash := range txHashes {
+		sb.txs[string(hash)] = &transaction.Transaction{Nonce: uint64(i)}
+		sb.logs[string(hash)] = &transaction.Log{Address: hash}
+	}
+	for i, hash := range scrHashes {
+		sb.scrs[string(hash)] = &smartContractResult.SmartContractResult{Nonce: uint64(i)}
+		sb.logs[string(hash)] = &transaction.Log{Address: hash}
+	}
+	storeShardBlock(t, args, sb)
+
+	var saved *indexer.ArgsSaveBlockData
+	args.OutportHandler = &testscommon.OutportStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) {
+			saved = args
+		},
+	}
+	br, _ := NewBlocksReplayer(args)
+
+	err := br.Replay(1, 1)
+	require.Nil(t, err)
+	require.NotNil(t, saved)
+
+	expectedOrder := append(txHashes, scrHashes...)
+	require.Equal(t, len(expectedOrder), len(saved.TransactionsPool.Logs))
+	for i, hash := range expectedOrder {
+		assert.Equal(t, string(hash), saved.TransactionsPool.Logs[i].TxHash)
+		assert.Equal(t, sb.logs[string(hash)], saved.TransactionsPool.Logs[i].LogHandler)
+	}
+}
+
+func TestBlocksReplayer_ReplayMetaBlockShouldSetNotarizedHeaders(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsBlocksReplayer()
+	args.ShardID = core.MetachainShardId
+	metaBlock := &block.MetaBlock{
+		Nonce: 7,
+		Epoch: 2,
+		ShardInfo: []block.ShardData{
+			{HeaderHash: []byte{0xaa}, ShardID: 0},
+			{HeaderHash: []byte{0xbb}, ShardID: 1},
+		},
+	}
+	storeBlock(t, args, dataRetriever.MetaBlockUnit, dataRetriever.MetaHdrNonceHashDataUnit, &storedBlock{
+		hash:   []byte("meta hash"),
+		header: metaBlock,
+	})
+
+	var saved *indexer.ArgsSaveBlockData
+	args.OutportHandler = &testscommon.OutportStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) {
+			saved = args
+		},
+	}
+	br, _ := NewBlocksReplayer(args)
+
+	err := br.Replay(7, 7)
+	require.Nil(t, err)
+	require.NotNil(t, saved)
+	assert.Equal(t, metaBlock, saved.Header)
+	assert.Equal(t, []string{"aa", "bb"}, saved.NotarizedHeadersHashes)
+}
+
+func TestBlocksReplayer_ReplayErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonces range should error", func(t *testing.T) {
+		t.Parallel()
+
+		br, _ := NewBlocksReplayer(createMockArgsBlocksReplayer())
+		err := br.Replay(2, 1)
+		assert.True(t, errors.Is(err, ErrInvalidNoncesRange))
+	})
+	t.Run("missing block should error and stop", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer()
+		storeShardBlock(t, args, createShardBlock(1, 0))
+		numSaved := 0
+		args.OutportHandler = &testscommon.OutportStub{
+			SaveBlockCalled: func(_ *indexer.ArgsSaveBlockData) {
+				numSaved++
+			},
+		}
+		br, _ := NewBlocksReplayer(args)
+
+		err := br.Replay(1, 2)
+		assert.True(t, errors.Is(err, ErrBlockNotFound))
+		assert.Equal(t, 1, numSaved)
+	})
+	t.Run("missing miniblock should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer()
+		sb := createShardBlock(1, 0)
+		sb.miniBlocks = nil
+		storeShardBlock(t, args, sb)
+		br, _ := NewBlocksReplayer(args)
+
+		err := br.Replay(1, 1)
+		assert.True(t, errors.Is(err, ErrMiniBlockNotFound))
+	})
+}
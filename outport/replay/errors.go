@@ -0,0 +1,33 @@
+package replay
+
+import "errors"
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilUint64ByteSliceConverter signals that a nil uint64 byte slice converter has been provided
+var ErrNilUint64ByteSliceConverter = errors.New("nil uint64 byte slice converter")
+
+// ErrNilNodesCoordinator signals that a nil nodes coordinator has been provided
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNilEconomicsHandler signals that a nil economics handler has been provided
+var ErrNilEconomicsHandler = errors.New("nil economics handler")
+
+// ErrNilOutportHandler signals that a nil outport handler has been provided
+var ErrNilOutportHandler = errors.New("nil outport handler")
+
+// ErrInvalidEpochsRange signals that the start epoch is after the current epoch
+var ErrInvalidEpochsRange = errors.New("invalid epochs range")
+
+// ErrInvalidNoncesRange signals that the start nonce is after the end nonce
+var ErrInvalidNoncesRange = errors.New("invalid nonces range")
+
+// ErrMissingStorer signals that a required storer is missing from the storage service
+var ErrMissingStorer = errors.New("missing storer")
+
+// ErrBlockNotFound signals that a block could not be found in storage
+var ErrBlockNotFound = errors.New("block not found")
+
+// ErrMiniBlockNotFound signals that a miniblock could not be found in storage
+var ErrMiniBlockNotFound = errors.New("miniblock not found")
@@ -0,0 +1,14 @@
+package replay
+
+// NodesCoordinator defines the nodes coordinator operations used to compute the signers of a replayed block
+type NodesCoordinator interface {
+	GetConsensusValidatorsPublicKeys(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error)
+	GetValidatorsIndexes(publicKeys []string, epoch uint32) ([]uint64, error)
+	IsInterfaceNil() bool
+}
+
+// EconomicsHandler defines the economics operations used when replaying a block
+type EconomicsHandler interface {
+	MaxGasLimitPerBlock(shardID uint32) uint64
+	IsInterfaceNil() bool
+}
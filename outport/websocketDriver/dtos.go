@@ -0,0 +1,70 @@
+package websocketDriver
+
+import (
+	nodeData "github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+)
+
+const (
+	// SaveBlockMessageType is the type of the messages carrying a saved block
+	SaveBlockMessageType = "saveBlock"
+	// RevertIndexedBlockMessageType is the type of the messages carrying a reverted block
+	RevertIndexedBlockMessageType = "revertIndexedBlock"
+	// FinalizedBlockMessageType is the type of the messages carrying a finalized block
+	FinalizedBlockMessageType = "finalizedBlock"
+)
+
+// Message is the envelope of all the data pushed to the subscribers. The counter is strictly increasing and
+// it is the value a subscriber should acknowledge, when acknowledges are enabled
+type Message struct {
+	Counter uint64      `json:"counter"`
+	Type    string      `json:"type"`
+	Nonce   uint64      `json:"nonce"`
+	Payload interface{} `json:"payload"`
+}
+
+// Acknowledge is the message a subscriber sends back after handling a message
+type Acknowledge struct {
+	Counter uint64 `json:"counter"`
+}
+
+// SaveBlockData holds the saved block data pushed to the subscribers. All the maps are keyed by hex encoded hashes
+type SaveBlockData struct {
+	Hash                   string                                 `json:"hash"`
+	Header                 nodeData.HeaderHandler                 `json:"header"`
+	Body                   nodeData.BodyHandler                   `json:"body"`
+	SignersIndexes         []uint64                               `json:"signersIndexes"`
+	NotarizedHeadersHashes []string                               `json:"notarizedHeadersHashes"`
+	HeaderGasConsumption   indexer.HeaderGasConsumption           `json:"headerGasConsumption"`
+	Txs                    map[string]nodeData.TransactionHandler `json:"txs"`
+	Scrs                   map[string]nodeData.TransactionHandler `json:"scrs"`
+	Rewards                map[string]nodeData.TransactionHandler `json:"rewards"`
+	InvalidTxs             map[string]nodeData.TransactionHandler `json:"invalidTxs"`
+	Receipts               map[string]nodeData.TransactionHandler `json:"receipts"`
+	Logs                   map[string]nodeData.LogHandler         `json:"logs"`
+	AlteredAccounts        map[string]*indexer.AlteredAccount     `json:"alteredAccounts"`
+}
+
+// RevertBlock holds the reverted block data pushed to the subscribers
+type RevertBlock struct {
+	Hash    string `json:"hash"`
+	Nonce   uint64 `json:"nonce"`
+	Round   uint64 `json:"round"`
+	Epoch   uint32 `json:"epoch"`
+	ShardID uint32 `json:"shardID"`
+}
+
+// FinalizedBlock holds the finalized block data pushed to the subscribers. The nonce is filled only if the block
+// is still among the retained events
+type FinalizedBlock struct {
+	Hash  string `json:"hash"`
+	Nonce uint64 `json:"nonce"`
+}
+
+type event struct {
+	counter    uint64
+	nonce      uint64
+	headerHash string
+	isBlock    bool
+	buff       []byte
+}
@@ -0,0 +1,39 @@
+package websocketDriver
+
+import "errors"
+
+// ErrEmptyURL signals that an empty URL was provided
+var ErrEmptyURL = errors.New("empty URL")
+
+// ErrInvalidRetainedEventsCount signals that an invalid number of retained events was provided
+var ErrInvalidRetainedEventsCount = errors.New("invalid retained events count")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers was provided
+var ErrInvalidMaxSubscribers = errors.New("invalid max subscribers")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers was reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrInvalidAcknowledgeTimeout signals that an invalid acknowledge timeout was provided
+var ErrInvalidAcknowledgeTimeout = errors.New("invalid acknowledge timeout")
+
+// ErrNilSaveBlockArgs signals that nil save block arguments were provided
+var ErrNilSaveBlockArgs = errors.New("nil save block arguments")
+
+// ErrNilHeader signals that a nil header was provided
+var ErrNilHeader = errors.New("nil header")
+
+// ErrNonceNotRetained signals that the nonce requested for resuming is older than the retained events
+var ErrNonceNotRetained = errors.New("requested nonce is no longer retained")
+
+// ErrSubscriberTooSlow signals that a subscriber did not keep up with the retained events
+var ErrSubscriberTooSlow = errors.New("subscriber fell behind the retained events")
+
+// ErrUnexpectedAcknowledge signals that a subscriber acknowledged a different message than the one sent
+var ErrUnexpectedAcknowledge = errors.New("unexpected acknowledge")
+
+// ErrAcknowledgeTimeout signals that a subscriber did not acknowledge a message in time
+var ErrAcknowledgeTimeout = errors.New("acknowledge timeout")
+
+// ErrSubscriberClosed signals that the subscriber connection was closed
+var ErrSubscriberClosed = errors.New("subscriber closed")
@@ -0,0 +1,17 @@
+package websocketDriver
+
+import (
+	"io"
+	"time"
+)
+
+type wsConn interface {
+	io.Closer
+	ReadMessage() (messageType int, p []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+	SetWriteDeadline(t time.Time) error
+}
+
+type eventsProvider interface {
+	getEventsFrom(counter uint64) ([]*event, error)
+}
@@ -0,0 +1,167 @@
+package websocketDriver
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/gorilla/websocket"
+)
+
+const writeTimeout = time.Second * 10
+
+type argsSubscriber struct {
+	conn               wsConn
+	provider           eventsProvider
+	marshaller         marshal.Marshalizer
+	startCounter       uint64
+	withAcknowledge    bool
+	acknowledgeTimeout time.Duration
+	onClose            func(sub *subscriber)
+}
+
+// subscriber pushes, in order, all the events starting from a given counter on a single web socket connection
+type subscriber struct {
+	conn               wsConn
+	provider           eventsProvider
+	marshaller         marshal.Marshalizer
+	nextCounter        uint64
+	withAcknowledge    bool
+	acknowledgeTimeout time.Duration
+	onClose            func(sub *subscriber)
+
+	chNewEvents chan struct{}
+	chAcks      chan uint64
+	chClosed    chan struct{}
+	closeOnce   sync.Once
+}
+
+func newSubscriber(args argsSubscriber) *subscriber {
+	return &subscriber{
+		conn:               args.conn,
+		provider:           args.provider,
+		marshaller:         args.marshaller,
+		nextCounter:        args.startCounter,
+		withAcknowledge:    args.withAcknowledge,
+		acknowledgeTimeout: args.acknowledgeTimeout,
+		onClose:            args.onClose,
+		chNewEvents:        make(chan struct{}, 1),
+		chAcks:             make(chan uint64),
+		chClosed:           make(chan struct{}),
+	}
+}
+
+// notifyNewEvents signals the subscriber that new events are available. It never blocks
+func (sub *subscriber) notifyNewEvents() {
+	select {
+	case sub.chNewEvents <- struct{}{}:
+	default:
+	}
+}
+
+// run starts reading from the connection and pushes the events until the connection is closed or an error occurs
+func (sub *subscriber) run() {
+	go sub.readContinuously()
+
+	err := sub.sendContinuously()
+	if err != nil {
+		log.Debug("websocket subscriber stopped", "next counter", sub.nextCounter, "reason", err)
+	}
+
+	sub.close()
+}
+
+func (sub *subscriber) sendContinuously() error {
+	for {
+		events, err := sub.provider.getEventsFrom(sub.nextCounter)
+		if err != nil {
+			return err
+		}
+
+		for _, ev := range events {
+			err = sub.send(ev)
+			if err != nil {
+				return err
+			}
+
+			sub.nextCounter = ev.counter + 1
+		}
+
+		select {
+		case <-sub.chNewEvents:
+		case <-sub.chClosed:
+			return ErrSubscriberClosed
+		}
+	}
+}
+
+func (sub *subscriber) send(ev *event) error {
+	err := sub.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err != nil {
+		return err
+	}
+
+	err = sub.conn.WriteMessage(websocket.TextMessage, ev.buff)
+	if err != nil {
+		return err
+	}
+
+	if !sub.withAcknowledge {
+		return nil
+	}
+
+	return sub.waitAcknowledge(ev.counter)
+}
+
+func (sub *subscriber) waitAcknowledge(counter uint64) error {
+	select {
+	case ackCounter := <-sub.chAcks:
+		if ackCounter != counter {
+			return fmt.Errorf("%w, expected counter %d, received %d", ErrUnexpectedAcknowledge, counter, ackCounter)
+		}
+		return nil
+	case <-time.After(sub.acknowledgeTimeout):
+		return fmt.Errorf("%w for counter %d", ErrAcknowledgeTimeout, counter)
+	case <-sub.chClosed:
+		return ErrSubscriberClosed
+	}
+}
+
+func (sub *subscriber) readContinuously() {
+	defer sub.close()
+
+	for {
+		_, message, err := sub.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if !sub.withAcknowledge {
+			continue
+		}
+
+		ack := &Acknowledge{}
+		err = sub.marshaller.Unmarshal(ack, message)
+		if err != nil {
+			log.Debug("websocket subscriber sent an invalid acknowledge", "error", err)
+			return
+		}
+
+		select {
+		case sub.chAcks <- ack.Counter:
+		case <-sub.chClosed:
+			return
+		}
+	}
+}
+
+func (sub *subscriber) close() {
+	sub.closeOnce.Do(func() {
+		close(sub.chClosed)
+		_ = sub.conn.Close()
+
+		if sub.onClose != nil {
+			sub.onClose(sub)
+		}
+	})
+}
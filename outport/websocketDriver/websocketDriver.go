@@ -0,0 +1,482 @@
+package websocketDriver
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	nodeData "github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/gorilla/websocket"
+)
+
+var log = logger.GetOrCreate("outport/websocketDriver")
+
+const (
+	// SubscribePath is the path on which the subscribers should open the web socket connections
+	SubscribePath = "/outport"
+	// FromNonceQueryParam is the optional query parameter used by a subscriber to resume from a given block nonce
+	FromNonceQueryParam = "fromNonce"
+
+	readHeaderTimeout = time.Second * 5
+)
+
+// ArgsWebSocketDriver holds the arguments needed to create a new web socket outport driver
+type ArgsWebSocketDriver struct {
+	URL                 string
+	WithAcknowledge     bool
+	AcknowledgeTimeout  time.Duration
+	RetainedEventsCount int
+	MaxSubscribers      int
+	Marshaller          marshal.Marshalizer
+	InternalMarshaller  marshal.Marshalizer
+	Hasher              hashing.Hasher
+}
+
+// webSocketDriver is an outport driver that runs a web socket server and pushes, in order, the saved, reverted and
+// finalized blocks to all the connected subscribers. The last events are retained in memory so that a subscriber can
+// resume from a given block nonce after reconnecting
+type webSocketDriver struct {
+	marshaller          marshal.Marshalizer
+	internalMarshaller  marshal.Marshalizer
+	hasher              hashing.Hasher
+	withAcknowledge     bool
+	acknowledgeTimeout  time.Duration
+	retainedEventsCount int
+	maxSubscribers      int
+
+	mutEvents   sync.RWMutex
+	events      []*event
+	nextCounter uint64
+	blockNonces map[string]uint64
+
+	mutSubscribers sync.RWMutex
+	subscribers    map[*subscriber]struct{}
+	numReserved    int
+
+	upgrader websocket.Upgrader
+	listener net.Listener
+	server   *http.Server
+}
+
+// NewWebSocketDriver creates a new web socket outport driver and starts listening on the provided URL
+func NewWebSocketDriver(args ArgsWebSocketDriver) (*webSocketDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", args.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	driver := &webSocketDriver{
+		marshaller:          args.Marshaller,
+		internalMarshaller:  args.InternalMarshaller,
+		hasher:              args.Hasher,
+		withAcknowledge:     args.WithAcknowledge,
+		acknowledgeTimeout:  args.AcknowledgeTimeout,
+		retainedEventsCount: args.RetainedEventsCount,
+		maxSubscribers:      args.MaxSubscribers,
+		events:              make([]*event, 0, args.RetainedEventsCount),
+		nextCounter:         1,
+		blockNonces:         make(map[string]uint64),
+		subscribers:         make(map[*subscriber]struct{}),
+		listener:            listener,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(SubscribePath, driver.handleSubscription)
+	driver.server = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	go driver.serve()
+
+	return driver, nil
+}
+
+func checkArgs(args ArgsWebSocketDriver) error {
+	if len(args.URL) == 0 {
+		return ErrEmptyURL
+	}
+	if args.RetainedEventsCount < 1 {
+		return fmt.Errorf("%w, provided: %d", ErrInvalidRetainedEventsCount, args.RetainedEventsCount)
+	}
+	if args.MaxSubscribers < 1 {
+		return fmt.Errorf("%w, provided: %d", ErrInvalidMaxSubscribers, args.MaxSubscribers)
+	}
+	if args.WithAcknowledge && args.AcknowledgeTimeout <= 0 {
+		return fmt.Errorf("%w, provided: %v", ErrInvalidAcknowledgeTimeout, args.AcknowledgeTimeout)
+	}
+	if check.IfNil(args.Marshaller) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.InternalMarshaller) {
+		return fmt.Errorf("%w for the internal marshaller", core.ErrNilMarshalizer)
+	}
+	if check.IfNil(args.Hasher) {
+		return core.ErrNilHasher
+	}
+
+	return nil
+}
+
+func (wsd *webSocketDriver) serve() {
+	log.Info("websocket outport driver started", "address", wsd.listener.Addr().String(), "path", SubscribePath)
+
+	err := wsd.server.Serve(wsd.listener)
+	if err != nil && err != http.ErrServerClosed {
+		log.Error("websocket outport driver stopped", "error", err)
+	}
+}
+
+func (wsd *webSocketDriver) handleSubscription(w http.ResponseWriter, r *http.Request) {
+	startCounter, err := wsd.getStartCounter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !wsd.reserveSubscriber() {
+		http.Error(w, ErrTooManySubscribers.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	conn, err := wsd.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		wsd.releaseSubscriber()
+		log.Debug("websocket outport driver: cannot upgrade connection", "error", err)
+		return
+	}
+
+	sub := newSubscriber(argsSubscriber{
+		conn:               conn,
+		provider:           wsd,
+		marshaller:         wsd.marshaller,
+		startCounter:       startCounter,
+		withAcknowledge:    wsd.withAcknowledge,
+		acknowledgeTimeout: wsd.acknowledgeTimeout,
+		onClose:            wsd.removeSubscriber,
+	})
+
+	wsd.mutSubscribers.Lock()
+	wsd.subscribers[sub] = struct{}{}
+	wsd.mutSubscribers.Unlock()
+
+	log.Debug("websocket outport driver: new subscriber", "remote address", r.RemoteAddr, "start counter", startCounter)
+
+	go sub.run()
+}
+
+func (wsd *webSocketDriver) getStartCounter(r *http.Request) (uint64, error) {
+	fromNonceStr := r.URL.Query().Get(FromNonceQueryParam)
+	if fromNonceStr == "" {
+		wsd.mutEvents.RLock()
+		defer wsd.mutEvents.RUnlock()
+
+		return wsd.nextCounter, nil
+	}
+
+	fromNonce, err := strconv.ParseUint(fromNonceStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", FromNonceQueryParam, err)
+	}
+
+	return wsd.getCounterForNonce(fromNonce)
+}
+
+// getCounterForNonce returns the counter of the first retained saved block having a nonce greater or equal to the
+// provided one. If no blocks are retained, the subscriber will only receive the new events
+func (wsd *webSocketDriver) getCounterForNonce(nonce uint64) (uint64, error) {
+	wsd.mutEvents.RLock()
+	defer wsd.mutEvents.RUnlock()
+
+	isFirstBlock := true
+	for _, ev := range wsd.events {
+		if !ev.isBlock {
+			continue
+		}
+		if isFirstBlock && ev.nonce > nonce {
+			return 0, fmt.Errorf("%w, requested: %d, oldest retained: %d", ErrNonceNotRetained, nonce, ev.nonce)
+		}
+		isFirstBlock = false
+
+		if ev.nonce >= nonce {
+			return ev.counter, nil
+		}
+	}
+
+	return wsd.nextCounter, nil
+}
+
+// getEventsFrom returns all the retained events starting with the provided counter
+func (wsd *webSocketDriver) getEventsFrom(counter uint64) ([]*event, error) {
+	wsd.mutEvents.RLock()
+	defer wsd.mutEvents.RUnlock()
+
+	if len(wsd.events) == 0 || counter >= wsd.nextCounter {
+		return nil, nil
+	}
+
+	oldestCounter := wsd.events[0].counter
+	if counter < oldestCounter {
+		return nil, fmt.Errorf("%w, requested counter: %d, oldest retained: %d", ErrSubscriberTooSlow, counter, oldestCounter)
+	}
+
+	startIndex := int(counter - oldestCounter)
+	events := make([]*event, len(wsd.events)-startIndex)
+	copy(events, wsd.events[startIndex:])
+
+	return events, nil
+}
+
+// reserveSubscriber reserves a slot for a new subscriber, returning false if all the slots are taken. The slot is
+// reserved before upgrading the connection, so the simultaneous subscription attempts cannot exceed the maximum
+func (wsd *webSocketDriver) reserveSubscriber() bool {
+	wsd.mutSubscribers.Lock()
+	defer wsd.mutSubscribers.Unlock()
+
+	if wsd.numReserved >= wsd.maxSubscribers {
+		return false
+	}
+	wsd.numReserved++
+
+	return true
+}
+
+func (wsd *webSocketDriver) releaseSubscriber() {
+	wsd.mutSubscribers.Lock()
+	wsd.numReserved--
+	wsd.mutSubscribers.Unlock()
+}
+
+func (wsd *webSocketDriver) removeSubscriber(sub *subscriber) {
+	wsd.mutSubscribers.Lock()
+	_, found := wsd.subscribers[sub]
+	if found {
+		delete(wsd.subscribers, sub)
+		wsd.numReserved--
+	}
+	wsd.mutSubscribers.Unlock()
+}
+
+func (wsd *webSocketDriver) pushEvent(messageType string, nonce uint64, headerHash []byte, isBlock bool, payload interface{}) error {
+	wsd.mutEvents.Lock()
+
+	msg := &Message{
+		Counter: wsd.nextCounter,
+		Type:    messageType,
+		Nonce:   nonce,
+		Payload: payload,
+	}
+	buff, err := wsd.marshaller.Marshal(msg)
+	if err != nil {
+		wsd.mutEvents.Unlock()
+		return err
+	}
+
+	ev := &event{
+		counter:    wsd.nextCounter,
+		nonce:      nonce,
+		headerHash: string(headerHash),
+		isBlock:    isBlock,
+		buff:       buff,
+	}
+	wsd.nextCounter++
+	wsd.events = append(wsd.events, ev)
+	if isBlock {
+		wsd.blockNonces[ev.headerHash] = nonce
+	}
+	wsd.evictOldEvents()
+
+	wsd.mutEvents.Unlock()
+
+	wsd.notifySubscribers()
+
+	return nil
+}
+
+func (wsd *webSocketDriver) evictOldEvents() {
+	numEvicted := len(wsd.events) - wsd.retainedEventsCount
+	if numEvicted <= 0 {
+		return
+	}
+
+	for _, ev := range wsd.events[:numEvicted] {
+		if ev.isBlock {
+			delete(wsd.blockNonces, ev.headerHash)
+		}
+	}
+
+	wsd.events = append(wsd.events[:0], wsd.events[numEvicted:]...)
+}
+
+func (wsd *webSocketDriver) notifySubscribers() {
+	wsd.mutSubscribers.RLock()
+	defer wsd.mutSubscribers.RUnlock()
+
+	for sub := range wsd.subscribers {
+		sub.notifyNewEvents()
+	}
+}
+
+// SaveBlock pushes the saved block to all the subscribers
+func (wsd *webSocketDriver) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	if args == nil {
+		return ErrNilSaveBlockArgs
+	}
+	if check.IfNil(args.Header) {
+		return ErrNilHeader
+	}
+
+	pool := args.TransactionsPool
+	if pool == nil {
+		pool = &indexer.Pool{}
+	}
+
+	blockData := &SaveBlockData{
+		Hash:                   hex.EncodeToString(args.HeaderHash),
+		Header:                 args.Header,
+		Body:                   args.Body,
+		SignersIndexes:         args.SignersIndexes,
+		NotarizedHeadersHashes: args.NotarizedHeadersHashes,
+		HeaderGasConsumption:   args.HeaderGasConsumption,
+		Txs:                    encodeTransactionsKeys(pool.Txs),
+		Scrs:                   encodeTransactionsKeys(pool.Scrs),
+		Rewards:                encodeTransactionsKeys(pool.Rewards),
+		InvalidTxs:             encodeTransactionsKeys(pool.Invalid),
+		Receipts:               encodeTransactionsKeys(pool.Receipts),
+		Logs:                   encodeLogsKeys(pool.Logs),
+		AlteredAccounts:        args.AlteredAccounts,
+	}
+
+	err := wsd.pushEvent(SaveBlockMessageType, args.Header.GetNonce(), args.HeaderHash, true, blockData)
+	if err != nil {
+		return fmt.Errorf("%w in webSocketDriver.SaveBlock", err)
+	}
+
+	return nil
+}
+
+func encodeTransactionsKeys(txs map[string]nodeData.TransactionHandler) map[string]nodeData.TransactionHandler {
+	encodedTxs := make(map[string]nodeData.TransactionHandler, len(txs))
+	for txHash, tx := range txs {
+		encodedTxs[hex.EncodeToString([]byte(txHash))] = tx
+	}
+
+	return encodedTxs
+}
+
+func encodeLogsKeys(logs []*nodeData.LogData) map[string]nodeData.LogHandler {
+	encodedLogs := make(map[string]nodeData.LogHandler, len(logs))
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		encodedLogs[hex.EncodeToString([]byte(logData.TxHash))] = logData.LogHandler
+	}
+
+	return encodedLogs
+}
+
+// RevertIndexedBlock pushes the reverted block to all the subscribers
+func (wsd *webSocketDriver) RevertIndexedBlock(header nodeData.HeaderHandler, _ nodeData.BodyHandler) error {
+	if check.IfNil(header) {
+		return ErrNilHeader
+	}
+
+	blockHash, err := core.CalculateHash(wsd.internalMarshaller, wsd.hasher, header)
+	if err != nil {
+		return fmt.Errorf("%w in webSocketDriver.RevertIndexedBlock while computing the block hash", err)
+	}
+
+	revertBlock := &RevertBlock{
+		Hash:    hex.EncodeToString(blockHash),
+		Nonce:   header.GetNonce(),
+		Round:   header.GetRound(),
+		Epoch:   header.GetEpoch(),
+		ShardID: header.GetShardID(),
+	}
+
+	err = wsd.pushEvent(RevertIndexedBlockMessageType, header.GetNonce(), blockHash, false, revertBlock)
+	if err != nil {
+		return fmt.Errorf("%w in webSocketDriver.RevertIndexedBlock", err)
+	}
+
+	return nil
+}
+
+// FinalizedBlock pushes the finalized block hash to all the subscribers
+func (wsd *webSocketDriver) FinalizedBlock(headerHash []byte) error {
+	wsd.mutEvents.RLock()
+	nonce := wsd.blockNonces[string(headerHash)]
+	wsd.mutEvents.RUnlock()
+
+	finalizedBlock := &FinalizedBlock{
+		Hash:  hex.EncodeToString(headerHash),
+		Nonce: nonce,
+	}
+
+	err := wsd.pushEvent(FinalizedBlockMessageType, nonce, headerHash, false, finalizedBlock)
+	if err != nil {
+		return fmt.Errorf("%w in webSocketDriver.FinalizedBlock", err)
+	}
+
+	return nil
+}
+
+// SaveRoundsInfo returns nil
+func (wsd *webSocketDriver) SaveRoundsInfo(_ []*indexer.RoundInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys returns nil
+func (wsd *webSocketDriver) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) error {
+	return nil
+}
+
+// SaveValidatorsRating returns nil
+func (wsd *webSocketDriver) SaveValidatorsRating(_ string, _ []*indexer.ValidatorRatingInfo) error {
+	return nil
+}
+
+// SaveAccounts returns nil
+func (wsd *webSocketDriver) SaveAccounts(_ uint64, _ []nodeData.UserAccountHandler) error {
+	return nil
+}
+
+// Close stops the web socket server and disconnects all the subscribers
+func (wsd *webSocketDriver) Close() error {
+	err := wsd.server.Close()
+
+	wsd.mutSubscribers.RLock()
+	subscribers := make([]*subscriber, 0, len(wsd.subscribers))
+	for sub := range wsd.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+	wsd.mutSubscribers.RUnlock()
+
+	for _, sub := range subscribers {
+		sub.close()
+	}
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wsd *webSocketDriver) IsInterfaceNil() bool {
+	return wsd == nil
+}
@@ -0,0 +1,364 @@
+package websocketDriver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const readTimeout = time.Second * 5
+
+func createMockArgsWebSocketDriver() ArgsWebSocketDriver {
+	return ArgsWebSocketDriver{
+		URL:                 "127.0.0.1:0",
+		WithAcknowledge:     false,
+		AcknowledgeTimeout:  time.Second,
+		RetainedEventsCount: 100,
+		MaxSubscribers:      10,
+		Marshaller:          &marshal.JsonMarshalizer{},
+		InternalMarshaller:  &testscommon.MarshalizerMock{},
+		Hasher:              &hashingMocks.HasherMock{},
+	}
+}
+
+func createDriver(t *testing.T, args ArgsWebSocketDriver) *webSocketDriver {
+	driver, err := NewWebSocketDriver(args)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = driver.Close()
+	})
+
+	return driver
+}
+
+func dial(driver *webSocketDriver, query string) (*websocket.Conn, *http.Response, error) {
+	url := fmt.Sprintf("ws://%s%s%s", driver.listener.Addr().String(), SubscribePath, query)
+
+	return websocket.DefaultDialer.Dial(url, nil)
+}
+
+func connect(t *testing.T, driver *webSocketDriver, query string) *websocket.Conn {
+	conn, _, err := dial(driver, query)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	waitSubscribers(t, driver, 1)
+
+	return conn
+}
+
+func waitSubscribers(t *testing.T, driver *webSocketDriver, expected int) {
+	deadline := time.Now().Add(readTimeout)
+	for time.Now().Before(deadline) {
+		driver.mutSubscribers.RLock()
+		numSubscribers := len(driver.subscribers)
+		driver.mutSubscribers.RUnlock()
+
+		if numSubscribers == expected {
+			return
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	require.Fail(t, "subscribers not registered in time")
+}
+
+func readMessage(t *testing.T, conn *websocket.Conn) *Message {
+	_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
+	_, buff, err := conn.ReadMessage()
+	require.Nil(t, err)
+
+	msg := &Message{}
+	err = json.Unmarshal(buff, msg)
+	require.Nil(t, err)
+
+	return msg
+}
+
+func saveBlock(t *testing.T, driver *webSocketDriver, nonce uint64) {
+	err := driver.SaveBlock(&indexer.ArgsSaveBlockData{
+		HeaderHash: []byte(fmt.Sprintf("hash%d", nonce)),
+		Header:     &block.Header{Nonce: nonce},
+		Body:       &block.Body{},
+	})
+	require.Nil(t, err)
+}
+
+func TestNewWebSocketDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebSocketDriver()
+		args.URL = ""
+		driver, err := NewWebSocketDriver(args)
+		assert.Nil(t, driver)
+		assert.Equal(t, ErrEmptyURL, err)
+	})
+	t.Run("invalid retained events count should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebSocketDriver()
+		args.RetainedEventsCount = 0
+		driver, err := NewWebSocketDriver(args)
+		assert.Nil(t, driver)
+		assert.True(t, errors.Is(err, ErrInvalidRetainedEventsCount))
+	})
+	t.Run("invalid max subscribers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebSocketDriver()
+		args.MaxSubscribers = 0
+		driver, err := NewWebSocketDriver(args)
+		assert.Nil(t, driver)
+		assert.True(t, errors.Is(err, ErrInvalidMaxSubscribers))
+	})
+	t.Run("invalid acknowledge timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebSocketDriver()
+		args.WithAcknowledge = true
+		args.AcknowledgeTimeout = 0
+		driver, err := NewWebSocketDriver(args)
+		assert.Nil(t, driver)
+		assert.True(t, errors.Is(err, ErrInvalidAcknowledgeTimeout))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebSocketDriver()
+		args.Marshaller = nil
+		driver, err := NewWebSocketDriver(args)
+		assert.Nil(t, driver)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("nil internal marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebSocketDriver()
+		args.InternalMarshaller = nil
+		driver, err := NewWebSocketDriver(args)
+		assert.Nil(t, driver)
+		assert.True(t, errors.Is(err, core.ErrNilMarshalizer))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebSocketDriver()
+		args.Hasher = nil
+		driver, err := NewWebSocketDriver(args)
+		assert.Nil(t, driver)
+		assert.Equal(t, core.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		driver, err := NewWebSocketDriver(createMockArgsWebSocketDriver())
+		assert.Nil(t, err)
+		assert.False(t, driver.IsInterfaceNil())
+
+		err = driver.Close()
+		assert.Nil(t, err)
+	})
+}
+
+func TestWebSocketDriver_SaveBlockInvalidArgs(t *testing.T) {
+	t.Parallel()
+
+	driver := createDriver(t, createMockArgsWebSocketDriver())
+
+	err := driver.SaveBlock(nil)
+	assert.Equal(t, ErrNilSaveBlockArgs, err)
+
+	err = driver.SaveBlock(&indexer.ArgsSaveBlockData{})
+	assert.Equal(t, ErrNilHeader, err)
+
+	err = driver.RevertIndexedBlock(nil, nil)
+	assert.Equal(t, ErrNilHeader, err)
+}
+
+func TestWebSocketDriver_ShouldPushEventsInOrder(t *testing.T) {
+	t.Parallel()
+
+	driver := createDriver(t, createMockArgsWebSocketDriver())
+	conn := connect(t, driver, "")
+
+	saveBlock(t, driver, 10)
+	err := driver.RevertIndexedBlock(&block.Header{Nonce: 10}, &block.Body{})
+	require.Nil(t, err)
+	saveBlock(t, driver, 11)
+	err = driver.FinalizedBlock([]byte("hash11"))
+	require.Nil(t, err)
+
+	msg := readMessage(t, conn)
+	assert.Equal(t, uint64(1), msg.Counter)
+	assert.Equal(t, SaveBlockMessageType, msg.Type)
+	assert.Equal(t, uint64(10), msg.Nonce)
+
+	msg = readMessage(t, conn)
+	assert.Equal(t, uint64(2), msg.Counter)
+	assert.Equal(t, RevertIndexedBlockMessageType, msg.Type)
+	assert.Equal(t, uint64(10), msg.Nonce)
+
+	msg = readMessage(t, conn)
+	assert.Equal(t, uint64(3), msg.Counter)
+	assert.Equal(t, SaveBlockMessageType, msg.Type)
+	assert.Equal(t, uint64(11), msg.Nonce)
+
+	msg = readMessage(t, conn)
+	assert.Equal(t, uint64(4), msg.Counter)
+	assert.Equal(t, FinalizedBlockMessageType, msg.Type)
+	assert.Equal(t, uint64(11), msg.Nonce)
+}
+
+func TestWebSocketDriver_WithAcknowledge(t *testing.T) {
+	t.Parallel()
+
+	t.Run("acknowledged messages should continue the stream", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebSocketDriver()
+		args.WithAcknowledge = true
+		driver := createDriver(t, args)
+		conn := connect(t, driver, "")
+
+		saveBlock(t, driver, 1)
+		saveBlock(t, driver, 2)
+
+		for i := uint64(1); i <= 2; i++ {
+			msg := readMessage(t, conn)
+			assert.Equal(t, i, msg.Nonce)
+
+			err := conn.WriteJSON(&Acknowledge{Counter: msg.Counter})
+			require.Nil(t, err)
+		}
+	})
+	t.Run("wrong acknowledge should close the connection", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebSocketDriver()
+		args.WithAcknowledge = true
+		driver := createDriver(t, args)
+		conn := connect(t, driver, "")
+
+		saveBlock(t, driver, 1)
+		saveBlock(t, driver, 2)
+
+		msg := readMessage(t, conn)
+		err := conn.WriteJSON(&Acknowledge{Counter: msg.Counter + 100})
+		require.Nil(t, err)
+
+		_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
+		_, _, err = conn.ReadMessage()
+		assert.NotNil(t, err)
+		waitSubscribers(t, driver, 0)
+	})
+}
+
+func TestWebSocketDriver_ResumeFromNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retained nonce should resume from it", func(t *testing.T) {
+		t.Parallel()
+
+		driver := createDriver(t, createMockArgsWebSocketDriver())
+		for nonce := uint64(1); nonce <= 5; nonce++ {
+			saveBlock(t, driver, nonce)
+		}
+
+		conn := connect(t, driver, "?fromNonce=3")
+		for nonce := uint64(3); nonce <= 5; nonce++ {
+			msg := readMessage(t, conn)
+			assert.Equal(t, nonce, msg.Nonce)
+			assert.Equal(t, nonce, msg.Counter)
+		}
+
+		saveBlock(t, driver, 6)
+		msg := readMessage(t, conn)
+		assert.Equal(t, uint64(6), msg.Nonce)
+	})
+	t.Run("evicted nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebSocketDriver()
+		args.RetainedEventsCount = 2
+		driver := createDriver(t, args)
+		for nonce := uint64(1); nonce <= 5; nonce++ {
+			saveBlock(t, driver, nonce)
+		}
+
+		_, resp, err := dial(driver, "?fromNonce=2")
+		require.NotNil(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		_ = resp.Body.Close()
+	})
+	t.Run("invalid nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		driver := createDriver(t, createMockArgsWebSocketDriver())
+
+		_, resp, err := dial(driver, "?fromNonce=abc")
+		require.NotNil(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		_ = resp.Body.Close()
+	})
+}
+
+func TestWebSocketDriver_GetEventsFromEvictedCounterShouldError(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsWebSocketDriver()
+	args.RetainedEventsCount = 2
+	driver := createDriver(t, args)
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		saveBlock(t, driver, nonce)
+	}
+
+	events, err := driver.getEventsFrom(1)
+	assert.Nil(t, events)
+	assert.True(t, errors.Is(err, ErrSubscriberTooSlow))
+
+	events, err = driver.getEventsFrom(4)
+	assert.Nil(t, err)
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, uint64(4), events[0].nonce)
+	assert.Equal(t, uint64(5), events[1].nonce)
+	assert.Equal(t, 2, len(driver.blockNonces))
+}
+
+func TestWebSocketDriver_ShouldRejectTheSubscribersOverTheMaximum(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsWebSocketDriver()
+	args.MaxSubscribers = 1
+	driver := createDriver(t, args)
+	conn := connect(t, driver, "")
+
+	_, resp, err := dial(driver, "")
+	require.NotNil(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	_ = conn.Close()
+	waitSubscribers(t, driver, 0)
+
+	connect(t, driver, "")
+}
@@ -0,0 +1,159 @@
+package blockInfo
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var log = logger.GetOrCreate("process/blockInfo")
+
+var _ process.BlockInfoProvider = (*blockInfoProvider)(nil)
+
+// maxBlocksSearchedForRootHash is the number of blocks, starting with the current one, searched for the header of a
+// provided root hash
+const maxBlocksSearchedForRootHash = 100
+
+// ArgsBlockInfoProvider holds the arguments needed to create a new block info provider
+type ArgsBlockInfoProvider struct {
+	SelfShardID              uint32
+	BlockChain               data.ChainHandler
+	StorageService           dataRetriever.StorageService
+	Marshaller               marshal.Marshalizer
+	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+}
+
+type blockInfoProvider struct {
+	selfShardID              uint32
+	blockChain               data.ChainHandler
+	storageService           dataRetriever.StorageService
+	marshaller               marshal.Marshalizer
+	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+}
+
+// NewBlockInfoProvider creates a component able to resolve committed blocks from storage
+func NewBlockInfoProvider(args ArgsBlockInfoProvider) (*blockInfoProvider, error) {
+	if check.IfNil(args.BlockChain) {
+		return nil, process.ErrNilBlockChain
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return nil, process.ErrNilUint64Converter
+	}
+
+	return &blockInfoProvider{
+		selfShardID:              args.SelfShardID,
+		blockChain:               args.BlockChain,
+		storageService:           args.StorageService,
+		marshaller:               args.Marshaller,
+		uint64ByteSliceConverter: args.Uint64ByteSliceConverter,
+	}, nil
+}
+
+// GetBlockInfo returns the header, the header hash and the state root hash of the block identified by the provided options.
+// If only the root hash is provided, the header is searched among the most recent blocks and the returned header and
+// header hash will be nil if none of them has the provided root hash
+func (bip *blockInfoProvider) GetBlockInfo(options common.BlockQueryOptions) (data.HeaderHandler, []byte, []byte, error) {
+	err := checkBlockQueryOptions(options)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if len(options.BlockRootHash) > 0 {
+		header, headerHash := bip.searchHeaderWithRootHash(options.BlockRootHash)
+		return header, headerHash, options.BlockRootHash, nil
+	}
+
+	var header data.HeaderHandler
+	var headerHash []byte
+	if options.BlockNonce.HasValue {
+		header, headerHash, err = process.GetHeaderFromStorageWithNonce(
+			options.BlockNonce.Value,
+			bip.selfShardID,
+			bip.storageService,
+			bip.uint64ByteSliceConverter,
+			bip.marshaller,
+		)
+	} else {
+		headerHash = options.BlockHash
+		header, err = bip.getHeaderByHash(headerHash)
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return header, headerHash, header.GetRootHash(), nil
+}
+
+func (bip *blockInfoProvider) searchHeaderWithRootHash(rootHash []byte) (data.HeaderHandler, []byte) {
+	header := bip.blockChain.GetCurrentBlockHeader()
+	headerHash := bip.blockChain.GetCurrentBlockHeaderHash()
+	for i := 0; i < maxBlocksSearchedForRootHash && !check.IfNil(header); i++ {
+		if bytes.Equal(header.GetRootHash(), rootHash) {
+			return header, headerHash
+		}
+		if header.GetNonce() == 0 {
+			break
+		}
+
+		var err error
+		header, headerHash, err = process.GetHeaderFromStorageWithNonce(
+			header.GetNonce()-1,
+			bip.selfShardID,
+			bip.storageService,
+			bip.uint64ByteSliceConverter,
+			bip.marshaller,
+		)
+		if err != nil {
+			log.Trace("searchHeaderWithRootHash: header not found", "error", err)
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (bip *blockInfoProvider) getHeaderByHash(headerHash []byte) (data.HeaderHandler, error) {
+	if bip.selfShardID == core.MetachainShardId {
+		return process.GetMetaHeaderFromStorage(headerHash, bip.marshaller, bip.storageService)
+	}
+
+	return process.GetShardHeaderFromStorage(headerHash, bip.marshaller, bip.storageService)
+}
+
+func checkBlockQueryOptions(options common.BlockQueryOptions) error {
+	numIdentifiers := 0
+	if options.BlockNonce.HasValue {
+		numIdentifiers++
+	}
+	if len(options.BlockHash) > 0 {
+		numIdentifiers++
+	}
+	if len(options.BlockRootHash) > 0 {
+		numIdentifiers++
+	}
+
+	if numIdentifiers != 1 {
+		return process.ErrInvalidBlockQueryOptions
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bip *blockInfoProvider) IsInterfaceNil() bool {
+	return bip == nil
+}
@@ -0,0 +1,244 @@
+package blockInfo
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsBlockInfoProvider() ArgsBlockInfoProvider {
+	return ArgsBlockInfoProvider{
+		SelfShardID:              0,
+		BlockChain:               &testscommon.ChainHandlerStub{},
+		StorageService:           genericMocks.NewChainStorerMock(0),
+		Marshaller:               &testscommon.MarshalizerMock{},
+		Uint64ByteSliceConverter: testscommon.NewNonceHashConverterMock(),
+	}
+}
+
+func TestNewBlockInfoProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil block chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockInfoProvider()
+		args.BlockChain = nil
+		bip, err := NewBlockInfoProvider(args)
+
+		assert.True(t, check.IfNil(bip))
+		assert.Equal(t, process.ErrNilBlockChain, err)
+	})
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockInfoProvider()
+		args.StorageService = nil
+		bip, err := NewBlockInfoProvider(args)
+
+		assert.True(t, check.IfNil(bip))
+		assert.Equal(t, process.ErrNilStorage, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockInfoProvider()
+		args.Marshaller = nil
+		bip, err := NewBlockInfoProvider(args)
+
+		assert.True(t, check.IfNil(bip))
+		assert.Equal(t, process.ErrNilMarshalizer, err)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockInfoProvider()
+		args.Uint64ByteSliceConverter = nil
+		bip, err := NewBlockInfoProvider(args)
+
+		assert.True(t, check.IfNil(bip))
+		assert.Equal(t, process.ErrNilUint64Converter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		bip, err := NewBlockInfoProvider(createMockArgsBlockInfoProvider())
+
+		assert.False(t, check.IfNil(bip))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBlockInfoProvider_GetBlockInfo(t *testing.T) {
+	t.Parallel()
+
+	headerHash := []byte("header hash")
+	header := &block.Header{
+		Nonce:    37,
+		RootHash: []byte("root hash"),
+	}
+
+	createStorageService := func(args ArgsBlockInfoProvider) *genericMocks.ChainStorerMock {
+		store := genericMocks.NewChainStorerMock(0)
+		headerBytes, _ := args.Marshaller.Marshal(header)
+		_ = store.HdrNonce.Put(headerHash, headerBytes)
+		_ = store.HdrNonce.Put(args.Uint64ByteSliceConverter.ToByteSlice(header.Nonce), headerHash)
+
+		return store
+	}
+
+	t.Run("no identifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		bip, _ := NewBlockInfoProvider(createMockArgsBlockInfoProvider())
+		_, _, _, err := bip.GetBlockInfo(common.BlockQueryOptions{})
+
+		assert.Equal(t, process.ErrInvalidBlockQueryOptions, err)
+	})
+	t.Run("more identifiers should error", func(t *testing.T) {
+		t.Parallel()
+
+		bip, _ := NewBlockInfoProvider(createMockArgsBlockInfoProvider())
+		_, _, _, err := bip.GetBlockInfo(common.BlockQueryOptions{
+			BlockNonce: common.OptionalUint64{Value: 0, HasValue: true},
+			BlockHash:  headerHash,
+		})
+
+		assert.Equal(t, process.ErrInvalidBlockQueryOptions, err)
+	})
+	t.Run("unknown root hash should return it without header", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockInfoProvider()
+		args.StorageService = createStorageService(args)
+		args.BlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: header.Nonce + 1, RootHash: []byte("current root hash")}
+			},
+		}
+		bip, _ := NewBlockInfoProvider(args)
+		hdr, hash, rootHash, err := bip.GetBlockInfo(common.BlockQueryOptions{
+			BlockRootHash: []byte("provided root hash"),
+		})
+
+		assert.Nil(t, err)
+		assert.Nil(t, hdr)
+		assert.Nil(t, hash)
+		assert.Equal(t, []byte("provided root hash"), rootHash)
+	})
+	t.Run("root hash of the current block should return the current header", func(t *testing.T) {
+		t.Parallel()
+
+		currentHeader := &block.Header{Nonce: header.Nonce + 1, RootHash: []byte("current root hash")}
+		args := createMockArgsBlockInfoProvider()
+		args.BlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return currentHeader
+			},
+			GetCurrentBlockHeaderHashCalled: func() []byte {
+				return []byte("current header hash")
+			},
+		}
+		bip, _ := NewBlockInfoProvider(args)
+		hdr, hash, rootHash, err := bip.GetBlockInfo(common.BlockQueryOptions{
+			BlockRootHash: currentHeader.RootHash,
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, currentHeader, hdr)
+		assert.Equal(t, []byte("current header hash"), hash)
+		assert.Equal(t, currentHeader.RootHash, rootHash)
+	})
+	t.Run("root hash of a previous block should return its header", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockInfoProvider()
+		args.StorageService = createStorageService(args)
+		args.BlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: header.Nonce + 1, RootHash: []byte("current root hash")}
+			},
+		}
+		bip, _ := NewBlockInfoProvider(args)
+		hdr, hash, rootHash, err := bip.GetBlockInfo(common.BlockQueryOptions{
+			BlockRootHash: header.RootHash,
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, header.Nonce, hdr.GetNonce())
+		assert.Equal(t, headerHash, hash)
+		assert.Equal(t, header.RootHash, rootHash)
+	})
+	t.Run("missing header should error", func(t *testing.T) {
+		t.Parallel()
+
+		bip, _ := NewBlockInfoProvider(createMockArgsBlockInfoProvider())
+		_, _, _, err := bip.GetBlockInfo(common.BlockQueryOptions{
+			BlockHash: headerHash,
+		})
+
+		assert.NotNil(t, err)
+	})
+	t.Run("by hash should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockInfoProvider()
+		args.StorageService = createStorageService(args)
+		bip, _ := NewBlockInfoProvider(args)
+		hdr, hash, rootHash, err := bip.GetBlockInfo(common.BlockQueryOptions{
+			BlockHash: headerHash,
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, header.Nonce, hdr.GetNonce())
+		assert.Equal(t, headerHash, hash)
+		assert.Equal(t, header.RootHash, rootHash)
+	})
+	t.Run("by nonce should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockInfoProvider()
+		args.StorageService = createStorageService(args)
+		bip, _ := NewBlockInfoProvider(args)
+		hdr, hash, rootHash, err := bip.GetBlockInfo(common.BlockQueryOptions{
+			BlockNonce: common.OptionalUint64{Value: header.Nonce, HasValue: true},
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, header.Nonce, hdr.GetNonce())
+		assert.Equal(t, headerHash, hash)
+		assert.Equal(t, header.RootHash, rootHash)
+	})
+	t.Run("metachain by hash should work", func(t *testing.T) {
+		t.Parallel()
+
+		metaHeader := &block.MetaBlock{
+			Nonce:    38,
+			RootHash: []byte("meta root hash"),
+		}
+		args := createMockArgsBlockInfoProvider()
+		args.SelfShardID = core.MetachainShardId
+		store := genericMocks.NewChainStorerMock(0)
+		metaHeaderBytes, _ := args.Marshaller.Marshal(metaHeader)
+		_ = store.HdrNonce.Put(headerHash, metaHeaderBytes)
+		args.StorageService = store
+
+		bip, _ := NewBlockInfoProvider(args)
+		hdr, _, rootHash, err := bip.GetBlockInfo(common.BlockQueryOptions{
+			BlockHash: headerHash,
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, metaHeader.Nonce, hdr.GetNonce())
+		assert.Equal(t, metaHeader.RootHash, rootHash)
+	})
+}
@@ -84,13 +84,22 @@ func (txv *txValidator) CheckTxValidity(interceptedTx process.TxValidatorHandler
 	accountNonce := accountHandler.GetNonce()
 	txNonce := interceptedTx.Nonce()
 	lowerNonceInTx := txNonce < accountNonce
+	if lowerNonceInTx {
+		return fmt.Errorf("%w, for address: %s, account nonce: %d, tx nonce: %d",
+			process.ErrLowerNonceInTransaction,
+			txv.pubkeyConverter.Encode(senderAddress),
+			accountNonce,
+			txNonce,
+		)
+	}
+
 	veryHighNonceInTx := txNonce > accountNonce+uint64(txv.maxNonceDeltaAllowed)
-	isTxRejected := lowerNonceInTx || veryHighNonceInTx
-	if isTxRejected {
-		return fmt.Errorf("%w lowerNonceInTx: %v, veryHighNonceInTx: %v",
-			process.ErrWrongTransaction,
-			lowerNonceInTx,
-			veryHighNonceInTx,
+	if veryHighNonceInTx {
+		return fmt.Errorf("%w, for address: %s, account nonce: %d, tx nonce: %d",
+			process.ErrHigherNonceInTransaction,
+			txv.pubkeyConverter.Encode(senderAddress),
+			accountNonce,
+			txNonce,
 		)
 	}
 
@@ -204,7 +204,7 @@ func TestTxValidator_CheckTxValidityAccountNonceIsGreaterThanTxNonceShouldReturn
 	txValidatorHandler := getTxValidatorHandler(currentShard, currentShard, txNonce, addressMock, big.NewInt(0))
 
 	result := txValidator.CheckTxValidity(txValidatorHandler)
-	assert.True(t, errors.Is(result, process.ErrWrongTransaction))
+	assert.True(t, errors.Is(result, process.ErrLowerNonceInTransaction))
 }
 
 func TestTxValidator_CheckTxValidityTxNonceIsTooHigh(t *testing.T) {
@@ -230,7 +230,7 @@ func TestTxValidator_CheckTxValidityTxNonceIsTooHigh(t *testing.T) {
 	txValidatorHandler := getTxValidatorHandler(currentShard, currentShard, txNonce, addressMock, big.NewInt(0))
 
 	result := txValidator.CheckTxValidity(txValidatorHandler)
-	assert.True(t, errors.Is(result, process.ErrWrongTransaction))
+	assert.True(t, errors.Is(result, process.ErrHigherNonceInTransaction))
 }
 
 func TestTxValidator_CheckTxValidityAccountBalanceIsLessThanTxTotalValueShouldReturnFalse(t *testing.T) {
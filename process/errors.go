@@ -1085,3 +1085,19 @@ var ErrMetaHeaderEpochOutOfRange = errors.New("epoch out of range for meta block
 
 // ErrNilESDTGlobalSettingsHandler signals that nil global settings handler was provided
 var ErrNilESDTGlobalSettingsHandler = errors.New("nil esdt global settings handler")
+
+// ErrNilBlockInfoProvider signals that a nil block info provider was provided
+var ErrNilBlockInfoProvider = errors.New("nil block info provider")
+
+// ErrNilStateOverridesHandler signals that a nil state overrides handler was provided
+var ErrNilStateOverridesHandler = errors.New("nil state overrides handler")
+
+// ErrStateOverridesNotSupported signals that the state overrides are not supported by the component
+var ErrStateOverridesNotSupported = errors.New("state overrides are not supported")
+
+// ErrNoBlockForRootHash signals that no recent block was found for the root hash provided for a VM query, so the block
+// information read by the contracts could not match the state
+var ErrNoBlockForRootHash = errors.New("no recent block found for the provided root hash, query by block nonce or block hash instead")
+
+// ErrInvalidBlockQueryOptions signals that the block identifiers provided for a state query are not valid
+var ErrInvalidBlockQueryOptions = errors.New("exactly one of block nonce, block hash or block root hash should be provided")
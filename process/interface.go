@@ -728,6 +728,8 @@ type SCQuery struct {
 	Arguments      [][]byte
 	SameScState    bool
 	ShouldBeSynced bool
+	BlockOptions   common.BlockQueryOptions
+	StateOverrides []*common.AccountStateOverride
 }
 
 // GasHandler is able to perform some gas calculation
@@ -866,6 +868,18 @@ type PeerValidatorMapper interface {
 	IsInterfaceNil() bool
 }
 
+// BlockInfoProvider is able to resolve the header, the header hash and the state root hash of a committed block
+type BlockInfoProvider interface {
+	GetBlockInfo(options common.BlockQueryOptions) (data.HeaderHandler, []byte, []byte, error)
+	IsInterfaceNil() bool
+}
+
+// StateOverridesHandler is able to run a function over a state in which the values of some accounts are overridden
+type StateOverridesHandler interface {
+	RunWithStateOverrides(overrides []*common.AccountStateOverride, handler func() error) error
+	IsInterfaceNil() bool
+}
+
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *SCQuery) (*vmcommon.VMOutput, error)
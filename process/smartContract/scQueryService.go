@@ -3,6 +3,7 @@ package smartContract
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"sync"
@@ -26,6 +27,9 @@ type SCQueryService struct {
 	mutRunSc                 sync.Mutex
 	blockChainHook           process.BlockChainHookHandler
 	blockChain               data.ChainHandler
+	apiBlockChain            data.ChainHandler
+	blockInfoProvider        process.BlockInfoProvider
+	stateOverridesHandler    process.StateOverridesHandler
 	numQueries               int
 	gasForQuery              uint64
 	arwenChangeLocker        common.Locker
@@ -39,6 +43,9 @@ type ArgsNewSCQueryService struct {
 	EconomicsFee             process.FeeHandler
 	BlockChainHook           process.BlockChainHookHandler
 	BlockChain               data.ChainHandler
+	APIBlockChain            data.ChainHandler
+	BlockInfoProvider        process.BlockInfoProvider
+	StateOverridesHandler    process.StateOverridesHandler
 	ArwenChangeLocker        common.Locker
 	Bootstrapper             process.Bootstrapper
 	AllowExternalQueriesChan chan struct{}
@@ -61,6 +68,15 @@ func NewSCQueryService(
 	if check.IfNil(args.BlockChain) {
 		return nil, process.ErrNilBlockChain
 	}
+	if check.IfNil(args.APIBlockChain) {
+		return nil, fmt.Errorf("%w for API block chain", process.ErrNilBlockChain)
+	}
+	if check.IfNil(args.BlockInfoProvider) {
+		return nil, process.ErrNilBlockInfoProvider
+	}
+	if check.IfNil(args.StateOverridesHandler) {
+		return nil, process.ErrNilStateOverridesHandler
+	}
 	if check.IfNilReflect(args.ArwenChangeLocker) {
 		return nil, process.ErrNilLocker
 	}
@@ -79,6 +95,9 @@ func NewSCQueryService(
 		vmContainer:              args.VmContainer,
 		economicsFee:             args.EconomicsFee,
 		blockChain:               args.BlockChain,
+		apiBlockChain:            args.APIBlockChain,
+		blockInfoProvider:        args.BlockInfoProvider,
+		stateOverridesHandler:    args.StateOverridesHandler,
 		blockChainHook:           args.BlockChainHook,
 		arwenChangeLocker:        args.ArwenChangeLocker,
 		bootstrapper:             args.Bootstrapper,
@@ -124,14 +143,18 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 		return nil, process.ErrNodeIsNotSynced
 	}
 
-	shouldCheckRootHashChanges := query.SameScState
+	isHistoricalQuery := !query.BlockOptions.IsEmpty()
+	shouldCheckRootHashChanges := query.SameScState && !isHistoricalQuery
 	rootHashBeforeExecution := make([]byte, 0)
 
 	if shouldCheckRootHashChanges {
 		rootHashBeforeExecution = service.blockChain.GetCurrentBlockRootHash()
 	}
 
-	service.blockChainHook.SetCurrentHeader(service.blockChain.GetCurrentBlockHeader())
+	err := service.prepareBlockContext(query.BlockOptions)
+	if err != nil {
+		return nil, err
+	}
 
 	service.arwenChangeLocker.RLock()
 	vm, err := findVMByScAddress(service.vmContainer, query.ScAddress)
@@ -142,7 +165,7 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 
 	query = prepareScQuery(query)
 	vmInput := service.createVMCallInput(query, gasPrice)
-	vmOutput, err := vm.RunSmartContractCall(vmInput)
+	vmOutput, err := service.runSmartContractCall(vm, vmInput, query.StateOverrides)
 	service.arwenChangeLocker.RUnlock()
 	if err != nil {
 		return nil, err
@@ -151,13 +174,13 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	if service.hasRetriableExecutionError(vmOutput) {
 		log.Error("Retriable execution error detected. Will retry (once) executeScCall()", "returnCode", vmOutput.ReturnCode, "returnMessage", vmOutput.ReturnMessage)
 
-		vmOutput, err = vm.RunSmartContractCall(vmInput)
+		vmOutput, err = service.runSmartContractCall(vm, vmInput, query.StateOverrides)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	if query.SameScState {
+	if shouldCheckRootHashChanges {
 		err = service.checkForRootHashChanges(rootHashBeforeExecution)
 		if err != nil {
 			return nil, err
@@ -167,6 +190,60 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	return vmOutput, nil
 }
 
+// runSmartContractCall runs the call over the state of the selected block, in which the provided accounts values are
+// overridden, if any
+func (service *SCQueryService) runSmartContractCall(
+	vm vmcommon.VMExecutionHandler,
+	vmInput *vmcommon.ContractCallInput,
+	overrides []*common.AccountStateOverride,
+) (*vmcommon.VMOutput, error) {
+	if len(overrides) == 0 {
+		return vm.RunSmartContractCall(vmInput)
+	}
+
+	var vmOutput *vmcommon.VMOutput
+	err := service.stateOverridesHandler.RunWithStateOverrides(overrides, func() error {
+		var errRun error
+		vmOutput, errRun = vm.RunSmartContractCall(vmInput)
+		return errRun
+	})
+
+	return vmOutput, err
+}
+
+// prepareBlockContext sets on the API block chain the block the query will be executed against. The accounts
+// adapter used by the VM follows the API block chain, so the state trie is recreated on the selected root hash
+func (service *SCQueryService) prepareBlockContext(options common.BlockQueryOptions) error {
+	header := service.blockChain.GetCurrentBlockHeader()
+	headerHash := service.blockChain.GetCurrentBlockHeaderHash()
+	rootHash := service.blockChain.GetCurrentBlockRootHash()
+
+	if !options.IsEmpty() {
+		historicalHeader, historicalHeaderHash, historicalRootHash, err := service.blockInfoProvider.GetBlockInfo(options)
+		if err != nil {
+			return err
+		}
+
+		// the contracts read the block information from the header, which must be the one of the queried state
+		if check.IfNil(historicalHeader) {
+			return process.ErrNoBlockForRootHash
+		}
+
+		header = historicalHeader
+		headerHash = historicalHeaderHash
+		rootHash = historicalRootHash
+	}
+
+	err := service.apiBlockChain.SetCurrentBlockHeaderAndRootHash(header, rootHash)
+	if err != nil {
+		return err
+	}
+	service.apiBlockChain.SetCurrentBlockHeaderHash(headerHash)
+	service.blockChainHook.SetCurrentHeader(header)
+
+	return nil
+}
+
 func (service *SCQueryService) checkForRootHashChanges(rootHashBefore []byte) error {
 	rootHashAfter := service.blockChain.GetCurrentBlockRootHash()
 
@@ -3,6 +3,7 @@ package smartContract
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"sync"
@@ -30,6 +31,9 @@ func createMockArgumentsForSCQuery() ArgsNewSCQueryService {
 		EconomicsFee:             &mock.FeeHandlerStub{},
 		BlockChainHook:           &testscommon.BlockChainHookStub{},
 		BlockChain:               &testscommon.ChainHandlerStub{},
+		APIBlockChain:            &testscommon.ChainHandlerStub{},
+		BlockInfoProvider:        &testscommon.BlockInfoProviderStub{},
+		StateOverridesHandler:    &testscommon.StateOverridesHandlerStub{},
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             &mock.BootstrapperStub{},
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
@@ -69,6 +73,39 @@ func TestNewSCQueryService_NilBLockChainShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilBlockChain, err)
 }
 
+func TestNewSCQueryService_NilAPIBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery()
+	args.APIBlockChain = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.True(t, errors.Is(err, process.ErrNilBlockChain))
+}
+
+func TestNewSCQueryService_NilBlockInfoProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery()
+	args.BlockInfoProvider = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilBlockInfoProvider, err)
+}
+
+func TestNewSCQueryService_NilStateOverridesHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery()
+	args.StateOverridesHandler = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilStateOverridesHandler, err)
+}
+
 func TestNewSCQueryService_NilBLockChainHookShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -657,6 +694,231 @@ func TestSCQueryService_ShouldWorkIfStateDidntChange(t *testing.T) {
 	require.NotNil(t, res)
 }
 
+func TestSCQueryService_ExecuteQueryShouldUseCurrentBlockWhenNoBlockOptions(t *testing.T) {
+	t.Parallel()
+
+	currentHeader := &block.Header{Nonce: 37}
+	currentRootHash := []byte("current root hash")
+	args := createMockArgumentsForSCQuery()
+	args.BlockChain = &testscommon.ChainHandlerStub{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return currentHeader
+		},
+		GetCurrentBlockRootHashCalled: func() []byte {
+			return currentRootHash
+		},
+	}
+	args.BlockInfoProvider = &testscommon.BlockInfoProviderStub{
+		GetBlockInfoCalled: func(options common.BlockQueryOptions) (data.HeaderHandler, []byte, []byte, error) {
+			require.Fail(t, "should have not called GetBlockInfo")
+			return nil, nil, nil, nil
+		},
+	}
+	var setHeader data.HeaderHandler
+	var setRootHash []byte
+	args.APIBlockChain = &testscommon.ChainHandlerStub{
+		SetCurrentBlockHeaderAndRootHashCalled: func(header data.HeaderHandler, rootHash []byte) error {
+			setHeader = header
+			setRootHash = rootHash
+			return nil
+		},
+	}
+
+	qs, _ := NewSCQueryService(args)
+	_, err := qs.ExecuteQuery(&process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+	})
+	require.Nil(t, err)
+	assert.Equal(t, currentHeader, setHeader)
+	assert.Equal(t, currentRootHash, setRootHash)
+}
+
+func TestSCQueryService_ExecuteQueryWithBlockOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("block info provider errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgumentsForSCQuery()
+		args.BlockInfoProvider = &testscommon.BlockInfoProviderStub{
+			GetBlockInfoCalled: func(options common.BlockQueryOptions) (data.HeaderHandler, []byte, []byte, error) {
+				return nil, nil, nil, expectedErr
+			},
+		}
+
+		qs, _ := NewSCQueryService(args)
+		res, err := qs.ExecuteQuery(&process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+			BlockOptions: common.BlockQueryOptions{
+				BlockNonce: common.OptionalUint64{Value: 5, HasValue: true},
+			},
+		})
+		assert.Nil(t, res)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("root hash without block should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForSCQuery()
+		args.BlockInfoProvider = &testscommon.BlockInfoProviderStub{
+			GetBlockInfoCalled: func(options common.BlockQueryOptions) (data.HeaderHandler, []byte, []byte, error) {
+				return nil, nil, options.BlockRootHash, nil
+			},
+		}
+
+		qs, _ := NewSCQueryService(args)
+		res, err := qs.ExecuteQuery(&process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+			BlockOptions: common.BlockQueryOptions{
+				BlockRootHash: []byte("root hash"),
+			},
+		})
+		assert.Nil(t, res)
+		assert.Equal(t, process.ErrNoBlockForRootHash, err)
+	})
+	t.Run("should run on the historical block and skip the state change check", func(t *testing.T) {
+		t.Parallel()
+
+		historicalHeader := &block.Header{Nonce: 5}
+		historicalHeaderHash := []byte("historical header hash")
+		historicalRootHash := []byte("historical root hash")
+		args := createMockArgumentsForSCQuery()
+		numRootHashCalls := 0
+		args.BlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockRootHashCalled: func() []byte {
+				numRootHashCalls++
+				return []byte(fmt.Sprintf("root hash %d", numRootHashCalls))
+			},
+		}
+		args.BlockInfoProvider = &testscommon.BlockInfoProviderStub{
+			GetBlockInfoCalled: func(options common.BlockQueryOptions) (data.HeaderHandler, []byte, []byte, error) {
+				assert.Equal(t, historicalHeaderHash, options.BlockHash)
+				return historicalHeader, historicalHeaderHash, historicalRootHash, nil
+			},
+		}
+		var setHeader data.HeaderHandler
+		var setRootHash []byte
+		var setHeaderHash []byte
+		args.APIBlockChain = &testscommon.ChainHandlerStub{
+			SetCurrentBlockHeaderAndRootHashCalled: func(header data.HeaderHandler, rootHash []byte) error {
+				setHeader = header
+				setRootHash = rootHash
+				return nil
+			},
+			SetCurrentBlockHeaderHashCalled: func(hash []byte) {
+				setHeaderHash = hash
+			},
+		}
+		var hookHeader data.HeaderHandler
+		args.BlockChainHook = &testscommon.BlockChainHookStub{
+			SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+				hookHeader = hdr
+			},
+		}
+
+		qs, _ := NewSCQueryService(args)
+		res, err := qs.ExecuteQuery(&process.SCQuery{
+			ScAddress:   []byte(DummyScAddress),
+			FuncName:    "function",
+			SameScState: true,
+			BlockOptions: common.BlockQueryOptions{
+				BlockHash: historicalHeaderHash,
+			},
+		})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, historicalHeader, setHeader)
+		assert.Equal(t, historicalRootHash, setRootHash)
+		assert.Equal(t, historicalHeaderHash, setHeaderHash)
+		assert.Equal(t, historicalHeader, hookHeader)
+	})
+}
+
+func TestSCQueryService_ExecuteQueryWithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no overrides should not use the state overrides handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgumentsForSCQuery()
+		args.StateOverridesHandler = &testscommon.StateOverridesHandlerStub{
+			RunWithStateOverridesCalled: func(overrides []*common.AccountStateOverride, handler func() error) error {
+				require.Fail(t, "should have not called RunWithStateOverrides")
+				return nil
+			},
+		}
+
+		qs, _ := NewSCQueryService(args)
+		_, err := qs.ExecuteQuery(&process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+		})
+		require.Nil(t, err)
+	})
+	t.Run("state overrides handler errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgumentsForSCQuery()
+		args.StateOverridesHandler = &testscommon.StateOverridesHandlerStub{
+			RunWithStateOverridesCalled: func(overrides []*common.AccountStateOverride, handler func() error) error {
+				return expectedErr
+			},
+		}
+
+		qs, _ := NewSCQueryService(args)
+		res, err := qs.ExecuteQuery(&process.SCQuery{
+			ScAddress:      []byte(DummyScAddress),
+			FuncName:       "function",
+			StateOverrides: []*common.AccountStateOverride{{Address: []byte("address")}},
+		})
+		assert.Nil(t, res)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should run the call over the overridden state", func(t *testing.T) {
+		t.Parallel()
+
+		isStateOverridden := false
+		mockVM := &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+				assert.True(t, isStateOverridden)
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+			},
+		}
+		args := createMockArgumentsForSCQuery()
+		args.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		}
+		overrides := []*common.AccountStateOverride{{Address: []byte("address"), Balance: big.NewInt(10)}}
+		args.StateOverridesHandler = &testscommon.StateOverridesHandlerStub{
+			RunWithStateOverridesCalled: func(providedOverrides []*common.AccountStateOverride, handler func() error) error {
+				assert.Equal(t, overrides, providedOverrides)
+				isStateOverridden = true
+				defer func() {
+					isStateOverridden = false
+				}()
+
+				return handler()
+			},
+		}
+
+		qs, _ := NewSCQueryService(args)
+		res, err := qs.ExecuteQuery(&process.SCQuery{
+			ScAddress:      []byte(DummyScAddress),
+			FuncName:       "function",
+			StateOverrides: overrides,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, vmcommon.Ok, res.ReturnCode)
+	})
+}
+
 func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 	t.Parallel()
 
@@ -742,6 +1004,9 @@ func TestNewSCQueryService_CloseShouldWork(t *testing.T) {
 		EconomicsFee:             &mock.FeeHandlerStub{},
 		BlockChainHook:           &testscommon.BlockChainHookStub{},
 		BlockChain:               &testscommon.ChainHandlerStub{},
+		APIBlockChain:            &testscommon.ChainHandlerStub{},
+		BlockInfoProvider:        &testscommon.BlockInfoProviderStub{},
+		StateOverridesHandler:    &testscommon.StateOverridesHandlerStub{},
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             &mock.BootstrapperStub{},
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
@@ -0,0 +1,110 @@
+package txsimulator
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+// ArgsBundleAccountsCreator holds the arguments required for creating a new bundle accounts creator
+type ArgsBundleAccountsCreator struct {
+	TrieStorageManager   common.StorageManager
+	MaxTrieLevelInMemory uint
+	ChainHandler         data.ChainHandler
+	Hasher               hashing.Hasher
+	Marshalizer          marshal.Marshalizer
+	AccountFactory       state.AccountFactory
+	ProcessStatusHandler common.ProcessStatusHandler
+}
+
+type bundleAccountsCreator struct {
+	trieStorageManager   common.StorageManager
+	maxTrieLevelInMemory uint
+	chainHandler         data.ChainHandler
+	hasher               hashing.Hasher
+	marshalizer          marshal.Marshalizer
+	accountFactory       state.AccountFactory
+	processStatusHandler common.ProcessStatusHandler
+}
+
+// NewBundleAccountsCreator returns a new instance of bundleAccountsCreator
+func NewBundleAccountsCreator(args ArgsBundleAccountsCreator) (*bundleAccountsCreator, error) {
+	if check.IfNil(args.TrieStorageManager) {
+		return nil, ErrNilTrieStorageManager
+	}
+	if check.IfNil(args.ChainHandler) {
+		return nil, ErrNilChainHandler
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.AccountFactory) {
+		return nil, ErrNilAccountFactory
+	}
+	if check.IfNil(args.ProcessStatusHandler) {
+		return nil, ErrNilProcessStatusHandler
+	}
+
+	readOnlyStorage, err := newReadOnlyStorageManager(args.TrieStorageManager)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bundleAccountsCreator{
+		trieStorageManager:   readOnlyStorage,
+		maxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+		chainHandler:         args.ChainHandler,
+		hasher:               args.Hasher,
+		marshalizer:          args.Marshalizer,
+		accountFactory:       args.AccountFactory,
+		processStatusHandler: args.ProcessStatusHandler,
+	}, nil
+}
+
+// CreateAccountsAdapter creates a disposable accounts adapter over the state of the current block. The trie of the
+// adapter is recreated over a read-only storage manager, so the adapter never writes in the node's trie storage
+func (bac *bundleAccountsCreator) CreateAccountsAdapter() (state.AccountsAdapter, error) {
+	rootHash := bac.chainHandler.GetCurrentBlockRootHash()
+	if len(rootHash) == 0 {
+		return nil, ErrNilRootHash
+	}
+
+	readOnlyTrie, err := trie.NewTrie(bac.trieStorageManager, bac.marshalizer, bac.hasher, bac.maxTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	args := state.ArgsAccountsDB{
+		Trie:                  readOnlyTrie,
+		Hasher:                bac.hasher,
+		Marshaller:            bac.marshalizer,
+		AccountFactory:        bac.accountFactory,
+		StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
+		ProcessingMode:        common.Normal,
+		ProcessStatusHandler:  bac.processStatusHandler,
+	}
+	accountsAdapter, err := state.NewAccountsDB(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = accountsAdapter.RecreateTrie(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountsAdapter, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bac *bundleAccountsCreator) IsInterfaceNil() bool {
+	return bac == nil
+}
@@ -0,0 +1,178 @@
+package txsimulator
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/factory"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsBundleAccountsCreator() ArgsBundleAccountsCreator {
+	return ArgsBundleAccountsCreator{
+		TrieStorageManager:   createTestTrie().GetStorageManager(),
+		MaxTrieLevelInMemory: 5,
+		ChainHandler:         &testscommon.ChainHandlerStub{},
+		Hasher:               &hashingMocks.HasherMock{},
+		Marshalizer:          &testscommon.MarshalizerMock{},
+		AccountFactory:       factory.NewAccountCreator(),
+		ProcessStatusHandler: &testscommon.ProcessStatusHandlerStub{},
+	}
+}
+
+func TestNewBundleAccountsCreator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		argsFunc func() ArgsBundleAccountsCreator
+		exError  error
+	}{
+		{
+			name: "NilTrieStorageManager",
+			argsFunc: func() ArgsBundleAccountsCreator {
+				args := createMockArgsBundleAccountsCreator()
+				args.TrieStorageManager = nil
+				return args
+			},
+			exError: ErrNilTrieStorageManager,
+		},
+		{
+			name: "NilChainHandler",
+			argsFunc: func() ArgsBundleAccountsCreator {
+				args := createMockArgsBundleAccountsCreator()
+				args.ChainHandler = nil
+				return args
+			},
+			exError: ErrNilChainHandler,
+		},
+		{
+			name: "NilHasher",
+			argsFunc: func() ArgsBundleAccountsCreator {
+				args := createMockArgsBundleAccountsCreator()
+				args.Hasher = nil
+				return args
+			},
+			exError: ErrNilHasher,
+		},
+		{
+			name: "NilMarshalizer",
+			argsFunc: func() ArgsBundleAccountsCreator {
+				args := createMockArgsBundleAccountsCreator()
+				args.Marshalizer = nil
+				return args
+			},
+			exError: ErrNilMarshalizer,
+		},
+		{
+			name: "NilAccountFactory",
+			argsFunc: func() ArgsBundleAccountsCreator {
+				args := createMockArgsBundleAccountsCreator()
+				args.AccountFactory = nil
+				return args
+			},
+			exError: ErrNilAccountFactory,
+		},
+		{
+			name: "NilProcessStatusHandler",
+			argsFunc: func() ArgsBundleAccountsCreator {
+				args := createMockArgsBundleAccountsCreator()
+				args.ProcessStatusHandler = nil
+				return args
+			},
+			exError: ErrNilProcessStatusHandler,
+		},
+		{
+			name:     "Ok",
+			argsFunc: createMockArgsBundleAccountsCreator,
+			exError:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creator, err := NewBundleAccountsCreator(tt.argsFunc())
+			require.Equal(t, tt.exError, err)
+			require.Equal(t, tt.exError != nil, check.IfNil(creator))
+		})
+	}
+}
+
+func TestBundleAccountsCreator_CreateAccountsAdapter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing current root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewBundleAccountsCreator(createMockArgsBundleAccountsCreator())
+		accounts, err := creator.CreateAccountsAdapter()
+		require.Nil(t, accounts)
+		require.Equal(t, ErrNilRootHash, err)
+	})
+	t.Run("should create an accounts adapter over the current root hash", func(t *testing.T) {
+		t.Parallel()
+
+		address := []byte("alice-address-of-32-bytes-length")
+		originalAccounts, rootHash := createAccountsWithBalance(t, address, big.NewInt(37))
+		creator := createBundleAccountsCreatorForRootHash(originalAccounts, rootHash)
+
+		accounts, err := creator.CreateAccountsAdapter()
+		require.NoError(t, err)
+		currentRootHash, _ := accounts.RootHash()
+		require.Equal(t, rootHash, currentRootHash)
+
+		account, err := accounts.GetExistingAccount(address)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(37), account.(state.UserAccountHandler).GetBalance())
+	})
+	t.Run("the accounts adapter should never write in the trie storage", func(t *testing.T) {
+		t.Parallel()
+
+		address := []byte("alice-address-of-32-bytes-length")
+		originalAccounts, rootHash := createAccountsWithBalance(t, address, big.NewInt(37))
+		mainTrie, _ := originalAccounts.GetTrie(rootHash)
+		originalStorage := mainTrie.GetStorageManager()
+
+		failErrMsg := "this function should have not be called"
+		args := createMockArgsBundleAccountsCreator()
+		args.TrieStorageManager = &testscommon.StorageManagerStub{
+			GetCalled: originalStorage.Get,
+			GetFromCurrentEpochCalled: func(_ []byte) ([]byte, error) {
+				return nil, errors.New("missing key")
+			},
+			PutCalled: func(_ []byte, _ []byte) error {
+				t.Errorf(failErrMsg)
+				return nil
+			},
+			PutInEpochCalled: func(_ []byte, _ []byte, _ uint32) error {
+				t.Errorf(failErrMsg)
+				return nil
+			},
+			TakeSnapshotCalled: func(_ []byte, _ []byte, _ chan core.KeyValueHolder, _ chan error, _ common.SnapshotStatisticsHandler, _ uint32) {
+				t.Errorf(failErrMsg)
+			},
+		}
+		args.ChainHandler = &testscommon.ChainHandlerStub{
+			GetCurrentBlockRootHashCalled: func() []byte {
+				return rootHash
+			},
+		}
+		creator, _ := NewBundleAccountsCreator(args)
+
+		accounts, err := creator.CreateAccountsAdapter()
+		require.NoError(t, err)
+
+		account, _ := accounts.LoadAccount(address)
+		_ = account.(state.UserAccountHandler).AddToBalance(big.NewInt(5))
+		_ = accounts.SaveAccount(account)
+		_, err = accounts.Commit()
+		require.NoError(t, err)
+	})
+}
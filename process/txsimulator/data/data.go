@@ -2,6 +2,7 @@ package data
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -12,5 +13,89 @@ type SimulationResults struct {
 	ScResults  map[string]*transaction.ApiSmartContractResult `json:"scResults,omitempty"`
 	Receipts   map[string]*transaction.ApiReceipt             `json:"receipts,omitempty"`
 	Hash       string                                         `json:"hash,omitempty"`
+	Trace      *ExecutionTrace                                `json:"trace,omitempty"`
 	VMOutput   *vmcommon.VMOutput                             `json:"-"`
 }
+
+// SimulationOptions holds the optional settings of a transaction simulation
+type SimulationOptions struct {
+	StateOverrides []*common.AccountStateOverride
+	WithTrace      bool
+}
+
+// ExecutionTrace holds the details of a smart contract execution, as reported by the VM. The byte fields are hex
+// encoded, except for the function names and the log event identifiers
+type ExecutionTrace struct {
+	ReturnCode     string                `json:"returnCode"`
+	ReturnMessage  string                `json:"returnMessage,omitempty"`
+	ReturnData     []string              `json:"returnData,omitempty"`
+	GasRemaining   uint64                `json:"gasRemaining"`
+	CallTree       *CallFrame            `json:"callTree"`
+	OutputAccounts []*OutputAccountTrace `json:"outputAccounts,omitempty"`
+	Logs           []*LogEventTrace      `json:"logs,omitempty"`
+}
+
+// CallFrame holds a call executed or issued while processing a transaction, together with the calls it issued and, for
+// the asynchronous calls, their callback. The calls executed in the same shard report the gas used by their receiver,
+// while the calls issued through output transfers also report the gas they were given
+type CallFrame struct {
+	Sender    string       `json:"sender,omitempty"`
+	Receiver  string       `json:"receiver"`
+	Function  string       `json:"function,omitempty"`
+	CallType  string       `json:"callType"`
+	Value     string       `json:"value,omitempty"`
+	GasLimit  uint64       `json:"gasLimit,omitempty"`
+	GasLocked uint64       `json:"gasLocked,omitempty"`
+	GasUsed   uint64       `json:"gasUsed,omitempty"`
+	Calls     []*CallFrame `json:"calls,omitempty"`
+}
+
+// OutputAccountTrace holds the changes the VM requested for an account
+type OutputAccountTrace struct {
+	Address       string          `json:"address"`
+	Nonce         uint64          `json:"nonce"`
+	BalanceDelta  string          `json:"balanceDelta,omitempty"`
+	GasUsed       uint64          `json:"gasUsed,omitempty"`
+	CodeDeployed  bool            `json:"codeDeployed,omitempty"`
+	StorageWrites []*StorageWrite `json:"storageWrites,omitempty"`
+}
+
+// StorageWrite holds a data trie key written by the VM and its new value
+type StorageWrite struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// LogEventTrace holds a log event emitted during the execution
+type LogEventTrace struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics,omitempty"`
+	Data       string   `json:"data,omitempty"`
+}
+
+// BundleSimulationResults is the data transfer object which will hold the results of simulating the execution of an
+// ordered list of transactions, each one being executed over the state left by the previous ones
+type BundleSimulationResults struct {
+	Results   []*SimulationResults `json:"results"`
+	StateDiff []*AccountStateDiff  `json:"stateDiff"`
+}
+
+// AccountStateDiff holds the changes brought by a bundle of transactions to an account
+type AccountStateDiff struct {
+	Address        string         `json:"address"`
+	BalanceBefore  string         `json:"balanceBefore"`
+	BalanceAfter   string         `json:"balanceAfter"`
+	NonceBefore    uint64         `json:"nonceBefore"`
+	NonceAfter     uint64         `json:"nonceAfter"`
+	CodeHashBefore string         `json:"codeHashBefore,omitempty"`
+	CodeHashAfter  string         `json:"codeHashAfter,omitempty"`
+	Storage        []*StorageDiff `json:"storage,omitempty"`
+}
+
+// StorageDiff holds the change of a data trie key, all the fields being hex encoded
+type StorageDiff struct {
+	Key    string `json:"key"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
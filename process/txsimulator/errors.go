@@ -25,3 +25,33 @@ var ErrNilMarshalizer = errors.New("nil marshalizer provided")
 
 // ErrNilHasher signals that a nil hasher has been provided
 var ErrNilHasher = errors.New("nil hasher provided")
+
+// ErrNilTrieStorageManager signals that a nil trie storage manager has been provided
+var ErrNilTrieStorageManager = errors.New("nil trie storage manager")
+
+// ErrNilChainHandler signals that a nil chain handler has been provided
+var ErrNilChainHandler = errors.New("nil chain handler")
+
+// ErrNilAccountFactory signals that a nil account factory has been provided
+var ErrNilAccountFactory = errors.New("nil account factory")
+
+// ErrNilProcessStatusHandler signals that a nil process status handler has been provided
+var ErrNilProcessStatusHandler = errors.New("nil process status handler")
+
+// ErrNilRootHash signals that the current block root hash is not available
+var ErrNilRootHash = errors.New("nil root hash")
+
+// ErrNilBundleAccountsHandler signals that a nil bundle accounts handler has been provided
+var ErrNilBundleAccountsHandler = errors.New("nil bundle accounts handler")
+
+// ErrNilAccountsAdapterCreator signals that a nil accounts adapter creator has been provided
+var ErrNilAccountsAdapterCreator = errors.New("nil accounts adapter creator")
+
+// ErrEmptyBundle signals that a bundle without transactions has been provided
+var ErrEmptyBundle = errors.New("empty bundle of transactions")
+
+// ErrBundleSimulationTimeout signals that the simulation of a bundle of transactions did not finish in time
+var ErrBundleSimulationTimeout = errors.New("bundle simulation timeout")
+
+// ErrInvalidStateOverride signals that an invalid state override has been provided
+var ErrInvalidStateOverride = errors.New("invalid state override")
@@ -0,0 +1,319 @@
+package txsimulator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const (
+	callTypeSynchronousCall = "synchronousCall"
+	callTypeUnknown         = "unknown"
+)
+
+var callTypeNames = map[vm.CallType]string{
+	vm.DirectCall:             "directCall",
+	vm.AsynchronousCall:       "asynchronousCall",
+	vm.AsynchronousCallBack:   "asynchronousCallBack",
+	vm.ESDTTransferAndExecute: "esdtTransferAndExecute",
+	vm.ExecOnDestByCaller:     "execOnDestByCaller",
+}
+
+// createExecutionTrace builds the trace of a transaction out of the VM output. The calls are nested by following the
+// output transfers from their sender to their receiver, the callbacks being placed under the asynchronous call they
+// answer. The contracts which used gas without being reached by any transfer are placed under the root call, as the VM
+// does not report their caller
+func (ts *transactionSimulator) createExecutionTrace(tx *transaction.Transaction, vmOutput *vmcommon.VMOutput) *txSimData.ExecutionTrace {
+	outputAccounts := sortOutputAccounts(vmOutput.OutputAccounts)
+
+	trace := &txSimData.ExecutionTrace{
+		ReturnCode:     vmOutput.ReturnCode.String(),
+		ReturnMessage:  vmOutput.ReturnMessage,
+		ReturnData:     encodeAllToHex(vmOutput.ReturnData),
+		GasRemaining:   vmOutput.GasRemaining,
+		CallTree:       ts.createCallTree(tx, vmOutput, outputAccounts),
+		OutputAccounts: make([]*txSimData.OutputAccountTrace, 0, len(outputAccounts)),
+		Logs:           make([]*txSimData.LogEventTrace, 0, len(vmOutput.Logs)),
+	}
+
+	for _, outputAccount := range outputAccounts {
+		trace.OutputAccounts = append(trace.OutputAccounts, ts.createOutputAccountTrace(outputAccount))
+	}
+	for _, logEntry := range vmOutput.Logs {
+		if logEntry == nil {
+			continue
+		}
+
+		trace.Logs = append(trace.Logs, &txSimData.LogEventTrace{
+			Address:    ts.addressPubKeyConverter.Encode(logEntry.Address),
+			Identifier: string(logEntry.Identifier),
+			Topics:     encodeAllToHex(logEntry.Topics),
+			Data:       hex.EncodeToString(logEntry.Data),
+		})
+	}
+
+	return trace
+}
+
+// callTreeBuilder nests the call frames of a transaction, each frame being visited once, in breadth-first order
+type callTreeBuilder struct {
+	pubKeyConverter core.PubkeyConverter
+	transfers       []*pendingTransfer
+	gasUsed         map[string]uint64
+	queue           []*addressedFrame
+}
+
+type pendingTransfer struct {
+	receiver []byte
+	transfer *vmcommon.OutputTransfer
+	used     bool
+}
+
+type addressedFrame struct {
+	address []byte
+	frame   *txSimData.CallFrame
+}
+
+func (ts *transactionSimulator) createCallTree(
+	tx *transaction.Transaction,
+	vmOutput *vmcommon.VMOutput,
+	outputAccounts []*vmcommon.OutputAccount,
+) *txSimData.CallFrame {
+	root := &txSimData.CallFrame{
+		Sender:   ts.addressPubKeyConverter.Encode(tx.SndAddr),
+		Receiver: ts.addressPubKeyConverter.Encode(tx.RcvAddr),
+		Function: functionFromData(tx.Data),
+		CallType: callTypeNames[vm.DirectCall],
+		Value:    bigIntToString(tx.Value),
+		GasLimit: tx.GasLimit,
+		Calls:    make([]*txSimData.CallFrame, 0),
+	}
+	if tx.GasLimit >= vmOutput.GasRemaining {
+		root.GasUsed = tx.GasLimit - vmOutput.GasRemaining
+	}
+
+	builder := &callTreeBuilder{
+		pubKeyConverter: ts.addressPubKeyConverter,
+		transfers:       make([]*pendingTransfer, 0),
+		gasUsed:         make(map[string]uint64),
+	}
+	for _, outputAccount := range outputAccounts {
+		if outputAccount.GasUsed > 0 {
+			builder.gasUsed[string(outputAccount.Address)] = outputAccount.GasUsed
+		}
+		for idx := range outputAccount.OutputTransfers {
+			builder.transfers = append(builder.transfers, &pendingTransfer{
+				receiver: outputAccount.Address,
+				transfer: &outputAccount.OutputTransfers[idx],
+			})
+		}
+	}
+	// the gas used by the receiver of the transaction is already reported by the root
+	delete(builder.gasUsed, string(tx.RcvAddr))
+
+	builder.queue = append(builder.queue, &addressedFrame{address: tx.RcvAddr, frame: root})
+	builder.visitQueuedFrames()
+	builder.addRemainingCalls(root)
+
+	return root
+}
+
+// visitQueuedFrames nests under each queued frame the calls issued by its receiver, queueing them in turn
+func (ctb *callTreeBuilder) visitQueuedFrames() {
+	for len(ctb.queue) > 0 {
+		current := ctb.queue[0]
+		ctb.queue = ctb.queue[1:]
+
+		for _, pending := range ctb.transfers {
+			if pending.used || pending.transfer.CallType == vm.AsynchronousCallBack {
+				continue
+			}
+			if !bytes.Equal(pending.transfer.SenderAddress, current.address) {
+				continue
+			}
+
+			child := ctb.addCall(current, pending)
+			if pending.transfer.CallType == vm.AsynchronousCall {
+				ctb.addCallBack(child, current.address)
+			}
+		}
+	}
+}
+
+func (ctb *callTreeBuilder) addCall(parent *addressedFrame, pending *pendingTransfer) *addressedFrame {
+	pending.used = true
+	child := &addressedFrame{
+		address: pending.receiver,
+		frame:   ctb.createTransferFrame(pending),
+	}
+	parent.frame.Calls = append(parent.frame.Calls, child.frame)
+	ctb.queue = append(ctb.queue, child)
+
+	return child
+}
+
+// addCallBack nests the callback of an asynchronous call under that call, as it is executed once the call finished
+func (ctb *callTreeBuilder) addCallBack(asyncCall *addressedFrame, originalCaller []byte) {
+	for _, pending := range ctb.transfers {
+		if pending.used || pending.transfer.CallType != vm.AsynchronousCallBack {
+			continue
+		}
+		if !bytes.Equal(pending.transfer.SenderAddress, asyncCall.address) || !bytes.Equal(pending.receiver, originalCaller) {
+			continue
+		}
+
+		ctb.addCall(asyncCall, pending)
+		return
+	}
+}
+
+// addRemainingCalls places under the root the contracts which used gas without being reached by any transfer, and the
+// transfers issued by an address which is not part of the tree
+func (ctb *callTreeBuilder) addRemainingCalls(root *txSimData.CallFrame) {
+	addresses := make([]string, 0, len(ctb.gasUsed))
+	for address := range ctb.gasUsed {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	for _, address := range addresses {
+		gasUsed, found := ctb.gasUsed[address]
+		if !found {
+			// reached meanwhile by a transfer issued by a previously placed contract
+			continue
+		}
+
+		frame := &txSimData.CallFrame{
+			Receiver: ctb.pubKeyConverter.Encode([]byte(address)),
+			CallType: callTypeSynchronousCall,
+			GasUsed:  gasUsed,
+			Calls:    make([]*txSimData.CallFrame, 0),
+		}
+		delete(ctb.gasUsed, address)
+		root.Calls = append(root.Calls, frame)
+		ctb.queue = append(ctb.queue, &addressedFrame{address: []byte(address), frame: frame})
+		ctb.visitQueuedFrames()
+	}
+
+	for _, pending := range ctb.transfers {
+		if pending.used {
+			continue
+		}
+
+		ctb.addCall(&addressedFrame{frame: root}, pending)
+		ctb.visitQueuedFrames()
+	}
+}
+
+// createTransferFrame creates the frame of a call issued through an output transfer. The receiver reports the gas it
+// used only for the first frame it is reached by, as the VM sums the gas used by an address over all its executions
+func (ctb *callTreeBuilder) createTransferFrame(pending *pendingTransfer) *txSimData.CallFrame {
+	transfer := pending.transfer
+	frame := &txSimData.CallFrame{
+		Sender:    ctb.pubKeyConverter.Encode(transfer.SenderAddress),
+		Receiver:  ctb.pubKeyConverter.Encode(pending.receiver),
+		Function:  functionFromData(transfer.Data),
+		CallType:  callTypeName(transfer.CallType),
+		Value:     bigIntToString(transfer.Value),
+		GasLimit:  transfer.GasLimit,
+		GasLocked: transfer.GasLocked,
+	}
+
+	gasUsed, found := ctb.gasUsed[string(pending.receiver)]
+	if found {
+		frame.GasUsed = gasUsed
+		delete(ctb.gasUsed, string(pending.receiver))
+	}
+
+	return frame
+}
+
+func (ts *transactionSimulator) createOutputAccountTrace(outputAccount *vmcommon.OutputAccount) *txSimData.OutputAccountTrace {
+	accountTrace := &txSimData.OutputAccountTrace{
+		Address:       ts.addressPubKeyConverter.Encode(outputAccount.Address),
+		Nonce:         outputAccount.Nonce,
+		BalanceDelta:  bigIntToString(outputAccount.BalanceDelta),
+		GasUsed:       outputAccount.GasUsed,
+		CodeDeployed:  len(outputAccount.Code) > 0,
+		StorageWrites: make([]*txSimData.StorageWrite, 0, len(outputAccount.StorageUpdates)),
+	}
+
+	keys := make([]string, 0, len(outputAccount.StorageUpdates))
+	for key := range outputAccount.StorageUpdates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		storageUpdate := outputAccount.StorageUpdates[key]
+		if storageUpdate == nil {
+			continue
+		}
+
+		accountTrace.StorageWrites = append(accountTrace.StorageWrites, &txSimData.StorageWrite{
+			Key:   hex.EncodeToString(storageUpdate.Offset),
+			Value: hex.EncodeToString(storageUpdate.Data),
+		})
+	}
+
+	return accountTrace
+}
+
+func sortOutputAccounts(outputAccounts map[string]*vmcommon.OutputAccount) []*vmcommon.OutputAccount {
+	sorted := make([]*vmcommon.OutputAccount, 0, len(outputAccounts))
+	for _, outputAccount := range outputAccounts {
+		if outputAccount == nil {
+			continue
+		}
+
+		sorted = append(sorted, outputAccount)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Address, sorted[j].Address) < 0
+	})
+
+	return sorted
+}
+
+func functionFromData(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	return strings.Split(string(data), "@")[0]
+}
+
+func callTypeName(callType vm.CallType) string {
+	name, found := callTypeNames[callType]
+	if !found {
+		return callTypeUnknown
+	}
+
+	return name
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return ""
+	}
+
+	return value.String()
+}
+
+func encodeAllToHex(values [][]byte) []string {
+	encoded := make([]string, 0, len(values))
+	for _, value := range values {
+		encoded = append(encoded, hex.EncodeToString(value))
+	}
+
+	return encoded
+}
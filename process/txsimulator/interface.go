@@ -2,6 +2,7 @@ package txsimulator
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -11,3 +12,23 @@ type TransactionProcessor interface {
 	VerifyTransaction(transaction *transaction.Transaction) error
 	IsInterfaceNil() bool
 }
+
+// BundleAccountsHandler defines the accounts wrapper able to redirect its operations towards a disposable accounts
+// adapter while a bundle of transactions is simulated
+type BundleAccountsHandler interface {
+	StartBundle(bundleAccounts state.AccountsAdapter)
+	FinishBundle() []*TouchedAccount
+	IsInterfaceNil() bool
+}
+
+// overridableAccountHandler defines a user account whose values can be directly set by the state overrides
+type overridableAccountHandler interface {
+	state.UserAccountHandler
+	SetNonce(nonce uint64)
+}
+
+// AccountsAdapterCreator defines the component able to create disposable accounts adapters over the current state
+type AccountsAdapterCreator interface {
+	CreateAccountsAdapter() (state.AccountsAdapter, error)
+	IsInterfaceNil() bool
+}
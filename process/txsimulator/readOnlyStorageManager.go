@@ -0,0 +1,128 @@
+package txsimulator
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// readOnlyStorageManager is a wrapper over a trie storage manager which works read-only. Write operations, snapshots
+// and checkpoints are disabled, so that the tries of the disposable accounts adapters never alter the node's storage
+type readOnlyStorageManager struct {
+	storageManager common.StorageManager
+}
+
+// newReadOnlyStorageManager returns a new instance of readOnlyStorageManager
+func newReadOnlyStorageManager(storageManager common.StorageManager) (*readOnlyStorageManager, error) {
+	if check.IfNil(storageManager) {
+		return nil, ErrNilTrieStorageManager
+	}
+
+	return &readOnlyStorageManager{storageManager: storageManager}, nil
+}
+
+// Get will call the original storage manager's function with the same name
+func (r *readOnlyStorageManager) Get(key []byte) ([]byte, error) {
+	return r.storageManager.Get(key)
+}
+
+// GetFromCurrentEpoch will call the original storage manager's function with the same name
+func (r *readOnlyStorageManager) GetFromCurrentEpoch(key []byte) ([]byte, error) {
+	return r.storageManager.GetFromCurrentEpoch(key)
+}
+
+// Put won't do anything as write operations are disabled on this component
+func (r *readOnlyStorageManager) Put(_ []byte, _ []byte) error {
+	return nil
+}
+
+// PutInEpoch won't do anything as write operations are disabled on this component
+func (r *readOnlyStorageManager) PutInEpoch(_ []byte, _ []byte, _ uint32) error {
+	return nil
+}
+
+// Remove won't do anything as write operations are disabled on this component
+func (r *readOnlyStorageManager) Remove(_ []byte) error {
+	return nil
+}
+
+// TakeSnapshot won't do anything as write operations are disabled on this component
+func (r *readOnlyStorageManager) TakeSnapshot(
+	_ []byte,
+	_ []byte,
+	leavesChan chan core.KeyValueHolder,
+	_ chan error,
+	stats common.SnapshotStatisticsHandler,
+	_ uint32,
+) {
+	if leavesChan != nil {
+		close(leavesChan)
+	}
+	stats.SnapshotFinished()
+}
+
+// SetCheckpoint won't do anything as write operations are disabled on this component
+func (r *readOnlyStorageManager) SetCheckpoint(
+	_ []byte,
+	_ []byte,
+	leavesChan chan core.KeyValueHolder,
+	_ chan error,
+	stats common.SnapshotStatisticsHandler,
+) {
+	if leavesChan != nil {
+		close(leavesChan)
+	}
+	stats.SnapshotFinished()
+}
+
+// GetLatestStorageEpoch will call the original storage manager's function with the same name
+func (r *readOnlyStorageManager) GetLatestStorageEpoch() (uint32, error) {
+	return r.storageManager.GetLatestStorageEpoch()
+}
+
+// IsPruningEnabled returns false as write operations are disabled on this component
+func (r *readOnlyStorageManager) IsPruningEnabled() bool {
+	return false
+}
+
+// IsPruningBlocked returns false as write operations are disabled on this component
+func (r *readOnlyStorageManager) IsPruningBlocked() bool {
+	return false
+}
+
+// EnterPruningBufferingMode won't do anything as write operations are disabled on this component
+func (r *readOnlyStorageManager) EnterPruningBufferingMode() {
+}
+
+// ExitPruningBufferingMode won't do anything as write operations are disabled on this component
+func (r *readOnlyStorageManager) ExitPruningBufferingMode() {
+}
+
+// AddDirtyCheckpointHashes won't do anything as write operations are disabled on this component
+func (r *readOnlyStorageManager) AddDirtyCheckpointHashes(_ []byte, _ common.ModifiedHashes) bool {
+	return false
+}
+
+// SetEpochForPutOperation won't do anything as write operations are disabled on this component
+func (r *readOnlyStorageManager) SetEpochForPutOperation(_ uint32) {
+}
+
+// ShouldTakeSnapshot returns false as write operations are disabled on this component
+func (r *readOnlyStorageManager) ShouldTakeSnapshot() bool {
+	return false
+}
+
+// IsClosed will call the original storage manager's function with the same name
+func (r *readOnlyStorageManager) IsClosed() bool {
+	return r.storageManager.IsClosed()
+}
+
+// Close won't do anything as the original storage manager is closed by its owner
+func (r *readOnlyStorageManager) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (r *readOnlyStorageManager) IsInterfaceNil() bool {
+	return r == nil
+}
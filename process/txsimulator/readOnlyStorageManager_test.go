@@ -0,0 +1,116 @@
+package txsimulator
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReadOnlyStorageManager(t *testing.T) {
+	t.Parallel()
+
+	storageManager, err := newReadOnlyStorageManager(nil)
+	require.True(t, check.IfNil(storageManager))
+	require.Equal(t, ErrNilTrieStorageManager, err)
+
+	storageManager, err = newReadOnlyStorageManager(&testscommon.StorageManagerStub{})
+	require.False(t, check.IfNil(storageManager))
+	require.NoError(t, err)
+}
+
+func TestReadOnlyStorageManager_WriteOperationsShouldNotBeCalled(t *testing.T) {
+	t.Parallel()
+
+	failErrMsg := "this function should have not be called"
+	storageManager, _ := newReadOnlyStorageManager(&testscommon.StorageManagerStub{
+		PutCalled: func(_ []byte, _ []byte) error {
+			t.Errorf(failErrMsg)
+			return nil
+		},
+		PutInEpochCalled: func(_ []byte, _ []byte, _ uint32) error {
+			t.Errorf(failErrMsg)
+			return nil
+		},
+		RemoveCalled: func(_ []byte) error {
+			t.Errorf(failErrMsg)
+			return nil
+		},
+		TakeSnapshotCalled: func(_ []byte, _ []byte, _ chan core.KeyValueHolder, _ chan error, _ common.SnapshotStatisticsHandler, _ uint32) {
+			t.Errorf(failErrMsg)
+		},
+		SetCheckpointCalled: func(_ []byte, _ []byte, _ chan core.KeyValueHolder, _ chan error, _ common.SnapshotStatisticsHandler) {
+			t.Errorf(failErrMsg)
+		},
+		EnterPruningBufferingModeCalled: func() {
+			t.Errorf(failErrMsg)
+		},
+		ExitPruningBufferingModeCalled: func() {
+			t.Errorf(failErrMsg)
+		},
+		SetEpochForPutOperationCalled: func(_ uint32) {
+			t.Errorf(failErrMsg)
+		},
+		ShouldTakeSnapshotCalled: func() bool {
+			t.Errorf(failErrMsg)
+			return true
+		},
+	})
+
+	require.NoError(t, storageManager.Put([]byte("key"), []byte("value")))
+	require.NoError(t, storageManager.PutInEpoch([]byte("key"), []byte("value"), 1))
+	require.NoError(t, storageManager.Remove([]byte("key")))
+	storageManager.EnterPruningBufferingMode()
+	storageManager.ExitPruningBufferingMode()
+	storageManager.SetEpochForPutOperation(1)
+	require.False(t, storageManager.ShouldTakeSnapshot())
+	require.False(t, storageManager.IsPruningEnabled())
+	require.NoError(t, storageManager.Close())
+
+	leavesChannel := make(chan core.KeyValueHolder)
+	storageManager.TakeSnapshot(nil, nil, leavesChannel, nil, &trieMock.MockStatistics{}, 0)
+	_, isOpen := <-leavesChannel
+	require.False(t, isOpen)
+
+	leavesChannel = make(chan core.KeyValueHolder)
+	storageManager.SetCheckpoint(nil, nil, leavesChannel, nil, &trieMock.MockStatistics{})
+	_, isOpen = <-leavesChannel
+	require.False(t, isOpen)
+}
+
+func TestReadOnlyStorageManager_ReadOperationsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	getCalled, getFromCurrentEpochCalled := false, false
+	storageManager, _ := newReadOnlyStorageManager(&testscommon.StorageManagerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			getCalled = true
+			return []byte("value"), nil
+		},
+		GetFromCurrentEpochCalled: func(key []byte) ([]byte, error) {
+			getFromCurrentEpochCalled = true
+			return []byte("current value"), nil
+		},
+		GetLatestStorageEpochCalled: func() (uint32, error) {
+			return 7, nil
+		},
+	})
+
+	value, err := storageManager.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.True(t, getCalled)
+
+	value, err = storageManager.GetFromCurrentEpoch([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("current value"), value)
+	require.True(t, getFromCurrentEpochCalled)
+
+	epoch, err := storageManager.GetLatestStorageEpoch()
+	require.NoError(t, err)
+	require.Equal(t, uint32(7), epoch)
+}
@@ -0,0 +1,131 @@
+package txsimulator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+type accountValues struct {
+	balance  *big.Int
+	nonce    uint64
+	codeHash []byte
+	storage  map[string][]byte
+}
+
+// computeStateDiff reads the touched accounts from the bundle accounts adapter, then recreates its trie from the
+// initial root hash, dropping the simulated changes, and reads the same accounts again
+func (ts *transactionSimulator) computeStateDiff(
+	bundleAccounts state.AccountsAdapter,
+	initialRootHash []byte,
+	touchedAccounts []*TouchedAccount,
+) ([]*txSimData.AccountStateDiff, error) {
+	valuesAfter := make([]*accountValues, 0, len(touchedAccounts))
+	for _, touched := range touchedAccounts {
+		values, err := readAccountValues(bundleAccounts, touched)
+		if err != nil {
+			return nil, err
+		}
+
+		valuesAfter = append(valuesAfter, values)
+	}
+
+	err := bundleAccounts.RecreateTrie(initialRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	stateDiff := make([]*txSimData.AccountStateDiff, 0, len(touchedAccounts))
+	for idx, touched := range touchedAccounts {
+		before, errRead := readAccountValues(bundleAccounts, touched)
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		accountDiff, isChanged := ts.createAccountStateDiff(touched, before, valuesAfter[idx])
+		if isChanged {
+			stateDiff = append(stateDiff, accountDiff)
+		}
+	}
+
+	return stateDiff, nil
+}
+
+func readAccountValues(accounts state.AccountsAdapter, touched *TouchedAccount) (*accountValues, error) {
+	values := &accountValues{
+		balance: big.NewInt(0),
+		storage: make(map[string][]byte),
+	}
+
+	account, err := accounts.GetExistingAccount(touched.Address)
+	if errors.Is(err, state.ErrAccNotFound) {
+		return values, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return values, nil
+	}
+
+	values.balance = userAccount.GetBalance()
+	values.nonce = userAccount.GetNonce()
+	values.codeHash = userAccount.GetCodeHash()
+	if check.IfNil(userAccount.DataTrie()) {
+		return values, nil
+	}
+
+	for _, key := range touched.Keys {
+		values.storage[string(key)], err = userAccount.RetrieveValueFromDataTrieTracker(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return values, nil
+}
+
+func (ts *transactionSimulator) createAccountStateDiff(
+	touched *TouchedAccount,
+	before *accountValues,
+	after *accountValues,
+) (*txSimData.AccountStateDiff, bool) {
+	accountDiff := &txSimData.AccountStateDiff{
+		Address:        ts.addressPubKeyConverter.Encode(touched.Address),
+		BalanceBefore:  before.balance.String(),
+		BalanceAfter:   after.balance.String(),
+		NonceBefore:    before.nonce,
+		NonceAfter:     after.nonce,
+		CodeHashBefore: hex.EncodeToString(before.codeHash),
+		CodeHashAfter:  hex.EncodeToString(after.codeHash),
+		Storage:        make([]*txSimData.StorageDiff, 0),
+	}
+
+	for _, key := range touched.Keys {
+		valueBefore := before.storage[string(key)]
+		valueAfter := after.storage[string(key)]
+		if bytes.Equal(valueBefore, valueAfter) {
+			continue
+		}
+
+		accountDiff.Storage = append(accountDiff.Storage, &txSimData.StorageDiff{
+			Key:    hex.EncodeToString(key),
+			Before: hex.EncodeToString(valueBefore),
+			After:  hex.EncodeToString(valueAfter),
+		})
+	}
+
+	isChanged := before.balance.Cmp(after.balance) != 0 ||
+		before.nonce != after.nonce ||
+		!bytes.Equal(before.codeHash, after.codeHash) ||
+		len(accountDiff.Storage) > 0
+
+	return accountDiff, isChanged
+}
@@ -0,0 +1,129 @@
+package txsimulator
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// ArgsStateOverridesHandler holds the arguments required for creating a new state overrides handler
+type ArgsStateOverridesHandler struct {
+	BundleAccounts        BundleAccountsHandler
+	BundleAccountsCreator AccountsAdapterCreator
+}
+
+// stateOverridesHandler runs a function while the accounts wrapper redirects its operations towards a disposable
+// accounts adapter, holding the overridden accounts
+type stateOverridesHandler struct {
+	bundleAccounts        BundleAccountsHandler
+	bundleAccountsCreator AccountsAdapterCreator
+	mutOverrides          sync.Mutex
+}
+
+// NewStateOverridesHandler returns a new instance of stateOverridesHandler
+func NewStateOverridesHandler(args ArgsStateOverridesHandler) (*stateOverridesHandler, error) {
+	if check.IfNil(args.BundleAccounts) {
+		return nil, ErrNilBundleAccountsHandler
+	}
+	if check.IfNil(args.BundleAccountsCreator) {
+		return nil, ErrNilAccountsAdapterCreator
+	}
+
+	return &stateOverridesHandler{
+		bundleAccounts:        args.BundleAccounts,
+		bundleAccountsCreator: args.BundleAccountsCreator,
+	}, nil
+}
+
+// RunWithStateOverrides calls the provided handler while the accounts wrapper sees the overridden state. The
+// overrides are never applied on the node's state
+func (soh *stateOverridesHandler) RunWithStateOverrides(overrides []*common.AccountStateOverride, handler func() error) error {
+	soh.mutOverrides.Lock()
+	defer soh.mutOverrides.Unlock()
+
+	overriddenAccounts, err := createOverriddenAccounts(soh.bundleAccountsCreator, overrides)
+	if err != nil {
+		return err
+	}
+
+	soh.bundleAccounts.StartBundle(overriddenAccounts)
+	defer soh.bundleAccounts.FinishBundle()
+
+	return handler()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (soh *stateOverridesHandler) IsInterfaceNil() bool {
+	return soh == nil
+}
+
+func createOverriddenAccounts(creator AccountsAdapterCreator, overrides []*common.AccountStateOverride) (state.AccountsAdapter, error) {
+	err := checkStateOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := creator.CreateAccountsAdapter()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, override := range overrides {
+		err = applyStateOverride(accounts, override)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return accounts, nil
+}
+
+func checkStateOverrides(overrides []*common.AccountStateOverride) error {
+	for idx, override := range overrides {
+		if override == nil || len(override.Address) == 0 {
+			return fmt.Errorf("%w at index %d: missing address", ErrInvalidStateOverride, idx)
+		}
+		if override.Balance != nil && override.Balance.Sign() < 0 {
+			return fmt.Errorf("%w at index %d: negative balance", ErrInvalidStateOverride, idx)
+		}
+	}
+
+	return nil
+}
+
+func applyStateOverride(accounts state.AccountsAdapter, override *common.AccountStateOverride) error {
+	account, err := accounts.LoadAccount(override.Address)
+	if err != nil {
+		return err
+	}
+
+	userAccount, ok := account.(overridableAccountHandler)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrInvalidStateOverride, state.ErrWrongTypeAssertion.Error())
+	}
+
+	if override.Balance != nil {
+		err = userAccount.AddToBalance(big.NewInt(0).Sub(override.Balance, userAccount.GetBalance()))
+		if err != nil {
+			return err
+		}
+	}
+	if override.Nonce.HasValue {
+		userAccount.SetNonce(override.Nonce.Value)
+	}
+	if override.Code != nil {
+		userAccount.SetCode(override.Code)
+	}
+	for key, value := range override.Storage {
+		err = userAccount.DataTrieTracker().SaveKeyValue([]byte(key), value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return accounts.SaveAccount(userAccount)
+}
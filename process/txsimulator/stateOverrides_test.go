@@ -0,0 +1,130 @@
+package txsimulator
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStateOverridesHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil bundle accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewStateOverridesHandler(ArgsStateOverridesHandler{
+			BundleAccountsCreator: &mock.AccountsAdapterCreatorStub{},
+		})
+		require.Nil(t, handler)
+		require.Equal(t, ErrNilBundleAccountsHandler, err)
+	})
+	t.Run("nil bundle accounts creator should error", func(t *testing.T) {
+		t.Parallel()
+
+		readOnlyAccounts, _ := NewReadOnlyAccountsDB(&stateMock.AccountsStub{})
+		handler, err := NewStateOverridesHandler(ArgsStateOverridesHandler{
+			BundleAccounts: readOnlyAccounts,
+		})
+		require.Nil(t, handler)
+		require.Equal(t, ErrNilAccountsAdapterCreator, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		readOnlyAccounts, _ := NewReadOnlyAccountsDB(&stateMock.AccountsStub{})
+		handler, err := NewStateOverridesHandler(ArgsStateOverridesHandler{
+			BundleAccounts:        readOnlyAccounts,
+			BundleAccountsCreator: &mock.AccountsAdapterCreatorStub{},
+		})
+		require.NoError(t, err)
+		require.False(t, handler.IsInterfaceNil())
+	})
+}
+
+func TestStateOverridesHandler_RunWithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid overrides should error", func(t *testing.T) {
+		t.Parallel()
+
+		readOnlyAccounts, _ := NewReadOnlyAccountsDB(&stateMock.AccountsStub{})
+		handler, _ := NewStateOverridesHandler(ArgsStateOverridesHandler{
+			BundleAccounts:        readOnlyAccounts,
+			BundleAccountsCreator: &mock.AccountsAdapterCreatorStub{},
+		})
+
+		invalidOverrides := [][]*common.AccountStateOverride{
+			{nil},
+			{{Balance: big.NewInt(1)}},
+			{{Address: []byte("alice"), Balance: big.NewInt(-1)}},
+		}
+		for _, overrides := range invalidOverrides {
+			err := handler.RunWithStateOverrides(overrides, func() error {
+				require.Fail(t, "should have not run the handler")
+				return nil
+			})
+			require.True(t, errors.Is(err, ErrInvalidStateOverride))
+		}
+	})
+	t.Run("the handler should see the overridden state", func(t *testing.T) {
+		t.Parallel()
+
+		alice, bob := []byte("alice-address-of-32-bytes-length"), []byte("bob-address-of-32-bytes-length!!")
+		accounts, rootHash := createAccountsWithBalance(t, alice, big.NewInt(100))
+		account, _ := accounts.LoadAccount(alice)
+		account.(state.UserAccountHandler).IncreaseNonce(10)
+		_ = accounts.SaveAccount(account)
+		rootHash, _ = accounts.Commit()
+
+		readOnlyAccounts, _ := NewReadOnlyAccountsDB(accounts)
+		handler, _ := NewStateOverridesHandler(ArgsStateOverridesHandler{
+			BundleAccounts:        readOnlyAccounts,
+			BundleAccountsCreator: createBundleAccountsCreatorForRootHash(accounts, rootHash),
+		})
+
+		overrides := []*common.AccountStateOverride{
+			{
+				Address: alice,
+				Balance: big.NewInt(7),
+				Nonce:   common.OptionalUint64{Value: 3, HasValue: true},
+			},
+			{
+				Address: bob,
+				Code:    []byte("code"),
+				Storage: map[string][]byte{"key": []byte("value")},
+			},
+		}
+		err := handler.RunWithStateOverrides(overrides, func() error {
+			aliceAccount, errLoad := readOnlyAccounts.LoadAccount(alice)
+			require.NoError(t, errLoad)
+			require.Equal(t, big.NewInt(7), aliceAccount.(state.UserAccountHandler).GetBalance())
+			require.Equal(t, uint64(3), aliceAccount.GetNonce())
+
+			bobAccount, errLoad := readOnlyAccounts.GetExistingAccount(bob)
+			require.NoError(t, errLoad)
+			bobUserAccount := bobAccount.(state.UserAccountHandler)
+			require.Equal(t, []byte("code"), readOnlyAccounts.GetCode(bobUserAccount.GetCodeHash()))
+			value, errLoad := bobUserAccount.RetrieveValueFromDataTrieTracker([]byte("key"))
+			require.NoError(t, errLoad)
+			require.Equal(t, []byte("value"), value)
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		// the node's state was not changed
+		currentRootHash, _ := accounts.RootHash()
+		require.Equal(t, rootHash, currentRootHash)
+		aliceAccount, _ := readOnlyAccounts.LoadAccount(alice)
+		require.Equal(t, big.NewInt(100), aliceAccount.(state.UserAccountHandler).GetBalance())
+		require.Equal(t, uint64(10), aliceAccount.GetNonce())
+		_, err = readOnlyAccounts.GetExistingAccount(bob)
+		require.Equal(t, state.ErrAccNotFound, err)
+	})
+}
@@ -1,7 +1,10 @@
 package txsimulator
 
 import (
+	"context"
 	"encoding/hex"
+	"fmt"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -27,6 +30,8 @@ type ArgsTxSimulator struct {
 	VMOutputCacher            storage.Cacher
 	Hasher                    hashing.Hasher
 	Marshalizer               marshal.Marshalizer
+	BundleAccounts            BundleAccountsHandler
+	BundleAccountsCreator     AccountsAdapterCreator
 }
 
 type transactionSimulator struct {
@@ -37,6 +42,11 @@ type transactionSimulator struct {
 	vmOutputCacher         storage.Cacher
 	hasher                 hashing.Hasher
 	marshalizer            marshal.Marshalizer
+	bundleAccounts         BundleAccountsHandler
+	bundleAccountsCreator  AccountsAdapterCreator
+	// mutBundle allows simple simulations to run in parallel, while a bundle simulation runs alone, as it changes
+	// the state seen by the simulation processors
+	mutBundle sync.RWMutex
 }
 
 // NewTransactionSimulator returns a new instance of a transactionSimulator
@@ -62,6 +72,12 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.Hasher) {
 		return nil, ErrNilHasher
 	}
+	if check.IfNil(args.BundleAccounts) {
+		return nil, ErrNilBundleAccountsHandler
+	}
+	if check.IfNil(args.BundleAccountsCreator) {
+		return nil, ErrNilAccountsAdapterCreator
+	}
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
@@ -71,11 +87,115 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 		vmOutputCacher:         args.VMOutputCacher,
 		marshalizer:            args.Marshalizer,
 		hasher:                 args.Hasher,
+		bundleAccounts:         args.BundleAccounts,
+		bundleAccountsCreator:  args.BundleAccountsCreator,
 	}, nil
 }
 
 // ProcessTx will process the transaction in a special environment, where state-writing is not allowed
 func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+	ts.mutBundle.RLock()
+	defer ts.mutBundle.RUnlock()
+
+	return ts.processTx(tx, false)
+}
+
+// ProcessTxWithOptions will process the transaction in the same special environment as ProcessTx, over a state in
+// which the provided accounts values are overridden, if any. The execution trace is added to the results on request
+func (ts *transactionSimulator) ProcessTxWithOptions(
+	tx *transaction.Transaction,
+	options txSimData.SimulationOptions,
+) (*txSimData.SimulationResults, error) {
+	if len(options.StateOverrides) == 0 {
+		ts.mutBundle.RLock()
+		defer ts.mutBundle.RUnlock()
+
+		return ts.processTx(tx, options.WithTrace)
+	}
+
+	ts.mutBundle.Lock()
+	defer ts.mutBundle.Unlock()
+
+	overriddenAccounts, err := createOverriddenAccounts(ts.bundleAccountsCreator, options.StateOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	ts.bundleAccounts.StartBundle(overriddenAccounts)
+	defer ts.bundleAccounts.FinishBundle()
+
+	return ts.processTx(tx, options.WithTrace)
+}
+
+// ProcessBundle will process the transactions one after another, each transaction seeing the state changes of the
+// previous ones. The changes are held by a disposable accounts adapter and are never applied on the node's state. The
+// simulation is aborted once the provided context is done, so that it does not hold back the other simulations
+func (ts *transactionSimulator) ProcessBundle(ctx context.Context, txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if len(txs) == 0 {
+		return nil, ErrEmptyBundle
+	}
+
+	ts.mutBundle.Lock()
+	defer ts.mutBundle.Unlock()
+
+	bundleAccounts, err := ts.bundleAccountsCreator.CreateAccountsAdapter()
+	if err != nil {
+		return nil, err
+	}
+	initialRootHash, err := bundleAccounts.RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	ts.bundleAccounts.StartBundle(bundleAccounts)
+	results, err := ts.processBundleTxs(ctx, txs)
+	touchedAccounts := ts.bundleAccounts.FinishBundle()
+	if err != nil {
+		return nil, err
+	}
+
+	stateDiff, err := ts.computeStateDiff(bundleAccounts, initialRootHash, touchedAccounts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txSimData.BundleSimulationResults{
+		Results:   results,
+		StateDiff: stateDiff,
+	}, nil
+}
+
+func (ts *transactionSimulator) processBundleTxs(ctx context.Context, txs []*transaction.Transaction) ([]*txSimData.SimulationResults, error) {
+	results := make([]*txSimData.SimulationResults, 0, len(txs))
+	for idx, tx := range txs {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w after %d of %d transactions", ErrBundleSimulationTimeout, idx, len(txs))
+		default:
+		}
+
+		// the transaction is checked against the bundle state, which holds the changes of the previous transactions
+		err := ts.txProcessor.VerifyTransaction(tx)
+		if err != nil {
+			results = append(results, &txSimData.SimulationResults{
+				Status:     transaction.TxStatusFail,
+				FailReason: err.Error(),
+			})
+			continue
+		}
+
+		result, err := ts.processTx(tx, false)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (ts *transactionSimulator) processTx(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResults, error) {
 	txStatus := transaction.TxStatusPending
 	failReason := ""
 
@@ -102,6 +222,9 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimDa
 	vmOutput, ok := ts.getVMOutputOfTx(tx)
 	if ok {
 		results.VMOutput = vmOutput
+		if withTrace {
+			results.Trace = ts.createExecutionTrace(tx, vmOutput)
+		}
 	}
 
 	return results, nil
@@ -1,8 +1,10 @@
 package txsimulator
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -11,12 +13,22 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
 	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/factory"
+	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/hashesHolder"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/require"
 )
@@ -90,6 +102,24 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: ErrNilCacher,
 		},
+		{
+			name: "NilBundleAccounts",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.BundleAccounts = nil
+				return args
+			},
+			exError: ErrNilBundleAccountsHandler,
+		},
+		{
+			name: "NilBundleAccountsCreator",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.BundleAccountsCreator = nil
+				return args
+			},
+			exError: ErrNilAccountsAdapterCreator,
+		},
 		{
 			name: "Ok",
 			argsFunc: func() ArgsTxSimulator {
@@ -217,6 +247,544 @@ func TestTransactionSimulator_ProcessTxShouldIncludeScrsAndReceipts(t *testing.T
 	)
 }
 
+func TestTransactionSimulator_ProcessBundle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty bundle should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts, _ := NewTransactionSimulator(getTxSimulatorArgs())
+		results, err := ts.ProcessBundle(context.Background(), nil)
+		require.Nil(t, results)
+		require.Equal(t, ErrEmptyBundle, err)
+	})
+	t.Run("accounts adapter creation error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := getTxSimulatorArgs()
+		args.BundleAccountsCreator = &mock.AccountsAdapterCreatorStub{
+			CreateAccountsAdapterCalled: func() (state.AccountsAdapter, error) {
+				return nil, expectedErr
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		results, err := ts.ProcessBundle(context.Background(), []*transaction.Transaction{{Nonce: 1}})
+		require.Nil(t, results)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("each transaction should see the changes of the previous ones", func(t *testing.T) {
+		t.Parallel()
+
+		alice, bob := []byte("alice-address-of-32-bytes-length"), []byte("bob-address-of-32-bytes-length!!")
+		accounts, rootHash := createAccountsWithBalance(t, alice, big.NewInt(100))
+		readOnlyAccounts, _ := NewReadOnlyAccountsDB(accounts)
+
+		args := getTxSimulatorArgs()
+		args.BundleAccounts = readOnlyAccounts
+		args.BundleAccountsCreator = createBundleAccountsCreatorForRootHash(accounts, rootHash)
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				return transferValue(readOnlyAccounts, tx)
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		txs := []*transaction.Transaction{
+			{Nonce: 0, SndAddr: alice, RcvAddr: bob, Value: big.NewInt(60)},
+			{Nonce: 1, SndAddr: alice, RcvAddr: bob, Value: big.NewInt(60)},
+			{Nonce: 1, SndAddr: alice, RcvAddr: bob, Value: big.NewInt(30)},
+		}
+		results, err := ts.ProcessBundle(context.Background(), txs)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(results.Results))
+		require.Equal(t, transaction.TxStatusSuccess, results.Results[0].Status)
+		require.Equal(t, transaction.TxStatusFail, results.Results[1].Status)
+		require.Equal(t, errInsufficientBalance.Error(), results.Results[1].FailReason)
+		require.Equal(t, transaction.TxStatusSuccess, results.Results[2].Status)
+
+		require.Equal(t, 2, len(results.StateDiff))
+		aliceDiff := results.StateDiff[0]
+		require.Equal(t, hex.EncodeToString(alice), aliceDiff.Address)
+		require.Equal(t, "100", aliceDiff.BalanceBefore)
+		require.Equal(t, "10", aliceDiff.BalanceAfter)
+		require.Equal(t, uint64(0), aliceDiff.NonceBefore)
+		require.Equal(t, uint64(2), aliceDiff.NonceAfter)
+		require.Equal(t, []*txSimData.StorageDiff{{
+			Key:    hex.EncodeToString([]byte("lastReceiver")),
+			Before: "",
+			After:  hex.EncodeToString(bob),
+		}}, aliceDiff.Storage)
+
+		bobDiff := results.StateDiff[1]
+		require.Equal(t, hex.EncodeToString(bob), bobDiff.Address)
+		require.Equal(t, "0", bobDiff.BalanceBefore)
+		require.Equal(t, "90", bobDiff.BalanceAfter)
+
+		// the node's state was not changed
+		currentRootHash, _ := accounts.RootHash()
+		require.Equal(t, rootHash, currentRootHash)
+		aliceAccount, _ := readOnlyAccounts.LoadAccount(alice)
+		require.Equal(t, big.NewInt(100), aliceAccount.(state.UserAccountHandler).GetBalance())
+	})
+	t.Run("done context should abort the bundle", func(t *testing.T) {
+		t.Parallel()
+
+		alice := []byte("alice-address-of-32-bytes-length")
+		accounts, rootHash := createAccountsWithBalance(t, alice, big.NewInt(100))
+		readOnlyAccounts, _ := NewReadOnlyAccountsDB(accounts)
+
+		args := getTxSimulatorArgs()
+		args.BundleAccounts = readOnlyAccounts
+		args.BundleAccountsCreator = createBundleAccountsCreatorForRootHash(accounts, rootHash)
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				require.Fail(t, "should have not processed the transaction")
+				return vmcommon.Ok, nil
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		results, err := ts.ProcessBundle(ctx, []*transaction.Transaction{{Nonce: 0, SndAddr: alice}})
+		require.Nil(t, results)
+		require.True(t, errors.Is(err, ErrBundleSimulationTimeout))
+
+		// the accounts wrapper is back on the node's state
+		_, isBundleStarted := readOnlyAccounts.getBundleAccounts()
+		require.False(t, isBundleStarted)
+	})
+	t.Run("transactions should be verified against the bundle state", func(t *testing.T) {
+		t.Parallel()
+
+		alice, bob := []byte("alice-address-of-32-bytes-length"), []byte("bob-address-of-32-bytes-length!!")
+		accounts, rootHash := createAccountsWithBalance(t, alice, big.NewInt(100))
+		readOnlyAccounts, _ := NewReadOnlyAccountsDB(accounts)
+
+		numProcessed := 0
+		args := getTxSimulatorArgs()
+		args.BundleAccounts = readOnlyAccounts
+		args.BundleAccountsCreator = createBundleAccountsCreatorForRootHash(accounts, rootHash)
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			VerifyTransactionCalled: func(tx *transaction.Transaction) error {
+				sender, _ := readOnlyAccounts.LoadAccount(tx.SndAddr)
+				if sender.GetNonce() != tx.Nonce {
+					return errInvalidNonce
+				}
+
+				return nil
+			},
+			ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				numProcessed++
+				return transferValue(readOnlyAccounts, tx)
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		txs := []*transaction.Transaction{
+			{Nonce: 0, SndAddr: alice, RcvAddr: bob, Value: big.NewInt(10)},
+			{Nonce: 1, SndAddr: alice, RcvAddr: bob, Value: big.NewInt(10)},
+			{Nonce: 5, SndAddr: alice, RcvAddr: bob, Value: big.NewInt(10)},
+		}
+		results, err := ts.ProcessBundle(context.Background(), txs)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(results.Results))
+		require.Equal(t, transaction.TxStatusSuccess, results.Results[0].Status)
+		require.Equal(t, transaction.TxStatusSuccess, results.Results[1].Status)
+		require.Equal(t, transaction.TxStatusFail, results.Results[2].Status)
+		require.Equal(t, errInvalidNonce.Error(), results.Results[2].FailReason)
+		require.Equal(t, 2, numProcessed)
+	})
+}
+
+func TestTransactionSimulator_ProcessTxWithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no overrides should process the transaction over the node's state", func(t *testing.T) {
+		t.Parallel()
+
+		args := getTxSimulatorArgs()
+		args.BundleAccountsCreator = &mock.AccountsAdapterCreatorStub{
+			CreateAccountsAdapterCalled: func() (state.AccountsAdapter, error) {
+				require.Fail(t, "should have not created an accounts adapter")
+				return nil, nil
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		results, err := ts.ProcessTxWithOptions(&transaction.Transaction{Nonce: 1}, txSimData.SimulationOptions{})
+		require.NoError(t, err)
+		require.Equal(t, transaction.TxStatusSuccess, results.Status)
+	})
+	t.Run("invalid override should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts, _ := NewTransactionSimulator(getTxSimulatorArgs())
+		overrides := []*common.AccountStateOverride{{Address: []byte("alice"), Balance: big.NewInt(-1)}}
+
+		results, err := ts.ProcessTxWithOptions(&transaction.Transaction{Nonce: 1}, txSimData.SimulationOptions{StateOverrides: overrides})
+		require.Nil(t, results)
+		require.True(t, errors.Is(err, ErrInvalidStateOverride))
+	})
+	t.Run("the transaction should see the overridden state", func(t *testing.T) {
+		t.Parallel()
+
+		alice, bob := []byte("alice-address-of-32-bytes-length"), []byte("bob-address-of-32-bytes-length!!")
+		accounts, rootHash := createAccountsWithBalance(t, alice, big.NewInt(100))
+		readOnlyAccounts, _ := NewReadOnlyAccountsDB(accounts)
+
+		args := getTxSimulatorArgs()
+		args.BundleAccounts = readOnlyAccounts
+		args.BundleAccountsCreator = createBundleAccountsCreatorForRootHash(accounts, rootHash)
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				return transferValue(readOnlyAccounts, tx)
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		tx := &transaction.Transaction{Nonce: 0, SndAddr: alice, RcvAddr: bob, Value: big.NewInt(500)}
+		results, err := ts.ProcessTxWithOptions(tx, txSimData.SimulationOptions{})
+		require.NoError(t, err)
+		require.Equal(t, transaction.TxStatusFail, results.Status)
+
+		overrides := []*common.AccountStateOverride{{Address: alice, Balance: big.NewInt(1000)}}
+		results, err = ts.ProcessTxWithOptions(tx, txSimData.SimulationOptions{StateOverrides: overrides})
+		require.NoError(t, err)
+		require.Equal(t, transaction.TxStatusSuccess, results.Status)
+
+		// the node's state was not changed
+		currentRootHash, _ := accounts.RootHash()
+		require.Equal(t, rootHash, currentRootHash)
+		aliceAccount, _ := readOnlyAccounts.LoadAccount(alice)
+		require.Equal(t, big.NewInt(100), aliceAccount.(state.UserAccountHandler).GetBalance())
+	})
+}
+
+func TestTransactionSimulator_ProcessTxWithOptionsShouldAddTheTrace(t *testing.T) {
+	t.Parallel()
+
+	sender, contract, callee, crossShard := []byte("sender"), []byte("contract"), []byte("callee"), []byte("cross-shard")
+	tx := &transaction.Transaction{
+		Nonce:    5,
+		SndAddr:  sender,
+		RcvAddr:  contract,
+		Value:    big.NewInt(0),
+		GasLimit: 1000,
+		Data:     []byte("swap@01@02"),
+	}
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		ReturnData:   [][]byte{{0x01}},
+		GasRemaining: 300,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(contract): {
+				Address: contract,
+				GasUsed: 400,
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					"b": {Offset: []byte("b"), Data: []byte{0x02}},
+					"a": {Offset: []byte("a"), Data: []byte{0x01}},
+				},
+			},
+			string(callee): {
+				Address:      callee,
+				GasUsed:      200,
+				BalanceDelta: big.NewInt(10),
+			},
+			string(crossShard): {
+				Address: crossShard,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{
+						Value:         big.NewInt(7),
+						GasLimit:      50,
+						GasLocked:     20,
+						Data:          []byte("deposit@03"),
+						CallType:      vm.AsynchronousCall,
+						SenderAddress: callee,
+					},
+				},
+			},
+		},
+		Logs: []*vmcommon.LogEntry{
+			{Identifier: []byte("swap"), Address: contract, Topics: [][]byte{{0xaa}}, Data: []byte{0xbb}},
+		},
+	}
+
+	createSimulator := func() *transactionSimulator {
+		args := getTxSimulatorArgs()
+		args.VMOutputCacher, _ = storageUnit.NewCache(storageUnit.CacheConfig{
+			Type:     storageUnit.LRUCache,
+			Capacity: 100,
+		})
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				txHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, tx)
+				args.VMOutputCacher.Put(txHash, vmOutput, 0)
+				return vmcommon.Ok, nil
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		return ts
+	}
+
+	t.Run("without trace", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := createSimulator().ProcessTxWithOptions(tx, txSimData.SimulationOptions{})
+		require.NoError(t, err)
+		require.Equal(t, vmOutput, results.VMOutput)
+		require.Nil(t, results.Trace)
+	})
+	t.Run("with trace", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := createSimulator().ProcessTxWithOptions(tx, txSimData.SimulationOptions{WithTrace: true})
+		require.NoError(t, err)
+
+		expectedCallTree := &txSimData.CallFrame{
+			Sender:   hex.EncodeToString(sender),
+			Receiver: hex.EncodeToString(contract),
+			Function: "swap",
+			CallType: "directCall",
+			Value:    "0",
+			GasLimit: 1000,
+			GasUsed:  700,
+			Calls: []*txSimData.CallFrame{
+				{
+					Receiver: hex.EncodeToString(callee),
+					CallType: "synchronousCall",
+					GasUsed:  200,
+					Calls: []*txSimData.CallFrame{
+						{
+							Sender:    hex.EncodeToString(callee),
+							Receiver:  hex.EncodeToString(crossShard),
+							Function:  "deposit",
+							CallType:  "asynchronousCall",
+							Value:     "7",
+							GasLimit:  50,
+							GasLocked: 20,
+						},
+					},
+				},
+			},
+		}
+		trace := results.Trace
+		require.NotNil(t, trace)
+		require.Equal(t, vmcommon.Ok.String(), trace.ReturnCode)
+		require.Equal(t, []string{"01"}, trace.ReturnData)
+		require.Equal(t, uint64(300), trace.GasRemaining)
+		require.Equal(t, expectedCallTree, trace.CallTree)
+
+		require.Equal(t, 3, len(trace.OutputAccounts))
+		require.Equal(t, hex.EncodeToString(callee), trace.OutputAccounts[0].Address)
+		require.Equal(t, "10", trace.OutputAccounts[0].BalanceDelta)
+		require.Equal(t, hex.EncodeToString(contract), trace.OutputAccounts[1].Address)
+		require.Equal(t, []*txSimData.StorageWrite{
+			{Key: hex.EncodeToString([]byte("a")), Value: "01"},
+			{Key: hex.EncodeToString([]byte("b")), Value: "02"},
+		}, trace.OutputAccounts[1].StorageWrites)
+
+		require.Equal(t, []*txSimData.LogEventTrace{{
+			Address:    hex.EncodeToString(contract),
+			Identifier: "swap",
+			Topics:     []string{"aa"},
+			Data:       "bb",
+		}}, trace.Logs)
+	})
+}
+
+func TestTransactionSimulator_CreateExecutionTraceShouldNestTheCalls(t *testing.T) {
+	t.Parallel()
+
+	sender, contract, callee, asyncCallee := []byte("sender"), []byte("contract"), []byte("callee"), []byte("async-callee")
+	tx := &transaction.Transaction{
+		SndAddr:  sender,
+		RcvAddr:  contract,
+		Value:    big.NewInt(0),
+		GasLimit: 1000,
+		Data:     []byte("run"),
+	}
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 100,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(contract): {
+				Address: contract,
+				GasUsed: 300,
+			},
+			string(callee): {
+				Address: callee,
+				GasUsed: 200,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{
+						Value:         big.NewInt(0),
+						Data:          []byte("compute@01"),
+						CallType:      vm.DirectCall,
+						SenderAddress: contract,
+					},
+					{
+						Value:         big.NewInt(0),
+						GasLimit:      40,
+						Data:          []byte("callBack@00"),
+						CallType:      vm.AsynchronousCallBack,
+						SenderAddress: asyncCallee,
+					},
+				},
+			},
+			string(asyncCallee): {
+				Address: asyncCallee,
+				GasUsed: 150,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{
+						Value:         big.NewInt(3),
+						GasLimit:      250,
+						GasLocked:     50,
+						Data:          []byte("store@02"),
+						CallType:      vm.AsynchronousCall,
+						SenderAddress: callee,
+					},
+				},
+			},
+		},
+	}
+
+	ts, _ := NewTransactionSimulator(getTxSimulatorArgs())
+	trace := ts.createExecutionTrace(tx, vmOutput)
+
+	expectedCallTree := &txSimData.CallFrame{
+		Sender:   hex.EncodeToString(sender),
+		Receiver: hex.EncodeToString(contract),
+		Function: "run",
+		CallType: "directCall",
+		Value:    "0",
+		GasLimit: 1000,
+		GasUsed:  900,
+		Calls: []*txSimData.CallFrame{
+			{
+				Sender:   hex.EncodeToString(contract),
+				Receiver: hex.EncodeToString(callee),
+				Function: "compute",
+				CallType: "directCall",
+				Value:    "0",
+				GasUsed:  200,
+				Calls: []*txSimData.CallFrame{
+					{
+						Sender:    hex.EncodeToString(callee),
+						Receiver:  hex.EncodeToString(asyncCallee),
+						Function:  "store",
+						CallType:  "asynchronousCall",
+						Value:     "3",
+						GasLimit:  250,
+						GasLocked: 50,
+						GasUsed:   150,
+						Calls: []*txSimData.CallFrame{
+							{
+								Sender:   hex.EncodeToString(asyncCallee),
+								Receiver: hex.EncodeToString(callee),
+								Function: "callBack",
+								CallType: "asynchronousCallBack",
+								Value:    "0",
+								GasLimit: 40,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	require.Equal(t, expectedCallTree, trace.CallTree)
+}
+
+var errInsufficientBalance = errors.New("insufficient balance")
+var errInvalidNonce = errors.New("invalid nonce")
+
+func transferValue(accounts state.AccountsAdapter, tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+	snapshot := accounts.JournalLen()
+
+	sender, _ := accounts.LoadAccount(tx.SndAddr)
+	senderAccount := sender.(state.UserAccountHandler)
+	senderAccount.IncreaseNonce(1)
+	_ = senderAccount.DataTrieTracker().SaveKeyValue([]byte("lastReceiver"), tx.RcvAddr)
+	err := senderAccount.SubFromBalance(tx.Value)
+	if err != nil {
+		_ = accounts.RevertToSnapshot(snapshot)
+		return vmcommon.UserError, errInsufficientBalance
+	}
+	_ = accounts.SaveAccount(senderAccount)
+
+	receiver, _ := accounts.LoadAccount(tx.RcvAddr)
+	receiverAccount := receiver.(state.UserAccountHandler)
+	_ = receiverAccount.AddToBalance(tx.Value)
+	_ = accounts.SaveAccount(receiverAccount)
+
+	return vmcommon.Ok, nil
+}
+
+func createTestTrie() common.Trie {
+	marshalizer := &testscommon.MarshalizerMock{}
+	hasher := &hashingMocks.HasherMock{}
+	args := trie.NewTrieStorageManagerArgs{
+		MainStorer:        testscommon.NewSnapshotPruningStorerMock(),
+		CheckpointsStorer: testscommon.NewSnapshotPruningStorerMock(),
+		Marshalizer:       marshalizer,
+		Hasher:            hasher,
+		GeneralConfig: config.TrieStorageManagerConfig{
+			PruningBufferLen:      1000,
+			SnapshotsBufferLen:    10,
+			SnapshotsGoroutineNum: 1,
+		},
+		CheckpointHashesHolder: hashesHolder.NewCheckpointHashesHolder(10000000, testscommon.HashSize),
+		IdleProvider:           &testscommon.ProcessStatusHandlerStub{},
+	}
+	trieStorage, _ := trie.NewTrieStorageManager(args)
+	tr, _ := trie.NewTrie(trieStorage, marshalizer, hasher, 5)
+
+	return tr
+}
+
+func createAccountsWithBalance(t *testing.T, address []byte, balance *big.Int) (state.AccountsAdapter, []byte) {
+	accounts, err := state.NewAccountsDB(state.ArgsAccountsDB{
+		Trie:                  createTestTrie(),
+		Hasher:                &hashingMocks.HasherMock{},
+		Marshaller:            &testscommon.MarshalizerMock{},
+		AccountFactory:        factory.NewAccountCreator(),
+		StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
+		ProcessingMode:        common.Normal,
+		ProcessStatusHandler:  &testscommon.ProcessStatusHandlerStub{},
+	})
+	require.NoError(t, err)
+
+	account, _ := accounts.LoadAccount(address)
+	_ = account.(state.UserAccountHandler).AddToBalance(balance)
+	_ = accounts.SaveAccount(account)
+	rootHash, err := accounts.Commit()
+	require.NoError(t, err)
+
+	return accounts, rootHash
+}
+
+func createBundleAccountsCreatorForRootHash(accounts state.AccountsAdapter, rootHash []byte) AccountsAdapterCreator {
+	mainTrie, _ := accounts.GetTrie(rootHash)
+	creator, _ := NewBundleAccountsCreator(ArgsBundleAccountsCreator{
+		TrieStorageManager:   mainTrie.GetStorageManager(),
+		MaxTrieLevelInMemory: 5,
+		ChainHandler: &testscommon.ChainHandlerStub{
+			GetCurrentBlockRootHashCalled: func() []byte {
+				return rootHash
+			},
+		},
+		Hasher:               &hashingMocks.HasherMock{},
+		Marshalizer:          &testscommon.MarshalizerMock{},
+		AccountFactory:       factory.NewAccountCreator(),
+		ProcessStatusHandler: &testscommon.ProcessStatusHandlerStub{},
+	})
+
+	return creator
+}
+
 func getTxSimulatorArgs() ArgsTxSimulator {
 	return ArgsTxSimulator{
 		TransactionProcessor:      &testscommon.TxProcessorStub{},
@@ -226,5 +794,7 @@ func getTxSimulatorArgs() ArgsTxSimulator {
 		VMOutputCacher:            txcache.NewDisabledCache(),
 		Marshalizer:               &mock.MarshalizerMock{},
 		Hasher:                    &hashingMocks.HasherMock{},
+		BundleAccounts:            &readOnlyAccountsDB{originalAccounts: &stateMock.AccountsStub{}},
+		BundleAccountsCreator:     &mock.AccountsAdapterCreatorStub{},
 	}
 }
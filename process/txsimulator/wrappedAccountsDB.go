@@ -2,6 +2,7 @@ package txsimulator
 
 import (
 	"context"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -10,9 +11,27 @@ import (
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
-// readOnlyAccountsDB is a wrapper over an accounts db which works read-only. write operation are disabled
+// TouchedAccount holds an account written while simulating a bundle of transactions, together with the data trie keys
+// written on that account
+type TouchedAccount struct {
+	Address []byte
+	Keys    [][]byte
+}
+
+// readOnlyAccountsDB is a wrapper over an accounts db which works read-only. write operation are disabled. While a
+// bundle is simulated, all the operations are redirected to a disposable accounts db, which is never committed
 type readOnlyAccountsDB struct {
 	originalAccounts state.AccountsAdapter
+
+	mutBundle       sync.RWMutex
+	bundleAccounts  state.AccountsAdapter
+	touchedAccounts []*TouchedAccount
+	touchedIndex    map[string]*touchedAccountKeys
+}
+
+type touchedAccountKeys struct {
+	account *TouchedAccount
+	keys    map[string]struct{}
 }
 
 // NewReadOnlyAccountsDB returns a new instance of readOnlyAccountsDB
@@ -24,34 +43,120 @@ func NewReadOnlyAccountsDB(accountsDB state.AccountsAdapter) (*readOnlyAccountsD
 	return &readOnlyAccountsDB{originalAccounts: accountsDB}, nil
 }
 
+// StartBundle redirects all the operations to the provided accounts db, until FinishBundle is called
+func (r *readOnlyAccountsDB) StartBundle(bundleAccounts state.AccountsAdapter) {
+	r.mutBundle.Lock()
+	r.bundleAccounts = bundleAccounts
+	r.touchedAccounts = make([]*TouchedAccount, 0)
+	r.touchedIndex = make(map[string]*touchedAccountKeys)
+	r.mutBundle.Unlock()
+}
+
+// FinishBundle redirects the operations back to the original accounts db and returns the accounts written during
+// the bundle, in the order they were first written
+func (r *readOnlyAccountsDB) FinishBundle() []*TouchedAccount {
+	r.mutBundle.Lock()
+	defer r.mutBundle.Unlock()
+
+	touchedAccounts := r.touchedAccounts
+	r.bundleAccounts = nil
+	r.touchedAccounts = nil
+	r.touchedIndex = nil
+
+	return touchedAccounts
+}
+
+func (r *readOnlyAccountsDB) getBundleAccounts() (state.AccountsAdapter, bool) {
+	r.mutBundle.RLock()
+	defer r.mutBundle.RUnlock()
+
+	return r.bundleAccounts, !check.IfNil(r.bundleAccounts)
+}
+
+func (r *readOnlyAccountsDB) currentAccounts() state.AccountsAdapter {
+	bundleAccounts, isBundleStarted := r.getBundleAccounts()
+	if isBundleStarted {
+		return bundleAccounts
+	}
+
+	return r.originalAccounts
+}
+
+func (r *readOnlyAccountsDB) markTouched(address []byte, keys map[string][]byte) {
+	r.mutBundle.Lock()
+	defer r.mutBundle.Unlock()
+
+	touched, found := r.touchedIndex[string(address)]
+	if !found {
+		touched = &touchedAccountKeys{
+			account: &TouchedAccount{
+				Address: address,
+				Keys:    make([][]byte, 0),
+			},
+			keys: make(map[string]struct{}),
+		}
+		r.touchedIndex[string(address)] = touched
+		r.touchedAccounts = append(r.touchedAccounts, touched.account)
+	}
+
+	for key := range keys {
+		_, found = touched.keys[key]
+		if found {
+			continue
+		}
+
+		touched.keys[key] = struct{}{}
+		touched.account.Keys = append(touched.account.Keys, []byte(key))
+	}
+}
+
 // GetCode returns the code for the given account
 func (r *readOnlyAccountsDB) GetCode(codeHash []byte) []byte {
-	return r.originalAccounts.GetCode(codeHash)
+	return r.currentAccounts().GetCode(codeHash)
 }
 
-// GetExistingAccount will call the original accounts' function with the same name
+// GetExistingAccount will call the current accounts' function with the same name
 func (r *readOnlyAccountsDB) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
-	return r.originalAccounts.GetExistingAccount(address)
+	return r.currentAccounts().GetExistingAccount(address)
 }
 
-// GetAccountFromBytes will call the original accounts' function with the same name
+// GetAccountFromBytes will call the current accounts' function with the same name
 func (r *readOnlyAccountsDB) GetAccountFromBytes(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
-	return r.originalAccounts.GetAccountFromBytes(address, accountBytes)
+	return r.currentAccounts().GetAccountFromBytes(address, accountBytes)
 }
 
-// LoadAccount will call the original accounts' function with the same name
+// LoadAccount will call the current accounts' function with the same name
 func (r *readOnlyAccountsDB) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
-	return r.originalAccounts.LoadAccount(address)
+	return r.currentAccounts().LoadAccount(address)
 }
 
-// SaveAccount won't do anything as write operations are disabled on this component
-func (r *readOnlyAccountsDB) SaveAccount(_ vmcommon.AccountHandler) error {
-	return nil
+// SaveAccount won't do anything as write operations are disabled on this component, unless a bundle is simulated
+func (r *readOnlyAccountsDB) SaveAccount(account vmcommon.AccountHandler) error {
+	bundleAccounts, isBundleStarted := r.getBundleAccounts()
+	if !isBundleStarted || check.IfNil(account) {
+		return nil
+	}
+
+	var dirtyData map[string][]byte
+	userAccount, ok := account.(state.UserAccountHandler)
+	if ok && !check.IfNil(userAccount.DataTrieTracker()) {
+		dirtyData = userAccount.DataTrieTracker().DirtyData()
+	}
+	r.markTouched(account.AddressBytes(), dirtyData)
+
+	return bundleAccounts.SaveAccount(account)
 }
 
-// RemoveAccount won't do anything as write operations are disabled on this component
-func (r *readOnlyAccountsDB) RemoveAccount(_ []byte) error {
-	return nil
+// RemoveAccount won't do anything as write operations are disabled on this component, unless a bundle is simulated
+func (r *readOnlyAccountsDB) RemoveAccount(address []byte) error {
+	bundleAccounts, isBundleStarted := r.getBundleAccounts()
+	if !isBundleStarted {
+		return nil
+	}
+
+	r.markTouched(address, nil)
+
+	return bundleAccounts.RemoveAccount(address)
 }
 
 // Commit won't do anything as write operations are disabled on this component
@@ -59,19 +164,24 @@ func (r *readOnlyAccountsDB) Commit() ([]byte, error) {
 	return nil, nil
 }
 
-// JournalLen will call the original accounts' function with the same name
+// JournalLen will call the current accounts' function with the same name
 func (r *readOnlyAccountsDB) JournalLen() int {
-	return r.originalAccounts.JournalLen()
+	return r.currentAccounts().JournalLen()
 }
 
-// RevertToSnapshot won't do anything as write operations are disabled on this component
-func (r *readOnlyAccountsDB) RevertToSnapshot(_ int) error {
-	return nil
+// RevertToSnapshot won't do anything as write operations are disabled on this component, unless a bundle is simulated
+func (r *readOnlyAccountsDB) RevertToSnapshot(snapshot int) error {
+	bundleAccounts, isBundleStarted := r.getBundleAccounts()
+	if !isBundleStarted {
+		return nil
+	}
+
+	return bundleAccounts.RevertToSnapshot(snapshot)
 }
 
-// RootHash will call the original accounts' function with the same name
+// RootHash will call the current accounts' function with the same name
 func (r *readOnlyAccountsDB) RootHash() ([]byte, error) {
-	return r.originalAccounts.RootHash()
+	return r.currentAccounts().RootHash()
 }
 
 // RecreateTrie won't do anything as write operations are disabled on this component
@@ -153,3 +153,65 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	err = roAccDb.GetAllLeaves(allLeaves, context.Background(), nil)
 	require.NoError(t, err)
 }
+
+func TestReadOnlyAccountsDB_BundleShouldRedirectTheOperations(t *testing.T) {
+	t.Parallel()
+
+	failErrMsg := "this function should have not be called"
+	originalAccounts := &stateMock.AccountsStub{
+		SaveAccountCalled: func(_ vmcommon.AccountHandler) error {
+			t.Errorf(failErrMsg)
+			return nil
+		},
+		RevertToSnapshotCalled: func(_ int) error {
+			t.Errorf(failErrMsg)
+			return nil
+		},
+	}
+	savedAccounts := make([]vmcommon.AccountHandler, 0)
+	revertedSnapshots := make([]int, 0)
+	bundleAccounts := &stateMock.AccountsStub{
+		SaveAccountCalled: func(account vmcommon.AccountHandler) error {
+			savedAccounts = append(savedAccounts, account)
+			return nil
+		},
+		RemoveAccountCalled: func(_ []byte) error {
+			return nil
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			revertedSnapshots = append(revertedSnapshots, snapshot)
+			return nil
+		},
+		JournalLenCalled: func() int {
+			return 7
+		},
+	}
+
+	roAccDb, _ := NewReadOnlyAccountsDB(originalAccounts)
+	roAccDb.StartBundle(bundleAccounts)
+
+	alice, _ := state.NewUserAccount([]byte("alice"))
+	_ = alice.DataTrieTracker().SaveKeyValue([]byte("key1"), []byte("value1"))
+	require.NoError(t, roAccDb.SaveAccount(alice))
+	alice.DataTrieTracker().ClearDataCaches()
+	_ = alice.DataTrieTracker().SaveKeyValue([]byte("key1"), []byte("value2"))
+	_ = alice.DataTrieTracker().SaveKeyValue([]byte("key2"), []byte("value3"))
+	require.NoError(t, roAccDb.SaveAccount(alice))
+	require.NoError(t, roAccDb.RemoveAccount([]byte("bob")))
+	require.Equal(t, 7, roAccDb.JournalLen())
+	require.NoError(t, roAccDb.RevertToSnapshot(3))
+
+	touchedAccounts := roAccDb.FinishBundle()
+	require.Equal(t, []vmcommon.AccountHandler{alice, alice}, savedAccounts)
+	require.Equal(t, []int{3}, revertedSnapshots)
+	require.Equal(t, 2, len(touchedAccounts))
+	require.Equal(t, []byte("alice"), touchedAccounts[0].Address)
+	require.ElementsMatch(t, [][]byte{[]byte("key1"), []byte("key2")}, touchedAccounts[0].Keys)
+	require.Equal(t, []byte("bob"), touchedAccounts[1].Address)
+	require.Empty(t, touchedAccounts[1].Keys)
+
+	// after the bundle is finished, the write operations are disabled again
+	require.NoError(t, roAccDb.SaveAccount(alice))
+	require.NoError(t, roAccDb.RevertToSnapshot(3))
+	require.Equal(t, 2, len(savedAccounts))
+}
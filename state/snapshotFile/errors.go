@@ -0,0 +1,45 @@
+package snapshotFile
+
+import "errors"
+
+// ErrNilStorageManager signals that a nil trie storage manager was provided
+var ErrNilStorageManager = errors.New("nil trie storage manager")
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilWriter signals that a nil writer was provided
+var ErrNilWriter = errors.New("nil writer")
+
+// ErrInvalidChunkSize signals that an invalid chunk size was provided
+var ErrInvalidChunkSize = errors.New("invalid chunk size")
+
+// ErrInvalidSnapshotFile signals that the provided file is not a state snapshot file
+var ErrInvalidSnapshotFile = errors.New("invalid state snapshot file")
+
+// ErrUnsupportedVersion signals that the state snapshot file was written with an unsupported version
+var ErrUnsupportedVersion = errors.New("unsupported state snapshot file version")
+
+// ErrChunkChecksumMismatch signals that a chunk of the state snapshot file does not match its checksum
+var ErrChunkChecksumMismatch = errors.New("chunk checksum mismatch")
+
+// ErrNumLeavesMismatch signals that the number of leaves found differs from the one in the manifest
+var ErrNumLeavesMismatch = errors.New("number of leaves mismatch")
+
+// ErrRootHashMismatch signals that a rebuilt trie does not have the root hash found in the manifest
+var ErrRootHashMismatch = errors.New("root hash mismatch")
+
+// ErrIncompleteTrie signals that a trie to be exported has missing or corrupted nodes
+var ErrIncompleteTrie = errors.New("incomplete trie")
+
+// ErrNilRootHash signals that a nil root hash was provided
+var ErrNilRootHash = errors.New("nil root hash")
+
+// ErrUnexpectedDataTrie signals that the state snapshot file holds a data trie which is not referenced by the accounts trie
+var ErrUnexpectedDataTrie = errors.New("unexpected data trie")
+
+// ErrMissingDataTries signals that the state snapshot file lacks data tries referenced by the accounts trie
+var ErrMissingDataTries = errors.New("missing data tries")
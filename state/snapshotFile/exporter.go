@@ -0,0 +1,285 @@
+package snapshotFile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+var log = logger.GetOrCreate("state/snapshotFile")
+
+// ArgsExporter holds the arguments needed for creating a new exporter
+type ArgsExporter struct {
+	StorageManager common.StorageManager
+	Marshalizer    marshal.Marshalizer
+	Hasher         hashing.Hasher
+	MaxChunkSize   uint64
+}
+
+type trieWalker interface {
+	Check(ctx context.Context, rootHash []byte, leafHandler func(key []byte, value []byte)) (*trie.IntegrityReport, error)
+	IsInterfaceNil() bool
+}
+
+type exporter struct {
+	walker       trieWalker
+	marshalizer  marshal.Marshalizer
+	maxChunkSize uint64
+}
+
+// NewExporter creates a component able to write the accounts trie and the accounts' data tries found under a root
+// hash in a state snapshot file. The max chunk size limits the size of the uncompressed leaves held by a chunk
+func NewExporter(args ArgsExporter) (*exporter, error) {
+	if check.IfNil(args.StorageManager) {
+		return nil, ErrNilStorageManager
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if args.MaxChunkSize == 0 {
+		return nil, ErrInvalidChunkSize
+	}
+
+	walker, err := trie.NewIntegrityChecker(trie.ArgsIntegrityChecker{
+		StorageManager: args.StorageManager,
+		Marshalizer:    args.Marshalizer,
+		Hasher:         args.Hasher,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &exporter{
+		walker:       walker,
+		marshalizer:  args.Marshalizer,
+		maxChunkSize: args.MaxChunkSize,
+	}, nil
+}
+
+// Export streams all the leaves of the accounts trie with the provided root hash, then the leaves of each account's
+// data trie, to the writer. The manifest is written at the end and is also returned. The tries are walked directly from
+// the storage and the export fails on the first trie having missing or corrupted nodes, so no manifest is written for
+// an incomplete state
+func (e *exporter) Export(ctx context.Context, rootHash []byte, shardID uint32, epoch uint32, writer io.Writer) (*Manifest, error) {
+	if writer == nil {
+		return nil, ErrNilWriter
+	}
+
+	fw := &fileWriter{
+		writer: writer,
+	}
+	err := fw.write(magic)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{
+		Version:  CurrentVersion,
+		ShardID:  shardID,
+		Epoch:    epoch,
+		RootHash: hex.EncodeToString(rootHash),
+		Tries:    make([]*TrieManifest, 0),
+	}
+
+	dataTries := make([]*TrieManifest, 0)
+	accountsTrieManifest, err := e.exportTrie(ctx, rootHash, fw, func(key []byte, value []byte) {
+		dataTrieRootHash := getDataTrieRootHash(e.marshalizer, key, value)
+		if len(dataTrieRootHash) == 0 {
+			return
+		}
+
+		dataTries = append(dataTries, &TrieManifest{
+			RootHash: hex.EncodeToString(dataTrieRootHash),
+			Address:  hex.EncodeToString(key),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	manifest.Tries = append(manifest.Tries, accountsTrieManifest)
+
+	for _, dataTrie := range dataTries {
+		dataTrieRootHash, _ := hex.DecodeString(dataTrie.RootHash)
+		dataTrieManifest, errExport := e.exportTrie(ctx, dataTrieRootHash, fw, nil)
+		if errExport != nil {
+			return nil, fmt.Errorf("%w for the data trie of address %s", errExport, dataTrie.Address)
+		}
+
+		dataTrieManifest.Address = dataTrie.Address
+		manifest.Tries = append(manifest.Tries, dataTrieManifest)
+	}
+
+	err = writeFooter(fw, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("state snapshot exported",
+		"root hash", rootHash,
+		"num leaves", accountsTrieManifest.NumLeaves,
+		"num data tries", len(dataTries),
+		"num bytes", fw.offset,
+	)
+
+	return manifest, nil
+}
+
+func (e *exporter) exportTrie(
+	ctx context.Context,
+	rootHash []byte,
+	fw *fileWriter,
+	leafHandler func(key []byte, value []byte),
+) (*TrieManifest, error) {
+	trieManifest := &TrieManifest{
+		RootHash: hex.EncodeToString(rootHash),
+		Chunks:   make([]*ChunkManifest, 0),
+	}
+
+	walkCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var errWrite error
+	chunk := newChunkBuilder()
+	report, err := e.walker.Check(walkCtx, rootHash, func(key []byte, value []byte) {
+		if errWrite != nil {
+			return
+		}
+
+		chunk.addLeaf(key, value)
+		trieManifest.NumLeaves++
+		if leafHandler != nil {
+			leafHandler(key, value)
+		}
+
+		if uint64(chunk.buff.Len()) < e.maxChunkSize {
+			return
+		}
+
+		errWrite = fw.writeChunk(chunk, trieManifest)
+		if errWrite != nil {
+			// stops the trie walk
+			cancel()
+		}
+		chunk = newChunkBuilder()
+	})
+	if errWrite != nil {
+		return nil, errWrite
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !report.IsComplete() {
+		return nil, newIncompleteTrieError(report)
+	}
+
+	if chunk.numLeaves > 0 {
+		err = fw.writeChunk(chunk, trieManifest)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return trieManifest, nil
+}
+
+func newIncompleteTrieError(report *trie.IntegrityReport) error {
+	var firstIssue trie.IntegrityIssue
+	if len(report.Missing) > 0 {
+		firstIssue = report.Missing[0]
+	} else {
+		firstIssue = report.Corrupted[0]
+	}
+
+	return fmt.Errorf("%w, %d missing and %d corrupted nodes, first one being %s",
+		ErrIncompleteTrie, len(report.Missing), len(report.Corrupted), firstIssue.String())
+}
+
+type chunkBuilder struct {
+	buff      *bytes.Buffer
+	numLeaves uint64
+}
+
+func newChunkBuilder() *chunkBuilder {
+	return &chunkBuilder{
+		buff: &bytes.Buffer{},
+	}
+}
+
+func (cb *chunkBuilder) addLeaf(key []byte, value []byte) {
+	length := make([]byte, leafLengthSize)
+	for _, field := range [][]byte{key, value} {
+		binary.BigEndian.PutUint32(length, uint32(len(field)))
+		cb.buff.Write(length)
+		cb.buff.Write(field)
+	}
+	cb.numLeaves++
+}
+
+type fileWriter struct {
+	writer io.Writer
+	offset uint64
+}
+
+func (fw *fileWriter) writeChunk(chunk *chunkBuilder, trieManifest *TrieManifest) error {
+	compressed := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(compressed)
+	_, err := gzipWriter.Write(chunk.buff.Bytes())
+	if err != nil {
+		return err
+	}
+	err = gzipWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	checksum := sha256.Sum256(compressed.Bytes())
+	chunkManifest := &ChunkManifest{
+		Offset:    fw.offset,
+		Size:      uint64(compressed.Len()),
+		NumLeaves: chunk.numLeaves,
+		Checksum:  hex.EncodeToString(checksum[:]),
+	}
+
+	err = fw.write(compressed.Bytes())
+	if err != nil {
+		return err
+	}
+
+	trieManifest.Chunks = append(trieManifest.Chunks, chunkManifest)
+
+	return nil
+}
+
+// Write writes the provided bytes, keeping track of the offset in the file
+func (fw *fileWriter) Write(buff []byte) (int, error) {
+	n, err := fw.writer.Write(buff)
+	fw.offset += uint64(n)
+
+	return n, err
+}
+
+func (fw *fileWriter) write(buff []byte) error {
+	_, err := fw.Write(buff)
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *exporter) IsInterfaceNil() bool {
+	return e == nil
+}
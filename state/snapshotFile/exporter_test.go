@@ -0,0 +1,233 @@
+package snapshotFile
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/hashesHolder/disabled"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	marshalizerForTest = &marshal.GogoProtoMarshalizer{}
+	hasherForTest      = blake2b.NewBlake2b()
+)
+
+func createStorageManagerForTest(t *testing.T) common.StorageManager {
+	storageManager, err := trie.NewTrieStorageManager(trie.NewTrieStorageManagerArgs{
+		MainStorer:        testscommon.NewSnapshotPruningStorerMock(),
+		CheckpointsStorer: testscommon.NewSnapshotPruningStorerMock(),
+		Marshalizer:       marshalizerForTest,
+		Hasher:            hasherForTest,
+		GeneralConfig: config.TrieStorageManagerConfig{
+			SnapshotsBufferLen:    10,
+			SnapshotsGoroutineNum: 1,
+		},
+		CheckpointHashesHolder: disabled.NewDisabledCheckpointHashesHolder(),
+		IdleProvider:           &testscommon.ProcessStatusHandlerStub{},
+	})
+	require.Nil(t, err)
+
+	return storageManager
+}
+
+func createTrieForTest(t *testing.T, storageManager common.StorageManager) common.Trie {
+	tr, err := trie.NewTrie(storageManager, marshalizerForTest, hasherForTest, 5)
+	require.Nil(t, err)
+
+	return tr
+}
+
+func createCommittedTrieForTest(t *testing.T, storageManager common.StorageManager, numLeaves int) []byte {
+	tr := createTrieForTest(t, storageManager)
+	for i := 0; i < numLeaves; i++ {
+		require.Nil(t, tr.Update([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+// createStateForTest creates an accounts trie with one account for each provided number of data trie leaves and
+// a code leaf
+func createStateForTest(t *testing.T, storageManager common.StorageManager, numDataTrieLeaves ...int) []byte {
+	tr := createTrieForTest(t, storageManager)
+	for i, numLeaves := range numDataTrieLeaves {
+		account := &state.UserAccountData{
+			Address: []byte(fmt.Sprintf("address%025d", i)),
+		}
+		if numLeaves > 0 {
+			account.RootHash = createCommittedTrieForTest(t, storageManager, numLeaves)
+		}
+
+		accountBytes, err := marshalizerForTest.Marshal(account)
+		require.Nil(t, err)
+		require.Nil(t, tr.Update(account.Address, accountBytes))
+	}
+	require.Nil(t, tr.Update([]byte("code hash"), []byte("code")))
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+func exportForTest(t *testing.T, maxChunkSize uint64, numDataTrieLeaves ...int) ([]byte, []byte, *Manifest) {
+	storageManager := createStorageManagerForTest(t)
+	rootHash := createStateForTest(t, storageManager, numDataTrieLeaves...)
+
+	exp, err := NewExporter(ArgsExporter{
+		StorageManager: storageManager,
+		Marshalizer:    marshalizerForTest,
+		Hasher:         hasherForTest,
+		MaxChunkSize:   maxChunkSize,
+	})
+	require.Nil(t, err)
+
+	buff := &bytes.Buffer{}
+	manifest, err := exp.Export(context.Background(), rootHash, 1, 7, buff)
+	require.Nil(t, err)
+
+	return rootHash, buff.Bytes(), manifest
+}
+
+func TestNewExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		exp, err := NewExporter(ArgsExporter{Marshalizer: marshalizerForTest, Hasher: hasherForTest, MaxChunkSize: 1})
+		assert.True(t, check.IfNil(exp))
+		assert.Equal(t, ErrNilStorageManager, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		exp, err := NewExporter(ArgsExporter{StorageManager: createStorageManagerForTest(t), Hasher: hasherForTest, MaxChunkSize: 1})
+		assert.True(t, check.IfNil(exp))
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		exp, err := NewExporter(ArgsExporter{StorageManager: createStorageManagerForTest(t), Marshalizer: marshalizerForTest, MaxChunkSize: 1})
+		assert.True(t, check.IfNil(exp))
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("invalid chunk size should error", func(t *testing.T) {
+		t.Parallel()
+
+		exp, err := NewExporter(ArgsExporter{StorageManager: createStorageManagerForTest(t), Marshalizer: marshalizerForTest, Hasher: hasherForTest})
+		assert.True(t, check.IfNil(exp))
+		assert.Equal(t, ErrInvalidChunkSize, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		exp, err := NewExporter(ArgsExporter{
+			StorageManager: createStorageManagerForTest(t),
+			Marshalizer:    marshalizerForTest,
+			Hasher:         hasherForTest,
+			MaxChunkSize:   1,
+		})
+		assert.False(t, check.IfNil(exp))
+		assert.Nil(t, err)
+	})
+}
+
+func TestExporter_ExportShouldWriteAllTheTries(t *testing.T) {
+	t.Parallel()
+
+	rootHash, fileBytes, manifest := exportForTest(t, 100, 10, 0, 50)
+
+	assert.Equal(t, CurrentVersion, manifest.Version)
+	assert.Equal(t, uint32(1), manifest.ShardID)
+	assert.Equal(t, uint32(7), manifest.Epoch)
+	assert.Equal(t, fmt.Sprintf("%x", rootHash), manifest.RootHash)
+
+	require.Equal(t, 3, len(manifest.Tries))
+	assert.Equal(t, manifest.RootHash, manifest.Tries[0].RootHash)
+	assert.Equal(t, "", manifest.Tries[0].Address)
+	assert.Equal(t, uint64(4), manifest.Tries[0].NumLeaves)
+
+	numLeaves := map[uint64]bool{}
+	for _, dataTrie := range manifest.Tries[1:] {
+		assert.NotEqual(t, "", dataTrie.Address)
+		numLeaves[dataTrie.NumLeaves] = true
+
+		numChunkLeaves := uint64(0)
+		for _, chunk := range dataTrie.Chunks {
+			numChunkLeaves += chunk.NumLeaves
+		}
+		assert.Equal(t, dataTrie.NumLeaves, numChunkLeaves)
+	}
+	assert.Equal(t, map[uint64]bool{10: true, 50: true}, numLeaves)
+	assert.True(t, len(manifest.Tries[2].Chunks) > 1 || len(manifest.Tries[1].Chunks) > 1)
+
+	readManifest, err := ReadManifest(bytes.NewReader(fileBytes))
+	require.Nil(t, err)
+	assert.Equal(t, manifest, readManifest)
+}
+
+func TestExporter_ExportWithCanceledContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	storageManager := createStorageManagerForTest(t)
+	rootHash := createStateForTest(t, storageManager, 10)
+	exp, _ := NewExporter(ArgsExporter{
+		StorageManager: storageManager,
+		Marshalizer:    marshalizerForTest,
+		Hasher:         hasherForTest,
+		MaxChunkSize:   100,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	manifest, err := exp.Export(ctx, rootHash, 0, 0, &bytes.Buffer{})
+	assert.Nil(t, manifest)
+	assert.NotNil(t, err)
+}
+
+func TestExporter_ExportWithMissingNodeShouldError(t *testing.T) {
+	t.Parallel()
+
+	storageManager := createStorageManagerForTest(t)
+	rootHash := createStateForTest(t, storageManager, 10, 20)
+	exp, _ := NewExporter(ArgsExporter{
+		StorageManager: storageManager,
+		Marshalizer:    marshalizerForTest,
+		Hasher:         hasherForTest,
+		MaxChunkSize:   100,
+	})
+
+	manifest, err := exp.Export(context.Background(), rootHash, 0, 0, &bytes.Buffer{})
+	require.Nil(t, err)
+
+	dataTrieRootHash, _ := hex.DecodeString(manifest.Tries[2].RootHash)
+	require.Nil(t, storageManager.Remove(dataTrieRootHash))
+
+	incompleteManifest, err := exp.Export(context.Background(), rootHash, 0, 0, &bytes.Buffer{})
+	assert.Nil(t, incompleteManifest)
+	assert.True(t, errors.Is(err, ErrIncompleteTrie))
+	assert.True(t, strings.Contains(err.Error(), manifest.Tries[2].Address))
+}
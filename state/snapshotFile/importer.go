@@ -0,0 +1,228 @@
+package snapshotFile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+// ArgsImporter holds the arguments needed for creating a new importer
+type ArgsImporter struct {
+	StorageManager       common.StorageManager
+	Marshalizer          marshal.Marshalizer
+	Hasher               hashing.Hasher
+	MaxTrieLevelInMemory uint
+}
+
+type importer struct {
+	storageManager       common.StorageManager
+	marshalizer          marshal.Marshalizer
+	hasher               hashing.Hasher
+	maxTrieLevelInMemory uint
+}
+
+// NewImporter creates a component able to rebuild, in the provided storage, the tries found in a state snapshot file
+func NewImporter(args ArgsImporter) (*importer, error) {
+	if check.IfNil(args.StorageManager) {
+		return nil, ErrNilStorageManager
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &importer{
+		storageManager:       args.StorageManager,
+		marshalizer:          args.Marshalizer,
+		hasher:               args.Hasher,
+		maxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+	}, nil
+}
+
+// Import rebuilds all the tries of the provided state snapshot file, which must hold the state of the expected root
+// hash. Every chunk is checked against its checksum before being used and every rebuilt trie must have the root hash
+// found in the manifest. The data tries of the file must be exactly the ones referenced by the rebuilt accounts trie.
+// The tries are committed after each chunk, so the memory used does not depend on the size of the state
+func (i *importer) Import(ctx context.Context, reader io.ReadSeeker, expectedRootHash []byte) (*Manifest, error) {
+	if len(expectedRootHash) == 0 {
+		return nil, ErrNilRootHash
+	}
+
+	manifest, err := ReadManifest(reader)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.RootHash != hex.EncodeToString(expectedRootHash) {
+		return nil, fmt.Errorf("%w, the file holds the state of root hash %s, expected %x",
+			ErrRootHashMismatch, manifest.RootHash, expectedRootHash)
+	}
+
+	// hex encoded data trie root hashes, by hex encoded address
+	expectedDataTries := make(map[string]string)
+	err = i.importTrie(ctx, reader, manifest.Tries[0], func(key []byte, value []byte) {
+		dataTrieRootHash := getDataTrieRootHash(i.marshalizer, key, value)
+		if len(dataTrieRootHash) > 0 {
+			expectedDataTries[hex.EncodeToString(key)] = hex.EncodeToString(dataTrieRootHash)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w for the accounts trie", err)
+	}
+
+	for _, trieManifest := range manifest.Tries[1:] {
+		expectedDataTrieRootHash, ok := expectedDataTries[trieManifest.Address]
+		if !ok || expectedDataTrieRootHash != trieManifest.RootHash {
+			return nil, fmt.Errorf("%w for address %s", ErrUnexpectedDataTrie, trieManifest.Address)
+		}
+		delete(expectedDataTries, trieManifest.Address)
+
+		err = i.importTrie(ctx, reader, trieManifest, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the data trie of address %s", err, trieManifest.Address)
+		}
+	}
+	if len(expectedDataTries) > 0 {
+		return nil, fmt.Errorf("%w, %d referenced data tries are not in the file", ErrMissingDataTries, len(expectedDataTries))
+	}
+
+	log.Debug("state snapshot imported",
+		"root hash", manifest.RootHash,
+		"shard", manifest.ShardID,
+		"epoch", manifest.Epoch,
+		"num data tries", len(manifest.Tries)-1,
+	)
+
+	return manifest, nil
+}
+
+func (i *importer) importTrie(
+	ctx context.Context,
+	reader io.ReadSeeker,
+	trieManifest *TrieManifest,
+	leafHandler func(key []byte, value []byte),
+) error {
+	expectedRootHash, err := hex.DecodeString(trieManifest.RootHash)
+	if err != nil {
+		return err
+	}
+
+	tr, err := trie.NewTrie(i.storageManager, i.marshalizer, i.hasher, i.maxTrieLevelInMemory)
+	if err != nil {
+		return err
+	}
+
+	numLeaves := uint64(0)
+	for _, chunk := range trieManifest.Chunks {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		numChunkLeaves, errImport := importChunk(reader, chunk, tr, leafHandler)
+		if errImport != nil {
+			return errImport
+		}
+		numLeaves += numChunkLeaves
+
+		err = tr.Commit()
+		if err != nil {
+			return err
+		}
+	}
+	if numLeaves != trieManifest.NumLeaves {
+		return fmt.Errorf("%w, found %d, expected %d", ErrNumLeavesMismatch, numLeaves, trieManifest.NumLeaves)
+	}
+
+	rootHash, err := tr.RootHash()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(rootHash, expectedRootHash) {
+		return fmt.Errorf("%w, rebuilt %x, expected %x", ErrRootHashMismatch, rootHash, expectedRootHash)
+	}
+
+	return nil
+}
+
+func importChunk(reader io.ReadSeeker, chunk *ChunkManifest, tr common.Trie, leafHandler func(key []byte, value []byte)) (uint64, error) {
+	compressed, err := readAt(reader, int64(chunk.Offset), int64(chunk.Size))
+	if err != nil {
+		return 0, err
+	}
+
+	checksum := sha256.Sum256(compressed)
+	if hex.EncodeToString(checksum[:]) != chunk.Checksum {
+		return 0, fmt.Errorf("%w for the chunk at offset %d", ErrChunkChecksumMismatch, chunk.Offset)
+	}
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return 0, err
+	}
+	leaves, err := ioutil.ReadAll(gzipReader)
+	if err != nil {
+		return 0, err
+	}
+
+	numLeaves := uint64(0)
+	for len(leaves) > 0 {
+		var key, value []byte
+		key, leaves, err = readField(leaves)
+		if err != nil {
+			return 0, err
+		}
+		value, leaves, err = readField(leaves)
+		if err != nil {
+			return 0, err
+		}
+
+		err = tr.Update(key, value)
+		if err != nil {
+			return 0, err
+		}
+		if leafHandler != nil {
+			leafHandler(key, value)
+		}
+		numLeaves++
+	}
+	if numLeaves != chunk.NumLeaves {
+		return 0, fmt.Errorf("%w in the chunk at offset %d, found %d, expected %d",
+			ErrNumLeavesMismatch, chunk.Offset, numLeaves, chunk.NumLeaves)
+	}
+
+	return numLeaves, nil
+}
+
+func readField(buff []byte) ([]byte, []byte, error) {
+	if len(buff) < leafLengthSize {
+		return nil, nil, fmt.Errorf("%w, truncated leaf length", ErrInvalidSnapshotFile)
+	}
+
+	length := int(binary.BigEndian.Uint32(buff[:leafLengthSize]))
+	buff = buff[leafLengthSize:]
+	if len(buff) < length {
+		return nil, nil, fmt.Errorf("%w, truncated leaf", ErrInvalidSnapshotFile)
+	}
+
+	return buff[:length], buff[length:], nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (i *importer) IsInterfaceNil() bool {
+	return i == nil
+}
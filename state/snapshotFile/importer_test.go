@@ -0,0 +1,237 @@
+package snapshotFile
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createImporterForTest(t *testing.T) *importer {
+	imp, err := NewImporter(ArgsImporter{
+		StorageManager:       createStorageManagerForTest(t),
+		Marshalizer:          marshalizerForTest,
+		Hasher:               hasherForTest,
+		MaxTrieLevelInMemory: 5,
+	})
+	require.Nil(t, err)
+
+	return imp
+}
+
+// replaceManifest returns the provided file with the manifest replaced by the provided one
+func replaceManifest(t *testing.T, fileBytes []byte, manifest *Manifest) []byte {
+	footerSize := manifestLengthSize + len(magic)
+	manifestLength := binary.BigEndian.Uint64(fileBytes[len(fileBytes)-footerSize:])
+	manifestOffset := uint64(len(fileBytes)-footerSize) - manifestLength
+
+	buff := bytes.NewBuffer(append(make([]byte, 0), fileBytes[:manifestOffset]...))
+	require.Nil(t, writeFooter(buff, manifest))
+
+	return buff.Bytes()
+}
+
+func TestNewImporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		imp, err := NewImporter(ArgsImporter{Marshalizer: marshalizerForTest, Hasher: hasherForTest})
+		assert.True(t, check.IfNil(imp))
+		assert.Equal(t, ErrNilStorageManager, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		imp, err := NewImporter(ArgsImporter{StorageManager: createStorageManagerForTest(t), Hasher: hasherForTest})
+		assert.True(t, check.IfNil(imp))
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		imp, err := NewImporter(ArgsImporter{StorageManager: createStorageManagerForTest(t), Marshalizer: marshalizerForTest})
+		assert.True(t, check.IfNil(imp))
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		imp := createImporterForTest(t)
+		assert.False(t, check.IfNil(imp))
+	})
+}
+
+func TestImporter_ImportShouldRebuildTheTries(t *testing.T) {
+	t.Parallel()
+
+	rootHash, fileBytes, exportedManifest := exportForTest(t, 100, 10, 0, 50)
+
+	imp := createImporterForTest(t)
+	manifest, err := imp.Import(context.Background(), bytes.NewReader(fileBytes), rootHash)
+	require.Nil(t, err)
+	assert.Equal(t, exportedManifest, manifest)
+
+	integrityChecker, err := trie.NewIntegrityChecker(trie.ArgsIntegrityChecker{
+		StorageManager: imp.storageManager,
+		Marshalizer:    marshalizerForTest,
+		Hasher:         hasherForTest,
+	})
+	require.Nil(t, err)
+
+	for _, trieManifest := range manifest.Tries {
+		trieRootHash, _ := hex.DecodeString(trieManifest.RootHash)
+		report, errCheck := integrityChecker.Check(context.Background(), trieRootHash, nil)
+		require.Nil(t, errCheck)
+		assert.True(t, report.IsComplete())
+		assert.Equal(t, trieManifest.NumLeaves, report.NumLeaves)
+	}
+	assert.Equal(t, hex.EncodeToString(rootHash), manifest.Tries[0].RootHash)
+}
+
+func TestImporter_ImportCorruptedChunkShouldError(t *testing.T) {
+	t.Parallel()
+
+	rootHash, fileBytes, manifest := exportForTest(t, 100, 10)
+	chunk := manifest.Tries[0].Chunks[0]
+	fileBytes[chunk.Offset+chunk.Size/2] ^= 0xFF
+
+	imp := createImporterForTest(t)
+	_, err := imp.Import(context.Background(), bytes.NewReader(fileBytes), rootHash)
+	assert.True(t, errors.Is(err, ErrChunkChecksumMismatch))
+}
+
+func TestImporter_ImportWrongRootHashShouldError(t *testing.T) {
+	t.Parallel()
+
+	_, fileBytes, manifest := exportForTest(t, 100, 10, 20)
+	manifest.RootHash = manifest.Tries[1].RootHash
+	manifest.Tries[0].RootHash = manifest.Tries[1].RootHash
+	fileBytes = replaceManifest(t, fileBytes, manifest)
+	expectedRootHash, _ := hex.DecodeString(manifest.RootHash)
+
+	imp := createImporterForTest(t)
+	_, err := imp.Import(context.Background(), bytes.NewReader(fileBytes), expectedRootHash)
+	assert.True(t, errors.Is(err, ErrRootHashMismatch))
+}
+
+func TestImporter_ImportShouldCheckTheExpectedRootHash(t *testing.T) {
+	t.Parallel()
+
+	_, fileBytes, _ := exportForTest(t, 100, 10)
+	imp := createImporterForTest(t)
+
+	t.Run("nil expected root hash should error", func(t *testing.T) {
+		_, err := imp.Import(context.Background(), bytes.NewReader(fileBytes), nil)
+		assert.Equal(t, ErrNilRootHash, err)
+	})
+	t.Run("file holding another state should error", func(t *testing.T) {
+		_, err := imp.Import(context.Background(), bytes.NewReader(fileBytes), []byte("another root hash"))
+		assert.True(t, errors.Is(err, ErrRootHashMismatch))
+	})
+}
+
+func TestImporter_ImportShouldCheckTheDataTries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing data trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		rootHash, fileBytes, manifest := exportForTest(t, 100, 10, 20)
+		manifest.Tries = manifest.Tries[:2]
+		fileBytes = replaceManifest(t, fileBytes, manifest)
+
+		imp := createImporterForTest(t)
+		_, err := imp.Import(context.Background(), bytes.NewReader(fileBytes), rootHash)
+		assert.True(t, errors.Is(err, ErrMissingDataTries))
+	})
+	t.Run("data trie of another address should error", func(t *testing.T) {
+		t.Parallel()
+
+		rootHash, fileBytes, manifest := exportForTest(t, 100, 10, 20)
+		manifest.Tries[2].Address = hex.EncodeToString([]byte("another address"))
+		fileBytes = replaceManifest(t, fileBytes, manifest)
+
+		imp := createImporterForTest(t)
+		_, err := imp.Import(context.Background(), bytes.NewReader(fileBytes), rootHash)
+		assert.True(t, errors.Is(err, ErrUnexpectedDataTrie))
+	})
+	t.Run("duplicated data trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		rootHash, fileBytes, manifest := exportForTest(t, 100, 10, 20)
+		manifest.Tries = append(manifest.Tries, manifest.Tries[1])
+		fileBytes = replaceManifest(t, fileBytes, manifest)
+
+		imp := createImporterForTest(t)
+		_, err := imp.Import(context.Background(), bytes.NewReader(fileBytes), rootHash)
+		assert.True(t, errors.Is(err, ErrUnexpectedDataTrie))
+	})
+	t.Run("data trie with another root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		rootHash, fileBytes, manifest := exportForTest(t, 100, 10, 20)
+		manifest.Tries[2].RootHash = manifest.Tries[1].RootHash
+		fileBytes = replaceManifest(t, fileBytes, manifest)
+
+		imp := createImporterForTest(t)
+		_, err := imp.Import(context.Background(), bytes.NewReader(fileBytes), rootHash)
+		assert.True(t, errors.Is(err, ErrUnexpectedDataTrie))
+	})
+}
+
+func TestImporter_ImportWrongNumLeavesShouldError(t *testing.T) {
+	t.Parallel()
+
+	rootHash, fileBytes, manifest := exportForTest(t, 100, 10)
+	manifest.Tries[0].NumLeaves++
+	fileBytes = replaceManifest(t, fileBytes, manifest)
+
+	imp := createImporterForTest(t)
+	_, err := imp.Import(context.Background(), bytes.NewReader(fileBytes), rootHash)
+	assert.True(t, errors.Is(err, ErrNumLeavesMismatch))
+}
+
+func TestImporter_ImportUnsupportedVersionShouldError(t *testing.T) {
+	t.Parallel()
+
+	rootHash, fileBytes, manifest := exportForTest(t, 100, 10)
+	manifest.Version = CurrentVersion + 1
+	fileBytes = replaceManifest(t, fileBytes, manifest)
+
+	imp := createImporterForTest(t)
+	_, err := imp.Import(context.Background(), bytes.NewReader(fileBytes), rootHash)
+	assert.True(t, errors.Is(err, ErrUnsupportedVersion))
+}
+
+func TestImporter_ImportInvalidFileShouldError(t *testing.T) {
+	t.Parallel()
+
+	imp := createImporterForTest(t)
+
+	t.Run("too small", func(t *testing.T) {
+		_, err := imp.Import(context.Background(), bytes.NewReader([]byte("small")), []byte("root hash"))
+		assert.True(t, errors.Is(err, ErrInvalidSnapshotFile))
+	})
+	t.Run("truncated", func(t *testing.T) {
+		rootHash, fileBytes, _ := exportForTest(t, 100, 10)
+
+		_, err := imp.Import(context.Background(), bytes.NewReader(fileBytes[:len(fileBytes)-1]), rootHash)
+		assert.True(t, errors.Is(err, ErrInvalidSnapshotFile))
+	})
+	t.Run("invalid manifest length", func(t *testing.T) {
+		rootHash, fileBytes, _ := exportForTest(t, 100, 10)
+		binary.BigEndian.PutUint64(fileBytes[len(fileBytes)-len(magic)-manifestLengthSize:], uint64(len(fileBytes)))
+
+		_, err := imp.Import(context.Background(), bytes.NewReader(fileBytes), rootHash)
+		assert.True(t, errors.Is(err, ErrInvalidSnapshotFile))
+	})
+}
@@ -0,0 +1,162 @@
+package snapshotFile
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// The state snapshot file has the following layout:
+//
+//	magic | chunk 1 | chunk 2 | ... | chunk N | manifest | manifest length (8 bytes, big endian) | magic
+//
+// Each chunk is a gzip stream holding leaves of a single trie, every leaf being written as
+// key length (4 bytes, big endian) | key | value length (4 bytes, big endian) | value.
+// The manifest is JSON encoded and is written last, so the file can be produced while the tries are walked.
+
+// CurrentVersion is the version of the state snapshot files written by the exporter
+const CurrentVersion = uint32(1)
+
+const (
+	manifestLengthSize = 8
+	leafLengthSize     = 4
+)
+
+var magic = []byte("ERDSNAP1")
+
+// Manifest describes the content of a state snapshot file. The first trie is the accounts trie, followed by the
+// accounts' data tries
+type Manifest struct {
+	Version  uint32          `json:"version"`
+	ShardID  uint32          `json:"shardID"`
+	Epoch    uint32          `json:"epoch"`
+	RootHash string          `json:"rootHash"`
+	Tries    []*TrieManifest `json:"tries"`
+}
+
+// TrieManifest describes a trie found in a state snapshot file. The address is empty for the accounts trie
+type TrieManifest struct {
+	RootHash  string           `json:"rootHash"`
+	Address   string           `json:"address,omitempty"`
+	NumLeaves uint64           `json:"numLeaves"`
+	Chunks    []*ChunkManifest `json:"chunks"`
+}
+
+// ChunkManifest describes a chunk of a state snapshot file. The checksum is the hex encoded sha256 of the compressed
+// chunk, as found at the given offset in the file
+type ChunkManifest struct {
+	Offset    uint64 `json:"offset"`
+	Size      uint64 `json:"size"`
+	NumLeaves uint64 `json:"numLeaves"`
+	Checksum  string `json:"checksum"`
+}
+
+func writeFooter(writer io.Writer, manifest *Manifest) error {
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	manifestLength := make([]byte, manifestLengthSize)
+	binary.BigEndian.PutUint64(manifestLength, uint64(len(manifestBytes)))
+
+	for _, buff := range [][]byte{manifestBytes, manifestLength, magic} {
+		_, err = writer.Write(buff)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadManifest reads the manifest of the provided state snapshot file, checking its version
+func ReadManifest(reader io.ReadSeeker) (*Manifest, error) {
+	fileSize, err := reader.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	footerSize := int64(manifestLengthSize + len(magic))
+	if fileSize < int64(len(magic))+footerSize {
+		return nil, fmt.Errorf("%w, the file is too small", ErrInvalidSnapshotFile)
+	}
+
+	header, err := readAt(reader, 0, int64(len(magic)))
+	if err != nil {
+		return nil, err
+	}
+	footer, err := readAt(reader, fileSize-footerSize, footerSize)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(header, magic) || !bytes.Equal(footer[manifestLengthSize:], magic) {
+		return nil, fmt.Errorf("%w, magic bytes not found", ErrInvalidSnapshotFile)
+	}
+
+	manifestLength := int64(binary.BigEndian.Uint64(footer[:manifestLengthSize]))
+	manifestOffset := fileSize - footerSize - manifestLength
+	if manifestLength <= 0 || manifestOffset < int64(len(magic)) {
+		return nil, fmt.Errorf("%w, invalid manifest length %d", ErrInvalidSnapshotFile, manifestLength)
+	}
+
+	manifestBytes, err := readAt(reader, manifestOffset, manifestLength)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	err = json.Unmarshal(manifestBytes, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the manifest", err)
+	}
+	if manifest.Version != CurrentVersion {
+		return nil, fmt.Errorf("%w %d", ErrUnsupportedVersion, manifest.Version)
+	}
+	if len(manifest.Tries) == 0 || manifest.Tries[0].RootHash != manifest.RootHash {
+		return nil, fmt.Errorf("%w, the manifest does not start with the accounts trie", ErrInvalidSnapshotFile)
+	}
+
+	for _, trieManifest := range manifest.Tries {
+		for _, chunk := range trieManifest.Chunks {
+			if chunk.Offset < uint64(len(magic)) || chunk.Offset+chunk.Size > uint64(manifestOffset) || chunk.Size == 0 {
+				return nil, fmt.Errorf("%w, chunk at offset %d with size %d is out of bounds",
+					ErrInvalidSnapshotFile, chunk.Offset, chunk.Size)
+			}
+		}
+	}
+
+	return manifest, nil
+}
+
+func readAt(reader io.ReadSeeker, offset int64, size int64) ([]byte, error) {
+	_, err := reader.Seek(offset, io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+
+	buff := make([]byte, size)
+	_, err = io.ReadFull(reader, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff, nil
+}
+
+// getDataTrieRootHash returns the root hash of the data trie of the account held by the provided accounts trie leaf.
+// It returns nil for the code leaves and for the accounts without a data trie
+func getDataTrieRootHash(marshalizer marshal.Marshalizer, key []byte, value []byte) []byte {
+	account := &state.UserAccountData{}
+	err := marshalizer.Unmarshal(account, value)
+	if err != nil || !bytes.Equal(account.Address, key) {
+		return nil
+	}
+
+	return account.RootHash
+}
@@ -125,6 +125,11 @@ func (a *userAccount) IncreaseNonce(value uint64) {
 	a.Nonce = a.Nonce + value
 }
 
+// SetNonce sets the current nonce. It is meant only for the simulations which override the accounts state
+func (a *userAccount) SetNonce(nonce uint64) {
+	a.Nonce = nonce
+}
+
 // SetCodeHash sets the code hash associated with the account
 func (a *userAccount) SetCodeHash(codeHash []byte) {
 	a.CodeHash = codeHash
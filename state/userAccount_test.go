@@ -137,6 +137,16 @@ func TestUserAccount_SetAndGetNonce(t *testing.T) {
 	assert.Equal(t, nonce, acc.GetNonce())
 }
 
+func TestUserAccount_SetNonceShouldReplaceTheNonce(t *testing.T) {
+	t.Parallel()
+
+	acc, _ := state.NewUserAccount(make([]byte, 32))
+	acc.IncreaseNonce(10)
+
+	acc.SetNonce(3)
+	assert.Equal(t, uint64(3), acc.GetNonce())
+}
+
 func TestUserAccount_SetAndGetCodeHash(t *testing.T) {
 	t.Parallel()
 
@@ -652,6 +652,36 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	createdStorers = append(createdStorers, esdtSuppliesUnit)
 	chainStorer.AddStorer(dataRetriever.ESDTSuppliesUnit, esdtSuppliesUnit)
 
+	if psf.generalConfig.DbLookupExtensions.AddressHistoryEnabled {
+		// Create the addressHistory (STATIC) storer
+		addressHistoryConfig := psf.generalConfig.DbLookupExtensions.AddressHistoryStorageConfig
+		addressHistoryDbConfig := GetDBFromConfig(addressHistoryConfig.DB)
+		addressHistoryDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, addressHistoryConfig.DB.FilePath)
+		addressHistoryCacherConfig := GetCacherFromConfig(addressHistoryConfig.Cache)
+		addressHistoryUnit, errCreate := storageUnit.NewStorageUnitFromConf(addressHistoryCacherConfig, addressHistoryDbConfig)
+		if errCreate != nil {
+			return createdStorers, errCreate
+		}
+
+		createdStorers = append(createdStorers, addressHistoryUnit)
+		chainStorer.AddStorer(dataRetriever.AddressHistoryUnit, addressHistoryUnit)
+	}
+
+	if psf.generalConfig.DbLookupExtensions.LogEventsIndexEnabled {
+		// Create the logEvents (STATIC) storer
+		logEventsConfig := psf.generalConfig.DbLookupExtensions.LogEventsStorageConfig
+		logEventsDbConfig := GetDBFromConfig(logEventsConfig.DB)
+		logEventsDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, logEventsConfig.DB.FilePath)
+		logEventsCacherConfig := GetCacherFromConfig(logEventsConfig.Cache)
+		logEventsUnit, errCreate := storageUnit.NewStorageUnitFromConf(logEventsCacherConfig, logEventsDbConfig)
+		if errCreate != nil {
+			return createdStorers, errCreate
+		}
+
+		createdStorers = append(createdStorers, logEventsUnit)
+		chainStorer.AddStorer(dataRetriever.LogEventsUnit, logEventsUnit)
+	}
+
 	return createdStorers, nil
 }
 
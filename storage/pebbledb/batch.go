@@ -0,0 +1,91 @@
+package pebbledb
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/cockroachdb/pebble"
+)
+
+var _ storage.Batcher = (*batch)(nil)
+
+// batch keeps the last operation done on each key, as pebble batches are bound to an opened database
+type batch struct {
+	cachedData  map[string][]byte
+	removedData map[string]struct{}
+	mutBatch    sync.RWMutex
+}
+
+// NewBatch creates a batch
+func NewBatch() *batch {
+	return &batch{
+		cachedData:  make(map[string][]byte),
+		removedData: make(map[string]struct{}),
+		mutBatch:    sync.RWMutex{},
+	}
+}
+
+// Put inserts one entry - key, value pair - into the batch
+func (b *batch) Put(key []byte, val []byte) error {
+	b.mutBatch.Lock()
+	b.cachedData[string(key)] = val
+	delete(b.removedData, string(key))
+	b.mutBatch.Unlock()
+	return nil
+}
+
+// Delete deletes the entry for the provided key from the batch
+func (b *batch) Delete(key []byte) error {
+	b.mutBatch.Lock()
+	b.removedData[string(key)] = struct{}{}
+	delete(b.cachedData, string(key))
+	b.mutBatch.Unlock()
+	return nil
+}
+
+// Reset clears the contents of the batch
+func (b *batch) Reset() {
+	b.mutBatch.Lock()
+	b.cachedData = make(map[string][]byte)
+	b.removedData = make(map[string]struct{})
+	b.mutBatch.Unlock()
+}
+
+// Get returns the value
+func (b *batch) Get(key []byte) []byte {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return b.cachedData[string(key)]
+}
+
+// IsRemoved returns true if the key is marked for removal
+func (b *batch) IsRemoved(key []byte) bool {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	_, found := b.removedData[string(key)]
+
+	return found
+}
+
+// writeTo copies the contents of the batch into a pebble batch of the provided database
+func (b *batch) writeTo(db *pebble.DB) *pebble.Batch {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	dbBatch := db.NewBatch()
+	for key, val := range b.cachedData {
+		_ = dbBatch.Set([]byte(key), val, nil)
+	}
+	for key := range b.removedData {
+		_ = dbBatch.Delete([]byte(key), nil)
+	}
+
+	return dbBatch
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *batch) IsInterfaceNil() bool {
+	return b == nil
+}
@@ -0,0 +1,164 @@
+package pebbledb
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/cockroachdb/pebble"
+)
+
+// the errors returned when the database is still locked by another instance, within the same process or not
+const resourceUnavailable = "resource temporarily unavailable"
+const lockHeldByCurrentProcess = "lock held by current process"
+const maxRetries = 10
+const timeBetweenRetries = time.Second
+
+// loggingDBCounter this variable should be used only used in logging prints
+var loggingDBCounter = uint32(0)
+
+// pebbleLogger redirects the messages of the pebble engine to the node's logger
+type pebbleLogger struct{}
+
+// Infof logs the message at debug level, as pebble reports its routine operations this way
+func (pl *pebbleLogger) Infof(format string, args ...interface{}) {
+	log.Debug(fmt.Sprintf(format, args...))
+}
+
+// Fatalf logs the message at error level and panics, as pebble can not continue after such an error
+func (pl *pebbleLogger) Fatalf(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	log.Error(message)
+	panic(message)
+}
+
+func openPebbleDB(path string, options *pebble.Options) (*pebble.DB, error) {
+	retries := 0
+	for {
+		db, err := pebble.Open(path, options)
+		if err == nil {
+			return db, nil
+		}
+		isLocked := strings.Contains(err.Error(), resourceUnavailable) || strings.Contains(err.Error(), lockHeldByCurrentProcess)
+		if !isLocked {
+			return nil, err
+		}
+
+		log.Debug("error opening DB",
+			"error", err,
+			"path", path,
+			"retry", retries,
+		)
+
+		time.Sleep(timeBetweenRetries)
+		retries++
+		if retries > maxRetries {
+			return nil, fmt.Errorf("%w, retried %d number of times", err, maxRetries)
+		}
+	}
+}
+
+type basePebbleDb struct {
+	mutDb sync.RWMutex
+	path  string
+	db    *pebble.DB
+}
+
+func (bpdb *basePebbleDb) getDbPointer() *pebble.DB {
+	bpdb.mutDb.RLock()
+	defer bpdb.mutDb.RUnlock()
+
+	return bpdb.db
+}
+
+// get and write hold the read lock while using the database, as pebble panics on calls done after its closing
+
+func (bpdb *basePebbleDb) get(key []byte) ([]byte, error) {
+	bpdb.mutDb.RLock()
+	defer bpdb.mutDb.RUnlock()
+
+	if bpdb.db == nil {
+		return nil, storage.ErrDBIsClosed
+	}
+
+	data, closer, err := bpdb.db.Get(key)
+	if err == pebble.ErrNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	clonedData := make([]byte, len(data))
+	copy(clonedData, data)
+	_ = closer.Close()
+
+	return clonedData, nil
+}
+
+func (bpdb *basePebbleDb) write(b *batch) error {
+	bpdb.mutDb.RLock()
+	defer bpdb.mutDb.RUnlock()
+
+	if bpdb.db == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	dbBatch := b.writeTo(bpdb.db)
+	defer func() {
+		_ = dbBatch.Close()
+	}()
+
+	return dbBatch.Commit(pebble.Sync)
+}
+
+func (bpdb *basePebbleDb) makeDbPointerNilReturningLast() *pebble.DB {
+	bpdb.mutDb.Lock()
+	defer bpdb.mutDb.Unlock()
+
+	if bpdb.db != nil {
+		crtCounter := atomic.AddUint32(&loggingDBCounter, ^uint32(0)) // subtract 1
+		log.Debug("makeDbPointerNilReturningLast", "path", bpdb.path, "nilled pointer", fmt.Sprintf("%p", bpdb.db), "global db counter", crtCounter)
+	}
+
+	db := bpdb.db
+	bpdb.db = nil
+
+	return db
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (bpdb *basePebbleDb) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	bpdb.mutDb.RLock()
+	defer bpdb.mutDb.RUnlock()
+
+	if bpdb.db == nil {
+		return
+	}
+
+	iterator := bpdb.db.NewIter(nil)
+	for valid := iterator.First(); valid; valid = iterator.Next() {
+		key := iterator.Key()
+		clonedKey := make([]byte, len(key))
+		copy(clonedKey, key)
+
+		val := iterator.Value()
+		clonedVal := make([]byte, len(val))
+		copy(clonedVal, val)
+
+		shouldContinue := handler(clonedKey, clonedVal)
+		if !shouldContinue {
+			break
+		}
+	}
+
+	_ = iterator.Close()
+}
@@ -0,0 +1,217 @@
+package pebbledb_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/pebbledb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPebbleDb(t *testing.T, batchDelaySeconds int, maxBatchSize int, maxOpenFiles int) (p *pebbledb.DB) {
+	pdb, err := pebbledb.NewDB(t.TempDir(), batchDelaySeconds, maxBatchSize, maxOpenFiles)
+
+	assert.Nil(t, err, "Failed creating pebble database file")
+	return pdb
+}
+
+func TestNewDB_InvalidNumOpenFilesShouldError(t *testing.T) {
+	pdb, err := pebbledb.NewDB(t.TempDir(), 10, 1, 0)
+
+	assert.Nil(t, pdb)
+	assert.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+}
+
+func TestDB_ReopenShouldKeepTheData(t *testing.T) {
+	dir := t.TempDir()
+	pdb, err := pebbledb.NewDB(dir, 10, 10, 10)
+	require.Nil(t, err)
+
+	key, val := []byte("key"), []byte("val")
+	err = pdb.Put(key, val)
+	require.Nil(t, err)
+	_ = pdb.Close()
+
+	pdbReopened, err := pebbledb.NewDB(dir, 10, 10, 10)
+	require.Nil(t, err)
+
+	recovered, err := pdbReopened.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+
+	_ = pdbReopened.Close()
+}
+
+func TestDB_DoubleOpenButClosedInTimeShouldWork(t *testing.T) {
+	dir := t.TempDir()
+	pdb1, err := pebbledb.NewDB(dir, 10, 1, 10)
+	require.Nil(t, err)
+
+	defer func() {
+		_ = pdb1.Close()
+	}()
+
+	go func() {
+		time.Sleep(time.Second * 3)
+		_ = pdb1.Close()
+	}()
+
+	pdb2, err := pebbledb.NewDB(dir, 10, 1, 10)
+	assert.Nil(t, err)
+	assert.NotNil(t, pdb2)
+
+	_ = pdb2.Close()
+}
+
+func TestDB_GetAfterPutBeforeAndAfterTimeout(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	pdb := createPebbleDb(t, 1, 100, 10)
+
+	err := pdb.Put(key, val)
+	assert.Nil(t, err)
+	v, err := pdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+
+	time.Sleep(time.Second * 2)
+
+	v, err = pdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+
+	_ = pdb.Close()
+}
+
+func TestDB_HasAndRemove(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	pdb := createPebbleDb(t, 10, 1, 10)
+
+	err := pdb.Has(key)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	err = pdb.Put(key, val)
+	require.Nil(t, err)
+
+	err = pdb.Has(key)
+	assert.Nil(t, err)
+
+	err = pdb.Remove(key)
+	require.Nil(t, err)
+
+	err = pdb.Has(key)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	v, err := pdb.Get(key)
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	_ = pdb.Close()
+}
+
+func TestDB_RemoveBeforeTimeoutOK(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	pdb := createPebbleDb(t, 1, 100, 10)
+
+	err := pdb.Put(key, val)
+	assert.Nil(t, err)
+
+	_ = pdb.Remove(key)
+	v, err := pdb.Get(key)
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	time.Sleep(time.Second * 2)
+
+	v, err = pdb.Get(key)
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	_ = pdb.Close()
+}
+
+func TestDB_RangeKeys(t *testing.T) {
+	pdb := createPebbleDb(t, 1, 1, 10)
+	defer func() {
+		_ = pdb.Close()
+	}()
+
+	keysVals := map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+		"key4": []byte("value4"),
+	}
+
+	for key, val := range keysVals {
+		_ = pdb.Put([]byte(key), val)
+	}
+
+	recovered := make(map[string][]byte)
+	handler := func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	}
+
+	pdb.RangeKeys(handler)
+
+	assert.Equal(t, keysVals, recovered)
+}
+
+func TestDB_Destroy(t *testing.T) {
+	pdb := createPebbleDb(t, 10, 1, 10)
+
+	err := pdb.Destroy()
+
+	assert.Nil(t, err, "no error expected but got %s", err)
+}
+
+func TestDB_MethodCallsAfterCloseOrDestroy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when closing", func(t *testing.T) {
+		t.Parallel()
+
+		testDbAllMethodsShouldNotPanic(t, func(db *pebbledb.DB) {
+			_ = db.Close()
+		})
+	})
+	t.Run("when destroying", func(t *testing.T) {
+		t.Parallel()
+
+		testDbAllMethodsShouldNotPanic(t, func(db *pebbledb.DB) {
+			_ = db.Destroy()
+		})
+	})
+}
+
+func testDbAllMethodsShouldNotPanic(t *testing.T, closeHandler func(db *pebbledb.DB)) {
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panic %v", r))
+		}
+	}()
+
+	pdb := createPebbleDb(t, 1, 1, 10)
+	closeHandler(pdb)
+
+	err := pdb.Put([]byte("key1"), []byte("val1"))
+	require.Equal(t, storage.ErrDBIsClosed, err)
+
+	_, err = pdb.Get([]byte("key2"))
+	require.Equal(t, storage.ErrDBIsClosed, err)
+
+	err = pdb.Has([]byte("key3"))
+	require.Equal(t, storage.ErrDBIsClosed, err)
+
+	pdb.RangeKeys(func(key []byte, value []byte) bool {
+		require.Fail(t, "should have not called range")
+		return false
+	})
+
+	err = pdb.Remove([]byte("key4"))
+	require.Equal(t, storage.ErrDBIsClosed, err)
+}
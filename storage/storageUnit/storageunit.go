@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/pebbledb"
 )
 
 var _ storage.Storer = (*Unit)(nil)
@@ -41,11 +42,12 @@ const (
 
 var log = logger.GetOrCreate("storage/storageUnit")
 
-// LvlDB currently the only supported DBs
-// More to be added
+// The supported DBs. PebbleDB is an alternative embedded engine to LevelDB, its files not being compatible with the
+// LevelDB ones
 const (
 	LvlDB       DBType = "LvlDB"
 	LvlDBSerial DBType = "LvlDBSerial"
+	PebbleDB    DBType = "PebbleDB"
 	MemoryDB    DBType = "MemoryDB"
 )
 
@@ -68,13 +70,14 @@ type UnitConfig struct {
 
 // CacheConfig holds the configurable elements of a cache
 type CacheConfig struct {
-	Name                 string
-	Type                 CacheType
-	SizeInBytes          uint64
-	SizeInBytesPerSender uint32
-	Capacity             uint32
-	SizePerSender        uint32
-	Shards               uint32
+	Name                      string
+	Type                      CacheType
+	SizeInBytes               uint64
+	SizeInBytesPerSender      uint32
+	Capacity                  uint32
+	SizePerSender             uint32
+	Shards                    uint32
+	MinGasPriceBumpPercentage uint32
 }
 
 // String returns a readable representation of the object
@@ -375,6 +378,8 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 			db, err = leveldb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
 		case LvlDBSerial:
 			db, err = leveldb.NewSerialDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
+		case PebbleDB:
+			db, err = pebbledb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
 		case MemoryDB:
 			db = memorydb.New()
 		default:
@@ -28,11 +28,13 @@ type ConfigSourceMe struct {
 	CountThreshold                uint32
 	CountPerSenderThreshold       uint32
 	NumSendersToPreemptivelyEvict uint32
+	MinGasPriceBumpPercentage     uint32
 }
 
 type senderConstraints struct {
-	maxNumTxs   uint32
-	maxNumBytes uint32
+	maxNumTxs                 uint32
+	maxNumBytes               uint32
+	minGasPriceBumpPercentage uint32
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -66,8 +68,9 @@ func (config *ConfigSourceMe) verify() error {
 
 func (config *ConfigSourceMe) getSenderConstraints() senderConstraints {
 	return senderConstraints{
-		maxNumBytes: config.NumBytesPerSenderThreshold,
-		maxNumTxs:   config.CountPerSenderThreshold,
+		maxNumBytes:               config.NumBytesPerSenderThreshold,
+		maxNumTxs:                 config.CountPerSenderThreshold,
+		minGasPriceBumpPercentage: config.MinGasPriceBumpPercentage,
 	}
 }
 
@@ -0,0 +1,186 @@
+package txcache
+
+// eventsQueueSize is the number of notifications which can wait to be delivered to the events handlers. Once the queue
+// is full, the new notifications are dropped, so that slow handlers never hold back the cache, and the handlers are
+// notified about the gap with a TxEventDropped event
+const eventsQueueSize = 1000
+
+// TxEventType defines the kind of change undergone by the transactions of the cache
+type TxEventType string
+
+// The changes the cache notifies its events handlers about
+const (
+	TxEventAdded    TxEventType = "added"
+	TxEventSelected TxEventType = "selected"
+	TxEventEvicted  TxEventType = "evicted"
+	TxEventReplaced TxEventType = "replaced"
+	TxEventRemoved  TxEventType = "removed"
+	// TxEventDropped carries no transactions: it tells that the notifications which should have followed the previous
+	// ones were dropped, so the handlers can not rely on the notified changes alone anymore
+	TxEventDropped TxEventType = "dropped"
+)
+
+// TxEventsHandler is called with the transactions which underwent a change. The handlers are called one after another,
+// in the order of the changes, from a single go routine, apart from the hot path of the cache. The changes notified
+// while the handlers are still busy with the previous ones are queued, up to eventsQueueSize, and dropped afterwards.
+// The dropped notifications are replaced by a single TxEventDropped event, delivered after the queued ones
+type TxEventsHandler func(eventType TxEventType, txs []*WrappedTransaction)
+
+type txEvent struct {
+	eventType TxEventType
+	txs       []*WrappedTransaction
+}
+
+// RegisterEventsHandler registers a handler to be notified when transactions are added to the cache, selected,
+// evicted, replaced or removed from it. The delivery go routine is started along with the first handler
+func (cache *TxCache) RegisterEventsHandler(handler TxEventsHandler) {
+	if handler == nil {
+		return
+	}
+
+	cache.mutEventsHandlers.Lock()
+	defer cache.mutEventsHandlers.Unlock()
+
+	if cache.isEventsDeliveryStopped {
+		return
+	}
+
+	cache.eventsHandlers = append(cache.eventsHandlers, handler)
+	if cache.eventsQueue == nil {
+		cache.eventsQueue = make(chan txEvent, eventsQueueSize)
+		cache.eventsGapSignal = make(chan struct{}, 1)
+		cache.stopEventsDelivery = make(chan struct{})
+		go cache.deliverEvents(cache.eventsQueue, cache.eventsGapSignal, cache.stopEventsDelivery)
+	}
+}
+
+// GetNumDroppedEvents returns the number of notifications dropped because the events queue was full
+func (cache *TxCache) GetNumDroppedEvents() uint64 {
+	return uint64(cache.numDroppedEvents.Get())
+}
+
+func (cache *TxCache) deliverEvents(queue chan txEvent, gapSignal chan struct{}, stop chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		case event := <-queue:
+			cache.deliverEvent(event)
+		case <-gapSignal:
+			cache.deliverEventsGap(queue)
+		}
+	}
+}
+
+// deliverEventsGap delivers the notifications queued before the first dropped one, followed by the TxEventDropped
+// event. No notification is queued while the gap is pending, so the queue can only shrink meanwhile
+func (cache *TxCache) deliverEventsGap(queue chan txEvent) {
+	isQueueEmpty := false
+	for !isQueueEmpty {
+		select {
+		case event := <-queue:
+			cache.deliverEvent(event)
+		default:
+			isQueueEmpty = true
+		}
+	}
+
+	cache.mutEventsQueue.Lock()
+	cache.isEventsGapPending = false
+	cache.mutEventsQueue.Unlock()
+
+	cache.deliverEvent(txEvent{eventType: TxEventDropped})
+}
+
+func (cache *TxCache) deliverEvent(event txEvent) {
+	for _, handler := range cache.getEventsHandlers() {
+		handler(event.eventType, event.txs)
+	}
+}
+
+func (cache *TxCache) getEventsHandlers() []TxEventsHandler {
+	cache.mutEventsHandlers.RLock()
+	defer cache.mutEventsHandlers.RUnlock()
+
+	return cache.eventsHandlers
+}
+
+func (cache *TxCache) stopDeliveringEvents() {
+	cache.mutEventsHandlers.Lock()
+	defer cache.mutEventsHandlers.Unlock()
+
+	if cache.isEventsDeliveryStopped {
+		return
+	}
+
+	cache.isEventsDeliveryStopped = true
+	cache.eventsHandlers = make([]TxEventsHandler, 0)
+	if cache.stopEventsDelivery != nil {
+		close(cache.stopEventsDelivery)
+	}
+}
+
+func (cache *TxCache) hasEventsHandlers() bool {
+	cache.mutEventsHandlers.RLock()
+	defer cache.mutEventsHandlers.RUnlock()
+
+	return len(cache.eventsHandlers) > 0
+}
+
+func (cache *TxCache) notifyEventsHandlers(eventType TxEventType, txs []*WrappedTransaction) {
+	if len(txs) == 0 {
+		return
+	}
+
+	cache.mutEventsHandlers.RLock()
+	defer cache.mutEventsHandlers.RUnlock()
+
+	if len(cache.eventsHandlers) == 0 {
+		return
+	}
+
+	// the slice is copied, as the caller keeps on using it while the handlers are called
+	event := txEvent{
+		eventType: eventType,
+		txs:       make([]*WrappedTransaction, len(txs)),
+	}
+	copy(event.txs, txs)
+
+	cache.mutEventsQueue.Lock()
+	defer cache.mutEventsQueue.Unlock()
+
+	// once a notification is dropped, the following ones are dropped as well until the handlers are notified about
+	// the gap, so that no notification is delivered out of order with respect to it
+	if !cache.isEventsGapPending {
+		select {
+		case cache.eventsQueue <- event:
+			return
+		default:
+			cache.isEventsGapPending = true
+			cache.eventsGapSignal <- struct{}{}
+		}
+	}
+
+	cache.numDroppedEvents.Increment()
+	log.Trace("TxCache.notifyEventsHandlers: events queue is full, notification dropped",
+		"name", cache.name, "event", eventType, "num txs", len(txs))
+}
+
+// removeTxsFromByHashAndNotify removes the transactions from the "by hash" map and notifies the events handlers
+// about the ones actually removed
+func (cache *TxCache) removeTxsFromByHashAndNotify(txHashes [][]byte, eventType TxEventType) uint32 {
+	if !cache.hasEventsHandlers() {
+		return cache.txByHash.RemoveTxsBulk(txHashes)
+	}
+
+	removedTxs := make([]*WrappedTransaction, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		tx, removed := cache.txByHash.removeTx(string(txHash))
+		if removed {
+			removedTxs = append(removedTxs, tx)
+		}
+	}
+
+	cache.notifyEventsHandlers(eventType, removedTxs)
+	return uint32(len(removedTxs))
+}
@@ -0,0 +1,223 @@
+package txcache
+
+import (
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type eventsRecorder struct {
+	mut    sync.Mutex
+	events map[TxEventType][]string
+}
+
+func newEventsRecorder(cache *TxCache) *eventsRecorder {
+	recorder := &eventsRecorder{
+		events: make(map[TxEventType][]string),
+	}
+	cache.RegisterEventsHandler(func(eventType TxEventType, txs []*WrappedTransaction) {
+		recorder.mut.Lock()
+		defer recorder.mut.Unlock()
+
+		for _, tx := range txs {
+			recorder.events[eventType] = append(recorder.events[eventType], string(tx.TxHash))
+		}
+	})
+
+	return recorder
+}
+
+func (recorder *eventsRecorder) get(eventType TxEventType) []string {
+	recorder.mut.Lock()
+	defer recorder.mut.Unlock()
+
+	return recorder.events[eventType]
+}
+
+// waitFor returns the transactions notified for the event type, once there are at least numTxs of them, as the events
+// are delivered asynchronously
+func (recorder *eventsRecorder) waitFor(t *testing.T, eventType TxEventType, numTxs int) []string {
+	require.Eventually(t, func() bool {
+		return len(recorder.get(eventType)) >= numTxs
+	}, time.Second, time.Millisecond)
+
+	return recorder.get(eventType)
+}
+
+func TestTxCache_RegisterEventsHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil handler should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newUnconstrainedCacheToTest()
+		cache.RegisterEventsHandler(nil)
+		require.False(t, cache.hasEventsHandlers())
+
+		cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+		require.Equal(t, uint64(1), cache.CountTx())
+	})
+	t.Run("added, selected and removed", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newUnconstrainedCacheToTest()
+		recorder := newEventsRecorder(cache)
+
+		cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+		cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+		cache.AddTx(createTx([]byte("hash-bob-1"), "bob", 1))
+		require.ElementsMatch(t, []string{"hash-alice-1", "hash-bob-1"}, recorder.waitFor(t, TxEventAdded, 2))
+
+		selected := cache.doSelectTransactions(10, 2, math.MaxUint64)
+		require.Equal(t, 2, len(selected))
+		require.ElementsMatch(t, []string{"hash-alice-1", "hash-bob-1"}, recorder.waitFor(t, TxEventSelected, 2))
+
+		cache.RemoveTxByHash([]byte("hash-alice-1"))
+		cache.RemoveTxByHash([]byte("hash-alice-1"))
+		require.Equal(t, []string{"hash-alice-1"}, recorder.waitFor(t, TxEventRemoved, 1))
+	})
+	t.Run("replaced and evicted wrt. limit by sender", func(t *testing.T) {
+		t.Parallel()
+
+		txGasHandler, _ := dummyParams()
+		cache, err := NewTxCache(ConfigSourceMe{
+			Name:                       "test",
+			NumChunks:                  16,
+			NumBytesPerSenderThreshold: maxNumBytesPerSenderUpperBound,
+			CountPerSenderThreshold:    2,
+			MinGasPriceBumpPercentage:  10,
+		}, txGasHandler)
+		require.Nil(t, err)
+		recorder := newEventsRecorder(cache)
+
+		cache.AddTx(createTxWithParams([]byte("hash-alice-1"), "alice", 1, 128, 42, oneBillion))
+		cache.AddTx(createTxWithParams([]byte("hash-alice-2"), "alice", 2, 128, 42, oneBillion))
+		cache.AddTx(createTxWithParams([]byte("hash-alice-3"), "alice", 3, 128, 42, oneBillion))
+		cache.AddTx(createTxWithParams([]byte("hash-alice-1++"), "alice", 1, 128, 42, 2*oneBillion))
+
+		require.Equal(t, []string{"hash-alice-1", "hash-alice-2", "hash-alice-3", "hash-alice-1++"}, recorder.waitFor(t, TxEventAdded, 4))
+		require.Equal(t, []string{"hash-alice-3"}, recorder.waitFor(t, TxEventEvicted, 1))
+		require.Equal(t, []string{"hash-alice-1"}, recorder.waitFor(t, TxEventReplaced, 1))
+		require.Equal(t, []string{"hash-alice-1++", "hash-alice-2"}, cache.getHashesForSender("alice"))
+	})
+	t.Run("evicted due to high load", func(t *testing.T) {
+		t.Parallel()
+
+		txGasHandler, _ := dummyParams()
+		cache, err := NewTxCache(ConfigSourceMe{
+			Name:                          "test",
+			NumChunks:                     1,
+			NumBytesPerSenderThreshold:    maxNumBytesPerSenderUpperBound,
+			CountPerSenderThreshold:       math.MaxUint32,
+			EvictionEnabled:               true,
+			NumBytesThreshold:             maxNumBytesUpperBound,
+			CountThreshold:                4,
+			NumSendersToPreemptivelyEvict: 1,
+		}, txGasHandler)
+		require.Nil(t, err)
+		recorder := newEventsRecorder(cache)
+
+		for _, sender := range []string{"alice", "bob", "carol", "dave", "eve", "frank"} {
+			cache.AddTx(createTx([]byte("hash-"+sender), sender, 1))
+		}
+
+		require.Equal(t, 6, len(recorder.waitFor(t, TxEventAdded, 6)))
+		numEvicted := 6 - int(cache.CountTx())
+		require.True(t, numEvicted > 0)
+		require.Equal(t, numEvicted, len(recorder.waitFor(t, TxEventEvicted, numEvicted)))
+	})
+	t.Run("a blocked handler should not block the cache", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newUnconstrainedCacheToTest()
+		release := make(chan struct{})
+		cache.RegisterEventsHandler(func(eventType TxEventType, txs []*WrappedTransaction) {
+			<-release
+		})
+
+		numTxs := eventsQueueSize + 10
+		for i := 0; i < numTxs; i++ {
+			cache.AddTx(createTx(createFakeTxHash([]byte("alice"), i), "alice", uint64(i)))
+		}
+		close(release)
+
+		require.Equal(t, uint64(numTxs), cache.CountTx())
+		// the blocked handler might have taken the first notification out of the queue, before the queue got full
+		numDropped := cache.GetNumDroppedEvents()
+		require.True(t, numDropped == uint64(numTxs-eventsQueueSize) || numDropped == uint64(numTxs-eventsQueueSize-1))
+	})
+	t.Run("a full queue should notify the gap after the queued events", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newUnconstrainedCacheToTest()
+		release := make(chan struct{})
+		mutSeen := sync.Mutex{}
+		seen := make([]string, 0)
+		cache.RegisterEventsHandler(func(eventType TxEventType, txs []*WrappedTransaction) {
+			<-release
+
+			mutSeen.Lock()
+			defer mutSeen.Unlock()
+
+			if eventType == TxEventDropped && len(txs) == 0 {
+				seen = append(seen, string(TxEventDropped))
+				return
+			}
+			for _, tx := range txs {
+				seen = append(seen, string(tx.TxHash))
+			}
+		})
+		getSeen := func() []string {
+			mutSeen.Lock()
+			defer mutSeen.Unlock()
+
+			return append([]string{}, seen...)
+		}
+
+		numTxs := eventsQueueSize + 10
+		hashes := make([]string, 0, numTxs)
+		for i := 0; i < numTxs; i++ {
+			hash := createFakeTxHash([]byte("alice"), i)
+			hashes = append(hashes, string(hash))
+			cache.AddTx(createTx(hash, "alice", uint64(i)))
+		}
+		close(release)
+
+		numDropped := int(cache.GetNumDroppedEvents())
+		numDelivered := numTxs - numDropped
+		require.Eventually(t, func() bool {
+			return len(getSeen()) == numDelivered+1
+		}, time.Second, time.Millisecond)
+
+		expectedSeen := append(append([]string{}, hashes[:numDelivered]...), string(TxEventDropped))
+		require.Equal(t, expectedSeen, getSeen())
+
+		// the notifications are delivered again once the gap was notified
+		cache.AddTx(createTx([]byte("hash-bob-1"), "bob", 1))
+		require.Eventually(t, func() bool {
+			return len(getSeen()) == numDelivered+2
+		}, time.Second, time.Millisecond)
+		require.Equal(t, append(expectedSeen, "hash-bob-1"), getSeen())
+		require.Equal(t, numDropped, int(cache.GetNumDroppedEvents()))
+	})
+	t.Run("close should stop the delivery", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newUnconstrainedCacheToTest()
+		recorder := newEventsRecorder(cache)
+		cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+		recorder.waitFor(t, TxEventAdded, 1)
+
+		require.Nil(t, cache.Close())
+		require.Nil(t, cache.Close())
+		require.False(t, cache.hasEventsHandlers())
+
+		cache.AddTx(createTx([]byte("hash-alice-2"), "alice", 2))
+		cache.RegisterEventsHandler(func(eventType TxEventType, txs []*WrappedTransaction) {})
+		require.False(t, cache.hasEventsHandlers())
+		require.Equal(t, []string{"hash-alice-1"}, recorder.get(TxEventAdded))
+	})
+}
@@ -0,0 +1,128 @@
+package txcache
+
+// Levels of the risk of a sender's transactions to be removed from the cache before being selected
+const (
+	EvictionRiskLow    = "low"
+	EvictionRiskMedium = "medium"
+	EvictionRiskHigh   = "high"
+)
+
+// the cache is considered close to eviction when it reaches this ratio of any of its capacity thresholds
+const evictionRiskFillRatio = 0.8
+
+// NonceGap holds an interval of nonces (both ends included) missing from the transactions of a sender
+type NonceGap struct {
+	From uint64
+	To   uint64
+}
+
+// SenderInspection holds a view over the transactions of a sender, together with the state the cache tracks for it
+type SenderInspection struct {
+	Sender              []byte
+	Transactions        []*WrappedTransaction
+	AccountNonce        uint64
+	AccountNonceKnown   bool
+	NonceGaps           []NonceGap
+	Score               uint32
+	NumFailedSelections uint64
+	IsInGracePeriod     bool
+	EvictionRisk        string
+}
+
+// InspectSender returns a view over the transactions of the given sender, sorted by nonce. The nonce gaps are computed
+// against the last account nonce the cache was notified about (if any) and between the consecutive transactions
+func (cache *TxCache) InspectSender(sender []byte) (*SenderInspection, bool) {
+	listForSender, ok := cache.txListBySender.getListForSender(string(sender))
+	if !ok {
+		return nil, false
+	}
+
+	inspection, hasInitialGap := listForSender.inspect()
+	inspection.EvictionRisk = cache.computeEvictionRisk(listForSender, hasInitialGap)
+
+	return inspection, true
+}
+
+func (listForSender *txListForSender) inspect() (*SenderInspection, bool) {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	inspection := &SenderInspection{
+		Sender:              []byte(listForSender.sender),
+		Transactions:        make([]*WrappedTransaction, 0, listForSender.countTx()),
+		AccountNonce:        listForSender.accountNonce.Get(),
+		AccountNonceKnown:   listForSender.accountNonceKnown.IsSet(),
+		NonceGaps:           make([]NonceGap, 0),
+		Score:               listForSender.getLastComputedScore(),
+		NumFailedSelections: listForSender.numFailedSelections.GetUint64(),
+		IsInGracePeriod:     listForSender.isInGracePeriod(),
+	}
+
+	hasInitialGap := listForSender.hasInitialGap()
+	expectedNonce := inspection.AccountNonce
+	isExpectedNonceKnown := inspection.AccountNonceKnown
+	for element := listForSender.items.Front(); element != nil; element = element.Next() {
+		value := element.Value.(*WrappedTransaction)
+		txNonce := value.Tx.GetNonce()
+
+		if isExpectedNonceKnown && txNonce > expectedNonce {
+			inspection.NonceGaps = append(inspection.NonceGaps, NonceGap{From: expectedNonce, To: txNonce - 1})
+		}
+		if !isExpectedNonceKnown || txNonce >= expectedNonce {
+			expectedNonce = txNonce + 1
+			isExpectedNonceKnown = true
+		}
+
+		inspection.Transactions = append(inspection.Transactions, value)
+	}
+
+	return inspection, hasInitialGap
+}
+
+// computeEvictionRisk estimates how likely it is for the transactions of the sender to be removed: the senders which
+// exceeded their grace period are swept at the next selection, while the ones with the lowest scores are the first
+// to be evicted once the cache reaches its capacity
+func (cache *TxCache) computeEvictionRisk(listForSender *txListForSender, hasInitialGap bool) string {
+	if listForSender.sweepable.IsSet() || listForSender.isGracePeriodExceeded() {
+		return EvictionRiskHigh
+	}
+	if !cache.isCloseToCapacity() {
+		if hasInitialGap {
+			return EvictionRiskMedium
+		}
+
+		return EvictionRiskLow
+	}
+
+	score := listForSender.getLastComputedScore()
+	numSendersWithLowerScore := uint32(0)
+	snapshot := cache.txListBySender.getSnapshotAscending()
+	for _, otherList := range snapshot {
+		if otherList.getLastComputedScore() >= score {
+			break
+		}
+
+		numSendersWithLowerScore++
+	}
+
+	if numSendersWithLowerScore < cache.config.NumSendersToPreemptivelyEvict {
+		return EvictionRiskHigh
+	}
+	if hasInitialGap || int(numSendersWithLowerScore) < len(snapshot)/2 {
+		return EvictionRiskMedium
+	}
+
+	return EvictionRiskLow
+}
+
+func (cache *TxCache) isCloseToCapacity() bool {
+	if !cache.config.EvictionEnabled {
+		return false
+	}
+
+	bytesRatio := float64(cache.NumBytes()) / float64(cache.config.NumBytesThreshold)
+	txsRatio := float64(cache.CountTx()) / float64(cache.config.CountThreshold)
+	sendersRatio := float64(cache.CountSenders()) / float64(cache.config.CountThreshold)
+
+	return bytesRatio >= evictionRiskFillRatio || txsRatio >= evictionRiskFillRatio || sendersRatio >= evictionRiskFillRatio
+}
@@ -0,0 +1,107 @@
+package txcache
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxCache_InspectSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown sender should return false", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newUnconstrainedCacheToTest()
+		cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+
+		inspection, ok := cache.InspectSender([]byte("bob"))
+		assert.False(t, ok)
+		assert.Nil(t, inspection)
+	})
+	t.Run("should report the nonce gaps", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newUnconstrainedCacheToTest()
+		cache.AddTx(createTx([]byte("hash-alice-5"), "alice", 5))
+		cache.AddTx(createTx([]byte("hash-alice-8"), "alice", 8))
+		cache.AddTx(createTx([]byte("hash-alice-6"), "alice", 6))
+		cache.AddTx(createTx([]byte("hash-alice-12"), "alice", 12))
+		cache.NotifyAccountNonce([]byte("alice"), 3)
+
+		inspection, ok := cache.InspectSender([]byte("alice"))
+		require.True(t, ok)
+		assert.Equal(t, []byte("alice"), inspection.Sender)
+		assert.Equal(t, uint64(3), inspection.AccountNonce)
+		assert.True(t, inspection.AccountNonceKnown)
+		assert.Equal(t, []string{"hash-alice-5", "hash-alice-6", "hash-alice-8", "hash-alice-12"}, inspectedHashes(inspection))
+		expectedGaps := []NonceGap{{From: 3, To: 4}, {From: 7, To: 7}, {From: 9, To: 11}}
+		assert.Equal(t, expectedGaps, inspection.NonceGaps)
+		assert.Equal(t, EvictionRiskMedium, inspection.EvictionRisk)
+	})
+	t.Run("unknown account nonce should only report the gaps between transactions", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newUnconstrainedCacheToTest()
+		cache.AddTx(createTx([]byte("hash-alice-5"), "alice", 5))
+		cache.AddTx(createTx([]byte("hash-alice-5-bis"), "alice", 5))
+		cache.AddTx(createTx([]byte("hash-alice-7"), "alice", 7))
+
+		inspection, ok := cache.InspectSender([]byte("alice"))
+		require.True(t, ok)
+		assert.False(t, inspection.AccountNonceKnown)
+		assert.Equal(t, []NonceGap{{From: 6, To: 6}}, inspection.NonceGaps)
+		assert.Equal(t, EvictionRiskLow, inspection.EvictionRisk)
+	})
+	t.Run("sweepable sender should be at high risk", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newUnconstrainedCacheToTest()
+		cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+		_ = cache.getListForSender("alice").sweepable.SetReturningPrevious()
+
+		inspection, ok := cache.InspectSender([]byte("alice"))
+		require.True(t, ok)
+		assert.Equal(t, EvictionRiskHigh, inspection.EvictionRisk)
+	})
+	t.Run("senders with the lowest scores should be at high risk when the cache is close to capacity", func(t *testing.T) {
+		t.Parallel()
+
+		txGasHandler, _ := dummyParams()
+		cache, err := NewTxCache(ConfigSourceMe{
+			Name:                          "test",
+			NumChunks:                     16,
+			EvictionEnabled:               true,
+			NumBytesThreshold:             maxNumBytesUpperBound,
+			CountThreshold:                10,
+			NumSendersToPreemptivelyEvict: 1,
+			NumBytesPerSenderThreshold:    maxNumBytesPerSenderUpperBound,
+			CountPerSenderThreshold:       math.MaxUint32,
+		}, txGasHandler)
+		require.Nil(t, err)
+
+		cache.AddTx(createTxWithParams([]byte("hash-alice-1"), "alice", 1, 128, 50000, oneBillion))
+		cache.AddTx(createTxWithParams([]byte("hash-bob-1"), "bob", 1, 128, 50000, 3*oneBillion))
+		for nonce := uint64(1); nonce <= 7; nonce++ {
+			cache.AddTx(createTxWithParams([]byte{'c', byte(nonce)}, "carol", nonce, 128, 50000, 3*oneBillion))
+		}
+		require.Less(t, cache.getScoreOfSender("alice"), cache.getScoreOfSender("bob"))
+
+		inspection, _ := cache.InspectSender([]byte("alice"))
+		assert.Equal(t, EvictionRiskHigh, inspection.EvictionRisk)
+
+		inspection, _ = cache.InspectSender([]byte("bob"))
+		assert.NotEqual(t, EvictionRiskHigh, inspection.EvictionRisk)
+	})
+}
+
+func inspectedHashes(inspection *SenderInspection) []string {
+	hashes := make([]string, 0, len(inspection.Transactions))
+	for _, tx := range inspection.Transactions {
+		hashes = append(hashes, string(tx.TxHash))
+	}
+
+	return hashes
+}
@@ -27,6 +27,15 @@ type TxCache struct {
 	sweepingMutex             sync.Mutex
 	sweepingListOfSenders     []*txListForSender
 	mutTxOperation            sync.Mutex
+	mutEventsHandlers         sync.RWMutex
+	eventsHandlers            []TxEventsHandler
+	eventsQueue               chan txEvent
+	eventsGapSignal           chan struct{}
+	stopEventsDelivery        chan struct{}
+	mutEventsQueue            sync.Mutex
+	isEventsGapPending        bool
+	isEventsDeliveryStopped   bool
+	numDroppedEvents          atomic.Counter
 }
 
 // NewTxCache creates a new transaction cache
@@ -54,6 +63,7 @@ func NewTxCache(config ConfigSourceMe, txGasHandler TxGasHandler) (*TxCache, err
 		txByHash:        newTxByHashMap(numChunks),
 		config:          config,
 		evictionJournal: evictionJournal{},
+		eventsHandlers:  make([]TxEventsHandler, 0),
 	}
 
 	txCache.initSweepable()
@@ -73,7 +83,7 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 
 	cache.mutTxOperation.Lock()
 	addedInByHash := cache.txByHash.addTx(tx)
-	addedInBySender, evicted := cache.txListBySender.addTx(tx)
+	addedInBySender, replaced, evicted := cache.txListBySender.addTx(tx)
 	cache.mutTxOperation.Unlock()
 	if addedInByHash != addedInBySender {
 		// This can happen  when two go-routines concur to add the same transaction:
@@ -84,9 +94,15 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 		log.Trace("TxCache.AddTx(): slight inconsistency detected:", "name", cache.name, "tx", tx.TxHash, "sender", tx.Tx.GetSndAddr(), "addedInByHash", addedInByHash, "addedInBySender", addedInBySender)
 	}
 
+	if addedInBySender {
+		cache.notifyEventsHandlers(TxEventAdded, []*WrappedTransaction{tx})
+	}
+	if len(replaced) > 0 {
+		cache.removeTxsFromByHashAndNotify(replaced, TxEventReplaced)
+	}
 	if len(evicted) > 0 {
 		cache.monitorEvictionWrtSenderLimit(tx.Tx.GetSndAddr(), evicted)
-		cache.txByHash.RemoveTxsBulk(evicted)
+		cache.removeTxsFromByHashAndNotify(evicted, TxEventEvicted)
 	}
 
 	// The return value "added" is true even if transaction added, but then removed due to limits be sender.
@@ -150,6 +166,7 @@ func (cache *TxCache) doSelectTransactions(numRequested int, batchSizePerSender
 
 	result = result[:resultFillIndex]
 	cache.monitorSelectionEnd(result, stopWatch)
+	cache.notifyEventsHandlers(TxEventSelected, result)
 	return result
 }
 
@@ -185,6 +202,7 @@ func (cache *TxCache) RemoveTxByHash(txHash []byte) bool {
 		log.Trace("TxCache.RemoveTxByHash(): slight inconsistency detected: !foundInBySender", "name", cache.name, "tx", txHash)
 	}
 
+	cache.notifyEventsHandlers(TxEventRemoved, []*WrappedTransaction{tx})
 	return true
 }
 
@@ -300,8 +318,9 @@ func (cache *TxCache) NotifyAccountNonce(accountKey []byte, nonce uint64) {
 func (cache *TxCache) ImmunizeTxsAgainstEviction(_ [][]byte) {
 }
 
-// Close does nothing for this cacher implementation
+// Close stops the delivery of the events, if any handler was registered
 func (cache *TxCache) Close() error {
+	cache.stopDeliveringEvents()
 	return nil
 }
 
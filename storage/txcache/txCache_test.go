@@ -153,6 +153,30 @@ func Test_AddTx_AppliesSizeConstraintsPerSenderForNumBytes(t *testing.T) {
 	require.True(t, cache.areInternalMapsConsistent())
 }
 
+func Test_AddTx_ReplacesTransactionsWithSameNonce(t *testing.T) {
+	txGasHandler, _ := dummyParams()
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  16,
+		NumBytesPerSenderThreshold: maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:    math.MaxUint32,
+		MinGasPriceBumpPercentage:  10,
+	}, txGasHandler)
+	require.Nil(t, err)
+
+	cache.AddTx(createTxWithParams([]byte("tx-alice-1"), "alice", 1, 128, 42, oneBillion))
+	cache.AddTx(createTxWithParams([]byte("tx-alice-2"), "alice", 2, 128, 42, oneBillion))
+	ok, added := cache.AddTx(createTxWithParams([]byte("tx-alice-2++"), "alice", 2, 128, 42, 2*oneBillion))
+	require.True(t, ok)
+	require.True(t, added)
+
+	require.Equal(t, []string{"tx-alice-1", "tx-alice-2++"}, cache.getHashesForSender("alice"))
+	_, found := cache.GetByTxHash([]byte("tx-alice-2"))
+	require.False(t, found)
+	require.Equal(t, uint64(2), cache.CountTx())
+	require.True(t, cache.areInternalMapsConsistent())
+}
+
 func Test_RemoveByTxHash(t *testing.T) {
 	cache := newUnconstrainedCacheToTest()
 
@@ -3,6 +3,7 @@ package txcache
 import (
 	"bytes"
 	"container/list"
+	"math/big"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
@@ -49,14 +50,21 @@ func newTxListForSender(sender string, constraints *senderConstraints, onScoreCh
 
 // AddTx adds a transaction in sender's list
 // This is a "sorted" insert
-func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler TxGasHandler, txFeeHelper feeHelper) (bool, [][]byte) {
+// When the replacement by fee is enabled, the incoming transaction replaces the ones having the same nonce whose gas price
+// it bumps enough. The hashes of the replaced and of the evicted transactions are returned.
+func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler TxGasHandler, txFeeHelper feeHelper) (bool, [][]byte, [][]byte) {
 	// We don't allow concurrent interceptor goroutines to mutate a given sender's list
 	listForSender.mutex.Lock()
 	defer listForSender.mutex.Unlock()
 
+	replaced, err := listForSender.removeTxsReplacedBy(tx)
+	if err != nil {
+		return false, nil, nil
+	}
+
 	insertionPlace, err := listForSender.findInsertionPlace(tx)
 	if err != nil {
-		return false, nil
+		return false, nil, nil
 	}
 
 	if insertionPlace == nil {
@@ -68,7 +76,66 @@ func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler T
 	listForSender.onAddedTransaction(tx, gasHandler, txFeeHelper)
 	evicted := listForSender.applySizeConstraints()
 	listForSender.triggerScoreChange()
-	return true, evicted
+	return true, replaced, evicted
+}
+
+// removeTxsReplacedBy removes the transactions having the same nonce as the incoming one, if the incoming gas price
+// bumps theirs enough. The cache does not broadcast the replacement: a transaction reaches the pool only after it
+// passed the interceptor validation, which does not look into the pool, so the replacement is already propagated
+// through the regular gossip path, and each node applies the same replacement rule when saving it.
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) removeTxsReplacedBy(incomingTx *WrappedTransaction) ([][]byte, error) {
+	bumpPercentage := listForSender.constraints.minGasPriceBumpPercentage
+	if bumpPercentage == 0 {
+		return nil, nil
+	}
+
+	incomingNonce := incomingTx.Tx.GetNonce()
+	incomingGasPrice := incomingTx.Tx.GetGasPrice()
+	elementsToReplace := make([]*list.Element, 0)
+
+	for element := listForSender.items.Front(); element != nil; element = element.Next() {
+		currentTx := element.Value.(*WrappedTransaction)
+		currentTxNonce := currentTx.Tx.GetNonce()
+
+		if currentTxNonce < incomingNonce {
+			continue
+		}
+		if currentTxNonce > incomingNonce {
+			break
+		}
+		if incomingTx.sameAs(currentTx) {
+			return nil, storage.ErrItemAlreadyInCache
+		}
+		// The transactions which are not outbid are kept (e.g. an underpriced one could still be requested when
+		// processing a block which contains it)
+		if isGasPriceBumpedEnough(currentTx.Tx.GetGasPrice(), incomingGasPrice, bumpPercentage) {
+			elementsToReplace = append(elementsToReplace, element)
+		}
+	}
+
+	replaced := make([][]byte, 0, len(elementsToReplace))
+	for _, element := range elementsToReplace {
+		listForSender.items.Remove(element)
+		listForSender.onRemovedListElement(element)
+
+		value := element.Value.(*WrappedTransaction)
+		replaced = append(replaced, value.TxHash)
+		log.Trace("txListForSender.removeTxsReplacedBy()", "sender", []byte(listForSender.sender), "nonce", incomingNonce, "replaced", value.TxHash, "replacement", incomingTx.TxHash)
+	}
+
+	return replaced, nil
+}
+
+// isGasPriceBumpedEnough checks if the incoming gas price is higher than the current one by at least the given percentage
+func isGasPriceBumpedEnough(currentGasPrice uint64, incomingGasPrice uint64, bumpPercentage uint32) bool {
+	// big integers are used in order to avoid overflows on (hostile) huge gas prices
+	minGasPrice := big.NewInt(0).SetUint64(currentGasPrice)
+	minGasPrice.Mul(minGasPrice, big.NewInt(int64(100+uint64(bumpPercentage))))
+	gasPrice := big.NewInt(0).SetUint64(incomingGasPrice)
+	gasPrice.Mul(gasPrice, big.NewInt(100))
+
+	return gasPrice.Cmp(minGasPrice) >= 0
 }
 
 // This function should only be used in critical section (listForSender.mutex)
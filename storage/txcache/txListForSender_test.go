@@ -54,16 +54,68 @@ func TestListForSender_AddTx_IgnoresDuplicates(t *testing.T) {
 	list := newUnconstrainedListToTest()
 	txGasHandler, txFeeHelper := dummyParams()
 
-	added, _ := list.AddTx(createTx([]byte("tx1"), ".", 1), txGasHandler, txFeeHelper)
+	added, _, _ := list.AddTx(createTx([]byte("tx1"), ".", 1), txGasHandler, txFeeHelper)
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
+	added, _, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
+	added, _, _ = list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
+	added, _, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
 	require.False(t, added)
 }
 
+func TestListForSender_AddTx_ReplacesTransactionsWithSameNonce(t *testing.T) {
+	list := newListToTest(math.MaxUint32, math.MaxUint32)
+	list.constraints.minGasPriceBumpPercentage = 10
+	txGasHandler, txFeeHelper := dummyParams()
+
+	list.AddTx(createTxWithParams([]byte("tx1"), ".", 1, 128, 42, 100), txGasHandler, txFeeHelper)
+	list.AddTx(createTxWithParams([]byte("tx2"), ".", 2, 128, 42, 100), txGasHandler, txFeeHelper)
+	list.AddTx(createTxWithParams([]byte("tx3"), ".", 3, 128, 42, 100), txGasHandler, txFeeHelper)
+
+	// Not enough of a bump, the transactions are kept side by side
+	added, replaced, _ := list.AddTx(createTxWithParams([]byte("tx2+"), ".", 2, 128, 42, 109), txGasHandler, txFeeHelper)
+	require.True(t, added)
+	require.Empty(t, replaced)
+	require.Equal(t, []string{"tx1", "tx2+", "tx2", "tx3"}, list.getTxHashesAsStrings())
+
+	// Outbids "tx2", but not "tx2+"
+	added, replaced, _ = list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 115), txGasHandler, txFeeHelper)
+	require.True(t, added)
+	require.Equal(t, []string{"tx2"}, hashesAsStrings(replaced))
+	require.Equal(t, []string{"tx1", "tx2++", "tx2+", "tx3"}, list.getTxHashesAsStrings())
+
+	added, replaced, _ = list.AddTx(createTxWithParams([]byte("tx2+++"), ".", 2, 128, 42, 200), txGasHandler, txFeeHelper)
+	require.True(t, added)
+	require.Equal(t, []string{"tx2++", "tx2+"}, hashesAsStrings(replaced))
+	require.Equal(t, []string{"tx1", "tx2+++", "tx3"}, list.getTxHashesAsStrings())
+	require.Equal(t, uint64(3), list.countTx())
+	require.Equal(t, int64(3*128), list.totalBytes.Get())
+
+	// Duplicates are still ignored
+	added, _, _ = list.AddTx(createTxWithParams([]byte("tx2+++"), ".", 2, 128, 42, 200), txGasHandler, txFeeHelper)
+	require.False(t, added)
+}
+
+func TestListForSender_AddTx_WithoutReplacementKeepsTransactionsWithSameNonce(t *testing.T) {
+	list := newUnconstrainedListToTest()
+	txGasHandler, txFeeHelper := dummyParams()
+
+	list.AddTx(createTxWithParams([]byte("tx1"), ".", 1, 128, 42, 100), txGasHandler, txFeeHelper)
+	added, replaced, _ := list.AddTx(createTxWithParams([]byte("tx1++"), ".", 1, 128, 42, 200), txGasHandler, txFeeHelper)
+	require.True(t, added)
+	require.Empty(t, replaced)
+	require.Equal(t, []string{"tx1++", "tx1"}, list.getTxHashesAsStrings())
+}
+
+func Test_isGasPriceBumpedEnough(t *testing.T) {
+	require.True(t, isGasPriceBumpedEnough(1000, 1100, 10))
+	require.False(t, isGasPriceBumpedEnough(1000, 1099, 10))
+	require.True(t, isGasPriceBumpedEnough(1000, 1000, 0))
+	require.False(t, isGasPriceBumpedEnough(math.MaxUint64, math.MaxUint64, 1))
+	require.True(t, isGasPriceBumpedEnough(math.MaxUint64/2, math.MaxUint64, 100))
+}
+
 func TestListForSender_AddTx_AppliesSizeConstraintsForNumTransactions(t *testing.T) {
 	list := newListToTest(math.MaxUint32, 3)
 	txGasHandler, txFeeHelper := dummyParams()
@@ -74,17 +126,17 @@ func TestListForSender_AddTx_AppliesSizeConstraintsForNumTransactions(t *testing
 	list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx4"}, list.getTxHashesAsStrings())
 
-	_, evicted := list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
+	_, _, evicted := list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx4"}, hashesAsStrings(evicted))
 
 	// Gives priority to higher gas - though undesirably to some extent, "tx3" is evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 42), txGasHandler, txFeeHelper)
+	_, _, evicted = list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2++", "tx2"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx3"}, hashesAsStrings(evicted))
 
 	// Though Undesirably to some extent, "tx3++"" is added, then evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 128, 42, 42), txGasHandler, txFeeHelper)
+	_, _, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2++", "tx2"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx3++"}, hashesAsStrings(evicted))
 }
@@ -96,20 +148,20 @@ func TestListForSender_AddTx_AppliesSizeConstraintsForNumBytes(t *testing.T) {
 	list.AddTx(createTxWithParams([]byte("tx1"), ".", 1, 128, 42, 42), txGasHandler, txFeeHelper)
 	list.AddTx(createTxWithParams([]byte("tx2"), ".", 2, 512, 42, 42), txGasHandler, txFeeHelper)
 	list.AddTx(createTxWithParams([]byte("tx3"), ".", 3, 256, 42, 42), txGasHandler, txFeeHelper)
-	_, evicted := list.AddTx(createTxWithParams([]byte("tx5"), ".", 4, 256, 42, 42), txGasHandler, txFeeHelper)
+	_, _, evicted := list.AddTx(createTxWithParams([]byte("tx5"), ".", 4, 256, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx5"}, hashesAsStrings(evicted))
 
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx5--"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
+	_, _, evicted = list.AddTx(createTxWithParams([]byte("tx5--"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx5--"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{}, hashesAsStrings(evicted))
 
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx4"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
+	_, _, evicted = list.AddTx(createTxWithParams([]byte("tx4"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx4"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx5--"}, hashesAsStrings(evicted))
 
 	// Gives priority to higher gas - though undesirably to some extent, "tx4" is evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 256, 42, 100), txGasHandler, txFeeHelper)
+	_, _, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 256, 42, 100), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3++", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx4"}, hashesAsStrings(evicted))
 }
@@ -0,0 +1,25 @@
+package testscommon
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// BlockInfoProviderStub -
+type BlockInfoProviderStub struct {
+	GetBlockInfoCalled func(options common.BlockQueryOptions) (data.HeaderHandler, []byte, []byte, error)
+}
+
+// GetBlockInfo -
+func (stub *BlockInfoProviderStub) GetBlockInfo(options common.BlockQueryOptions) (data.HeaderHandler, []byte, []byte, error) {
+	if stub.GetBlockInfoCalled != nil {
+		return stub.GetBlockInfoCalled(options)
+	}
+
+	return nil, nil, nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *BlockInfoProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -10,12 +10,14 @@ import (
 
 // HistoryRepositoryStub -
 type HistoryRepositoryStub struct {
-	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, logs []*data.LogData) error
+	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, txsPool map[string]data.TransactionHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, logs []*data.LogData) error
 	OnNotarizedBlocksCalled            func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
 	GetESDTSupplyCalled                func(token string) (*esdtSupply.SupplyESDT, error)
+	GetTransactionsByAddressCalled     func(address []byte, from uint64, size uint64) ([]*dblookupext.AddressTransaction, uint64, error)
+	GetLogEventsCalled                 func(filter dblookupext.LogEventsFilter) ([]*dblookupext.LogEvent, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -24,12 +26,13 @@ func (hp *HistoryRepositoryStub) RecordBlock(
 	blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsPool map[string]data.TransactionHandler,
 	scrsPool map[string]data.TransactionHandler,
 	receipts map[string]data.TransactionHandler,
 	logs []*data.LogData,
 ) error {
 	if hp.RecordBlockCalled != nil {
-		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts, logs)
+		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, txsPool, scrsPool, receipts, logs)
 	}
 	return nil
 }
@@ -87,6 +90,24 @@ func (hp *HistoryRepositoryStub) GetESDTSupply(token string) (*esdtSupply.Supply
 	return nil, nil
 }
 
+// GetTransactionsByAddress -
+func (hp *HistoryRepositoryStub) GetTransactionsByAddress(address []byte, from uint64, size uint64) ([]*dblookupext.AddressTransaction, uint64, error) {
+	if hp.GetTransactionsByAddressCalled != nil {
+		return hp.GetTransactionsByAddressCalled(address, from, size)
+	}
+
+	return nil, 0, nil
+}
+
+// GetLogEvents -
+func (hp *HistoryRepositoryStub) GetLogEvents(filter dblookupext.LogEventsFilter) ([]*dblookupext.LogEvent, error) {
+	if hp.GetLogEventsCalled != nil {
+		return hp.GetLogEventsCalled(filter)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
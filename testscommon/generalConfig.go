@@ -362,6 +362,14 @@ func GetGeneralConfig() config.Config {
 			MaxHardCapForMissingNodes: 500,
 			TrieSyncerVersion:         2,
 		},
+		GasPriceOracle: config.GasPriceOracleConfig{
+			NumRecentBlocks: 10,
+		},
+		StateStatistics: config.StateStatisticsConfig{
+			Enabled:             false,
+			NumLargestDataTries: 10,
+			NumEpochsInHistory:  10,
+		},
 		Antiflood: config.AntifloodConfig{
 			NumConcurrentResolverJobs: 2,
 			TxAccumulator: config.TxAccumulatorConfig{
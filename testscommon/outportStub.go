@@ -1,6 +1,8 @@
 package testscommon
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
 	"github.com/ElrondNetwork/elrond-go/outport"
@@ -12,6 +14,8 @@ type OutportStub struct {
 	SaveValidatorsRatingCalled  func(index string, validatorsInfo []*indexer.ValidatorRatingInfo)
 	SaveValidatorsPubKeysCalled func(shardPubKeys map[uint32][][]byte, epoch uint32)
 	HasDriversCalled            func() bool
+	FlushCalled                 func(ctx context.Context) error
+	FinalizedBlockCalled        func(headerHash []byte)
 }
 
 // SaveBlock -
@@ -40,6 +44,14 @@ func (as *OutportStub) IsInterfaceNil() bool {
 	return as == nil
 }
 
+// Flush -
+func (as *OutportStub) Flush(ctx context.Context) error {
+	if as.FlushCalled != nil {
+		return as.FlushCalled(ctx)
+	}
+	return nil
+}
+
 // HasDrivers -
 func (as *OutportStub) HasDrivers() bool {
 	if as.HasDriversCalled != nil {
@@ -74,5 +86,8 @@ func (as *OutportStub) SubscribeDriver(_ outport.Driver) error {
 }
 
 // FinalizedBlock -
-func (as *OutportStub) FinalizedBlock(_ []byte) {
+func (as *OutportStub) FinalizedBlock(headerHash []byte) {
+	if as.FinalizedBlockCalled != nil {
+		as.FinalizedBlockCalled(headerHash)
+	}
 }
@@ -0,0 +1,24 @@
+package testscommon
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// StateOverridesHandlerStub -
+type StateOverridesHandlerStub struct {
+	RunWithStateOverridesCalled func(overrides []*common.AccountStateOverride, handler func() error) error
+}
+
+// RunWithStateOverrides -
+func (stub *StateOverridesHandlerStub) RunWithStateOverrides(overrides []*common.AccountStateOverride, handler func() error) error {
+	if stub.RunWithStateOverridesCalled != nil {
+		return stub.RunWithStateOverridesCalled(overrides, handler)
+	}
+
+	return handler()
+}
+
+// IsInterfaceNil -
+func (stub *StateOverridesHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
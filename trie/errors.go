@@ -108,3 +108,6 @@ var ErrNilEpochNotifier = errors.New("nil EpochNotifier")
 
 // ErrNilIdleNodeProvider signals that a nil idle node provider was provided
 var ErrNilIdleNodeProvider = errors.New("nil idle node provider")
+
+// ErrNodeHashMismatch signals that the hash of a stored trie node differs from the key it was stored under
+var ErrNodeHashMismatch = errors.New("node hash mismatch")
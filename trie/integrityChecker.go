@@ -0,0 +1,189 @@
+package trie
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// ArgsIntegrityChecker holds the arguments needed for creating a new integrityChecker
+type ArgsIntegrityChecker struct {
+	StorageManager common.StorageManager
+	Marshalizer    marshal.Marshalizer
+	Hasher         hashing.Hasher
+}
+
+// IntegrityIssue describes a trie node that is either missing from the storage or corrupted
+type IntegrityIssue struct {
+	Hash  []byte
+	Path  []byte
+	Error error
+}
+
+// String returns the issue as a human-readable string, the path being written as hex nibbles from the root
+func (issue IntegrityIssue) String() string {
+	path := strings.Builder{}
+	for _, nibble := range issue.Path {
+		_, _ = fmt.Fprintf(&path, "%x", nibble)
+	}
+
+	return fmt.Sprintf("hash %x, path [%s]: %v", issue.Hash, path.String(), issue.Error)
+}
+
+// IntegrityReport holds the result of a trie integrity check, together with the size of the walked trie. The depth
+// counts the nodes from the root to the deepest found node, the root included
+type IntegrityReport struct {
+	NumNodes  uint64
+	NumLeaves uint64
+	NumBytes  uint64
+	MaxDepth  uint32
+	Missing   []IntegrityIssue
+	Corrupted []IntegrityIssue
+}
+
+// IsComplete returns true if no missing or corrupted node was found
+func (report *IntegrityReport) IsComplete() bool {
+	return len(report.Missing) == 0 && len(report.Corrupted) == 0
+}
+
+type integrityChecker struct {
+	storageManager common.StorageManager
+	marshalizer    marshal.Marshalizer
+	hasher         hashing.Hasher
+}
+
+// NewIntegrityChecker creates a component able to walk a trie directly from the storage, without recreating it
+func NewIntegrityChecker(args ArgsIntegrityChecker) (*integrityChecker, error) {
+	if check.IfNil(args.StorageManager) {
+		return nil, ErrNilTrieStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &integrityChecker{
+		storageManager: args.StorageManager,
+		marshalizer:    args.Marshalizer,
+		hasher:         args.Hasher,
+	}, nil
+}
+
+// Check walks the trie with the provided root hash and verifies that every node exists in the storage, decodes and
+// hashes to the key it was stored under. The walk continues past the missing or corrupted nodes, so all of them are
+// reported. The leaf handler, if provided, is called for every leaf with its key and value
+func (ic *integrityChecker) Check(ctx context.Context, rootHash []byte, leafHandler func(key []byte, value []byte)) (*IntegrityReport, error) {
+	report := &IntegrityReport{
+		Missing:   make([]IntegrityIssue, 0),
+		Corrupted: make([]IntegrityIssue, 0),
+	}
+	if emptyTrie(rootHash) {
+		return report, nil
+	}
+
+	err := ic.checkNode(ctx, rootHash, make([]byte, 0), 1, report, leafHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func (ic *integrityChecker) checkNode(
+	ctx context.Context,
+	hash []byte,
+	path []byte,
+	depth uint32,
+	report *IntegrityReport,
+	leafHandler func(key []byte, value []byte),
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	encodedNode, err := ic.storageManager.Get(hash)
+	if isClosingError(err) {
+		return err
+	}
+	if len(encodedNode) == 0 {
+		if err == nil {
+			err = ErrKeyNotFound
+		}
+		report.Missing = append(report.Missing, newIntegrityIssue(hash, path, err))
+		return nil
+	}
+
+	report.NumNodes++
+	report.NumBytes += uint64(len(encodedNode))
+	if depth > report.MaxDepth {
+		report.MaxDepth = depth
+	}
+	computedHash := ic.hasher.Compute(string(encodedNode))
+	if !bytes.Equal(computedHash, hash) {
+		err = fmt.Errorf("%w, computed hash is %x", ErrNodeHashMismatch, computedHash)
+		report.Corrupted = append(report.Corrupted, newIntegrityIssue(hash, path, err))
+		return nil
+	}
+
+	decodedNode, err := decodeNode(encodedNode, ic.marshalizer, ic.hasher)
+	if err != nil {
+		report.Corrupted = append(report.Corrupted, newIntegrityIssue(hash, path, err))
+		return nil
+	}
+	if !decodedNode.isValid() {
+		report.Corrupted = append(report.Corrupted, newIntegrityIssue(hash, path, ErrInvalidNode))
+		return nil
+	}
+
+	switch n := decodedNode.(type) {
+	case *branchNode:
+		for i, childHash := range n.EncodedChildren {
+			if len(childHash) == 0 {
+				continue
+			}
+
+			err = ic.checkNode(ctx, childHash, concat(path, byte(i)), depth+1, report, leafHandler)
+			if err != nil {
+				return err
+			}
+		}
+	case *extensionNode:
+		return ic.checkNode(ctx, n.EncodedChild, concat(path, n.Key...), depth+1, report, leafHandler)
+	case *leafNode:
+		report.NumLeaves++
+		key, errKey := hexToKeyBytes(concat(path, n.Key...))
+		if errKey != nil {
+			report.Corrupted = append(report.Corrupted, newIntegrityIssue(hash, path, errKey))
+			return nil
+		}
+
+		if leafHandler != nil {
+			leafHandler(key, n.Value)
+		}
+	}
+
+	return nil
+}
+
+func newIntegrityIssue(hash []byte, path []byte, err error) IntegrityIssue {
+	return IntegrityIssue{
+		Hash:  hash,
+		Path:  concat(path),
+		Error: err,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ic *integrityChecker) IsInterfaceNil() bool {
+	return ic == nil
+}
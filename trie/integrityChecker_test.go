@@ -0,0 +1,155 @@
+package trie_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsIntegrityChecker(tr common.Trie) trie.ArgsIntegrityChecker {
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	return trie.ArgsIntegrityChecker{
+		StorageManager: tr.GetStorageManager(),
+		Marshalizer:    marshalizer,
+		Hasher:         hasher,
+	}
+}
+
+func createCommittedTrieForIntegrityCheck(t *testing.T) (common.Trie, []byte) {
+	tr, _ := initTrieMultipleValues(100)
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return tr, rootHash
+}
+
+func TestNewIntegrityChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsIntegrityChecker(emptyTrie())
+		args.StorageManager = nil
+		ic, err := trie.NewIntegrityChecker(args)
+
+		assert.True(t, check.IfNil(ic))
+		assert.Equal(t, trie.ErrNilTrieStorage, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsIntegrityChecker(emptyTrie())
+		args.Marshalizer = nil
+		ic, err := trie.NewIntegrityChecker(args)
+
+		assert.True(t, check.IfNil(ic))
+		assert.Equal(t, trie.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsIntegrityChecker(emptyTrie())
+		args.Hasher = nil
+		ic, err := trie.NewIntegrityChecker(args)
+
+		assert.True(t, check.IfNil(ic))
+		assert.Equal(t, trie.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ic, err := trie.NewIntegrityChecker(createArgsIntegrityChecker(emptyTrie()))
+
+		assert.False(t, check.IfNil(ic))
+		assert.Nil(t, err)
+	})
+}
+
+func TestIntegrityChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty trie should report nothing", func(t *testing.T) {
+		t.Parallel()
+
+		ic, _ := trie.NewIntegrityChecker(createArgsIntegrityChecker(emptyTrie()))
+		report, err := ic.Check(context.Background(), emptyTrieHash, nil)
+
+		assert.Nil(t, err)
+		assert.True(t, report.IsComplete())
+		assert.Equal(t, uint64(0), report.NumNodes)
+	})
+	t.Run("complete trie should report every node and leaf", func(t *testing.T) {
+		t.Parallel()
+
+		tr, rootHash := createCommittedTrieForIntegrityCheck(t)
+		hashes, err := tr.GetAllHashes()
+		require.Nil(t, err)
+
+		leaves := make(map[string][]byte)
+		ic, _ := trie.NewIntegrityChecker(createArgsIntegrityChecker(tr))
+		report, err := ic.Check(context.Background(), rootHash, func(key []byte, value []byte) {
+			leaves[string(key)] = value
+		})
+
+		assert.Nil(t, err)
+		assert.True(t, report.IsComplete())
+		assert.Equal(t, uint64(len(hashes)), report.NumNodes)
+		assert.Equal(t, uint64(100), report.NumLeaves)
+		assert.True(t, report.NumBytes > report.NumNodes)
+		assert.True(t, report.MaxDepth > 1)
+		assert.Equal(t, 100, len(leaves))
+		for key, value := range leaves {
+			retrieved, errGet := tr.Get([]byte(key))
+			assert.Nil(t, errGet)
+			assert.Equal(t, retrieved, value)
+		}
+	})
+	t.Run("missing and corrupted nodes should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		tr, rootHash := createCommittedTrieForIntegrityCheck(t)
+		hashes, err := tr.GetAllHashes()
+		require.Nil(t, err)
+
+		missingHash := hashes[0]
+		corruptedHash := hashes[1]
+		storageManager := tr.GetStorageManager()
+		require.Nil(t, storageManager.Remove(missingHash))
+		require.Nil(t, storageManager.Put(corruptedHash, []byte("corrupted node")))
+
+		ic, _ := trie.NewIntegrityChecker(createArgsIntegrityChecker(tr))
+		report, err := ic.Check(context.Background(), rootHash, nil)
+
+		assert.Nil(t, err)
+		assert.False(t, report.IsComplete())
+		require.Equal(t, 1, len(report.Missing))
+		assert.Equal(t, missingHash, report.Missing[0].Hash)
+		assert.NotEqual(t, 0, len(report.Missing[0].Path))
+		require.Equal(t, 1, len(report.Corrupted))
+		assert.Equal(t, corruptedHash, report.Corrupted[0].Hash)
+		assert.True(t, errors.Is(report.Corrupted[0].Error, trie.ErrNodeHashMismatch))
+	})
+	t.Run("closed context should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr, rootHash := createCommittedTrieForIntegrityCheck(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		ic, _ := trie.NewIntegrityChecker(createArgsIntegrityChecker(tr))
+		report, err := ic.Check(ctx, rootHash, nil)
+
+		assert.Nil(t, report)
+		assert.Equal(t, context.Canceled, err)
+	})
+}
@@ -0,0 +1,12 @@
+package stateStatistics
+
+import "errors"
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNoStateStatistics signals that no state statistics were computed yet
+var ErrNoStateStatistics = errors.New("no state statistics computed yet, the analysis runs at each epoch start when StateStatistics.Enabled is set")
@@ -0,0 +1,349 @@
+package stateStatistics
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+var log = logger.GetOrCreate("trie/stateStatistics")
+
+// ArgsStateStatistics holds the arguments needed for creating a new stateStatistics
+type ArgsStateStatistics struct {
+	StorageManager         common.StorageManager
+	Marshalizer            marshal.Marshalizer
+	Hasher                 hashing.Hasher
+	AddressPubkeyConverter core.PubkeyConverter
+	NumLargestDataTries    uint32
+	NumEpochsInHistory     uint32
+}
+
+type trieWalker interface {
+	Check(ctx context.Context, rootHash []byte, leafHandler func(key []byte, value []byte)) (*trie.IntegrityReport, error)
+	IsInterfaceNil() bool
+}
+
+type dataTrieRoot struct {
+	address  []byte
+	rootHash []byte
+}
+
+type epochStartRoot struct {
+	rootHash []byte
+	epoch    uint32
+}
+
+type stateStatistics struct {
+	storageManager      common.StorageManager
+	walker              trieWalker
+	marshalizer         marshal.Marshalizer
+	pubkeyConverter     core.PubkeyConverter
+	numLargestDataTries int
+	numEpochsInHistory  int
+
+	mutState   sync.RWMutex
+	inProgress bool
+	closed     bool
+	cancelFunc context.CancelFunc
+	last       *common.StateSizeAPIResponse
+	epochs     []*common.StateEpochGrowthAPIResponse
+
+	mutLastEpochStart sync.Mutex
+	lastEpochStart    *epochStartRoot
+}
+
+// NewStateStatistics creates a component able to compute the size of the state found under a root hash, per account
+// data trie, and to keep the growth of the state over the analyzed epochs. It can be used offline, by calling Analyze,
+// or online, as it starts in background, at each epoch start, the analysis of the state of the previous epoch start
+func NewStateStatistics(args ArgsStateStatistics) (*stateStatistics, error) {
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if args.NumLargestDataTries == 0 {
+		return nil, fmt.Errorf("%w for NumLargestDataTries", ErrInvalidValue)
+	}
+	if args.NumEpochsInHistory == 0 {
+		return nil, fmt.Errorf("%w for NumEpochsInHistory", ErrInvalidValue)
+	}
+
+	walker, err := trie.NewIntegrityChecker(trie.ArgsIntegrityChecker{
+		StorageManager: args.StorageManager,
+		Marshalizer:    args.Marshalizer,
+		Hasher:         args.Hasher,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateStatistics{
+		storageManager:      args.StorageManager,
+		walker:              walker,
+		marshalizer:         args.Marshalizer,
+		pubkeyConverter:     args.AddressPubkeyConverter,
+		numLargestDataTries: int(args.NumLargestDataTries),
+		numEpochsInHistory:  int(args.NumEpochsInHistory),
+		epochs:              make([]*common.StateEpochGrowthAPIResponse, 0),
+	}, nil
+}
+
+// Analyze computes the size of the state found under the provided root hash and records it for the provided epoch.
+// The analysis does not hold back the pruning, thus the walked state should not be pruned meanwhile: either the node
+// is not running or the state is the one of an epoch start block of a previous epoch. The epoch start state is
+// snapshotted in the storer of its epoch and the pruning only removes nodes from the storer of the current epoch.
+// The nodes which could not be found are counted as missing
+func (ss *stateStatistics) Analyze(ctx context.Context, rootHash []byte, epoch uint32) (*common.StateSizeAPIResponse, error) {
+	start := time.Now()
+	result := &common.StateSizeAPIResponse{
+		Epoch:            epoch,
+		RootHash:         hex.EncodeToString(rootHash),
+		LargestDataTries: make([]*common.DataTrieSizeAPIResponse, 0, ss.numLargestDataTries),
+	}
+
+	dataTries := make([]dataTrieRoot, 0)
+	accountsTrieReport, err := ss.walker.Check(ctx, rootHash, func(key []byte, value []byte) {
+		account := &state.UserAccountData{}
+		errUnmarshal := ss.marshalizer.Unmarshal(account, value)
+		if errUnmarshal != nil || !bytes.Equal(account.Address, key) {
+			// this must be a leaf with code
+			return
+		}
+
+		result.NumAccounts++
+		if len(account.RootHash) == 0 {
+			return
+		}
+
+		dataTries = append(dataTries, dataTrieRoot{
+			address:  key,
+			rootHash: account.RootHash,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.AccountsTrie = trieSizeFromReport(accountsTrieReport)
+	result.NumMissingNodes = uint64(len(accountsTrieReport.Missing) + len(accountsTrieReport.Corrupted))
+
+	for _, dataTrie := range dataTries {
+		dataTrieReport, errCheck := ss.walker.Check(ctx, dataTrie.rootHash, nil)
+		if errCheck != nil {
+			return nil, errCheck
+		}
+
+		dataTrieSize := trieSizeFromReport(dataTrieReport)
+		result.NumDataTries++
+		result.NumMissingNodes += uint64(len(dataTrieReport.Missing) + len(dataTrieReport.Corrupted))
+		addToTrieSize(&result.DataTries, dataTrieSize)
+		result.LargestDataTries = ss.addToLargest(result.LargestDataTries, dataTrie.address, dataTrieSize)
+	}
+
+	result.DurationSeconds = time.Since(start).Seconds()
+	ss.record(result)
+
+	log.Debug("state statistics computed",
+		"epoch", epoch,
+		"root hash", rootHash,
+		"num accounts", result.NumAccounts,
+		"num data tries", result.NumDataTries,
+		"num bytes", result.AccountsTrie.NumBytes+result.DataTries.NumBytes,
+		"num missing nodes", result.NumMissingNodes,
+		"duration", time.Since(start),
+	)
+
+	return result, nil
+}
+
+func trieSizeFromReport(report *trie.IntegrityReport) common.TrieSizeAPIResponse {
+	return common.TrieSizeAPIResponse{
+		NumNodes:  report.NumNodes,
+		NumLeaves: report.NumLeaves,
+		NumBytes:  report.NumBytes,
+		MaxDepth:  report.MaxDepth,
+	}
+}
+
+func addToTrieSize(total *common.TrieSizeAPIResponse, size common.TrieSizeAPIResponse) {
+	total.NumNodes += size.NumNodes
+	total.NumLeaves += size.NumLeaves
+	total.NumBytes += size.NumBytes
+	if size.MaxDepth > total.MaxDepth {
+		total.MaxDepth = size.MaxDepth
+	}
+}
+
+// addToLargest keeps the provided slice sorted by the number of bytes, the largest data trie first
+func (ss *stateStatistics) addToLargest(
+	largest []*common.DataTrieSizeAPIResponse,
+	address []byte,
+	size common.TrieSizeAPIResponse,
+) []*common.DataTrieSizeAPIResponse {
+	if len(largest) == ss.numLargestDataTries && largest[len(largest)-1].NumBytes >= size.NumBytes {
+		return largest
+	}
+
+	index := sort.Search(len(largest), func(i int) bool {
+		return largest[i].NumBytes < size.NumBytes
+	})
+	entry := &common.DataTrieSizeAPIResponse{
+		Address:             ss.pubkeyConverter.Encode(address),
+		TrieSizeAPIResponse: size,
+	}
+
+	largest = append(largest, nil)
+	copy(largest[index+1:], largest[index:])
+	largest[index] = entry
+
+	if len(largest) > ss.numLargestDataTries {
+		largest = largest[:ss.numLargestDataTries]
+	}
+
+	return largest
+}
+
+func (ss *stateStatistics) record(result *common.StateSizeAPIResponse) {
+	ss.mutState.Lock()
+	defer ss.mutState.Unlock()
+
+	ss.last = result
+
+	numBytes := result.AccountsTrie.NumBytes + result.DataTries.NumBytes
+	epochs := make([]*common.StateEpochGrowthAPIResponse, 0, len(ss.epochs)+1)
+	for _, epochGrowth := range ss.epochs {
+		if epochGrowth.Epoch < result.Epoch {
+			epochs = append(epochs, epochGrowth)
+		}
+	}
+
+	growth := int64(0)
+	if len(epochs) > 0 {
+		growth = int64(numBytes) - int64(epochs[len(epochs)-1].NumBytes)
+	}
+	epochs = append(epochs, &common.StateEpochGrowthAPIResponse{
+		Epoch:       result.Epoch,
+		NumBytes:    numBytes,
+		GrowthBytes: growth,
+	})
+
+	if len(epochs) > ss.numEpochsInHistory {
+		epochs = epochs[len(epochs)-ss.numEpochsInHistory:]
+	}
+	ss.epochs = epochs
+}
+
+// StartAnalysis starts analyzing the state found under the provided root hash in background. It returns false if an
+// analysis is already in progress, the new one being skipped
+func (ss *stateStatistics) StartAnalysis(rootHash []byte, epoch uint32) bool {
+	ss.mutState.Lock()
+	defer ss.mutState.Unlock()
+
+	if ss.inProgress || ss.closed {
+		return false
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ss.inProgress = true
+	ss.cancelFunc = cancel
+
+	go func() {
+		_, err := ss.Analyze(ctx, rootHash, epoch)
+		if err != nil {
+			log.Warn("state statistics analysis failed", "epoch", epoch, "root hash", rootHash, "error", err)
+		}
+
+		ss.mutState.Lock()
+		ss.inProgress = false
+		ss.cancelFunc = nil
+		ss.mutState.Unlock()
+		cancel()
+	}()
+
+	return true
+}
+
+// GetStateStatistics returns the last state size analysis and the state growth over the analyzed epochs
+func (ss *stateStatistics) GetStateStatistics() (*common.StateStatisticsAPIResponse, error) {
+	ss.mutState.RLock()
+	defer ss.mutState.RUnlock()
+
+	if ss.last == nil && !ss.inProgress {
+		return nil, ErrNoStateStatistics
+	}
+
+	epochs := make([]*common.StateEpochGrowthAPIResponse, len(ss.epochs))
+	copy(epochs, ss.epochs)
+
+	return &common.StateStatisticsAPIResponse{
+		InProgress: ss.inProgress,
+		Last:       ss.last,
+		Epochs:     epochs,
+	}, nil
+}
+
+// EpochStartAction starts the analysis of the state of the previous epoch start block, which can no longer be pruned,
+// and keeps the state of the current one for the next epoch start
+func (ss *stateStatistics) EpochStartAction(hdr data.HeaderHandler) {
+	if check.IfNil(hdr) {
+		return
+	}
+
+	ss.mutLastEpochStart.Lock()
+	previous := ss.lastEpochStart
+	ss.lastEpochStart = &epochStartRoot{
+		rootHash: hdr.GetRootHash(),
+		epoch:    hdr.GetEpoch(),
+	}
+	ss.mutLastEpochStart.Unlock()
+
+	if previous == nil || previous.epoch >= hdr.GetEpoch() {
+		log.Debug("state statistics analysis postponed to the next epoch start, until the state can no longer be pruned",
+			"epoch", hdr.GetEpoch())
+		return
+	}
+
+	started := ss.StartAnalysis(previous.rootHash, previous.epoch)
+	if !started {
+		log.Debug("state statistics analysis skipped, the previous one is still in progress", "epoch", previous.epoch)
+	}
+}
+
+// EpochStartPrepare does nothing
+func (ss *stateStatistics) EpochStartPrepare(_ data.HeaderHandler, _ data.BodyHandler) {
+}
+
+// NotifyOrder returns the notification order for a start of epoch event
+func (ss *stateStatistics) NotifyOrder() uint32 {
+	return common.StateStatisticsOrder
+}
+
+// Close stops the analysis in progress, if any
+func (ss *stateStatistics) Close() error {
+	ss.mutState.Lock()
+	defer ss.mutState.Unlock()
+
+	ss.closed = true
+	if ss.cancelFunc != nil {
+		ss.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ss *stateStatistics) IsInterfaceNil() bool {
+	return ss == nil
+}
@@ -0,0 +1,287 @@
+package stateStatistics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/hashesHolder/disabled"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	marshalizerForTest = &marshal.GogoProtoMarshalizer{}
+	hasherForTest      = blake2b.NewBlake2b()
+)
+
+func createStorageManagerForTest(t *testing.T) common.StorageManager {
+	storageManager, err := trie.NewTrieStorageManager(trie.NewTrieStorageManagerArgs{
+		MainStorer:        testscommon.NewSnapshotPruningStorerMock(),
+		CheckpointsStorer: testscommon.NewSnapshotPruningStorerMock(),
+		Marshalizer:       marshalizerForTest,
+		Hasher:            hasherForTest,
+		GeneralConfig: config.TrieStorageManagerConfig{
+			SnapshotsBufferLen:    10,
+			SnapshotsGoroutineNum: 1,
+		},
+		CheckpointHashesHolder: disabled.NewDisabledCheckpointHashesHolder(),
+		IdleProvider:           &testscommon.ProcessStatusHandlerStub{},
+	})
+	require.Nil(t, err)
+
+	return storageManager
+}
+
+func createCommittedTrieForTest(t *testing.T, storageManager common.StorageManager, numLeaves int) []byte {
+	tr, err := trie.NewTrie(storageManager, marshalizerForTest, hasherForTest, 5)
+	require.Nil(t, err)
+
+	for i := 0; i < numLeaves; i++ {
+		require.Nil(t, tr.Update([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+// createStateForTest creates an accounts trie with one account for each provided number of data trie leaves
+func createStateForTest(t *testing.T, storageManager common.StorageManager, numDataTrieLeaves ...int) []byte {
+	tr, err := trie.NewTrie(storageManager, marshalizerForTest, hasherForTest, 5)
+	require.Nil(t, err)
+
+	for i, numLeaves := range numDataTrieLeaves {
+		account := &state.UserAccountData{
+			Address: []byte(fmt.Sprintf("address%025d", i)),
+		}
+		if numLeaves > 0 {
+			account.RootHash = createCommittedTrieForTest(t, storageManager, numLeaves)
+		}
+
+		accountBytes, errMarshal := marshalizerForTest.Marshal(account)
+		require.Nil(t, errMarshal)
+		require.Nil(t, tr.Update(account.Address, accountBytes))
+	}
+	require.Nil(t, tr.Update([]byte("code hash"), []byte("code")))
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+func createMockArgsStateStatistics(storageManager common.StorageManager) ArgsStateStatistics {
+	return ArgsStateStatistics{
+		StorageManager:         storageManager,
+		Marshalizer:            marshalizerForTest,
+		Hasher:                 hasherForTest,
+		AddressPubkeyConverter: testscommon.NewPubkeyConverterMock(32),
+		NumLargestDataTries:    2,
+		NumEpochsInHistory:     3,
+	}
+}
+
+func TestNewStateStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateStatistics(nil)
+		ss, err := NewStateStatistics(args)
+		assert.True(t, check.IfNil(ss))
+		assert.Equal(t, trie.ErrNilTrieStorage, err)
+	})
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateStatistics(createStorageManagerForTest(t))
+		args.AddressPubkeyConverter = nil
+		ss, err := NewStateStatistics(args)
+		assert.True(t, check.IfNil(ss))
+		assert.Equal(t, ErrNilPubkeyConverter, err)
+	})
+	t.Run("invalid num largest data tries should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateStatistics(createStorageManagerForTest(t))
+		args.NumLargestDataTries = 0
+		ss, err := NewStateStatistics(args)
+		assert.True(t, check.IfNil(ss))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("invalid num epochs in history should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateStatistics(createStorageManagerForTest(t))
+		args.NumEpochsInHistory = 0
+		ss, err := NewStateStatistics(args)
+		assert.True(t, check.IfNil(ss))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ss, err := NewStateStatistics(createMockArgsStateStatistics(createStorageManagerForTest(t)))
+		assert.False(t, check.IfNil(ss))
+		assert.Nil(t, err)
+	})
+}
+
+func TestStateStatistics_AnalyzeShouldComputeTheLargestDataTries(t *testing.T) {
+	t.Parallel()
+
+	storageManager := createStorageManagerForTest(t)
+	rootHash := createStateForTest(t, storageManager, 10, 0, 100, 50)
+
+	ss, _ := NewStateStatistics(createMockArgsStateStatistics(storageManager))
+	result, err := ss.Analyze(context.Background(), rootHash, 7)
+	require.Nil(t, err)
+
+	assert.Equal(t, uint32(7), result.Epoch)
+	assert.Equal(t, uint64(4), result.NumAccounts)
+	assert.Equal(t, uint64(3), result.NumDataTries)
+	assert.Equal(t, uint64(5), result.AccountsTrie.NumLeaves)
+	assert.Equal(t, uint64(160), result.DataTries.NumLeaves)
+	assert.Equal(t, uint64(0), result.NumMissingNodes)
+
+	require.Equal(t, 2, len(result.LargestDataTries))
+	assert.Equal(t, uint64(100), result.LargestDataTries[0].NumLeaves)
+	assert.Equal(t, uint64(50), result.LargestDataTries[1].NumLeaves)
+	assert.True(t, result.LargestDataTries[0].NumBytes > result.LargestDataTries[1].NumBytes)
+}
+
+func TestStateStatistics_AnalyzeShouldRecordTheGrowthPerEpoch(t *testing.T) {
+	t.Parallel()
+
+	storageManager := createStorageManagerForTest(t)
+	ss, _ := NewStateStatistics(createMockArgsStateStatistics(storageManager))
+
+	_, err := ss.GetStateStatistics()
+	assert.Equal(t, ErrNoStateStatistics, err)
+
+	for epoch := uint32(1); epoch <= 4; epoch++ {
+		rootHash := createStateForTest(t, storageManager, int(epoch)*10)
+		_, err = ss.Analyze(context.Background(), rootHash, epoch)
+		require.Nil(t, err)
+	}
+
+	statistics, err := ss.GetStateStatistics()
+	require.Nil(t, err)
+	assert.False(t, statistics.InProgress)
+	assert.Equal(t, uint32(4), statistics.Last.Epoch)
+
+	require.Equal(t, 3, len(statistics.Epochs))
+	for i, epochGrowth := range statistics.Epochs {
+		assert.Equal(t, uint32(i+2), epochGrowth.Epoch)
+		assert.True(t, epochGrowth.GrowthBytes > 0)
+	}
+	assert.Equal(t, int64(statistics.Epochs[2].NumBytes-statistics.Epochs[1].NumBytes), statistics.Epochs[2].GrowthBytes)
+}
+
+func TestStateStatistics_AnalyzeShouldCountMissingNodes(t *testing.T) {
+	t.Parallel()
+
+	storageManager := createStorageManagerForTest(t)
+	dataTrieRootHash := createCommittedTrieForTest(t, storageManager, 10)
+	rootHash := createStateForTest(t, storageManager, 10)
+	require.Nil(t, storageManager.Remove(dataTrieRootHash))
+
+	ss, _ := NewStateStatistics(createMockArgsStateStatistics(storageManager))
+	result, err := ss.Analyze(context.Background(), rootHash, 1)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), result.NumMissingNodes)
+	assert.Equal(t, uint64(1), result.NumDataTries)
+	assert.Equal(t, uint64(0), result.DataTries.NumNodes)
+}
+
+func TestStateStatistics_EpochStartActionShouldAnalyzeInBackground(t *testing.T) {
+	t.Parallel()
+
+	storageManager := createStorageManagerForTest(t)
+	rootHash := createStateForTest(t, storageManager, 10)
+	ss, _ := NewStateStatistics(createMockArgsStateStatistics(storageManager))
+
+	ss.EpochStartAction(&block.Header{Epoch: 2, RootHash: rootHash})
+	time.Sleep(time.Millisecond * 100)
+
+	_, err := ss.GetStateStatistics()
+	assert.Equal(t, ErrNoStateStatistics, err)
+
+	ss.EpochStartAction(&block.Header{Epoch: 3, RootHash: []byte("root hash")})
+
+	require.Eventually(t, func() bool {
+		statistics, err := ss.GetStateStatistics()
+		return err == nil && !statistics.InProgress && statistics.Last != nil
+	}, time.Second*5, time.Millisecond*10)
+
+	statistics, _ := ss.GetStateStatistics()
+	assert.Equal(t, uint32(2), statistics.Last.Epoch)
+	assert.Zero(t, statistics.Last.NumMissingNodes)
+	assert.Equal(t, uint32(common.StateStatisticsOrder), ss.NotifyOrder())
+}
+
+type pruningDuringWalkStorageManager struct {
+	common.StorageManager
+	onGet func()
+}
+
+// Get -
+func (psm *pruningDuringWalkStorageManager) Get(key []byte) ([]byte, error) {
+	psm.onGet()
+
+	return psm.StorageManager.Get(key)
+}
+
+func TestStateStatistics_AnalyzeShouldNotBlockThePruning(t *testing.T) {
+	t.Parallel()
+
+	storageManager := createStorageManagerForTest(t)
+	rootHash := createStateForTest(t, storageManager, 10)
+	prunedRootHash := createCommittedTrieForTest(t, storageManager, 3)
+
+	numGets := 0
+	wrappedStorageManager := &pruningDuringWalkStorageManager{
+		StorageManager: storageManager,
+		onGet: func() {
+			numGets++
+			assert.False(t, storageManager.IsPruningBlocked())
+			if numGets == 2 {
+				assert.Nil(t, storageManager.Remove(prunedRootHash))
+			}
+		},
+	}
+	ss, _ := NewStateStatistics(createMockArgsStateStatistics(wrappedStorageManager))
+
+	statistics, err := ss.Analyze(context.Background(), rootHash, 1)
+	require.Nil(t, err)
+	assert.Zero(t, statistics.NumMissingNodes)
+	assert.True(t, numGets > 2)
+
+	_, err = storageManager.Get(prunedRootHash)
+	assert.NotNil(t, err)
+}
+
+func TestStateStatistics_StartAnalysisAfterCloseShouldNotStart(t *testing.T) {
+	t.Parallel()
+
+	ss, _ := NewStateStatistics(createMockArgsStateStatistics(createStorageManagerForTest(t)))
+	require.Nil(t, ss.Close())
+
+	assert.False(t, ss.StartAnalysis([]byte("root hash"), 1))
+}